@@ -0,0 +1,167 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+)
+
+// balanceDeltasEpochsKept is the number of most recent epoch transitions for which
+// the balance deltas of the tracked validators are kept in memory.
+const balanceDeltasEpochsKept = 32
+
+// ValidatorBalanceDelta is the balance change of a tracked validator across an epoch transition,
+// attributed to its causes where derivable from the state transition.
+//
+// The attribution is an approximation with the following limits:
+//   - The rewards and penalties are computed from the pre-state of the first block of the epoch,
+//     which has the same participation records as the state processed by the epoch transition.
+//   - The balance after the transition is read from the post-state of that block, so balance changes
+//     caused by its operations (slashings, deposits) are part of the delta but not of any category.
+//   - Slashing penalties applied during the epoch transition are also not attributed.
+//   - Epoch transitions with no blocks in between and blocks processed during initial sync are skipped.
+type ValidatorBalanceDelta struct {
+	ValidatorIndex    types.ValidatorIndex
+	Epoch             types.Epoch
+	BalanceBefore     uint64
+	BalanceAfter      uint64
+	AttestationReward uint64
+	ProposerReward    uint64
+	Penalty           uint64
+}
+
+// tracksBalanceDeltas returns whether validators are tracked and a block at the given slot on top of
+// the given pre-state crosses exactly one epoch transition.
+func (s *Service) tracksBalanceDeltas(preState iface.ReadOnlyBeaconState, slot types.Slot) bool {
+	return len(s.trackedValidators) != 0 && helpers.SlotToEpoch(slot) == helpers.CurrentEpoch(preState)+1
+}
+
+// trackBalanceDeltas attributes and records the balance deltas of the tracked validators for the epoch
+// transition crossed by a block at the given slot. It is run in the background, as the epoch precompute
+// would otherwise delay the processing of the block, so the pre-state must not be modified afterwards.
+func (s *Service) trackBalanceDeltas(preState iface.BeaconState, slot types.Slot, postState iface.ReadOnlyBeaconState) {
+	// The block is processed by the time this runs, so the context of its processing is not used.
+	ctx, cancel := context.WithTimeout(context.Background(), slotDeadline)
+	defer cancel()
+	deltas, err := s.attributeBalanceDeltas(ctx, preState, slot)
+	if err != nil {
+		log.WithError(err).Error("Could not compute balance deltas of tracked validators")
+		return
+	}
+	if err := s.recordBalanceDeltas(deltas, postState); err != nil {
+		log.WithError(err).Error("Could not record balance deltas of tracked validators")
+	}
+}
+
+// attributeBalanceDeltas computes the rewards and penalties of the tracked validators
+// for the epoch transition crossed by a block at the given slot on top of the given pre-state.
+// It returns nil if no validator is tracked or the block does not cross exactly one epoch transition.
+func (s *Service) attributeBalanceDeltas(ctx context.Context, preState iface.BeaconState, slot types.Slot) ([]*ValidatorBalanceDelta, error) {
+	if !s.tracksBalanceDeltas(preState, slot) {
+		return nil, nil
+	}
+	epochState := preState.Copy()
+	vp, bp, err := precompute.New(ctx, epochState)
+	if err != nil {
+		return nil, err
+	}
+	vp, bp, err = precompute.ProcessAttestations(ctx, epochState, vp, bp)
+	if err != nil {
+		return nil, err
+	}
+	// The rewards depend on the finality delay, which is updated before they are applied.
+	epochState, err = precompute.ProcessJustificationAndFinalizationPreCompute(epochState, bp)
+	if err != nil {
+		return nil, err
+	}
+	attRewards, attPenalties, err := precompute.AttestationsDelta(epochState, bp, vp)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attestation delta")
+	}
+	proposerRewards, err := precompute.ProposersDelta(epochState, bp, vp)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposer delta")
+	}
+
+	deltas := make([]*ValidatorBalanceDelta, 0, len(s.trackedValidators))
+	for idx := range s.trackedValidators {
+		if uint64(idx) >= uint64(len(vp)) {
+			continue
+		}
+		bal, err := preState.BalanceAtIndex(idx)
+		if err != nil {
+			return nil, err
+		}
+		deltas = append(deltas, &ValidatorBalanceDelta{
+			ValidatorIndex:    idx,
+			Epoch:             helpers.SlotToEpoch(slot),
+			BalanceBefore:     bal,
+			AttestationReward: attRewards[idx],
+			ProposerReward:    proposerRewards[idx],
+			Penalty:           attPenalties[idx],
+		})
+	}
+	sort.Slice(deltas, func(i, j int) bool {
+		return deltas[i].ValidatorIndex < deltas[j].ValidatorIndex
+	})
+	return deltas, nil
+}
+
+// recordBalanceDeltas completes the given balance deltas with the balances of the post-state,
+// reports them as metrics and keeps them in memory to be served over RPC. Only the first
+// recorded block of each epoch is accounted for, deltas of competing forks are ignored.
+func (s *Service) recordBalanceDeltas(deltas []*ValidatorBalanceDelta, postState iface.ReadOnlyBeaconState) error {
+	if len(deltas) == 0 {
+		return nil
+	}
+	epoch := deltas[0].Epoch
+
+	s.balanceDeltasLock.Lock()
+	defer s.balanceDeltasLock.Unlock()
+	if n := len(s.balanceDeltas); n > 0 && s.balanceDeltas[n-1].Epoch >= epoch {
+		return nil
+	}
+	for _, d := range deltas {
+		bal, err := postState.BalanceAtIndex(d.ValidatorIndex)
+		if err != nil {
+			return err
+		}
+		d.BalanceAfter = bal
+
+		index := fmt.Sprintf("%d", d.ValidatorIndex)
+		trackedValidatorBalanceChange.WithLabelValues(index, "total").Set(float64(int64(d.BalanceAfter) - int64(d.BalanceBefore)))
+		trackedValidatorBalanceChange.WithLabelValues(index, "attestation_reward").Set(float64(d.AttestationReward))
+		trackedValidatorBalanceChange.WithLabelValues(index, "proposer_reward").Set(float64(d.ProposerReward))
+		trackedValidatorBalanceChange.WithLabelValues(index, "penalty").Set(float64(d.Penalty))
+	}
+
+	s.balanceDeltas = append(s.balanceDeltas, deltas...)
+	if epoch >= balanceDeltasEpochsKept {
+		oldest := epoch - balanceDeltasEpochsKept + 1
+		i := 0
+		for i < len(s.balanceDeltas) && s.balanceDeltas[i].Epoch < oldest {
+			i++
+		}
+		s.balanceDeltas = s.balanceDeltas[i:]
+	}
+	return nil
+}
+
+// ValidatorBalanceDeltas returns the balance deltas recorded for the tracked validators over the
+// recent epoch transitions, ordered by epoch and then by validator index.
+func (s *Service) ValidatorBalanceDeltas() []*ValidatorBalanceDelta {
+	s.balanceDeltasLock.RLock()
+	defer s.balanceDeltasLock.RUnlock()
+	deltas := make([]*ValidatorBalanceDelta, len(s.balanceDeltas))
+	for i, d := range s.balanceDeltas {
+		copied := *d
+		deltas[i] = &copied
+	}
+	return deltas
+}
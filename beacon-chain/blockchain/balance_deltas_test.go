@@ -0,0 +1,83 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_AttributeBalanceDeltas(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{trackedValidators: map[types.ValidatorIndex]bool{3: true, 1: true, 1000: true}}
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*2-1))
+
+	deltas, err := s.attributeBalanceDeltas(ctx, st, st.Slot())
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(deltas), "Expected no deltas without an epoch transition")
+
+	deltas, err = s.attributeBalanceDeltas(ctx, st, st.Slot()+1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(deltas), "Expected deltas only for the tracked validators in the registry")
+	assert.Equal(t, types.ValidatorIndex(1), deltas[0].ValidatorIndex)
+	assert.Equal(t, types.ValidatorIndex(3), deltas[1].ValidatorIndex)
+	for _, d := range deltas {
+		assert.Equal(t, types.Epoch(2), d.Epoch)
+		assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, d.BalanceBefore)
+		// No attestation was included during the previous epoch.
+		assert.Equal(t, uint64(0), d.AttestationReward)
+		assert.NotEqual(t, uint64(0), d.Penalty)
+	}
+
+	deltas, err = s.attributeBalanceDeltas(ctx, st, st.Slot()+1+params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(deltas), "Expected no deltas when crossing several epoch transitions")
+}
+
+func TestService_RecordBalanceDeltas(t *testing.T) {
+	s := &Service{}
+	st, _ := testutil.DeterministicGenesisState(t, 8)
+	require.NoError(t, st.UpdateBalancesAtIndex(2, params.BeaconConfig().MaxEffectiveBalance-100))
+
+	delta := func(epoch types.Epoch) []*ValidatorBalanceDelta {
+		return []*ValidatorBalanceDelta{{
+			ValidatorIndex: 2,
+			Epoch:          epoch,
+			BalanceBefore:  params.BeaconConfig().MaxEffectiveBalance,
+			Penalty:        100,
+		}}
+	}
+	require.NoError(t, s.recordBalanceDeltas(delta(1), st))
+	// A competing block of the same epoch is ignored.
+	require.NoError(t, s.recordBalanceDeltas(delta(1), st))
+	got := s.ValidatorBalanceDeltas()
+	require.Equal(t, 1, len(got))
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance-100, got[0].BalanceAfter)
+
+	for epoch := types.Epoch(2); epoch <= balanceDeltasEpochsKept+4; epoch++ {
+		require.NoError(t, s.recordBalanceDeltas(delta(epoch), st))
+	}
+	got = s.ValidatorBalanceDeltas()
+	require.Equal(t, balanceDeltasEpochsKept, len(got))
+	assert.Equal(t, types.Epoch(5), got[0].Epoch)
+	assert.Equal(t, types.Epoch(balanceDeltasEpochsKept+4), got[len(got)-1].Epoch)
+}
+
+func TestService_TrackBalanceDeltas(t *testing.T) {
+	s := &Service{trackedValidators: map[types.ValidatorIndex]bool{1: true}}
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*2-1))
+	assert.Equal(t, false, s.tracksBalanceDeltas(st, st.Slot()))
+	require.Equal(t, true, s.tracksBalanceDeltas(st, st.Slot()+1))
+
+	s.trackBalanceDeltas(st, st.Slot()+1, st)
+	got := s.ValidatorBalanceDeltas()
+	require.Equal(t, 1, len(got))
+	assert.Equal(t, types.ValidatorIndex(1), got[0].ValidatorIndex)
+	assert.Equal(t, types.Epoch(2), got[0].Epoch)
+}
@@ -50,6 +50,29 @@ type HeadFetcher interface {
 	ProtoArrayStore() *protoarray.Store
 }
 
+// HeadUpdater defines a common interface for methods in blockchain service which
+// trigger a fork choice head update on demand.
+type HeadUpdater interface {
+	UpdateHead(ctx context.Context) ([]byte, error)
+}
+
+// BalanceDeltasFetcher retrieves the balance deltas recorded for the tracked validators
+// across the recent epoch transitions.
+type BalanceDeltasFetcher interface {
+	ValidatorBalanceDeltas() []*ValidatorBalanceDelta
+}
+
+// EffectivenessFetcher retrieves the attestation effectiveness scores recorded for the tracked
+// validators over the recent epochs.
+type EffectivenessFetcher interface {
+	AttestationEffectiveness() []*AttestationEffectiveness
+}
+
+// CheckpointHistoryFetcher retrieves the recorded changes of the justified and finalized checkpoints.
+type CheckpointHistoryFetcher interface {
+	CheckpointHistory() []*CheckpointRecord
+}
+
 // ForkFetcher retrieves the current fork information of the Ethereum beacon chain.
 type ForkFetcher interface {
 	CurrentFork() *pb.Fork
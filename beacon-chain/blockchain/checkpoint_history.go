@@ -0,0 +1,96 @@
+package blockchain
+
+import (
+	"bytes"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// checkpointHistorySize is the number of justified and finalized checkpoint changes kept in memory.
+const checkpointHistorySize = 1024
+
+// CheckpointRecord is the justified and finalized checkpoints of the node's fork choice store
+// after one of them changed, along with the time of the change.
+type CheckpointRecord struct {
+	Time      time.Time
+	Slot      types.Slot
+	Justified *ethpb.Checkpoint
+	Finalized *ethpb.Checkpoint
+	// JustificationBits of the post state of the block which changed the checkpoints. They are
+	// not known for the blocks processed in batches during initial sync.
+	JustificationBits bitfield.Bitvector4
+}
+
+// checkpointRing is a ring buffer of the most recent checkpoint records.
+type checkpointRing struct {
+	records []*CheckpointRecord
+	start   int
+}
+
+func (r *checkpointRing) push(record *CheckpointRecord) {
+	if len(r.records) < checkpointHistorySize {
+		r.records = append(r.records, record)
+		return
+	}
+	r.records[r.start] = record
+	r.start = (r.start + 1) % len(r.records)
+}
+
+func (r *checkpointRing) last() *CheckpointRecord {
+	if len(r.records) == 0 {
+		return nil
+	}
+	return r.records[(r.start+len(r.records)-1)%len(r.records)]
+}
+
+// ordered returns the records from the oldest to the most recent one.
+func (r *checkpointRing) ordered() []*CheckpointRecord {
+	records := make([]*CheckpointRecord, 0, len(r.records))
+	records = append(records, r.records[r.start:]...)
+	return append(records, r.records[:r.start]...)
+}
+
+// recordCheckpoints records the justified and finalized checkpoints of the store if either of them
+// changed since the last record. The given block slot and justification bits are those of the block
+// being processed, the bits may be nil.
+func (s *Service) recordCheckpoints(slot types.Slot, bits bitfield.Bitvector4) {
+	s.checkpointHistoryLock.Lock()
+	defer s.checkpointHistoryLock.Unlock()
+	if s.justifiedCheckpt == nil || s.finalizedCheckpt == nil {
+		return
+	}
+	last := s.checkpointHistory.last()
+	if last != nil && sameCheckpoint(last.Justified, s.justifiedCheckpt) &&
+		sameCheckpoint(last.Finalized, s.finalizedCheckpt) {
+		return
+	}
+	var copiedBits bitfield.Bitvector4
+	if bits != nil {
+		copiedBits = make(bitfield.Bitvector4, len(bits))
+		copy(copiedBits, bits)
+	}
+	s.checkpointHistory.push(&CheckpointRecord{
+		Time:              timeutils.Now(),
+		Slot:              slot,
+		Justified:         stateTrie.CopyCheckpoint(s.justifiedCheckpt),
+		Finalized:         stateTrie.CopyCheckpoint(s.finalizedCheckpt),
+		JustificationBits: copiedBits,
+	})
+}
+
+func sameCheckpoint(a, b *ethpb.Checkpoint) bool {
+	return a.Epoch == b.Epoch && bytes.Equal(a.Root, b.Root)
+}
+
+// CheckpointHistory returns the recorded changes of the justified and finalized checkpoints, from the
+// oldest to the most recent one.
+func (s *Service) CheckpointHistory() []*CheckpointRecord {
+	s.checkpointHistoryLock.RLock()
+	defer s.checkpointHistoryLock.RUnlock()
+	return s.checkpointHistory.ordered()
+}
@@ -0,0 +1,56 @@
+package blockchain
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_RecordCheckpoints(t *testing.T) {
+	s := &Service{}
+	// Nothing is recorded before the store is initialized.
+	s.recordCheckpoints(1, nil)
+	assert.Equal(t, 0, len(s.CheckpointHistory()))
+
+	s.justifiedCheckpt = &ethpb.Checkpoint{Epoch: 1, Root: []byte{'a'}}
+	s.finalizedCheckpt = &ethpb.Checkpoint{Epoch: 0, Root: []byte{'g'}}
+	bits := bitfield.Bitvector4{0x01}
+	s.recordCheckpoints(33, bits)
+	// Unchanged checkpoints are not recorded again.
+	s.recordCheckpoints(34, bits)
+	s.justifiedCheckpt = &ethpb.Checkpoint{Epoch: 2, Root: []byte{'b'}}
+	s.recordCheckpoints(65, nil)
+
+	history := s.CheckpointHistory()
+	require.Equal(t, 2, len(history))
+	assert.Equal(t, types.Slot(33), history[0].Slot)
+	assert.Equal(t, types.Epoch(1), history[0].Justified.Epoch)
+	assert.DeepEqual(t, bitfield.Bitvector4{0x01}, history[0].JustificationBits)
+	assert.Equal(t, types.Slot(65), history[1].Slot)
+	assert.Equal(t, types.Epoch(2), history[1].Justified.Epoch)
+	assert.Equal(t, 0, len(history[1].JustificationBits))
+
+	// The recorded checkpoints are copies.
+	s.justifiedCheckpt.Root[0] = 'c'
+	bits[0] = 0x0f
+	assert.DeepEqual(t, []byte{'b'}, s.CheckpointHistory()[1].Justified.Root)
+	assert.DeepEqual(t, bitfield.Bitvector4{0x01}, s.CheckpointHistory()[0].JustificationBits)
+}
+
+func TestCheckpointRing(t *testing.T) {
+	r := &checkpointRing{}
+	assert.Equal(t, (*CheckpointRecord)(nil), r.last())
+	for i := 0; i < checkpointHistorySize+10; i++ {
+		r.push(&CheckpointRecord{Slot: types.Slot(i)})
+	}
+	records := r.ordered()
+	require.Equal(t, checkpointHistorySize, len(records))
+	for i, record := range records {
+		assert.Equal(t, types.Slot(i+10), record.Slot)
+	}
+	assert.Equal(t, types.Slot(checkpointHistorySize+9), r.last().Slot)
+}
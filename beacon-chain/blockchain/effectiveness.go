@@ -0,0 +1,167 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+// AttestationEffectiveness scores how optimal the attestation of a tracked validator was for an epoch,
+// based on the attestations of the epoch included in the canonical chain by the end of the next epoch.
+//
+// The score is between 0 and 1 and gives an equal weight to the three votes and to the inclusion delay:
+//
+//	score = (source + target + head + 1/inclusion_distance) / 4
+//
+// where source, target and head are 1 if the vote of the included attestation was correct and 0
+// otherwise. An attestation is only included with a correct source, and the optimal inclusion distance
+// is 1 slot. A validator whose attestation wasn't included in time scores 0.
+type AttestationEffectiveness struct {
+	ValidatorIndex    types.ValidatorIndex
+	Epoch             types.Epoch
+	Included          bool
+	CorrectTarget     bool
+	CorrectHead       bool
+	InclusionDistance types.Slot
+	Score             float64
+}
+
+// scoreAttestationEffectiveness computes the attestation effectiveness for the previous epoch of
+// a validator from its precomputed attesting records.
+func scoreAttestationEffectiveness(idx types.ValidatorIndex, epoch types.Epoch, v *precompute.Validator) *AttestationEffectiveness {
+	e := &AttestationEffectiveness{
+		ValidatorIndex: idx,
+		Epoch:          epoch,
+		Included:       v.IsPrevEpochAttester,
+		CorrectTarget:  v.IsPrevEpochTargetAttester,
+		CorrectHead:    v.IsPrevEpochHeadAttester,
+	}
+	if !e.Included || v.InclusionDistance == 0 {
+		return e
+	}
+	e.InclusionDistance = v.InclusionDistance
+	votes := 1.0
+	if e.CorrectTarget {
+		votes++
+	}
+	if e.CorrectHead {
+		votes++
+	}
+	e.Score = (votes + 1/float64(v.InclusionDistance)) / 4
+	return e
+}
+
+// scoreEffectivenessRoutine scores the attestations of the tracked validators at the last slot of each
+// epoch, in the background so that block processing is not delayed.
+func (s *Service) scoreEffectivenessRoutine() {
+	if len(s.trackedValidators) == 0 {
+		return
+	}
+	for s.genesisTime.IsZero() {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+
+	ticker := slotutil.NewSlotTicker(s.genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case slot := <-ticker.C():
+			if !helpers.IsEpochEnd(slot) {
+				continue
+			}
+			scores, err := s.scoreHeadEffectiveness(s.ctx, helpers.SlotToEpoch(slot))
+			if err != nil {
+				log.WithError(err).Error("Could not score attestation effectiveness of tracked validators")
+				continue
+			}
+			s.recordAttestationEffectiveness(scores)
+		}
+	}
+}
+
+// scoreHeadEffectiveness computes the attestation effectiveness of the tracked validators for the
+// epoch before the given one, from the attestations included in the canonical chain up to the head.
+// The attestations of an epoch can be included until the end of the next epoch, so nothing is scored
+// unless the head is in the given epoch, such as while the node is syncing.
+func (s *Service) scoreHeadEffectiveness(ctx context.Context, epoch types.Epoch) ([]*AttestationEffectiveness, error) {
+	headState, err := s.HeadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if headState == nil || headState.IsNil() || epoch == 0 || helpers.CurrentEpoch(headState) != epoch {
+		return nil, nil
+	}
+	vp, bp, err := precompute.New(ctx, headState)
+	if err != nil {
+		return nil, err
+	}
+	vp, _, err = precompute.ProcessAttestations(ctx, headState, vp, bp)
+	if err != nil {
+		return nil, err
+	}
+	scores := make([]*AttestationEffectiveness, 0, len(s.trackedValidators))
+	for idx := range s.trackedValidators {
+		if uint64(idx) >= uint64(len(vp)) || !vp[idx].IsActivePrevEpoch {
+			continue
+		}
+		scores = append(scores, scoreAttestationEffectiveness(idx, epoch-1, vp[idx]))
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		return scores[i].ValidatorIndex < scores[j].ValidatorIndex
+	})
+	return scores, nil
+}
+
+// recordAttestationEffectiveness reports the given effectiveness scores as metrics and keeps them in
+// memory to be served over RPC. The scores of an epoch are only recorded once.
+func (s *Service) recordAttestationEffectiveness(scores []*AttestationEffectiveness) {
+	if len(scores) == 0 {
+		return
+	}
+	epoch := scores[0].Epoch
+
+	s.effectivenessLock.Lock()
+	defer s.effectivenessLock.Unlock()
+	if n := len(s.effectiveness); n > 0 && s.effectiveness[n-1].Epoch >= epoch {
+		return
+	}
+	for _, e := range scores {
+		trackedValidatorEffectiveness.WithLabelValues(fmt.Sprintf("%d", e.ValidatorIndex)).Set(e.Score)
+	}
+
+	s.effectiveness = append(s.effectiveness, scores...)
+	if epoch >= balanceDeltasEpochsKept {
+		oldest := epoch - balanceDeltasEpochsKept + 1
+		i := 0
+		for i < len(s.effectiveness) && s.effectiveness[i].Epoch < oldest {
+			i++
+		}
+		s.effectiveness = s.effectiveness[i:]
+	}
+}
+
+// AttestationEffectiveness returns the attestation effectiveness scores of the tracked validators over
+// the recent epochs, ordered by epoch and then by validator index.
+func (s *Service) AttestationEffectiveness() []*AttestationEffectiveness {
+	s.effectivenessLock.RLock()
+	defer s.effectivenessLock.RUnlock()
+	scores := make([]*AttestationEffectiveness, len(s.effectiveness))
+	for i, e := range s.effectiveness {
+		copied := *e
+		scores[i] = &copied
+	}
+	return scores
+}
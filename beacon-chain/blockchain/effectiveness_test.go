@@ -0,0 +1,107 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestScoreAttestationEffectiveness(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator *precompute.Validator
+		want      float64
+	}{
+		{
+			name:      "not included",
+			validator: &precompute.Validator{IsActivePrevEpoch: true},
+			want:      0,
+		},
+		{
+			name: "optimal",
+			validator: &precompute.Validator{
+				IsPrevEpochAttester:       true,
+				IsPrevEpochTargetAttester: true,
+				IsPrevEpochHeadAttester:   true,
+				InclusionDistance:         1,
+			},
+			want: 1,
+		},
+		{
+			name: "wrong head, late inclusion",
+			validator: &precompute.Validator{
+				IsPrevEpochAttester:       true,
+				IsPrevEpochTargetAttester: true,
+				InclusionDistance:         2,
+			},
+			want: 0.625,
+		},
+		{
+			name: "source only",
+			validator: &precompute.Validator{
+				IsPrevEpochAttester: true,
+				InclusionDistance:   4,
+			},
+			want: 0.3125,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := scoreAttestationEffectiveness(7, 3, tt.validator)
+			assert.Equal(t, types.ValidatorIndex(7), e.ValidatorIndex)
+			assert.Equal(t, types.Epoch(3), e.Epoch)
+			assert.Equal(t, tt.want, e.Score)
+		})
+	}
+}
+
+func TestService_ScoreHeadEffectiveness(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*2-1))
+	s := &Service{
+		trackedValidators: map[types.ValidatorIndex]bool{3: true, 1: true, 1000: true},
+		head:              &head{state: st},
+	}
+
+	scores, err := s.scoreHeadEffectiveness(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(scores), "Expected scores only for the tracked validators in the registry")
+	assert.Equal(t, types.ValidatorIndex(1), scores[0].ValidatorIndex)
+	assert.Equal(t, types.ValidatorIndex(3), scores[1].ValidatorIndex)
+	for _, e := range scores {
+		assert.Equal(t, types.Epoch(0), e.Epoch)
+		assert.Equal(t, false, e.Included)
+		assert.Equal(t, float64(0), e.Score)
+	}
+
+	// Nothing is scored when the head is behind the epoch, as more attestations may still be included.
+	scores, err = s.scoreHeadEffectiveness(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(scores))
+}
+
+func TestService_RecordAttestationEffectiveness(t *testing.T) {
+	s := &Service{}
+	score := func(epoch types.Epoch) []*AttestationEffectiveness {
+		return []*AttestationEffectiveness{{ValidatorIndex: 2, Epoch: epoch, Included: true, Score: 0.5}}
+	}
+	s.recordAttestationEffectiveness(score(1))
+	// The scores of an epoch are only recorded once.
+	s.recordAttestationEffectiveness(score(1))
+	require.Equal(t, 1, len(s.AttestationEffectiveness()))
+
+	for epoch := types.Epoch(2); epoch <= balanceDeltasEpochsKept+4; epoch++ {
+		s.recordAttestationEffectiveness(score(epoch))
+	}
+	got := s.AttestationEffectiveness()
+	require.Equal(t, balanceDeltasEpochsKept, len(got))
+	assert.Equal(t, types.Epoch(5), got[0].Epoch)
+	assert.Equal(t, 0.5, got[0].Score)
+}
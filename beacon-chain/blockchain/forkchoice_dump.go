@@ -0,0 +1,59 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+)
+
+// CheckpointJSON is the JSON representation of a checkpoint.
+type CheckpointJSON struct {
+	Epoch types.Epoch `json:"epoch"`
+	Root  string      `json:"root"`
+}
+
+// ForkChoiceDumpResponse is the response body of the /forkchoice endpoint.
+type ForkChoiceDumpResponse struct {
+	HeadRoot                    string                    `json:"head_root"`
+	HeadSlot                    types.Slot                `json:"head_slot"`
+	JustifiedCheckpoint         *CheckpointJSON           `json:"justified_checkpoint"`
+	BestJustifiedCheckpoint     *CheckpointJSON           `json:"best_justified_checkpoint"`
+	PreviousJustifiedCheckpoint *CheckpointJSON           `json:"previous_justified_checkpoint"`
+	FinalizedCheckpoint         *CheckpointJSON           `json:"finalized_checkpoint"`
+	Store                       *protoarray.StoreSnapshot `json:"store"`
+}
+
+// ForkChoiceDumpHandler serves the fork choice store as JSON: every block node with its weight,
+// parent and best descendant links, along with the head and the checkpoints used by fork choice.
+// This is read only. The store itself is copied under a single lock so its contents are
+// consistent, but the head and checkpoints are read separately and may be one update apart.
+func (s *Service) ForkChoiceDumpHandler(w http.ResponseWriter, _ *http.Request) {
+	resp := &ForkChoiceDumpResponse{
+		JustifiedCheckpoint:         checkpointJSON(s.CurrentJustifiedCheckpt()),
+		PreviousJustifiedCheckpoint: checkpointJSON(s.PreviousJustifiedCheckpt()),
+		FinalizedCheckpoint:         checkpointJSON(s.FinalizedCheckpt()),
+		Store:                       s.forkChoiceStore.Store().Snapshot(),
+	}
+	if s.bestJustifiedCheckpt != nil {
+		resp.BestJustifiedCheckpoint = checkpointJSON(s.bestJustifiedCheckpt)
+	}
+	s.headLock.RLock()
+	if s.hasHeadState() {
+		resp.HeadRoot = fmt.Sprintf("%#x", s.headRoot())
+		resp.HeadSlot = s.headSlot()
+	}
+	s.headLock.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.WithError(err).Error("Failed to write fork choice dump response")
+	}
+}
+
+func checkpointJSON(c *ethpb.Checkpoint) *CheckpointJSON {
+	return &CheckpointJSON{Epoch: c.Epoch, Root: fmt.Sprintf("%#x", c.Root)}
+}
@@ -0,0 +1,56 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_ForkChoiceDumpHandler(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	headState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetSlot(1))
+	cfg := &Config{
+		BeaconDB:        beaconDB,
+		ForkChoiceStore: protoarray.New(0, 0, [32]byte{'a'}),
+		StateGen:        stategen.New(beaconDB),
+	}
+	s, err := NewService(ctx, cfg)
+	require.NoError(t, err)
+	require.NoError(t, s.forkChoiceStore.ProcessBlock(ctx, 0, [32]byte{'a'}, [32]byte{'g'}, [32]byte{}, 0, 0))
+	require.NoError(t, s.forkChoiceStore.ProcessBlock(ctx, 1, [32]byte{'b'}, [32]byte{'a'}, [32]byte{}, 0, 0))
+	s.finalizedCheckpt = &ethpb.Checkpoint{Epoch: 0, Root: []byte{'a'}}
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 1
+	s.setHead([32]byte{'b'}, b, headState)
+
+	req, err := http.NewRequest("GET", "/forkchoice", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(s.ForkChoiceDumpHandler).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	resp := &ForkChoiceDumpResponse{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), resp))
+	assert.Equal(t, fmt.Sprintf("%#x", [32]byte{'b'}), resp.HeadRoot)
+	assert.Equal(t, types.Slot(1), resp.HeadSlot)
+	assert.Equal(t, "0x61", resp.FinalizedCheckpoint.Root)
+	require.Equal(t, 2, len(resp.Store.Nodes))
+	assert.Equal(t, "", resp.Store.Nodes[0].ParentRoot)
+	assert.Equal(t, fmt.Sprintf("%#x", [32]byte{'a'}), resp.Store.Nodes[1].ParentRoot)
+	assert.Equal(t, fmt.Sprintf("%#x", [32]byte{'b'}), resp.Store.Nodes[0].BestDescendantRoot)
+}
@@ -20,6 +20,19 @@ import (
 	"go.opencensus.io/trace"
 )
 
+// UpdateHead processes the fork choice attestations in the pool and determines the head
+// immediately, rather than waiting for the next slot tick. It returns the resulting head root.
+func (s *Service) UpdateHead(ctx context.Context) ([]byte, error) {
+	if s.justifiedCheckpt == nil || s.finalizedCheckpt == nil {
+		return nil, errors.New("fork choice store is not initialized")
+	}
+	s.processAttestations(ctx)
+	if err := s.updateHead(ctx, s.getJustifiedBalances()); err != nil {
+		return nil, errors.Wrap(err, "could not update head")
+	}
+	return s.HeadRoot(ctx)
+}
+
 // This defines the current chain service's view of head.
 type head struct {
 	slot  types.Slot               // current head slot.
@@ -117,35 +130,89 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 		return errors.New("cannot save nil head state")
 	}
 
-	// A chain re-org occurred, so we fire an event notifying the rest of the services.
+	// A chain re-org occurred, so we fire an event notifying the rest of the services. A new head
+	// descending from the old head through blocks which never became head is not a re-org.
 	headSlot := s.HeadSlot()
 	if bytesutil.ToBytes32(newHeadBlock.Block.ParentRoot) != bytesutil.ToBytes32(r) {
-		log.WithFields(logrus.Fields{
-			"newSlot": fmt.Sprintf("%d", newHeadBlock.Block.Slot),
-			"oldSlot": fmt.Sprintf("%d", headSlot),
-		}).Debug("Chain reorg occurred")
-		s.stateNotifier.StateFeed().Send(&feed.Event{
-			Type: statefeed.Reorg,
-			Data: &statefeed.ReorgData{
-				NewSlot: newHeadBlock.Block.Slot,
-				OldSlot: headSlot,
-			},
-		})
-
-		reorgCount.Inc()
+		if data, reorged := s.reorgData(ctx, bytesutil.ToBytes32(r), headSlot, headRoot, newHeadBlock.Block); reorged {
+			s.notifyReorg(data)
+		}
 	}
 
 	// Cache the new head info.
 	s.setHead(headRoot, newHeadBlock, newHeadState)
+	// Keep the head state in memory even if the states of other forks fill the hot state cache.
+	s.stateGen.PinHeadState(headRoot, newHeadState)
 
 	// Save the new head root to DB.
 	if err := s.beaconDB.SaveHeadBlockRoot(ctx, headRoot); err != nil {
 		return errors.Wrap(err, "could not save head root in DB")
 	}
 
+	s.notifyNewHead(&statefeed.NewHeadData{
+		Slot:            newHeadBlock.Block.Slot,
+		BlockRoot:       headRoot,
+		StateRoot:       bytesutil.ToBytes32(newHeadBlock.Block.StateRoot),
+		EpochTransition: helpers.IsEpochStart(newHeadBlock.Block.Slot),
+	})
+
 	return nil
 }
 
+// This logs the reorg and notifies the rest of the services about it.
+func (s *Service) notifyReorg(data *statefeed.ReorgData) {
+	log.WithFields(logrus.Fields{
+		"newSlot":            fmt.Sprintf("%d", data.NewSlot),
+		"oldSlot":            fmt.Sprintf("%d", data.OldSlot),
+		"commonAncestorSlot": fmt.Sprintf("%d", data.CommonAncestorSlot),
+		"depth":              data.Depth,
+	}).Debug("Chain reorg occurred")
+	s.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.Reorg,
+		Data: data,
+	})
+
+	reorgCount.Inc()
+	if data.Depth > 0 {
+		reorgDepth.Observe(float64(data.Depth))
+	}
+}
+
+// This builds the data of the reorg event from the old head to the new head, and returns false if
+// the common ancestor of the heads is the old head, in which case no reorg occurred. The depth and
+// the common ancestor are left unset if the old head's chain cannot be walked back in the DB.
+func (s *Service) reorgData(
+	ctx context.Context,
+	oldHeadRoot [32]byte,
+	oldHeadSlot types.Slot,
+	newHeadRoot [32]byte,
+	newHeadBlock *ethpb.BeaconBlock,
+) (*statefeed.ReorgData, bool) {
+	data := &statefeed.ReorgData{
+		NewSlot:      newHeadBlock.Slot,
+		OldSlot:      oldHeadSlot,
+		NewHeadRoot:  newHeadRoot,
+		OldHeadRoot:  oldHeadRoot,
+		NewStateRoot: bytesutil.ToBytes32(newHeadBlock.StateRoot),
+	}
+	oldHeadBlock, err := s.beaconDB.Block(ctx, oldHeadRoot)
+	if err == nil && oldHeadBlock != nil && oldHeadBlock.Block != nil {
+		data.OldStateRoot = bytesutil.ToBytes32(oldHeadBlock.Block.StateRoot)
+	}
+	ancestorSlot, err := s.commonAncestorSlot(ctx, oldHeadRoot, newHeadRoot)
+	if err != nil {
+		log.WithError(err).Debug("Could not find common ancestor of reorg")
+		return data, true
+	}
+	// The common ancestor is on the old head's chain, so it is the old head itself if it has its slot.
+	if ancestorSlot >= oldHeadSlot {
+		return data, false
+	}
+	data.CommonAncestorSlot = ancestorSlot
+	data.Depth = uint64(oldHeadSlot - ancestorSlot)
+	return data, true
+}
+
 // This gets called to update canonical root mapping. It does not save head block
 // root in DB. With the inception of initial-sync-cache-state flag, it uses finalized
 // check point as anchors to resume sync therefore head is no longer needed to be saved on per slot basis.
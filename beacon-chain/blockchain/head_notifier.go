@@ -0,0 +1,101 @@
+package blockchain
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+)
+
+// headDebouncer coalesces new head notifications so that at most one of them is sent per
+// interval. The notifications received while waiting for the end of an interval replace each
+// other, so the latest head is always the one sent.
+type headDebouncer struct {
+	interval time.Duration
+	send     func(*statefeed.NewHeadData)
+	lock     sync.Mutex
+	lastSent time.Time
+	pending  *statefeed.NewHeadData
+	timer    *time.Timer
+	stopped  bool
+}
+
+func newHeadDebouncer(interval time.Duration, send func(*statefeed.NewHeadData)) *headDebouncer {
+	return &headDebouncer{
+		interval: interval,
+		send:     send,
+	}
+}
+
+// notify sends the new head right away if no head was sent during the last interval, otherwise
+// it sends it at the end of the interval unless a later head replaces it in the meantime.
+func (d *headDebouncer) notify(data *statefeed.NewHeadData) {
+	d.lock.Lock()
+	if d.stopped {
+		d.lock.Unlock()
+		return
+	}
+	if d.pending != nil {
+		// Subscribers rely on the epoch transition flag, so it's kept when the head which
+		// crossed the epoch boundary is replaced by a later one.
+		data.EpochTransition = data.EpochTransition || d.pending.EpochTransition
+		d.pending = data
+		d.lock.Unlock()
+		return
+	}
+	wait := d.interval - time.Since(d.lastSent)
+	if wait > 0 {
+		d.pending = data
+		d.timer = time.AfterFunc(wait, d.flush)
+		d.lock.Unlock()
+		return
+	}
+	d.lastSent = time.Now()
+	d.lock.Unlock()
+	d.send(data)
+}
+
+// flush sends the pending head at the end of an interval.
+func (d *headDebouncer) flush() {
+	d.lock.Lock()
+	data := d.pending
+	d.pending = nil
+	d.timer = nil
+	if d.stopped || data == nil {
+		d.lock.Unlock()
+		return
+	}
+	d.lastSent = time.Now()
+	d.lock.Unlock()
+	d.send(data)
+}
+
+// stop drops the pending head, if any, and ignores the notifications received afterwards.
+func (d *headDebouncer) stop() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.stopped = true
+	d.pending = nil
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+	}
+}
+
+// notifyNewHead sends a new head event on the state feed, through the debouncer when head
+// notifications are debounced.
+func (s *Service) notifyNewHead(data *statefeed.NewHeadData) {
+	if s.headDebouncer != nil {
+		s.headDebouncer.notify(data)
+		return
+	}
+	s.sendNewHead(data)
+}
+
+func (s *Service) sendNewHead(data *statefeed.NewHeadData) {
+	s.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: data,
+	})
+}
@@ -0,0 +1,56 @@
+package blockchain
+
+import (
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestHeadDebouncer_CoalescesToLatestHead(t *testing.T) {
+	sent := make(chan *statefeed.NewHeadData, 10)
+	d := newHeadDebouncer(100*time.Millisecond, func(data *statefeed.NewHeadData) {
+		sent <- data
+	})
+
+	d.notify(&statefeed.NewHeadData{Slot: 1})
+	select {
+	case data := <-sent:
+		assert.Equal(t, types.Slot(1), data.Slot, "First head should be sent right away")
+	default:
+		t.Fatal("First head was not sent right away")
+	}
+
+	d.notify(&statefeed.NewHeadData{Slot: 2, EpochTransition: true})
+	d.notify(&statefeed.NewHeadData{Slot: 3})
+	d.notify(&statefeed.NewHeadData{Slot: 4})
+	assert.Equal(t, 0, len(sent), "Heads within the interval should not be sent right away")
+
+	select {
+	case data := <-sent:
+		assert.Equal(t, types.Slot(4), data.Slot, "Latest head should be sent")
+		assert.Equal(t, true, data.EpochTransition, "Coalesced epoch transition was lost")
+	case <-time.After(time.Second):
+		t.Fatal("Pending head was not sent")
+	}
+	time.Sleep(200 * time.Millisecond)
+	assert.Equal(t, 0, len(sent), "Coalesced heads should not be sent")
+}
+
+func TestHeadDebouncer_Stop(t *testing.T) {
+	sent := make(chan *statefeed.NewHeadData, 10)
+	d := newHeadDebouncer(50*time.Millisecond, func(data *statefeed.NewHeadData) {
+		sent <- data
+	})
+	d.notify(&statefeed.NewHeadData{Slot: 1})
+	d.notify(&statefeed.NewHeadData{Slot: 2})
+	d.stop()
+	d.notify(&statefeed.NewHeadData{Slot: 3})
+
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, 1, len(sent))
+	assert.Equal(t, types.Slot(1), (<-sent).Slot)
+}
@@ -9,6 +9,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -122,3 +123,69 @@ func TestUpdateHead_MissingJustifiedRoot(t *testing.T) {
 
 	require.NoError(t, service.updateHead(context.Background(), []uint64{}))
 }
+
+func TestReorgData_CommonAncestor(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := setupBeaconChain(t, beaconDB)
+
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	// The old head is two slots past the genesis block, the new head forks off from the genesis block.
+	oldParent := testutil.NewBeaconBlock()
+	oldParent.Block.Slot = 1
+	oldParent.Block.ParentRoot = genesisRoot[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, oldParent))
+	oldParentRoot, err := oldParent.Block.HashTreeRoot()
+	require.NoError(t, err)
+	oldHead := testutil.NewBeaconBlock()
+	oldHead.Block.Slot = 2
+	oldHead.Block.ParentRoot = oldParentRoot[:]
+	oldHead.Block.StateRoot = bytesutil.PadTo([]byte{'o'}, 32)
+	require.NoError(t, beaconDB.SaveBlock(ctx, oldHead))
+	oldHeadRoot, err := oldHead.Block.HashTreeRoot()
+	require.NoError(t, err)
+	newHead := testutil.NewBeaconBlock()
+	newHead.Block.Slot = 3
+	newHead.Block.ParentRoot = genesisRoot[:]
+	newHead.Block.StateRoot = bytesutil.PadTo([]byte{'n'}, 32)
+	require.NoError(t, beaconDB.SaveBlock(ctx, newHead))
+	newHeadRoot, err := newHead.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	ancestorSlot, err := service.commonAncestorSlot(ctx, oldHeadRoot, newHeadRoot)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(0), ancestorSlot)
+	ancestorSlot, err = service.commonAncestorSlot(ctx, oldHeadRoot, oldParentRoot)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(1), ancestorSlot)
+
+	data, reorged := service.reorgData(ctx, oldHeadRoot, 2, newHeadRoot, newHead.Block)
+	assert.Equal(t, true, reorged)
+	assert.Equal(t, types.Slot(3), data.NewSlot)
+	assert.Equal(t, types.Slot(2), data.OldSlot)
+	assert.Equal(t, newHeadRoot, data.NewHeadRoot)
+	assert.Equal(t, oldHeadRoot, data.OldHeadRoot)
+	assert.Equal(t, bytesutil.ToBytes32(newHead.Block.StateRoot), data.NewStateRoot)
+	assert.Equal(t, bytesutil.ToBytes32(oldHead.Block.StateRoot), data.OldStateRoot)
+	assert.Equal(t, types.Slot(0), data.CommonAncestorSlot)
+	assert.Equal(t, uint64(2), data.Depth)
+
+	// The depth is unknown if the old head is missing.
+	data, reorged = service.reorgData(ctx, [32]byte{'A'}, 2, newHeadRoot, newHead.Block)
+	assert.Equal(t, true, reorged)
+	assert.Equal(t, uint64(0), data.Depth)
+
+	// A descendant of the old head is not a reorg, even when its parent never became head.
+	descendant := testutil.NewBeaconBlock()
+	descendant.Block.Slot = 4
+	descendant.Block.ParentRoot = oldHeadRoot[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, descendant))
+	descendantRoot, err := descendant.Block.HashTreeRoot()
+	require.NoError(t, err)
+	_, reorged = service.reorgData(ctx, oldParentRoot, 1, descendantRoot, descendant.Block)
+	assert.Equal(t, false, reorged)
+}
@@ -95,10 +95,33 @@ var (
 		Name: "beacon_prev_epoch_head_gwei",
 		Help: "The total amount of ether, in gwei, that has been used in voting attestation head of previous epoch",
 	})
+	trackedValidatorBalanceChange = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tracked_validator_epoch_balance_change_gwei",
+		Help: "The balance change of a tracked validator across the last epoch transition, in gwei, as a total " +
+			"and broken down into attestation rewards, proposer rewards and penalties",
+	}, []string{"validator_index", "source"})
+	trackedValidatorEffectiveness = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tracked_validator_attestation_effectiveness",
+		Help: "The attestation effectiveness score, between 0 and 1, of a tracked validator for the last processed epoch",
+	}, []string{"validator_index"})
 	reorgCount = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "beacon_reorg_total",
 		Help: "Count the number of times beacon chain has a reorg",
 	})
+	reorgDepth = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "beacon_reorg_depth_slots",
+			Help:    "The number of slots of the old head's chain past the common ancestor of a reorg",
+			Buckets: []float64{1, 2, 3, 4, 8, 16, 32, 64},
+		},
+	)
+	blockProcessingLatency = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "beacon_block_processing_seconds",
+			Help:    "Time spent importing a block received over gossip, from its state transition to fork choice",
+			Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2, 4, 8},
+		},
+	)
 	attestationInclusionDelay = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "attestation_inclusion_delay_slots",
@@ -106,6 +129,18 @@ var (
 			Buckets: []float64{1, 2, 3, 4, 6, 32, 64},
 		},
 	)
+	blsBatchSize = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "bls_batch_verification_size",
+			Help:    "The number of signatures verified in a BLS batch during block processing",
+			Buckets: []float64{8, 16, 32, 64, 128, 256, 512, 1024, 4096},
+		},
+	)
+	blsBatchFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "bls_batch_verification_failures_total",
+		Help: "The number of BLS batches which failed to verify during block processing, the failure rate is " +
+			"this counter over the count of bls_batch_verification_size",
+	})
 )
 
 // reportSlotMetrics reports slot related metrics.
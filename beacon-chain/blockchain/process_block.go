@@ -93,22 +93,26 @@ func (s *Service) onBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 	if err != nil {
 		return err
 	}
+	// The state transition modifies the pre-state, which the balance deltas are attributed from.
+	var balanceDeltasPreState iface.BeaconState
+	if s.tracksBalanceDeltas(preState, b.Slot) {
+		balanceDeltasPreState = preState.Copy()
+	}
 
 	set, postState, err := state.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, signed)
 	if err != nil {
 		return errors.Wrap(err, "could not execute state transition")
 	}
-	valid, err := set.Verify()
-	if err != nil {
-		return errors.Wrap(err, "could not batch verify signature")
-	}
-	if !valid {
-		return errors.New("signature in block failed to verify")
+	if err := s.verifySignatureSet(set); err != nil {
+		return errors.Wrap(err, "signature in block failed to verify")
 	}
 
 	if err := s.savePostStateInfo(ctx, blockRoot, signed, postState, false /* reg sync */); err != nil {
 		return err
 	}
+	if balanceDeltasPreState != nil {
+		go s.trackBalanceDeltas(balanceDeltasPreState, b.Slot, postState)
+	}
 
 	// Updating next slot state cache can happen in the background. It shouldn't block rest of the process.
 	if featureconfig.Get().EnableNextSlotStateCache {
@@ -166,6 +170,23 @@ func (s *Service) onBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 		if err := s.forkChoiceStore.Prune(ctx, fRoot); err != nil {
 			return errors.Wrap(err, "could not prune proto array fork choice nodes")
 		}
+		fBlock, err := s.beaconDB.Block(ctx, fRoot)
+		if err != nil {
+			return errors.Wrap(err, "could not get finalized block")
+		}
+		var fStateRoot [32]byte
+		if fBlock != nil && fBlock.Block != nil {
+			fStateRoot = bytesutil.ToBytes32(fBlock.Block.StateRoot)
+		}
+		// Send notification of the new finalized checkpoint to the state feed.
+		s.stateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.FinalizedCheckpoint,
+			Data: &statefeed.FinalizedCheckpointData{
+				Epoch:     postState.FinalizedCheckpoint().Epoch,
+				BlockRoot: fRoot,
+				StateRoot: fStateRoot,
+			},
+		})
 		if !featureconfig.Get().UpdateHeadTimely {
 			if err := s.finalizedImpliesNewJustified(ctx, postState); err != nil {
 				return errors.Wrap(err, "could not save new justified")
@@ -183,6 +204,8 @@ func (s *Service) onBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock,
 		}()
 	}
 
+	s.recordCheckpoints(b.Slot, postState.JustificationBits())
+
 	defer reportAttestationInclusion(b)
 
 	return s.handleEpochBoundary(ctx, postState)
@@ -221,8 +244,20 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []*ethpb.SignedBeaconBl
 		Messages:   [][32]byte{},
 	}
 	var set *bls.SignatureSet
+	// With verification workers, the signatures of each block are verified while the next blocks
+	// are applied, rather than all at once after the last block.
+	var verifier *signatureVerifier
+	if s.sigVerifyWorkers > 0 {
+		verifier = newSignatureVerifier(s.sigVerifyWorkers, s.verifySignatureSet)
+		defer verifier.stop()
+	}
 	boundaries := make(map[[32]byte]iface.BeaconState)
 	for i, b := range blks {
+		if verifier != nil {
+			if err := verifier.err(); err != nil {
+				return nil, nil, errors.Wrap(err, "batch block signature verification failed")
+			}
+		}
 		set, preState, err = state.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b)
 		if err != nil {
 			return nil, nil, err
@@ -236,14 +271,19 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []*ethpb.SignedBeaconBl
 		}
 		jCheckpoints[i] = preState.CurrentJustifiedCheckpoint()
 		fCheckpoints[i] = preState.FinalizedCheckpoint()
+		if verifier != nil {
+			verifier.submit(set)
+			continue
+		}
 		sigSet.Join(set)
 	}
-	verify, err := sigSet.Verify()
-	if err != nil {
-		return nil, nil, err
+	if verifier != nil {
+		err = verifier.wait()
+	} else {
+		err = s.verifySignatureSet(sigSet)
 	}
-	if !verify {
-		return nil, nil, errors.New("batch block signature verification failed")
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "batch block signature verification failed")
 	}
 	for r, st := range boundaries {
 		if err := s.stateGen.SaveState(ctx, r, st); err != nil {
@@ -299,6 +339,7 @@ func (s *Service) handleBlockAfterBatchVerify(ctx context.Context, signed *ethpb
 			return err
 		}
 	}
+	s.recordCheckpoints(b.Slot, nil)
 	return nil
 }
 
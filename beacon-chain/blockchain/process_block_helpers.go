@@ -318,6 +318,51 @@ func (s *Service) ancestorByDB(ctx context.Context, r [32]byte, slot types.Slot)
 	return s.ancestorByDB(ctx, bytesutil.ToBytes32(b.ParentRoot), slot)
 }
 
+// This retrieves the slot of the latest block which is an ancestor of both given blocks, by walking
+// back the parents of the later one in the DB until the two chains meet.
+func (s *Service) commonAncestorSlot(ctx context.Context, r1, r2 [32]byte) (types.Slot, error) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.commonAncestorSlot")
+	defer span.End()
+
+	block := func(r [32]byte) (*ethpb.BeaconBlock, error) {
+		signed, err := s.beaconDB.Block(ctx, r)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get ancestor block")
+		}
+		if s.hasInitSyncBlock(r) {
+			signed = s.getInitSyncBlock(r)
+		}
+		if signed == nil || signed.Block == nil {
+			return nil, errors.New("nil block")
+		}
+		return signed.Block, nil
+	}
+	b1, err := block(r1)
+	if err != nil {
+		return 0, err
+	}
+	b2, err := block(r2)
+	if err != nil {
+		return 0, err
+	}
+	for r1 != r2 {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		if b1.Slot >= b2.Slot {
+			r1 = bytesutil.ToBytes32(b1.ParentRoot)
+			b1, err = block(r1)
+		} else {
+			r2 = bytesutil.ToBytes32(b2.ParentRoot)
+			b2, err = block(r2)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return b1.Slot, nil
+}
+
 // This updates justified check point in store, if the new justified is later than stored justified or
 // the store's justified is not in chain with finalized check point.
 //
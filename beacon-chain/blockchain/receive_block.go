@@ -81,6 +81,7 @@ func (s *Service) ReceiveBlock(ctx context.Context, block *ethpb.SignedBeaconBlo
 	// Log state transition data.
 	logStateTransitionData(blockCopy.Block)
 
+	traceutil.ObserveWithTraceID(ctx, blockProcessingLatency, timeutils.Since(receivedTime).Seconds())
 	return nil
 }
 
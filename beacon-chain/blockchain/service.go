@@ -80,6 +80,16 @@ type Service struct {
 	wsEpoch               types.Epoch
 	wsRoot                []byte
 	wsVerified            bool
+	trackedValidators     map[types.ValidatorIndex]bool
+	blsBatchSize          int
+	sigVerifyWorkers      int
+	balanceDeltas         []*ValidatorBalanceDelta
+	balanceDeltasLock     sync.RWMutex
+	effectiveness         []*AttestationEffectiveness
+	effectivenessLock     sync.RWMutex
+	headDebouncer         *headDebouncer
+	checkpointHistory     checkpointRing
+	checkpointHistoryLock sync.RWMutex
 }
 
 // Config options for the service.
@@ -99,13 +109,26 @@ type Config struct {
 	StateGen          *stategen.State
 	WspBlockRoot      []byte
 	WspEpoch          types.Epoch
+	TrackedValidators []types.ValidatorIndex
+	BlsBatchSize      int
+	// SigVerifyWorkers is the number of workers verifying the signatures of the blocks of a batch
+	// while the state transitions of the next blocks are applied. Zero verifies the signatures of
+	// a batch at once after its last block.
+	SigVerifyWorkers int
+	// HeadEventInterval is the minimum interval between two new head events, the heads
+	// updated in between being coalesced into the latest one. Zero sends every new head.
+	HeadEventInterval time.Duration
 }
 
 // NewService instantiates a new block service instance that will
 // be registered into a running beacon node.
 func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	ctx, cancel := context.WithCancel(ctx)
-	return &Service{
+	trackedValidators := make(map[types.ValidatorIndex]bool, len(cfg.TrackedValidators))
+	for _, idx := range cfg.TrackedValidators {
+		trackedValidators[idx] = true
+	}
+	srv := &Service{
 		ctx:                  ctx,
 		cancel:               cancel,
 		beaconDB:             cfg.BeaconDB,
@@ -126,7 +149,14 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		justifiedBalances:    make([]uint64, 0),
 		wsEpoch:              cfg.WspEpoch,
 		wsRoot:               cfg.WspBlockRoot,
-	}, nil
+		trackedValidators:    trackedValidators,
+		blsBatchSize:         cfg.BlsBatchSize,
+		sigVerifyWorkers:     cfg.SigVerifyWorkers,
+	}
+	if cfg.HeadEventInterval > 0 {
+		srv.headDebouncer = newHeadDebouncer(cfg.HeadEventInterval, srv.sendNewHead)
+	}
+	return srv, nil
 }
 
 // Start a blockchain service's main event loop.
@@ -172,16 +202,18 @@ func (s *Service) Start() {
 			log.Fatalf("Could not set up chain info: %v", err)
 		}
 
-		// We start a counter to genesis, if needed.
+		// We start a counter to genesis, if needed. A node started from a checkpoint has no genesis state.
 		gState, err := s.beaconDB.GenesisState(s.ctx)
 		if err != nil {
 			log.Fatalf("Could not retrieve genesis state: %v", err)
 		}
-		gRoot, err := gState.HashTreeRoot(s.ctx)
-		if err != nil {
-			log.Fatalf("Could not hash tree root genesis state: %v", err)
+		if gState != nil {
+			gRoot, err := gState.HashTreeRoot(s.ctx)
+			if err != nil {
+				log.Fatalf("Could not hash tree root genesis state: %v", err)
+			}
+			go slotutil.CountdownToGenesis(s.ctx, s.genesisTime, uint64(gState.NumValidators()), gRoot)
 		}
-		go slotutil.CountdownToGenesis(s.ctx, s.genesisTime, uint64(gState.NumValidators()), gRoot)
 
 		justifiedCheckpoint, err := s.beaconDB.JustifiedCheckpoint(s.ctx)
 		if err != nil {
@@ -266,6 +298,7 @@ func (s *Service) Start() {
 	}
 
 	go s.processAttestationsRoutine(attestationProcessorSubscribed)
+	go s.scoreEffectivenessRoutine()
 }
 
 // processChainStartTime initializes a series of deposits from the ChainStart deposits in the eth1
@@ -338,6 +371,10 @@ func (s *Service) initializeBeaconChain(
 func (s *Service) Stop() error {
 	defer s.cancel()
 
+	if s.headDebouncer != nil {
+		s.headDebouncer.stop()
+	}
+
 	if s.stateGen != nil && s.head != nil && s.head.state != nil {
 		if err := s.stateGen.ForceCheckpoint(s.ctx, s.head.state.FinalizedCheckpoint().Root); err != nil {
 			return err
@@ -428,14 +465,23 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "could not get genesis block from db")
 	}
-	if genesisBlock == nil {
-		return errors.New("no genesis block in db")
-	}
-	genesisBlkRoot, err := genesisBlock.Block.HashTreeRoot()
-	if err != nil {
-		return errors.Wrap(err, "could not get signing root of genesis block")
+	if genesisBlock != nil {
+		genesisBlkRoot, err := genesisBlock.Block.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not get signing root of genesis block")
+		}
+		s.genesisRoot = genesisBlkRoot
+	} else {
+		// A node started from a checkpoint has no genesis block, its chain starts at the checkpoint block.
+		originRoot, err := s.beaconDB.OriginCheckpointBlockRoot(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get origin checkpoint block root from db")
+		}
+		if originRoot == params.BeaconConfig().ZeroHash {
+			return errors.New("no genesis block in db")
+		}
+		s.genesisRoot = originRoot
 	}
-	s.genesisRoot = genesisBlkRoot
 
 	finalized, err := s.beaconDB.FinalizedCheckpoint(ctx)
 	if err != nil {
@@ -0,0 +1,32 @@
+package blockchain
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+// This verifies the signature set of one or more blocks in batches of the configured size.
+// When a batch fails, its signatures are verified one by one to report the invalid one.
+func (s *Service) verifySignatureSet(set *bls.SignatureSet) error {
+	for _, batch := range set.Split(s.blsBatchSize) {
+		blsBatchSize.Observe(float64(len(batch.Signatures)))
+		valid, err := batch.Verify()
+		if err != nil {
+			return errors.Wrap(err, "could not batch verify signature")
+		}
+		if valid {
+			continue
+		}
+		blsBatchFailures.Inc()
+		idx, err := batch.FindInvalid()
+		if err != nil {
+			return errors.Wrap(err, "could not verify signatures individually")
+		}
+		if idx < 0 {
+			return errors.New("signature batch failed to verify")
+		}
+		return errors.Errorf("signature of message %#x by public key %#x failed to verify",
+			batch.Messages[idx], batch.PublicKeys[idx].Marshal())
+	}
+	return nil
+}
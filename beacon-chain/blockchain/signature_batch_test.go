@@ -0,0 +1,33 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_VerifySignatureSet(t *testing.T) {
+	set := bls.NewSet()
+	for i := 0; i < 5; i++ {
+		priv, err := bls.RandKey()
+		require.NoError(t, err)
+		msg := [32]byte{byte(i)}
+		set.Signatures = append(set.Signatures, priv.Sign(msg[:]).Marshal())
+		set.PublicKeys = append(set.PublicKeys, priv.PublicKey())
+		set.Messages = append(set.Messages, msg)
+	}
+
+	for _, size := range []int{0, 2, 5} {
+		s := &Service{blsBatchSize: size}
+		require.NoError(t, s.verifySignatureSet(set))
+	}
+
+	set.Messages[3] = [32]byte{'b', 'a', 'd'}
+	for _, size := range []int{0, 2, 5} {
+		s := &Service{blsBatchSize: size}
+		err := s.verifySignatureSet(set)
+		assert.ErrorContains(t, "signature of message 0x6261640000", err)
+	}
+}
@@ -0,0 +1,78 @@
+package blockchain
+
+import (
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+// signatureVerifier verifies the signature sets of the blocks of a batch on a pool of workers,
+// so that the signatures of a block are verified while the state transitions of the following
+// blocks are applied. The state transitions themselves stay serial, and the caller only saves
+// the post states of the batch once every set has been verified.
+type signatureVerifier struct {
+	sets     chan *bls.SignatureSet
+	verify   func(*bls.SignatureSet) error
+	wg       sync.WaitGroup
+	lock     sync.Mutex
+	firstErr error
+	stopOnce sync.Once
+}
+
+// newSignatureVerifier starts the given number of workers verifying the submitted sets with
+// the provided function.
+func newSignatureVerifier(workers int, verify func(*bls.SignatureSet) error) *signatureVerifier {
+	v := &signatureVerifier{
+		sets:   make(chan *bls.SignatureSet, workers),
+		verify: verify,
+	}
+	v.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go v.run()
+	}
+	return v
+}
+
+func (v *signatureVerifier) run() {
+	defer v.wg.Done()
+	for set := range v.sets {
+		// Once a set failed the batch is rejected, so the remaining sets are not verified.
+		if v.err() != nil {
+			continue
+		}
+		if err := v.verify(set); err != nil {
+			v.lock.Lock()
+			if v.firstErr == nil {
+				v.firstErr = err
+			}
+			v.lock.Unlock()
+		}
+	}
+}
+
+// submit queues the set for verification. It blocks while all the workers are busy and the
+// queue is full, which bounds how far the state transitions run ahead of the verification.
+func (v *signatureVerifier) submit(set *bls.SignatureSet) {
+	v.sets <- set
+}
+
+// err returns the first verification failure so far, if any.
+func (v *signatureVerifier) err() error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.firstErr
+}
+
+// wait waits for the submitted sets to be verified and returns the first verification failure.
+func (v *signatureVerifier) wait() error {
+	v.stop()
+	return v.err()
+}
+
+// stop stops the workers once the submitted sets are handled. It can be called more than once.
+func (v *signatureVerifier) stop() {
+	v.stopOnce.Do(func() {
+		close(v.sets)
+	})
+	v.wg.Wait()
+}
@@ -0,0 +1,130 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func signatureSets(t testing.TB, n int) []*bls.SignatureSet {
+	sets := make([]*bls.SignatureSet, n)
+	for i := range sets {
+		priv, err := bls.RandKey()
+		require.NoError(t, err)
+		msg := [32]byte{byte(i)}
+		sets[i] = &bls.SignatureSet{
+			Signatures: [][]byte{priv.Sign(msg[:]).Marshal()},
+			PublicKeys: []bls.PublicKey{priv.PublicKey()},
+			Messages:   [][32]byte{msg},
+		}
+	}
+	return sets
+}
+
+func TestSignatureVerifier(t *testing.T) {
+	s := &Service{}
+	sets := signatureSets(t, 10)
+	for _, workers := range []int{1, 4} {
+		v := newSignatureVerifier(workers, s.verifySignatureSet)
+		for _, set := range sets {
+			v.submit(set)
+		}
+		require.NoError(t, v.wait())
+		require.NoError(t, v.wait())
+	}
+
+	sets[6].Messages[0] = [32]byte{'b', 'a', 'd'}
+	for _, workers := range []int{1, 4} {
+		v := newSignatureVerifier(workers, s.verifySignatureSet)
+		for _, set := range sets {
+			v.submit(set)
+		}
+		assert.ErrorContains(t, "signature of message 0x6261640000", v.wait())
+		assert.ErrorContains(t, "signature of message 0x6261640000", v.err())
+	}
+}
+
+func TestStore_OnBlockBatch_VerifyWorkers(t *testing.T) {
+	ctx := context.Background()
+	service, blks, blkRoots := setupBlockBatch(t, 10)
+	service.sigVerifyWorkers = 2
+	_, _, err := service.onBlockBatch(ctx, blks, blkRoots)
+	require.NoError(t, err)
+
+	// A tampered signature fails the batch, without saving its post state.
+	service, blks, blkRoots = setupBlockBatch(t, 10)
+	service.sigVerifyWorkers = 2
+	blks[3].Signature = blks[2].Signature
+	_, _, err = service.onBlockBatch(ctx, blks, blkRoots)
+	assert.ErrorContains(t, "batch block signature verification failed", err)
+	saved, err := service.stateGen.HasState(ctx, blkRoots[len(blkRoots)-1])
+	require.NoError(t, err)
+	assert.Equal(t, false, saved)
+}
+
+// setupBlockBatch returns a service holding the post state of a first block, along with the
+// batch of the following blocks built on top of it.
+func setupBlockBatch(t testing.TB, n int) (*Service, []*ethpb.SignedBeaconBlock, [][32]byte) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service, err := NewService(ctx, &Config{
+		BeaconDB: beaconDB,
+		StateGen: stategen.New(beaconDB),
+	})
+	require.NoError(t, err)
+
+	genesis := blocks.NewGenesisBlock(make([]byte, 32))
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	service.finalizedCheckpt = &ethpb.Checkpoint{Root: gRoot[:]}
+	service.forkChoiceStore = protoarray.New(0, 0, [32]byte{})
+	service.saveInitSyncBlock(gRoot, genesis)
+
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	var blks []*ethpb.SignedBeaconBlock
+	var blkRoots [][32]byte
+	for i := 1; i <= n; i++ {
+		b, err := testutil.GenerateFullBlock(st, keys, testutil.DefaultBlockGenConfig(), types.Slot(i))
+		require.NoError(t, err)
+		st, err = state.ExecuteStateTransition(ctx, st, b)
+		require.NoError(t, err)
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		if i == 1 {
+			require.NoError(t, service.stateGen.SaveState(ctx, root, st.Copy()))
+		}
+		service.saveInitSyncBlock(root, b)
+		blks = append(blks, b)
+		blkRoots = append(blkRoots, root)
+	}
+	blks[0].Block.ParentRoot = gRoot[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, blks[0]))
+	return service, blks[1:], blkRoots[1:]
+}
+
+func BenchmarkStore_OnBlockBatch(b *testing.B) {
+	service, blks, blkRoots := setupBlockBatch(b, 32)
+	for _, workers := range []int{0, 1, 2, 4} {
+		b.Run(fmt.Sprintf("%d workers", workers), func(b *testing.B) {
+			service.sigVerifyWorkers = workers
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, _, err := service.onBlockBatch(context.Background(), blks, blkRoots)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
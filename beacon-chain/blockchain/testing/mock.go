@@ -286,6 +286,11 @@ func (s *ChainService) ReceiveAttestationNoPubsub(context.Context, *ethpb.Attest
 	return nil
 }
 
+// UpdateHead mocks UpdateHead method in chain service.
+func (s *ChainService) UpdateHead(_ context.Context) ([]byte, error) {
+	return s.Root, nil
+}
+
 // AttestationPreState mocks AttestationPreState method in chain service.
 func (s *ChainService) AttestationPreState(_ context.Context, _ *ethpb.Attestation) (iface.BeaconState, error) {
 	return s.State, nil
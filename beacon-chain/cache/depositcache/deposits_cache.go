@@ -12,6 +12,7 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -28,6 +29,10 @@ var (
 		Name: "beacondb_all_deposits",
 		Help: "The number of total deposits in the beaconDB in-memory database",
 	})
+	depositCacheSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "deposit_cache_size",
+		Help: "The number of deposits retained in the in-memory deposit cache",
+	})
 )
 
 // DepositFetcher defines a struct which can retrieve deposit information from a store.
@@ -93,6 +98,7 @@ func (dc *DepositCache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blo
 	newDeposits := append([]*dbpb.DepositContainer{{Deposit: d, Eth1BlockHeight: blockNum, DepositRoot: depositRoot[:], Index: index}}, dc.deposits[heightIdx:]...)
 	dc.deposits = append(dc.deposits[:heightIdx], newDeposits...)
 	historicalDepositsCount.Inc()
+	depositCacheSize.Set(float64(len(dc.deposits)))
 }
 
 // InsertDepositContainers inserts a set of deposit containers into our deposit cache.
@@ -105,6 +111,7 @@ func (dc *DepositCache) InsertDepositContainers(ctx context.Context, ctrs []*dbp
 	sort.SliceStable(ctrs, func(i int, j int) bool { return ctrs[i].Index < ctrs[j].Index })
 	dc.deposits = ctrs
 	historicalDepositsCount.Add(float64(len(ctrs)))
+	depositCacheSize.Set(float64(len(dc.deposits)))
 }
 
 // InsertFinalizedDeposits inserts deposits up to eth1DepositIndex (inclusive) into the finalized deposits cache.
@@ -138,7 +145,30 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 	}
 }
 
-// AllDepositContainers returns all historical deposit containers.
+// InsertFinalizedDepositLeaves resets the finalized deposits trie to the given deposit data roots, which
+// must be those of the deposits preceding the first deposit in the cache. This rebuilds the trie on
+// startup when the finalized deposits were pruned from the cache before being persisted.
+func (dc *DepositCache) InsertFinalizedDepositLeaves(ctx context.Context, leaves [][]byte) error {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertFinalizedDepositLeaves")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	if len(dc.deposits) > 0 && dc.deposits[0].Index != int64(len(leaves)) {
+		return errors.Errorf("got %d finalized deposits but the first cached deposit has index %d", len(leaves), dc.deposits[0].Index)
+	}
+	depositTrie, err := trieutil.GenerateTrieFromItems(leaves, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return errors.Wrap(err, "could not generate finalized deposits trie")
+	}
+	dc.finalizedDeposits = &FinalizedDeposits{
+		Deposits:        depositTrie,
+		MerkleTrieIndex: int64(len(leaves)) - 1,
+	}
+	return nil
+}
+
+// AllDepositContainers returns all deposit containers retained in the cache.
 func (dc *DepositCache) AllDepositContainers(ctx context.Context) []*dbpb.DepositContainer {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.AllDepositContainers")
 	defer span.End()
@@ -148,8 +178,8 @@ func (dc *DepositCache) AllDepositContainers(ctx context.Context) []*dbpb.Deposi
 	return dc.deposits
 }
 
-// AllDeposits returns a list of historical deposits until the given block number
-// (inclusive). If no block is specified then this method returns all historical deposits.
+// AllDeposits returns a list of the deposits retained in the cache until the given block number
+// (inclusive). If no block is specified then this method returns all retained deposits.
 func (dc *DepositCache) AllDeposits(ctx context.Context, untilBlk *big.Int) []*ethpb.Deposit {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.AllDeposits")
 	defer span.End()
@@ -178,10 +208,25 @@ func (dc *DepositCache) DepositsNumberAndRootAtHeight(ctx context.Context, block
 	if heightIdx == 0 {
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
+	// Account for the deposits pruned from the cache, which precede the earliest retained deposit.
+	return uint64(heightIdx) + uint64(dc.deposits[0].Index), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
 }
 
-// DepositByPubkey looks through historical deposits and finds one which contains
+// NumberOfDeposits returns the number of deposits inserted into the cache, including the
+// deposits which have since been pruned.
+func (dc *DepositCache) NumberOfDeposits(ctx context.Context) uint64 {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.NumberOfDeposits")
+	defer span.End()
+	dc.depositsLock.RLock()
+	defer dc.depositsLock.RUnlock()
+
+	if len(dc.deposits) == 0 {
+		return 0
+	}
+	return uint64(len(dc.deposits)) + uint64(dc.deposits[0].Index)
+}
+
+// DepositByPubkey looks through the deposits retained in the cache and finds one which contains
 // a certain public key within its deposit data.
 func (dc *DepositCache) DepositByPubkey(ctx context.Context, pubKey []byte) (*ethpb.Deposit, *big.Int) {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.DepositByPubkey")
@@ -244,11 +289,16 @@ func (dc *DepositCache) PruneProofs(ctx context.Context, untilDepositIndex int64
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
-	if untilDepositIndex >= int64(len(dc.deposits)) {
-		untilDepositIndex = int64(len(dc.deposits) - 1)
+	if len(dc.deposits) == 0 {
+		return nil
+	}
+	// The deposits preceding the earliest retained deposit have been pruned along with their proofs.
+	untilPos := untilDepositIndex - dc.deposits[0].Index
+	if untilPos >= int64(len(dc.deposits)) {
+		untilPos = int64(len(dc.deposits) - 1)
 	}
 
-	for i := untilDepositIndex; i >= 0; i-- {
+	for i := untilPos; i >= 0; i-- {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
@@ -261,3 +311,31 @@ func (dc *DepositCache) PruneProofs(ctx context.Context, untilDepositIndex int64
 
 	return nil
 }
+
+// PruneDeposits removes the deposits up to untilDepositIndex (inclusive) which were made in an eth1
+// block below the given height, and returns the number of removed deposits. Only deposits already
+// inserted into the finalized deposits trie are removed, as the proofs of future deposits are built
+// on top of that trie. The latest of the removable deposits is retained, so that the number of
+// deposits and the deposit root at the height of any retained deposit are still known.
+func (dc *DepositCache) PruneDeposits(ctx context.Context, untilDepositIndex int64, beforeHeight uint64) int {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.PruneDeposits")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	if untilDepositIndex > dc.finalizedDeposits.MerkleTrieIndex {
+		untilDepositIndex = dc.finalizedDeposits.MerkleTrieIndex
+	}
+	// Deposits are sorted by index, and so by eth1 block height.
+	removable := sort.Search(len(dc.deposits), func(i int) bool {
+		return dc.deposits[i].Index > untilDepositIndex || dc.deposits[i].Eth1BlockHeight >= beforeHeight
+	})
+	if removable <= 1 {
+		return 0
+	}
+	pruned := removable - 1
+	// Copy the retained deposits so that the backing array of the pruned ones can be freed.
+	dc.deposits = append([]*dbpb.DepositContainer{}, dc.deposits[pruned:]...)
+	depositCacheSize.Set(float64(len(dc.deposits)))
+	return pruned
+}
@@ -743,6 +743,99 @@ func TestPruneProofs_CorrectlyHandleLastIndex(t *testing.T) {
 	assert.DeepEqual(t, [][]byte(nil), dc.deposits[3].Deposit.Proof)
 }
 
+func TestPruneDeposits_KeepsNonFinalizedAndRecentDeposits(t *testing.T) {
+	dc, err := New()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 6; i++ {
+		root := bytesutil.ToBytes32([]byte{byte(i + 1)})
+		dc.InsertDeposit(ctx, &ethpb.Deposit{Data: &ethpb.Deposit_Data{
+			PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			Signature:             make([]byte, 96),
+		}}, uint64(10+i), int64(i), root)
+	}
+	dc.InsertFinalizedDeposits(ctx, 3)
+	finalizedRoot := dc.FinalizedDeposits(ctx).Deposits.HashTreeRoot()
+
+	// Deposits 4 and 5 are not in the finalized deposits trie, so they are kept regardless of height.
+	// Deposit 3 is the latest removable deposit, and is kept as well.
+	assert.Equal(t, 3, dc.PruneDeposits(ctx, 5, 100))
+	require.Equal(t, 3, len(dc.deposits))
+	assert.Equal(t, int64(3), dc.deposits[0].Index)
+	assert.Equal(t, uint64(6), dc.NumberOfDeposits(ctx))
+	assert.Equal(t, finalizedRoot, dc.FinalizedDeposits(ctx).Deposits.HashTreeRoot())
+	assert.Equal(t, 2, len(dc.NonFinalizedDeposits(ctx, nil)))
+
+	n, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(14))
+	assert.Equal(t, uint64(5), n)
+	assert.Equal(t, bytesutil.ToBytes32([]byte{5}), root)
+	n, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(13))
+	assert.Equal(t, uint64(4), n)
+	assert.Equal(t, bytesutil.ToBytes32([]byte{4}), root)
+
+	// Proofs are pruned by deposit index.
+	dc.deposits[1].Deposit.Proof = makeDepositProof()
+	require.NoError(t, dc.PruneProofs(ctx, 4))
+	assert.DeepEqual(t, [][]byte(nil), dc.deposits[1].Deposit.Proof)
+
+	assert.Equal(t, 0, dc.PruneDeposits(ctx, 5, 100))
+}
+
+func TestPruneDeposits_KeepsDepositsAfterHeight(t *testing.T) {
+	dc, err := New()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 4; i++ {
+		dc.InsertDeposit(ctx, &ethpb.Deposit{Data: &ethpb.Deposit_Data{
+			PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			Signature:             make([]byte, 96),
+		}}, uint64(10+i), int64(i), [32]byte{})
+	}
+	dc.InsertFinalizedDeposits(ctx, 3)
+
+	assert.Equal(t, 1, dc.PruneDeposits(ctx, 3, 12))
+	require.Equal(t, 3, len(dc.deposits))
+	assert.Equal(t, uint64(11), dc.deposits[0].Eth1BlockHeight)
+}
+
+func TestInsertFinalizedDepositLeaves_RebuildsPrunedDeposits(t *testing.T) {
+	dc, err := New()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	var ctrs []*dbpb.DepositContainer
+	var leaves [][]byte
+	for i := 0; i < 4; i++ {
+		ctr := &dbpb.DepositContainer{
+			Deposit: &ethpb.Deposit{Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Signature:             make([]byte, 96),
+			}},
+			Index: int64(i),
+		}
+		hash, err := ctr.Deposit.Data.HashTreeRoot()
+		require.NoError(t, err)
+		ctrs = append(ctrs, ctr)
+		leaves = append(leaves, hash[:])
+	}
+	dc.InsertDepositContainers(ctx, ctrs)
+	dc.InsertFinalizedDeposits(ctx, 3)
+	want := dc.FinalizedDeposits(ctx).Deposits.HashTreeRoot()
+
+	restored, err := New()
+	require.NoError(t, err)
+	restored.InsertDepositContainers(ctx, ctrs[2:])
+	require.ErrorContains(t, "first cached deposit has index 2", restored.InsertFinalizedDepositLeaves(ctx, leaves[:1]))
+	require.NoError(t, restored.InsertFinalizedDepositLeaves(ctx, leaves[:2]))
+	restored.InsertFinalizedDeposits(ctx, 3)
+	assert.Equal(t, want, restored.FinalizedDeposits(ctx).Deposits.HashTreeRoot())
+}
+
 func makeDepositProof() [][]byte {
 	proof := make([][]byte, int(params.BeaconConfig().DepositContractTreeDepth)+1)
 	for i := range proof {
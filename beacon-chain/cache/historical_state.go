@@ -0,0 +1,74 @@
+package cache
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+)
+
+var (
+	// Metrics.
+	historicalStateMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "historical_state_cache_miss",
+		Help: "The number of historical state requests that aren't present in the cache.",
+	})
+	historicalStateHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "historical_state_cache_hit",
+		Help: "The number of historical state requests that are present in the cache.",
+	})
+)
+
+// HistoricalStateCache is a LRU cache of historical states keyed by slot, used to avoid
+// replaying the same historical state from the nearest stored state on repeated queries.
+type HistoricalStateCache struct {
+	cache *lru.Cache
+	lock  sync.RWMutex
+}
+
+// NewHistoricalStateCache creates a new historical state cache holding at most size states.
+func NewHistoricalStateCache(size int) *HistoricalStateCache {
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &HistoricalStateCache{
+		cache: cache,
+	}
+}
+
+// StateBySlot fetches a copy of the state at the given slot. Returns nil if it does not exist.
+func (c *HistoricalStateCache) StateBySlot(slot types.Slot) iface.BeaconState {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	item, exists := c.cache.Get(slot)
+	if exists && item != nil {
+		historicalStateHit.Inc()
+		return item.(iface.BeaconState).Copy()
+	}
+	historicalStateMiss.Inc()
+	return nil
+}
+
+// AddState adds a copy of the state at the given slot to the cache, trimming the least
+// recently used state if the cache is full.
+func (c *HistoricalStateCache) AddState(slot types.Slot, s iface.BeaconState) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Add(slot, s.Copy())
+}
+
+// PruneAfter removes the states of the slots after the given slot, as they may
+// no longer be canonical after a chain reorg.
+func (c *HistoricalStateCache) PruneAfter(slot types.Slot) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, k := range c.cache.Keys() {
+		if k.(types.Slot) > slot {
+			c.cache.Remove(k)
+		}
+	}
+}
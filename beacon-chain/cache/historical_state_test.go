@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestHistoricalStateCache_StateBySlot(t *testing.T) {
+	cache := NewHistoricalStateCache(2)
+	assert.Equal(t, iface.BeaconState(nil), cache.StateBySlot(1), "Expected state not to exist in empty cache")
+
+	for _, slot := range []types.Slot{1, 2, 3} {
+		st, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: slot})
+		require.NoError(t, err)
+		cache.AddState(slot, st)
+	}
+
+	// The least recently used state is evicted once the cache is full.
+	assert.Equal(t, iface.BeaconState(nil), cache.StateBySlot(1), "Expected state to be evicted")
+	st := cache.StateBySlot(3)
+	require.NotNil(t, st)
+	assert.Equal(t, types.Slot(3), st.Slot())
+
+	// Mutating the returned state does not affect the cached one.
+	require.NoError(t, st.SetSlot(10))
+	assert.Equal(t, types.Slot(3), cache.StateBySlot(3).Slot())
+}
+
+func TestHistoricalStateCache_PruneAfter(t *testing.T) {
+	cache := NewHistoricalStateCache(4)
+	for _, slot := range []types.Slot{1, 2, 3} {
+		st, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: slot})
+		require.NoError(t, err)
+		cache.AddState(slot, st)
+	}
+
+	cache.PruneAfter(1)
+	require.NotNil(t, cache.StateBySlot(1))
+	assert.Equal(t, iface.BeaconState(nil), cache.StateBySlot(2))
+	assert.Equal(t, iface.BeaconState(nil), cache.StateBySlot(3))
+}
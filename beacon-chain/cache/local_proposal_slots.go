@@ -0,0 +1,35 @@
+package cache
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+type localProposalSlots struct {
+	slots *lru.Cache
+}
+
+// LocalProposalSlots records the slots at which a validator attached to this node is
+// assigned to propose a block.
+var LocalProposalSlots = newLocalProposalSlots()
+
+func newLocalProposalSlots() *localProposalSlots {
+	// Proposer duties are requested for the current epoch, keep up to 2 epochs worth of slots.
+	cacheSize := int(params.BeaconConfig().SlotsPerEpoch * 2)
+	slots, err := lru.New(cacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &localProposalSlots{slots: slots}
+}
+
+// Add records a slot at which a local validator proposes a block.
+func (c *localProposalSlots) Add(slot types.Slot) {
+	c.slots.Add(slot, true)
+}
+
+// Has returns true if a local validator proposes a block at the given slot.
+func (c *localProposalSlots) Has(slot types.Slot) bool {
+	return c.slots.Contains(slot)
+}
@@ -0,0 +1,25 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestLocalProposalSlots_RoundTrip(t *testing.T) {
+	c := newLocalProposalSlots()
+	assert.Equal(t, false, c.Has(10))
+
+	c.Add(10)
+	assert.Equal(t, true, c.Has(10))
+	assert.Equal(t, false, c.Has(11))
+
+	// Old slots are evicted once the cache is full.
+	for i := types.Slot(0); i < params.BeaconConfig().SlotsPerEpoch*2; i++ {
+		c.Add(100 + i)
+	}
+	assert.Equal(t, false, c.Has(10))
+	assert.Equal(t, true, c.Has(100))
+}
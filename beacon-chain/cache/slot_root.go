@@ -0,0 +1,77 @@
+package cache
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// slotRootCacheSize is the number of slots whose canonical block root is cached,
+// which covers the last few epochs polled by dashboards.
+const slotRootCacheSize = 256
+
+var (
+	// Metrics.
+	slotRootMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slot_root_cache_miss",
+		Help: "The number of block root by slot requests that aren't present in the cache.",
+	})
+	slotRootHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slot_root_cache_hit",
+		Help: "The number of block root by slot requests that are present in the cache.",
+	})
+)
+
+// SlotRootCache is a LRU cache of the canonical block roots keyed by slot, used to resolve
+// the blocks of recent slots without scanning the slot index of the database.
+type SlotRootCache struct {
+	cache *lru.Cache
+	lock  sync.RWMutex
+}
+
+// NewSlotRootCache creates a new slot root cache.
+func NewSlotRootCache() *SlotRootCache {
+	cache, err := lru.New(slotRootCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &SlotRootCache{
+		cache: cache,
+	}
+}
+
+// RootBySlot fetches the canonical block root of the given slot, and whether it exists.
+func (c *SlotRootCache) RootBySlot(slot types.Slot) ([32]byte, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	item, exists := c.cache.Get(slot)
+	if exists && item != nil {
+		slotRootHit.Inc()
+		return item.([32]byte), true
+	}
+	slotRootMiss.Inc()
+	return [32]byte{}, false
+}
+
+// AddRoot adds the canonical block root of the given slot to the cache, trimming the
+// least recently used root if the cache is full.
+func (c *SlotRootCache) AddRoot(slot types.Slot, root [32]byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Add(slot, root)
+}
+
+// PruneAfter removes the roots of the slots after the given slot, as they may
+// no longer be canonical after a chain reorg.
+func (c *SlotRootCache) PruneAfter(slot types.Slot) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, k := range c.cache.Keys() {
+		if k.(types.Slot) > slot {
+			c.cache.Remove(k)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestSlotRootCache_RootBySlot(t *testing.T) {
+	cache := NewSlotRootCache()
+	_, ok := cache.RootBySlot(1)
+	assert.Equal(t, false, ok, "Expected root not to exist in empty cache")
+
+	cache.AddRoot(1, [32]byte{'a'})
+	root, ok := cache.RootBySlot(1)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, [32]byte{'a'}, root)
+
+	// The least recently used root is evicted once the cache is full.
+	for i := types.Slot(2); i <= slotRootCacheSize+1; i++ {
+		cache.AddRoot(i, [32]byte{byte(i)})
+	}
+	_, ok = cache.RootBySlot(1)
+	assert.Equal(t, false, ok, "Expected root to be evicted")
+}
+
+func TestSlotRootCache_PruneAfter(t *testing.T) {
+	cache := NewSlotRootCache()
+	for _, slot := range []types.Slot{1, 2, 3} {
+		cache.AddRoot(slot, [32]byte{byte(slot)})
+	}
+
+	cache.PruneAfter(1)
+	_, ok := cache.RootBySlot(1)
+	assert.Equal(t, true, ok)
+	_, ok = cache.RootBySlot(2)
+	assert.Equal(t, false, ok)
+	_, ok = cache.RootBySlot(3)
+	assert.Equal(t, false, ok)
+}
@@ -17,6 +17,7 @@ type subnetIDs struct {
 	aggregator        *lru.Cache
 	aggregatorLock    sync.RWMutex
 	persistentSubnets *cache.Cache
+	backboneSubnets   []uint64
 	subnetsLock       sync.RWMutex
 }
 
@@ -108,13 +109,14 @@ func (s *subnetIDs) GetPersistentSubnets(pubkey []byte) ([]uint64, bool, time.Ti
 }
 
 // GetAllSubnets retrieves all the non-expired subscribed subnets of all the validators
-// in the cache.
+// in the cache, along with the backbone subnets of the node.
 func (s *subnetIDs) GetAllSubnets() []uint64 {
 	s.subnetsLock.RLock()
 	defer s.subnetsLock.RUnlock()
 
 	itemsMap := s.persistentSubnets.Items()
 	var committees []uint64
+	committees = append(committees, s.backboneSubnets...)
 
 	for _, v := range itemsMap {
 		if v.Expired() {
@@ -134,6 +136,15 @@ func (s *subnetIDs) AddPersistentCommittee(pubkey []byte, comIndex []uint64, dur
 	s.persistentSubnets.Set(string(pubkey), comIndex, duration)
 }
 
+// SetBackboneSubnets sets the subnets the node stays subscribed to,
+// independently of the duties of its validators.
+func (s *subnetIDs) SetBackboneSubnets(subnets []uint64) {
+	s.subnetsLock.Lock()
+	defer s.subnetsLock.Unlock()
+
+	s.backboneSubnets = subnets
+}
+
 // EmptyAllCaches empties out all the related caches and flushes any stored
 // entries on them. This should only ever be used for testing, in normal
 // production, handling of the relevant subnets for each role is done
@@ -150,5 +161,6 @@ func (s *subnetIDs) EmptyAllCaches() {
 
 	s.subnetsLock.Lock()
 	s.persistentSubnets.Flush()
+	s.backboneSubnets = nil
 	s.subnetsLock.Unlock()
 }
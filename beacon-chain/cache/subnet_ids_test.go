@@ -63,3 +63,15 @@ func TestSubnetIDsCache_PersistentCommitteeRoundtrip(t *testing.T) {
 	coms := c.GetAllSubnets()
 	assert.Equal(t, 20, len(coms))
 }
+
+func TestSubnetIDsCache_BackboneSubnets(t *testing.T) {
+	c := newSubnetIDs()
+	c.SetBackboneSubnets([]uint64{3, 7})
+	pubkey := [48]byte{'a'}
+	c.AddPersistentCommittee(pubkey[:], []uint64{7, 9}, 0)
+
+	assert.DeepEqual(t, []uint64{3, 7, 9}, c.GetAllSubnets())
+
+	c.EmptyAllCaches()
+	assert.Equal(t, 0, len(c.GetAllSubnets()))
+}
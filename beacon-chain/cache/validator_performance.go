@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// validatorPerformanceCacheSize is the number of epochs whose performance record is cached.
+// A record holds a few bytes per validator of the registry.
+const validatorPerformanceCacheSize = 32
+
+var (
+	// Metrics.
+	validatorPerformanceMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "validator_performance_cache_miss",
+		Help: "The number of epoch performance requests that aren't present in the cache.",
+	})
+	validatorPerformanceHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "validator_performance_cache_hit",
+		Help: "The number of epoch performance requests that are present in the cache.",
+	})
+)
+
+// EpochPerformance is the record of the attestation and proposal duties of the validators
+// during an epoch, as included in the canonical chain.
+type EpochPerformance struct {
+	// Active holds, by validator index, whether the validator was active during the epoch.
+	Active []bool
+	// InclusionDistances holds, by validator index, the inclusion distance of the attestation
+	// of the validator for the epoch, 0 if none was included.
+	InclusionDistances []types.Slot
+	// AssignedProposals holds the number of slots of the epoch assigned to each proposer.
+	AssignedProposals map[types.ValidatorIndex]uint64
+	// MissedProposals holds the number of assigned slots for which a proposer has no canonical block.
+	MissedProposals map[types.ValidatorIndex]uint64
+}
+
+// ValidatorPerformanceCache is a LRU cache of the performance records of the validators keyed by epoch.
+type ValidatorPerformanceCache struct {
+	cache *lru.Cache
+	lock  sync.RWMutex
+}
+
+// NewValidatorPerformanceCache creates a new validator performance cache.
+func NewValidatorPerformanceCache() *ValidatorPerformanceCache {
+	cache, err := lru.New(validatorPerformanceCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return &ValidatorPerformanceCache{
+		cache: cache,
+	}
+}
+
+// EpochPerformance fetches the performance record of the given epoch, and whether it exists.
+func (c *ValidatorPerformanceCache) EpochPerformance(epoch types.Epoch) (*EpochPerformance, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	item, exists := c.cache.Get(epoch)
+	if exists && item != nil {
+		validatorPerformanceHit.Inc()
+		return item.(*EpochPerformance), true
+	}
+	validatorPerformanceMiss.Inc()
+	return nil, false
+}
+
+// AddEpochPerformance adds the performance record of the given epoch to the cache, trimming
+// the least recently used record if the cache is full.
+func (c *ValidatorPerformanceCache) AddEpochPerformance(epoch types.Epoch, p *EpochPerformance) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Add(epoch, p)
+}
+
+// PruneAfter removes the records which depend on blocks after the given slot, as they may
+// no longer be canonical after a chain reorg. The attestations of an epoch can be included
+// until the end of the next epoch, so its record depends on the blocks up to that point.
+func (c *ValidatorPerformanceCache) PruneAfter(slot types.Slot) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, k := range c.cache.Keys() {
+		lastInclusionSlot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(k.(types.Epoch)+2)) - 1
+		if lastInclusionSlot > slot {
+			c.cache.Remove(k)
+		}
+	}
+}
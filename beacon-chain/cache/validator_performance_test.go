@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestValidatorPerformanceCache_EpochPerformance(t *testing.T) {
+	cache := NewValidatorPerformanceCache()
+	_, ok := cache.EpochPerformance(1)
+	assert.Equal(t, false, ok, "Expected record not to exist in empty cache")
+
+	p := &EpochPerformance{Active: []bool{true}, InclusionDistances: []types.Slot{1}}
+	cache.AddEpochPerformance(1, p)
+	got, ok := cache.EpochPerformance(1)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, p, got)
+
+	// The least recently used record is evicted once the cache is full.
+	for i := types.Epoch(2); i <= validatorPerformanceCacheSize+1; i++ {
+		cache.AddEpochPerformance(i, &EpochPerformance{})
+	}
+	_, ok = cache.EpochPerformance(1)
+	assert.Equal(t, false, ok, "Expected record to be evicted")
+}
+
+func TestValidatorPerformanceCache_PruneAfter(t *testing.T) {
+	cache := NewValidatorPerformanceCache()
+	for _, epoch := range []types.Epoch{1, 2, 3} {
+		cache.AddEpochPerformance(epoch, &EpochPerformance{})
+	}
+
+	// The attestations of epoch 2 can be included until the last slot of epoch 3.
+	cache.PruneAfter(params.BeaconConfig().SlotsPerEpoch*4 - 1)
+	_, ok := cache.EpochPerformance(1)
+	assert.Equal(t, true, ok)
+	_, ok = cache.EpochPerformance(2)
+	assert.Equal(t, true, ok)
+	_, ok = cache.EpochPerformance(3)
+	assert.Equal(t, false, ok)
+
+	cache.PruneAfter(params.BeaconConfig().SlotsPerEpoch*4 - 2)
+	_, ok = cache.EpochPerformance(1)
+	assert.Equal(t, true, ok)
+	_, ok = cache.EpochPerformance(2)
+	assert.Equal(t, false, ok)
+}
@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"sync"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+)
+
+// ValidatorPubkeyCache maps the validator indices of the registry to their public keys and back.
+// Validators are only ever appended to the registry and keep their index, so the cache is
+// refreshed by adding the validators of the head state past the ones already cached.
+type ValidatorPubkeyCache struct {
+	pubkeys [][48]byte
+	indices map[[48]byte]types.ValidatorIndex
+	lock    sync.RWMutex
+}
+
+// NewValidatorPubkeyCache creates a new, empty validator public key cache.
+func NewValidatorPubkeyCache() *ValidatorPubkeyCache {
+	return &ValidatorPubkeyCache{
+		indices: make(map[[48]byte]types.ValidatorIndex),
+	}
+}
+
+// Update adds the validators of the state's registry which are not cached yet.
+func (c *ValidatorPubkeyCache) Update(st iface.ReadOnlyValidators) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for i := len(c.pubkeys); i < st.NumValidators(); i++ {
+		pubkey := st.PubkeyAtIndex(types.ValidatorIndex(i))
+		c.pubkeys = append(c.pubkeys, pubkey)
+		c.indices[pubkey] = types.ValidatorIndex(i)
+	}
+}
+
+// PublicKey returns the public key of the validator at the given index, and whether it is cached.
+func (c *ValidatorPubkeyCache) PublicKey(idx types.ValidatorIndex) ([48]byte, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if uint64(idx) >= uint64(len(c.pubkeys)) {
+		return [48]byte{}, false
+	}
+	return c.pubkeys[idx], true
+}
+
+// Index returns the index of the validator with the given public key, and whether it is cached.
+func (c *ValidatorPubkeyCache) Index(pubkey [48]byte) (types.ValidatorIndex, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	idx, ok := c.indices[pubkey]
+	return idx, ok
+}
+
+// Len returns the number of cached validators.
+func (c *ValidatorPubkeyCache) Len() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return len(c.pubkeys)
+}
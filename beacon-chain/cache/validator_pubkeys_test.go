@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestValidatorPubkeyCache_Update(t *testing.T) {
+	cache := NewValidatorPubkeyCache()
+	validators := []*ethpb.Validator{
+		{PublicKey: bytesutil.PadTo([]byte{'a'}, 48)},
+		{PublicKey: bytesutil.PadTo([]byte{'b'}, 48)},
+	}
+	st, err := stateTrie.InitializeFromProto(&pb.BeaconState{Validators: validators})
+	require.NoError(t, err)
+	cache.Update(st)
+	assert.Equal(t, 2, cache.Len())
+
+	pubkey, ok := cache.PublicKey(1)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, bytesutil.ToBytes48(validators[1].PublicKey), pubkey)
+	idx, ok := cache.Index(bytesutil.ToBytes48(validators[0].PublicKey))
+	assert.Equal(t, true, ok)
+	assert.Equal(t, types.ValidatorIndex(0), idx)
+
+	newKey := bytesutil.ToBytes48(bytesutil.PadTo([]byte{'c'}, 48))
+	_, ok = cache.PublicKey(2)
+	assert.Equal(t, false, ok, "Expected validator not to be cached yet")
+	_, ok = cache.Index(newKey)
+	assert.Equal(t, false, ok, "Expected validator not to be cached yet")
+
+	// Validators appended to the registry are added on the next update.
+	validators = append(validators, &ethpb.Validator{PublicKey: newKey[:]})
+	st, err = stateTrie.InitializeFromProto(&pb.BeaconState{Validators: validators})
+	require.NoError(t, err)
+	cache.Update(st)
+	assert.Equal(t, 3, cache.Len())
+	pubkey, ok = cache.PublicKey(2)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, newKey, pubkey)
+	idx, ok = cache.Index(newKey)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, types.ValidatorIndex(2), idx)
+}
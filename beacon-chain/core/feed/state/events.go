@@ -22,6 +22,10 @@ const (
 	// Reorg is an event sent when the new head state's slot after a block
 	// transition is lower than its previous head state slot value.
 	Reorg
+	// NewHead is sent after the beacon node has updated its head block.
+	NewHead
+	// FinalizedCheckpoint is sent after the beacon node has saved a new finalized checkpoint.
+	FinalizedCheckpoint
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -62,4 +66,38 @@ type ReorgData struct {
 	NewSlot types.Slot
 	// OldSlot is the slot of the head state before the reorg.
 	OldSlot types.Slot
+	// NewHeadRoot is the root of the head block after the reorg.
+	NewHeadRoot [32]byte
+	// OldHeadRoot is the root of the head block before the reorg.
+	OldHeadRoot [32]byte
+	// NewStateRoot is the state root of the head block after the reorg.
+	NewStateRoot [32]byte
+	// OldStateRoot is the state root of the head block before the reorg.
+	OldStateRoot [32]byte
+	// CommonAncestorSlot is the slot of the latest block shared by the old and the new head.
+	CommonAncestorSlot types.Slot
+	// Depth is the number of slots of the old head's chain past the common ancestor, 0 if unknown.
+	Depth uint64
+}
+
+// NewHeadData is the data sent with NewHead events.
+type NewHeadData struct {
+	// Slot is the slot of the new head block.
+	Slot types.Slot
+	// BlockRoot of the new head block.
+	BlockRoot [32]byte
+	// StateRoot of the new head block.
+	StateRoot [32]byte
+	// EpochTransition is true if the new head block is the first slot of an epoch.
+	EpochTransition bool
+}
+
+// FinalizedCheckpointData is the data sent with FinalizedCheckpoint events.
+type FinalizedCheckpointData struct {
+	// Epoch of the finalized checkpoint.
+	Epoch types.Epoch
+	// BlockRoot of the finalized checkpoint.
+	BlockRoot [32]byte
+	// StateRoot of the finalized checkpoint block.
+	StateRoot [32]byte
 }
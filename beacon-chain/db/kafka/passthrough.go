@@ -137,6 +137,16 @@ func (e Exporter) SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte)
 	return e.db.SaveGenesisBlockRoot(ctx, blockRoot)
 }
 
+// OriginCheckpointBlockRoot -- passthrough.
+func (e Exporter) OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error) {
+	return e.db.OriginCheckpointBlockRoot(ctx)
+}
+
+// SaveOriginCheckpointBlockRoot -- passthrough.
+func (e Exporter) SaveOriginCheckpointBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	return e.db.SaveOriginCheckpointBlockRoot(ctx, blockRoot)
+}
+
 // SaveState -- passthrough.
 func (e Exporter) SaveState(ctx context.Context, st iface.ReadOnlyBeaconState, blockRoot [32]byte) error {
 	return e.db.SaveState(ctx, st, blockRoot)
@@ -266,3 +276,8 @@ func (e Exporter) RunMigrations(ctx context.Context) error {
 func (e Exporter) CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint types.Slot) error {
 	return e.db.RunMigrations(ctx)
 }
+
+// PruneStates -- passthrough
+func (e Exporter) PruneStates(ctx context.Context, belowSlot, slotsPerArchivedPoint types.Slot) (int, error) {
+	return e.db.PruneStates(ctx, belowSlot, slotsPerArchivedPoint)
+}
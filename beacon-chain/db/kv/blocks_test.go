@@ -161,6 +161,29 @@ func TestStore_GenesisBlock(t *testing.T) {
 	assert.Equal(t, true, proto.Equal(genesisBlock, retrievedBlock), "Wanted: %v, received: %v", genesisBlock, retrievedBlock)
 }
 
+func TestStore_OriginCheckpointBlockRoot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	root, err := db.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, root)
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 64
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	require.NoError(t, db.SaveOriginCheckpointBlockRoot(ctx, blockRoot))
+	root, err = db.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, root)
+
+	// The checkpoint block must not be served as the genesis block.
+	genesisBlock, err := db.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, genesisBlock == nil, "Expected no genesis block")
+}
+
 func TestStore_BlocksCRUD_NoCache(t *testing.T) {
 	db := setupDB(t)
 	ctx := context.Background()
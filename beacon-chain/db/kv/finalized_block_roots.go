@@ -46,6 +46,7 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 	root := checkpoint.Root
 	var previousRoot []byte
 	genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+	originRoot := tx.Bucket(blocksBucket).Get(originBlockRootKey)
 
 	// De-index recent finalized block roots, to be re-indexed.
 	previousFinalizedCheckpoint := &ethpb.Checkpoint{}
@@ -72,9 +73,9 @@ func (s *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, chec
 	}
 
 	// Walk up the ancestry chain until we reach a block root present in the finalized block roots
-	// index bucket or genesis block root.
+	// index bucket, the genesis block root or the root of the checkpoint block the node started from.
 	for {
-		if bytes.Equal(root, genesisRoot) {
+		if bytes.Equal(root, genesisRoot) || bytes.Equal(root, originRoot) {
 			break
 		}
 
@@ -166,10 +167,11 @@ func (s *Store) IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool {
 	var exists bool
 	err := s.db.View(func(tx *bolt.Tx) error {
 		exists = tx.Bucket(finalizedBlockRootsIndexBucket).Get(blockRoot[:]) != nil
-		// Check genesis block root.
+		// Check genesis and origin checkpoint block roots.
 		if !exists {
 			genRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
-			exists = bytesutil.ToBytes32(genRoot) == blockRoot
+			originRoot := tx.Bucket(blocksBucket).Get(originBlockRootKey)
+			exists = bytesutil.ToBytes32(genRoot) == blockRoot || bytesutil.ToBytes32(originRoot) == blockRoot
 		}
 		return nil
 	})
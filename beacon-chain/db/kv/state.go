@@ -138,9 +138,11 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 
 		blockBkt := tx.Bucket(blocksBucket)
 		headBlkRoot := blockBkt.Get(headBlockRootKey)
+		originBlkRoot := blockBkt.Get(originBlockRootKey)
 		bkt = tx.Bucket(stateBucket)
-		// Safe guard against deleting genesis, finalized, head state.
-		if bytes.Equal(blockRoot[:], checkpoint.Root) || bytes.Equal(blockRoot[:], genesisBlockRoot) || bytes.Equal(blockRoot[:], headBlkRoot) {
+		// Safe guard against deleting genesis, origin checkpoint, finalized, head state.
+		if bytes.Equal(blockRoot[:], checkpoint.Root) || bytes.Equal(blockRoot[:], genesisBlockRoot) ||
+			bytes.Equal(blockRoot[:], originBlkRoot) || bytes.Equal(blockRoot[:], headBlkRoot) {
 			return errors.New("cannot delete genesis, finalized, or head state")
 		}
 
@@ -287,6 +289,65 @@ func (s *Store) HighestSlotStatesBelow(ctx context.Context, slot types.Slot) ([]
 	return []iface.ReadOnlyBeaconState{st}, nil
 }
 
+// PruneStates removes the states below the input slot that do not lay on an archived point,
+// returning the number of deleted states. The genesis, finalized and head states are always kept.
+// Pruned states are regenerated by replaying blocks on top of the closest saved state below them.
+func (s *Store) PruneStates(ctx context.Context, belowSlot, slotsPerArchivedPoint types.Slot) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneStates")
+	defer span.End()
+
+	if slotsPerArchivedPoint == 0 {
+		return 0, errors.New("slots per archived point can not be 0")
+	}
+	prunedRoots := make([][32]byte, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		blockBkt := tx.Bucket(blocksBucket)
+		protected := [][]byte{blockBkt.Get(genesisBlockRootKey), blockBkt.Get(originBlockRootKey), blockBkt.Get(headBlockRootKey)}
+		if enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey); enc != nil {
+			checkpoint := &ethpb.Checkpoint{}
+			if err := decode(ctx, enc, checkpoint); err != nil {
+				return err
+			}
+			protected = append(protected, checkpoint.Root)
+		}
+
+		c := tx.Bucket(stateSlotIndicesBucket).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			slot := bytesutil.BytesToSlotBigEndian(k)
+			if slot >= belowSlot {
+				break
+			}
+			if slot == 0 || slot%slotsPerArchivedPoint == 0 || v == nil {
+				continue
+			}
+			isProtected := false
+			for _, root := range protected {
+				if bytes.Equal(root, v) {
+					isProtected = true
+					break
+				}
+			}
+			if !isProtected {
+				prunedRoots = append(prunedRoots, bytesutil.ToBytes32(v))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(prunedRoots) == 0 {
+		return 0, nil
+	}
+	if err := s.DeleteStates(ctx, prunedRoots); err != nil {
+		return 0, err
+	}
+	return len(prunedRoots), nil
+}
+
 // createBlockIndicesFromBlock takes in a beacon block and returns
 // a map of bolt DB index buckets corresponding to each particular key for indices for
 // data, such as (shard indices bucket -> shard 5).
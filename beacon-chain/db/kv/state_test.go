@@ -340,3 +340,49 @@ func TestStore_CleanUpDirtyStates_DontDeleteNonFinalized(t *testing.T) {
 		require.Equal(t, true, db.HasState(context.Background(), rt))
 	}
 }
+
+func TestStore_PruneStates(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	genesisState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	genesisRoot := [32]byte{'a'}
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveState(ctx, genesisState, genesisRoot))
+
+	bRoots := make([][32]byte, 0)
+	prevRoot := genesisRoot
+	for i := types.Slot(1); i <= 16; i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = i
+		b.Block.ParentRoot = prevRoot[:]
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(ctx, b))
+		bRoots = append(bRoots, r)
+		prevRoot = r
+
+		st, err := testutil.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(i))
+		require.NoError(t, db.SaveState(ctx, st, r))
+	}
+	// The finalized and head states are kept even if they fall in the pruned range.
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: bRoots[4][:]}))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, bRoots[6]))
+
+	pruned, err := db.PruneStates(ctx, 12, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 7, pruned)
+
+	assert.Equal(t, true, db.HasState(ctx, genesisRoot))
+	for i, root := range bRoots {
+		slot := types.Slot(i + 1)
+		kept := slot >= 12 || slot%4 == 0 || slot == 5 || slot == 7
+		assert.Equal(t, kept, db.HasState(ctx, root), "Unexpected state presence at slot %d", slot)
+	}
+
+	_, err = db.PruneStates(ctx, 12, 0)
+	assert.ErrorContains(t, "slots per archived point can not be 0", err)
+}
@@ -0,0 +1,292 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// IntegrityReport lists the dangling references found in the block, state and
+// state summary buckets of the database.
+type IntegrityReport struct {
+	BlocksScanned    int
+	StatesScanned    int
+	SummariesScanned int
+	// MissingParentBlocks are the blocks whose parent block is not in the database.
+	MissingParentBlocks [][32]byte
+	// UnreachableBlocks are the blocks which cannot be linked back to the finalized chain,
+	// that is the blocks with a missing parent and all of their descendants.
+	UnreachableBlocks [][32]byte
+	// OrphanedStates are the states whose block is missing or unreachable.
+	OrphanedStates [][32]byte
+	// OrphanedSummaries are the state summaries whose block is missing or unreachable.
+	OrphanedSummaries [][32]byte
+	// DanglingHead is true if the head block root points to a missing or unreachable block.
+	DanglingHead bool
+
+	// protected holds the dangling entries which are part of the finalized history and
+	// are therefore never pruned.
+	protected map[[32]byte]bool
+	// fallbackHead is the root the head is reset to when it is dangling.
+	fallbackHead [32]byte
+}
+
+// Healthy returns true if no dangling reference was found.
+func (r *IntegrityReport) Healthy() bool {
+	return len(r.UnreachableBlocks) == 0 && len(r.OrphanedStates) == 0 && len(r.OrphanedSummaries) == 0 && !r.DanglingHead
+}
+
+// Protected returns the number of dangling entries which belong to the finalized history
+// and are left untouched by a repair.
+func (r *IntegrityReport) Protected() int {
+	return len(r.protected)
+}
+
+// VerifyIntegrity walks the block, state and state summary buckets and reports the
+// entries which cannot be linked back to the finalized chain. Blocks at or before the
+// finalized slot, the genesis block and the blocks of the finalized index are treated
+// as anchors of the chain.
+func (s *Store) VerifyIntegrity(ctx context.Context) (*IntegrityReport, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.VerifyIntegrity")
+	defer span.End()
+
+	// Flush the cached state summaries so that all of them are verified.
+	if err := s.saveCachedStateSummariesDB(ctx); err != nil {
+		return nil, err
+	}
+
+	report := &IntegrityReport{protected: make(map[[32]byte]bool)}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		blkBkt := tx.Bucket(blocksBucket)
+		genesisRoot := bytesutil.ToBytes32(blkBkt.Get(genesisBlockRootKey))
+		// The checkpoint block a node was started from has no parent in the db.
+		originRoot := bytesutil.ToBytes32(blkBkt.Get(originBlockRootKey))
+		checkpoint := &ethpb.Checkpoint{Root: genesisRoot[:]}
+		if enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey); enc != nil {
+			if err := decode(ctx, enc, checkpoint); err != nil {
+				return err
+			}
+		}
+		finalizedRoot := bytesutil.ToBytes32(checkpoint.Root)
+		finalizedSlot, err := helpers.StartSlot(checkpoint.Epoch)
+		if err != nil {
+			return err
+		}
+		finalizedIndex := tx.Bucket(finalizedBlockRootsIndexBucket)
+		isFinalized := func(root [32]byte, slot types.Slot) bool {
+			return root == genesisRoot || root == finalizedRoot || slot <= finalizedSlot ||
+				finalizedIndex.Get(root[:]) != nil
+		}
+
+		slots := make(map[[32]byte]types.Slot)
+		parents := make(map[[32]byte][32]byte)
+		if err := blkBkt.ForEach(func(k, v []byte) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// Skip the head, genesis and origin root keys stored alongside the blocks.
+			if len(k) != 32 {
+				return nil
+			}
+			blk := &ethpb.SignedBeaconBlock{}
+			if err := decode(ctx, v, blk); err != nil {
+				return err
+			}
+			if err := helpers.VerifyNilBeaconBlock(blk); err != nil {
+				return err
+			}
+			root := bytesutil.ToBytes32(k)
+			slots[root] = blk.Block.Slot
+			parents[root] = bytesutil.ToBytes32(blk.Block.ParentRoot)
+			return nil
+		}); err != nil {
+			return err
+		}
+		report.BlocksScanned = len(slots)
+
+		// A block is reachable if it is an anchor of the chain or if its parent is reachable.
+		reachable := make(map[[32]byte]bool, len(slots))
+		for root := range slots {
+			var path [][32]byte
+			r := root
+			ok := false
+			for {
+				if known, seen := reachable[r]; seen {
+					ok = known
+					break
+				}
+				slot, exists := slots[r]
+				if !exists {
+					break
+				}
+				if isFinalized(r, slot) {
+					reachable[r] = true
+					ok = true
+					break
+				}
+				path = append(path, r)
+				parent := parents[r]
+				if _, exists := slots[parent]; !exists {
+					report.MissingParentBlocks = append(report.MissingParentBlocks, r)
+				}
+				r = parent
+			}
+			for _, p := range path {
+				reachable[p] = ok
+			}
+		}
+		for root, slot := range slots {
+			if _, exists := slots[parents[root]]; !exists && parents[root] != params.BeaconConfig().ZeroHash && root != originRoot && isFinalized(root, slot) {
+				// Finalized blocks are never pruned, but their missing parent is still reported.
+				report.MissingParentBlocks = append(report.MissingParentBlocks, root)
+				report.protected[root] = true
+			}
+			if !reachable[root] {
+				report.UnreachableBlocks = append(report.UnreachableBlocks, root)
+			}
+		}
+		attached := func(root [32]byte) bool {
+			_, exists := slots[root]
+			return exists && reachable[root]
+		}
+
+		if err := tx.Bucket(stateBucket).ForEach(func(k, _ []byte) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			report.StatesScanned++
+			root := bytesutil.ToBytes32(k)
+			if attached(root) {
+				return nil
+			}
+			report.OrphanedStates = append(report.OrphanedStates, root)
+			slot, err := slotByBlockRoot(ctx, tx, k)
+			if err != nil {
+				return err
+			}
+			if isFinalized(root, slot) {
+				report.protected[root] = true
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		if err := tx.Bucket(stateSummaryBucket).ForEach(func(k, v []byte) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			report.SummariesScanned++
+			root := bytesutil.ToBytes32(k)
+			if attached(root) {
+				return nil
+			}
+			report.OrphanedSummaries = append(report.OrphanedSummaries, root)
+			summary := &pb.StateSummary{}
+			if err := decode(ctx, v, summary); err != nil {
+				return err
+			}
+			if isFinalized(root, summary.Slot) {
+				report.protected[root] = true
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		head := blkBkt.Get(headBlockRootKey)
+		report.DanglingHead = head != nil && !attached(bytesutil.ToBytes32(head))
+		report.fallbackHead = finalizedRoot
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// RepairIntegrity prunes the unreachable blocks and the orphaned states and state summaries
+// listed in the given report, and resets a dangling head to the finalized block root. Entries
+// belonging to the finalized history are never deleted. It returns the number of pruned entries.
+func (s *Store) RepairIntegrity(ctx context.Context, report *IntegrityReport) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RepairIntegrity")
+	defer span.End()
+
+	pruned := 0
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		if report.DanglingHead {
+			if tx.Bucket(blocksBucket).Get(report.fallbackHead[:]) == nil {
+				return errors.Errorf("cannot reset head, finalized block %#x is missing", report.fallbackHead)
+			}
+			if err := tx.Bucket(blocksBucket).Put(headBlockRootKey, report.fallbackHead[:]); err != nil {
+				return err
+			}
+		}
+
+		// States are deleted before the blocks and summaries their slot index is derived from.
+		stateBkt := tx.Bucket(stateBucket)
+		for _, root := range report.OrphanedStates {
+			if report.protected[root] {
+				continue
+			}
+			slot, err := slotByBlockRoot(ctx, tx, root[:])
+			if err != nil {
+				return err
+			}
+			if err := deleteValueForIndices(ctx, createStateIndicesFromStateSlot(ctx, slot), root[:], tx); err != nil {
+				return err
+			}
+			if err := stateBkt.Delete(root[:]); err != nil {
+				return err
+			}
+			pruned++
+		}
+
+		summaryBkt := tx.Bucket(stateSummaryBucket)
+		for _, root := range report.OrphanedSummaries {
+			if report.protected[root] {
+				continue
+			}
+			if err := summaryBkt.Delete(root[:]); err != nil {
+				return err
+			}
+			pruned++
+		}
+
+		blkBkt := tx.Bucket(blocksBucket)
+		for _, root := range report.UnreachableBlocks {
+			if report.protected[root] {
+				continue
+			}
+			enc := blkBkt.Get(root[:])
+			if enc == nil {
+				continue
+			}
+			blk := &ethpb.SignedBeaconBlock{}
+			if err := decode(ctx, enc, blk); err != nil {
+				return err
+			}
+			if err := deleteValueForIndices(ctx, createBlockIndicesFromBlock(ctx, blk.Block), root[:], tx); err != nil {
+				return err
+			}
+			s.blockCache.Del(string(root[:]))
+			if err := blkBkt.Delete(root[:]); err != nil {
+				return err
+			}
+			pruned++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return pruned, nil
+}
@@ -0,0 +1,115 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func saveTestBlock(t *testing.T, db *Store, slot types.Slot, parentRoot [32]byte) [32]byte {
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = slot
+	b.Block.ParentRoot = parentRoot[:]
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(context.Background(), b))
+	return r
+}
+
+func TestStore_VerifyIntegrity_Healthy(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	genesisRoot := saveTestBlock(t, db, 0, [32]byte{})
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	r1 := saveTestBlock(t, db, 1, genesisRoot)
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 1, Root: r1[:]}))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, r1))
+
+	report, err := db.VerifyIntegrity(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.BlocksScanned)
+	assert.Equal(t, 1, report.SummariesScanned)
+	assert.Equal(t, true, report.Healthy())
+}
+
+func TestStore_VerifyIntegrity_RepairsDanglingReferences(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	genesisRoot := saveTestBlock(t, db, 0, [32]byte{})
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 0, Root: genesisRoot[:]}))
+	r1 := saveTestBlock(t, db, 1, genesisRoot)
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: genesisRoot[:]}))
+
+	// A block whose parent is missing, and its child.
+	r5 := saveTestBlock(t, db, 5, [32]byte{'p'})
+	r6 := saveTestBlock(t, db, 6, r5)
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 6, Root: r6[:]}))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, r6))
+
+	// A state and a state summary without a block.
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(7))
+	require.NoError(t, db.SaveState(ctx, st, [32]byte{'s'}))
+	orphanedRoot := [32]byte{'y'}
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 8, Root: orphanedRoot[:]}))
+
+	report, err := db.VerifyIntegrity(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, false, report.Healthy())
+	assert.DeepEqual(t, [][32]byte{r5}, report.MissingParentBlocks)
+	assert.Equal(t, 2, len(report.UnreachableBlocks))
+	assert.DeepEqual(t, [][32]byte{{'s'}}, report.OrphanedStates)
+	assert.Equal(t, 2, len(report.OrphanedSummaries))
+	assert.Equal(t, true, report.DanglingHead)
+	assert.Equal(t, 0, report.Protected())
+
+	pruned, err := db.RepairIntegrity(ctx, report)
+	require.NoError(t, err)
+	assert.Equal(t, 5, pruned)
+	assert.Equal(t, false, db.HasBlock(ctx, r5))
+	assert.Equal(t, false, db.HasBlock(ctx, r6))
+	assert.Equal(t, false, db.HasState(ctx, [32]byte{'s'}))
+	assert.Equal(t, false, db.HasStateSummary(ctx, orphanedRoot))
+	assert.Equal(t, true, db.HasBlock(ctx, genesisRoot))
+	assert.Equal(t, true, db.HasBlock(ctx, r1))
+	head, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	headRoot, err := head.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, genesisRoot, headRoot)
+
+	report, err = db.VerifyIntegrity(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, report.Healthy())
+}
+
+func TestStore_RepairIntegrity_KeepsFinalizedEntries(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	genesisRoot := saveTestBlock(t, db, 0, [32]byte{})
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	// A state summary at the finalized slot without a block.
+	orphanedRoot := [32]byte{'z'}
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 0, Root: orphanedRoot[:]}))
+
+	report, err := db.VerifyIntegrity(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{orphanedRoot}, report.OrphanedSummaries)
+	assert.Equal(t, 1, report.Protected())
+
+	pruned, err := db.RepairIntegrity(ctx, report)
+	require.NoError(t, err)
+	assert.Equal(t, 0, pruned)
+	assert.Equal(t, true, db.HasStateSummary(ctx, orphanedRoot))
+}
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Verify walks the beacon chain database of the data directory and reports its dangling
+// references. With the repair flag, the unreachable entries are pruned. The beacon node
+// must not be running, as the database is opened directly.
+func Verify(cliCtx *cli.Context) error {
+	dbDir := path.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	if !fileutil.FileExists(path.Join(dbDir, kv.DatabaseFileName)) {
+		return errors.Errorf("no database found in %s", dbDir)
+	}
+	store, err := kv.NewKVStore(cliCtx.Context, dbDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	report, err := store.VerifyIntegrity(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not verify database")
+	}
+	log.WithFields(logrus.Fields{
+		"blocks":              report.BlocksScanned,
+		"states":              report.StatesScanned,
+		"stateSummaries":      report.SummariesScanned,
+		"missingParentBlocks": len(report.MissingParentBlocks),
+		"unreachableBlocks":   len(report.UnreachableBlocks),
+		"orphanedStates":      len(report.OrphanedStates),
+		"orphanedSummaries":   len(report.OrphanedSummaries),
+		"danglingHead":        report.DanglingHead,
+		"finalizedProtected":  report.Protected(),
+	}).Info("Verified database")
+	for _, r := range report.MissingParentBlocks {
+		log.WithField("blockRoot", fmt.Sprintf("%#x", r)).Warn("Block parent is missing")
+	}
+
+	if report.Healthy() {
+		log.Info("No dangling reference found")
+		return nil
+	}
+	if !cliCtx.Bool(cmd.VerifyRepairFlag.Name) {
+		log.Infof("Run again with --%s to prune the unreachable entries", cmd.VerifyRepairFlag.Name)
+		return nil
+	}
+	pruned, err := store.RepairIntegrity(cliCtx.Context, report)
+	if err != nil {
+		return errors.Wrap(err, "could not repair database")
+	}
+	log.WithField("pruned", pruned).Info("Repair completed successfully")
+	return nil
+}
@@ -0,0 +1,68 @@
+package protoarray
+
+import (
+	"fmt"
+
+	types "github.com/prysmaticlabs/eth2-types"
+)
+
+// NodeSnapshot is a point in time copy of a block node in the fork choice store.
+// Links to other nodes are given as block roots, and are empty if the node does not exist.
+type NodeSnapshot struct {
+	Slot               types.Slot  `json:"slot"`
+	Root               string      `json:"root"`
+	ParentRoot         string      `json:"parent_root"`
+	JustifiedEpoch     types.Epoch `json:"justified_epoch"`
+	FinalizedEpoch     types.Epoch `json:"finalized_epoch"`
+	Weight             uint64      `json:"weight"`
+	BestChildRoot      string      `json:"best_child_root"`
+	BestDescendantRoot string      `json:"best_descendant_root"`
+	Canonical          bool        `json:"canonical"`
+}
+
+// StoreSnapshot is a point in time copy of the fork choice store.
+type StoreSnapshot struct {
+	PruneThreshold uint64          `json:"prune_threshold"`
+	JustifiedEpoch types.Epoch     `json:"justified_epoch"`
+	FinalizedEpoch types.Epoch     `json:"finalized_epoch"`
+	FinalizedRoot  string          `json:"finalized_root"`
+	Nodes          []*NodeSnapshot `json:"nodes"`
+}
+
+// Snapshot returns a copy of the fork choice store. The store is read under a single lock,
+// so the node weights and links are consistent with each other and with the store checkpoints.
+func (s *Store) Snapshot() *StoreSnapshot {
+	s.nodesLock.RLock()
+	defer s.nodesLock.RUnlock()
+
+	snapshot := &StoreSnapshot{
+		PruneThreshold: s.pruneThreshold,
+		JustifiedEpoch: s.justifiedEpoch,
+		FinalizedEpoch: s.finalizedEpoch,
+		FinalizedRoot:  fmt.Sprintf("%#x", s.finalizedRoot),
+		Nodes:          make([]*NodeSnapshot, len(s.nodes)),
+	}
+	for i, n := range s.nodes {
+		snapshot.Nodes[i] = &NodeSnapshot{
+			Slot:               n.slot,
+			Root:               fmt.Sprintf("%#x", n.root),
+			ParentRoot:         s.rootAtIndex(n.parent),
+			JustifiedEpoch:     n.justifiedEpoch,
+			FinalizedEpoch:     n.finalizedEpoch,
+			Weight:             n.weight,
+			BestChildRoot:      s.rootAtIndex(n.bestChild),
+			BestDescendantRoot: s.rootAtIndex(n.bestDescendant),
+			Canonical:          s.canonicalNodes[n.root],
+		}
+	}
+	return snapshot
+}
+
+// rootAtIndex returns the hex encoded root of the node at the given index, or an empty
+// string if there is no such node. This assumes that a lock is already held on the store.
+func (s *Store) rootAtIndex(i uint64) string {
+	if i == NonExistentNode || i >= uint64(len(s.nodes)) {
+		return ""
+	}
+	return fmt.Sprintf("%#x", s.nodes[i].root)
+}
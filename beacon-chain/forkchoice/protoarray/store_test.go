@@ -589,3 +589,37 @@ func TestStore_UpdateCanonicalNodes_ContextCancelled(t *testing.T) {
 	cancel()
 	require.ErrorContains(t, "context canceled", f.store.updateCanonicalNodes(ctx, [32]byte{'c'}))
 }
+
+func TestStore_Snapshot(t *testing.T) {
+	s := &Store{
+		pruneThreshold: defaultPruneThreshold,
+		justifiedEpoch: 2,
+		finalizedEpoch: 1,
+		finalizedRoot:  [32]byte{'a'},
+		nodes: []*Node{
+			{slot: 10, root: [32]byte{'a'}, parent: NonExistentNode, weight: 3, bestChild: 1, bestDescendant: 1},
+			{slot: 11, root: [32]byte{'b'}, parent: 0, weight: 2, bestChild: NonExistentNode, bestDescendant: NonExistentNode},
+		},
+		canonicalNodes: map[[32]byte]bool{{'a'}: true},
+	}
+	snapshot := s.Snapshot()
+	assert.Equal(t, types.Epoch(2), snapshot.JustifiedEpoch)
+	assert.Equal(t, types.Epoch(1), snapshot.FinalizedEpoch)
+	require.Equal(t, 2, len(snapshot.Nodes))
+	wanted := &NodeSnapshot{
+		Slot:               10,
+		Root:               "0x6100000000000000000000000000000000000000000000000000000000000000",
+		Weight:             3,
+		BestChildRoot:      "0x6200000000000000000000000000000000000000000000000000000000000000",
+		BestDescendantRoot: "0x6200000000000000000000000000000000000000000000000000000000000000",
+		Canonical:          true,
+	}
+	assert.DeepEqual(t, wanted, snapshot.Nodes[0])
+	assert.Equal(t, wanted.Root, snapshot.Nodes[1].ParentRoot)
+	assert.Equal(t, "", snapshot.Nodes[1].BestChildRoot)
+	assert.Equal(t, false, snapshot.Nodes[1].Canonical)
+
+	// The snapshot is not affected by later changes to the store.
+	s.nodes[0].weight = 5
+	assert.Equal(t, uint64(3), snapshot.Nodes[0].Weight)
+}
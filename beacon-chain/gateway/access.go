@@ -0,0 +1,85 @@
+package gateway
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// pathRule matches the requests of an HTTP method to a path. An empty method matches
+// all the methods, and a path ending with "*" matches all the paths starting with it.
+type pathRule struct {
+	method string
+	path   string
+	prefix bool
+}
+
+// parsePathRules parses access rules of the form "[METHOD] PATH", such as "GET /eth/v1/beacon/*"
+// or "/eth/v1alpha1/debug/*".
+func parsePathRules(entries []string) ([]pathRule, error) {
+	rules := make([]pathRule, 0, len(entries))
+	for _, entry := range entries {
+		fields := strings.Fields(entry)
+		if len(fields) == 0 {
+			continue
+		}
+		var r pathRule
+		switch len(fields) {
+		case 1:
+			r.path = fields[0]
+		case 2:
+			r.method = strings.ToUpper(fields[0])
+			r.path = fields[1]
+		default:
+			return nil, errors.Errorf("invalid path rule %q, expected [METHOD] PATH", entry)
+		}
+		if !strings.HasPrefix(r.path, "/") {
+			return nil, errors.Errorf("invalid path rule %q, the path must start with /", entry)
+		}
+		if strings.HasSuffix(r.path, "*") {
+			r.path = strings.TrimSuffix(r.path, "*")
+			r.prefix = true
+		}
+		if strings.Contains(r.path, "*") {
+			return nil, errors.Errorf("invalid path rule %q, * is only allowed at the end of the path", entry)
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+func (r pathRule) matches(req *http.Request) bool {
+	if r.method != "" && r.method != req.Method {
+		return false
+	}
+	if r.prefix {
+		return strings.HasPrefix(req.URL.Path, r.path)
+	}
+	return req.URL.Path == r.path
+}
+
+func matchesAny(rules []pathRule, req *http.Request) bool {
+	for _, r := range rules {
+		if r.matches(req) {
+			return true
+		}
+	}
+	return false
+}
+
+// newAccessHandler rejects with 403 the requests matching a denied rule, and the requests
+// not matching any allowed rule when there are some. Denied rules take precedence.
+func newAccessHandler(srv http.Handler, allowed, denied []pathRule) http.Handler {
+	if len(allowed) == 0 && len(denied) == 0 {
+		return srv
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if matchesAny(denied, req) || (len(allowed) > 0 && !matchesAny(allowed, req)) {
+			log.Debugf("Blocked access to %s %s", req.Method, req.URL.Path)
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+		srv.ServeHTTP(w, req)
+	})
+}
@@ -0,0 +1,63 @@
+package gateway
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestAccessHandler(t *testing.T) {
+	allowed, err := parsePathRules([]string{"GET /eth/v1/beacon/*", "/eth/v1/node/health"})
+	require.NoError(t, err)
+	denied, err := parsePathRules([]string{"/eth/v1/beacon/pool/*", "POST /eth/v1/node/health"})
+	require.NoError(t, err)
+	handler := newAccessHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), allowed, denied)
+
+	tests := []struct {
+		method string
+		path   string
+		want   int
+	}{
+		{method: http.MethodGet, path: "/eth/v1/beacon/genesis", want: http.StatusOK},
+		{method: http.MethodPost, path: "/eth/v1/beacon/genesis", want: http.StatusForbidden},
+		{method: http.MethodGet, path: "/eth/v1/beacon/pool/attestations", want: http.StatusForbidden},
+		{method: http.MethodGet, path: "/eth/v1/node/health", want: http.StatusOK},
+		{method: http.MethodPost, path: "/eth/v1/node/health", want: http.StatusForbidden},
+		{method: http.MethodGet, path: "/eth/v1/node/health/extra", want: http.StatusForbidden},
+		{method: http.MethodGet, path: "/eth/v1alpha1/debug/state", want: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+		assert.Equal(t, tt.want, rec.Code, "%s %s", tt.method, tt.path)
+	}
+}
+
+func TestAccessHandler_DenyOnly(t *testing.T) {
+	denied, err := parsePathRules([]string{"/eth/v1alpha1/debug/*"})
+	require.NoError(t, err)
+	handler := newAccessHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), nil, denied)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/eth/v1alpha1/debug/state", nil))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/eth/v1alpha1/node/version", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestParsePathRules_Invalid(t *testing.T) {
+	_, err := parsePathRules([]string{"GET eth/v1/beacon"})
+	assert.ErrorContains(t, "the path must start with /", err)
+	_, err = parsePathRules([]string{"/eth/*/beacon"})
+	assert.ErrorContains(t, "* is only allowed at the end", err)
+	_, err = parsePathRules([]string{"GET /eth/v1 extra"})
+	assert.ErrorContains(t, "expected [METHOD] PATH", err)
+}
@@ -31,7 +31,10 @@ type Gateway struct {
 	remoteCert              string
 	server                  *http.Server
 	mux                     *http.ServeMux
+	router                  func(gateway http.Handler) http.Handler
 	allowedOrigins          []string
+	allowedPaths            []string
+	deniedPaths             []string
 	startFailure            error
 	enableDebugRPCEndpoints bool
 	maxCallRecvMsgSize      uint64
@@ -77,11 +80,28 @@ func (g *Gateway) Start() {
 		}
 	}
 
-	g.mux.Handle("/", gwmux)
+	if g.router != nil {
+		g.mux.Handle("/", g.router(gwmux))
+	} else {
+		g.mux.Handle("/", gwmux)
+	}
+
+	allowed, err := parsePathRules(g.allowedPaths)
+	if err != nil {
+		log.WithError(err).Error("Failed to parse allowed paths")
+		g.startFailure = err
+		return
+	}
+	denied, err := parsePathRules(g.deniedPaths)
+	if err != nil {
+		log.WithError(err).Error("Failed to parse denied paths")
+		g.startFailure = err
+		return
+	}
 
 	g.server = &http.Server{
 		Addr:    g.gatewayAddr,
-		Handler: newCorsHandler(g.mux, g.allowedOrigins),
+		Handler: newCorsHandler(newAccessHandler(g.mux, allowed, denied), g.allowedOrigins),
 	}
 	go func() {
 		if err := g.server.ListenAndServe(); err != http.ErrServerClosed {
@@ -121,14 +141,21 @@ func (g *Gateway) Stop() error {
 }
 
 // New returns a new gateway server which translates HTTP into gRPC.
-// Accepts a context and optional http.ServeMux.
+// Accepts a context and optional http.ServeMux. The optional router
+// wraps the gateway to serve the endpoints which cannot be proxied to
+// gRPC on their exact paths, passing any other request to the gateway.
+// The allowed and denied paths restrict which endpoints can be reached,
+// see parsePathRules.
 func New(
 	ctx context.Context,
 	remoteAddress,
 	remoteCert,
 	gatewayAddress string,
 	mux *http.ServeMux,
+	router func(gateway http.Handler) http.Handler,
 	allowedOrigins []string,
+	allowedPaths []string,
+	deniedPaths []string,
 	enableDebugRPCEndpoints bool,
 	maxCallRecvMsgSize uint64,
 ) *Gateway {
@@ -142,7 +169,10 @@ func New(
 		gatewayAddr:             gatewayAddress,
 		ctx:                     ctx,
 		mux:                     mux,
+		router:                  router,
 		allowedOrigins:          allowedOrigins,
+		allowedPaths:            allowedPaths,
+		deniedPaths:             deniedPaths,
 		enableDebugRPCEndpoints: enableDebugRPCEndpoints,
 		maxCallRecvMsgSize:      maxCallRecvMsgSize,
 	}
@@ -21,6 +21,8 @@ var (
 	host                    = flag.String("host", "127.0.0.1", "Host to serve on")
 	debug                   = flag.Bool("debug", false, "Enable debug logging")
 	allowedOrigins          = flag.String("corsdomain", "localhost:4242", "A comma separated list of CORS domains to allow")
+	allowedPaths            = flag.String("allowed-paths", "", "A comma separated list of [METHOD] PATH rules of the only endpoints to serve, a path ending with * matches all the paths starting with it")
+	deniedPaths             = flag.String("denied-paths", "", "A comma separated list of [METHOD] PATH rules of the endpoints to reject with 403, taking precedence over the allowed paths")
 	enableDebugRPCEndpoints = flag.Bool("enable-debug-rpc-endpoints", false, "Enable debug rpc endpoints such as /eth/v1alpha1/beacon/state")
 	grpcMaxMsgSize          = flag.Int("grpc-max-msg-size", 1<<22, "Integer to define max recieve message call size")
 )
@@ -42,7 +44,10 @@ func main() {
 		"", // remoteCert
 		fmt.Sprintf("%s:%d", *host, *port),
 		mux,
+		nil, // router
 		strings.Split(*allowedOrigins, ","),
+		strings.Split(*allowedPaths, ","),
+		strings.Split(*deniedPaths, ","),
 		*enableDebugRPCEndpoints,
 		uint64(*grpcMaxMsgSize),
 	)
@@ -0,0 +1,227 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// checkpointStateTimeout bounds the time spent downloading a checkpoint state.
+const checkpointStateTimeout = 5 * time.Minute
+
+// errCheckpointRootMismatch is returned when a checkpoint state does not match the trusted block root.
+var errCheckpointRootMismatch = errors.New("checkpoint state does not match the trusted block root")
+
+// startFromCheckpoint downloads the checkpoint state and block, verifies them against the trusted
+// block root supplied by the operator and saves them as the origin of the chain, from which the
+// node syncs forward as it does after a restart. It does nothing if the database already holds a
+// chain.
+func startFromCheckpoint(ctx context.Context, beaconDB db.HeadAccessDatabase, stateURL, blockURL, blockRoot string) error {
+	genesisBlock, err := beaconDB.GenesisBlock(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis block from db")
+	}
+	originRoot, err := beaconDB.OriginCheckpointBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get origin checkpoint block root from db")
+	}
+	if genesisBlock != nil || originRoot != params.BeaconConfig().ZeroHash {
+		log.Warn("Database already holds a chain, ignoring the checkpoint sync origin")
+		return nil
+	}
+	st, blk, err := loadCheckpointOrigin(ctx, stateURL, blockURL, blockRoot)
+	if err != nil {
+		return err
+	}
+	return saveCheckpointOrigin(ctx, beaconDB, st, blk)
+}
+
+// loadCheckpointOrigin downloads the checkpoint state and block from the given URLs and verifies
+// that the latest block header of the state and the block both hash to the trusted block root.
+func loadCheckpointOrigin(
+	ctx context.Context, stateURL, blockURL, blockRoot string,
+) (iface.BeaconState, *ethpb.SignedBeaconBlock, error) {
+	if blockRoot == "" {
+		return nil, nil, errors.New("a trusted block root is required to verify the checkpoint state")
+	}
+	if blockURL == "" {
+		return nil, nil, errors.New("a checkpoint block URL is required to start from the checkpoint state")
+	}
+	trustedRoot, err := convertBlockRootInput(blockRoot)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid checkpoint block root")
+	}
+	st, err := fetchCheckpointState(ctx, stateURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := verifyCheckpointState(ctx, st, trustedRoot); err != nil {
+		return nil, nil, err
+	}
+	blk, err := fetchCheckpointBlock(ctx, blockURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	root, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not compute checkpoint block root")
+	}
+	if root != trustedRoot {
+		return nil, nil, fmt.Errorf("checkpoint block does not match the trusted block root: got %#x, wanted %#x", root, trustedRoot)
+	}
+	log.WithFields(logrus.Fields{
+		"slot":      st.Slot(),
+		"blockRoot": fmt.Sprintf("%#x", trustedRoot),
+	}).Info("Verified checkpoint state against trusted block root")
+	return st, blk, nil
+}
+
+// saveCheckpointOrigin saves the checkpoint state and block as the origin, head, justified and
+// finalized block of the database. The node has no history before the checkpoint, so the origin
+// checkpoint block ends the walks up the finalized chain. The genesis block root is left unset, as
+// the checkpoint block must not be served as the genesis block.
+func saveCheckpointOrigin(ctx context.Context, beaconDB db.HeadAccessDatabase, st iface.BeaconState, blk *ethpb.SignedBeaconBlock) error {
+	if !helpers.IsEpochStart(st.Slot()) {
+		return fmt.Errorf("checkpoint state slot %d is not the start of an epoch", st.Slot())
+	}
+	root, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute checkpoint block root")
+	}
+	if err := beaconDB.SaveBlock(ctx, blk); err != nil {
+		return errors.Wrap(err, "could not save checkpoint block")
+	}
+	if err := beaconDB.SaveState(ctx, st, root); err != nil {
+		return errors.Wrap(err, "could not save checkpoint state")
+	}
+	if err := beaconDB.SaveStateSummary(ctx, &pbp2p.StateSummary{Slot: st.Slot(), Root: root[:]}); err != nil {
+		return errors.Wrap(err, "could not save checkpoint state summary")
+	}
+	if err := beaconDB.SaveOriginCheckpointBlockRoot(ctx, root); err != nil {
+		return errors.Wrap(err, "could not save origin checkpoint block root")
+	}
+	if err := beaconDB.SaveHeadBlockRoot(ctx, root); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+	checkpoint := &ethpb.Checkpoint{Epoch: helpers.SlotToEpoch(st.Slot()), Root: root[:]}
+	if err := beaconDB.SaveJustifiedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := beaconDB.SaveFinalizedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not save finalized checkpoint")
+	}
+	log.WithFields(logrus.Fields{
+		"epoch":     checkpoint.Epoch,
+		"blockRoot": fmt.Sprintf("%#x", root),
+	}).Info("Saved checkpoint state as the origin of the chain")
+	return nil
+}
+
+// fetchCheckpointState downloads and decodes an SSZ encoded beacon state.
+func fetchCheckpointState(ctx context.Context, stateURL string) (iface.BeaconState, error) {
+	enc, err := fetchSSZ(ctx, stateURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not download checkpoint state")
+	}
+	st := &pbp2p.BeaconState{}
+	if err := st.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal checkpoint state")
+	}
+	trie, err := stateTrie.InitializeFromProtoUnsafe(st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize checkpoint state")
+	}
+	return trie, nil
+}
+
+// fetchCheckpointBlock downloads and decodes an SSZ encoded signed beacon block.
+func fetchCheckpointBlock(ctx context.Context, blockURL string) (*ethpb.SignedBeaconBlock, error) {
+	enc, err := fetchSSZ(ctx, blockURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not download checkpoint block")
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal checkpoint block")
+	}
+	return blk, nil
+}
+
+// fetchSSZ downloads an SSZ encoded object.
+func fetchSSZ(ctx context.Context, url string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, checkpointStateTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close checkpoint response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// verifyCheckpointState checks that the latest block header of the state hashes to the
+// trusted block root. The state root of the latest block header is only filled in during
+// the next slot transition, in which case it is set to the root of the state itself, as
+// done in process_slot.
+func verifyCheckpointState(ctx context.Context, st iface.ReadOnlyBeaconState, trustedRoot [32]byte) error {
+	header := st.LatestBlockHeader()
+	if header == nil {
+		return errors.New("checkpoint state has no latest block header")
+	}
+	if bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		stateRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not compute checkpoint state root")
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute checkpoint block root")
+	}
+	if headerRoot != trustedRoot {
+		return errors.Wrapf(errCheckpointRootMismatch, "got %#x, wanted %#x", headerRoot, trustedRoot)
+	}
+	return nil
+}
+
+// convertBlockRootInput parses a hex encoded block root, with or without the 0x prefix.
+func convertBlockRootInput(root string) ([32]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(root, "0x"))
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if len(b) != 32 {
+		return [32]byte{}, errors.New("block root is not length of 32")
+	}
+	var r [32]byte
+	copy(r[:], b)
+	return r, nil
+}
@@ -0,0 +1,169 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func serveSSZ(t *testing.T, enc []byte) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write(enc)
+		require.NoError(t, err)
+	}))
+}
+
+func serveState(t *testing.T, st iface.BeaconState) *httptest.Server {
+	enc, err := st.CloneInnerState().MarshalSSZ()
+	require.NoError(t, err)
+	return serveSSZ(t, enc)
+}
+
+func serveBlock(t *testing.T, blk *ethpb.SignedBeaconBlock) *httptest.Server {
+	enc, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	return serveSSZ(t, enc)
+}
+
+// checkpointOrigin returns a state whose latest block header refers to the returned block,
+// and the root of the block.
+func checkpointOrigin(t *testing.T) (iface.BeaconState, *ethpb.SignedBeaconBlock, [32]byte) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	blk := testutil.NewBeaconBlock()
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:          blk.Block.Slot,
+		ProposerIndex: blk.Block.ProposerIndex,
+		ParentRoot:    blk.Block.ParentRoot,
+		StateRoot:     make([]byte, 32),
+		BodyRoot:      bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	return st, blk, root
+}
+
+func TestLoadCheckpointOrigin(t *testing.T) {
+	ctx := context.Background()
+	st, blk, trustedRoot := checkpointOrigin(t)
+
+	stateSrv := serveState(t, st)
+	defer stateSrv.Close()
+	blockSrv := serveBlock(t, blk)
+	defer blockSrv.Close()
+	gotState, gotBlock, err := loadCheckpointOrigin(ctx, stateSrv.URL, blockSrv.URL, fmt.Sprintf("%#x", trustedRoot))
+	require.NoError(t, err)
+	assert.Equal(t, st.Slot(), gotState.Slot())
+	assert.DeepEqual(t, blk, gotBlock)
+}
+
+func TestLoadCheckpointOrigin_TamperedState(t *testing.T) {
+	ctx := context.Background()
+	st, blk, trustedRoot := checkpointOrigin(t)
+
+	// Tampering with any field changes the state root committed to by the latest block header.
+	require.NoError(t, st.UpdateBalancesAtIndex(3, 1))
+	stateSrv := serveState(t, st)
+	defer stateSrv.Close()
+	blockSrv := serveBlock(t, blk)
+	defer blockSrv.Close()
+	_, _, err := loadCheckpointOrigin(ctx, stateSrv.URL, blockSrv.URL, fmt.Sprintf("%#x", trustedRoot))
+	assert.ErrorContains(t, errCheckpointRootMismatch.Error(), err)
+}
+
+func TestLoadCheckpointOrigin_TamperedBlock(t *testing.T) {
+	ctx := context.Background()
+	st, blk, trustedRoot := checkpointOrigin(t)
+
+	blk.Block.Body.Graffiti = []byte("tampered graffiti which is 32 b.")
+	stateSrv := serveState(t, st)
+	defer stateSrv.Close()
+	blockSrv := serveBlock(t, blk)
+	defer blockSrv.Close()
+	_, _, err := loadCheckpointOrigin(ctx, stateSrv.URL, blockSrv.URL, fmt.Sprintf("%#x", trustedRoot))
+	assert.ErrorContains(t, "checkpoint block does not match the trusted block root", err)
+}
+
+func TestVerifyCheckpointState_HeaderStateRootSet(t *testing.T) {
+	ctx := context.Background()
+	st, _, trustedRoot := checkpointOrigin(t)
+
+	// Once the next slot is processed the header commits to the state root directly.
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	header := st.LatestBlockHeader()
+	header.StateRoot = stateRoot[:]
+	require.NoError(t, st.SetLatestBlockHeader(header))
+	require.NoError(t, verifyCheckpointState(ctx, st, trustedRoot))
+
+	header.StateRoot = make([]byte, 32)
+	header.StateRoot[0] = 'a'
+	require.NoError(t, st.SetLatestBlockHeader(header))
+	assert.ErrorContains(t, errCheckpointRootMismatch.Error(), verifyCheckpointState(ctx, st, trustedRoot))
+}
+
+func TestLoadCheckpointOrigin_BadInput(t *testing.T) {
+	ctx := context.Background()
+	_, _, err := loadCheckpointOrigin(ctx, "http://localhost", "http://localhost", "")
+	assert.ErrorContains(t, "trusted block root is required", err)
+	_, _, err = loadCheckpointOrigin(ctx, "http://localhost", "", fmt.Sprintf("%#x", [32]byte{}))
+	assert.ErrorContains(t, "checkpoint block URL is required", err)
+	_, _, err = loadCheckpointOrigin(ctx, "http://localhost", "http://localhost", "0x1234")
+	assert.ErrorContains(t, "invalid checkpoint block root", err)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+	_, _, err = loadCheckpointOrigin(ctx, srv.URL, srv.URL, fmt.Sprintf("%#x", [32]byte{}))
+	assert.ErrorContains(t, "unexpected status", err)
+}
+
+func TestStartFromCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	st, blk, root := checkpointOrigin(t)
+
+	stateSrv := serveState(t, st)
+	defer stateSrv.Close()
+	blockSrv := serveBlock(t, blk)
+	defer blockSrv.Close()
+	require.NoError(t, startFromCheckpoint(ctx, beaconDB, stateSrv.URL, blockSrv.URL, fmt.Sprintf("%#x", root)))
+
+	// The checkpoint block is recorded as the origin of the chain, not as its genesis block.
+	genesisBlock, err := beaconDB.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, genesisBlock == nil)
+	originRoot, err := beaconDB.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, originRoot)
+	headBlock, err := beaconDB.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, headBlock)
+	finalized, err := beaconDB.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], finalized.Root)
+	justified, err := beaconDB.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], justified.Root)
+	savedState, err := beaconDB.State(ctx, root)
+	require.NoError(t, err)
+	assert.DeepEqual(t, st.InnerStateUnsafe(), savedState.InnerStateUnsafe())
+
+	// A database holding a chain is left untouched, without downloading anything.
+	require.NoError(t, startFromCheckpoint(ctx, beaconDB, "http://localhost:0", "http://localhost:0", ""))
+}
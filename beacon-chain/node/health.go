@@ -0,0 +1,101 @@
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	chainSync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// eth1HeadMaxAge is how old the latest eth1 block seen can be while the eth1 node is still
+// considered synced. Blocks are minutes apart at worst on the eth1 mainnet.
+const eth1HeadMaxAge = 5 * time.Minute
+
+const (
+	healthStatusReady    = "ready"
+	healthStatusNotReady = "not_ready"
+)
+
+// eth1HealthProvider is the part of the powchain service reporting on the eth1 node.
+type eth1HealthProvider interface {
+	IsConnectedToETH1() bool
+	LatestBlockTime() time.Time
+}
+
+type componentHealth struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Details string `json:"details"`
+}
+
+type healthResponse struct {
+	Status     string             `json:"status"`
+	Components []*componentHealth `json:"components"`
+}
+
+// healthChecker reports the readiness of the components of the node.
+type healthChecker struct {
+	eth1     eth1HealthProvider
+	syncer   chainSync.Checker
+	peers    p2p.PeersProvider
+	minPeers int
+}
+
+// components returns the health of each component of the node. The eth1 components are left out
+// when the node does not follow an eth1 node.
+func (h *healthChecker) components() []*componentHealth {
+	syncing := h.syncer.Syncing()
+	beaconSync := &componentHealth{Name: "beacon_synced", Healthy: !syncing, Details: "Synced to the head of the chain"}
+	if syncing {
+		beaconSync.Details = "Initial sync in progress"
+	}
+
+	numPeers := len(h.peers.Peers().Connected())
+	peers := &componentHealth{
+		Name:    "p2p_peers",
+		Healthy: numPeers >= h.minPeers,
+		Details: fmt.Sprintf("%d connected peers, %d required", numPeers, h.minPeers),
+	}
+
+	if h.eth1 == nil {
+		return []*componentHealth{beaconSync, peers}
+	}
+	connected := h.eth1.IsConnectedToETH1()
+	eth1Conn := &componentHealth{Name: "eth1_connected", Healthy: connected, Details: "Connected to the eth1 node"}
+	if !connected {
+		eth1Conn.Details = "Not connected to the eth1 node"
+	}
+
+	headTime := h.eth1.LatestBlockTime()
+	eth1Sync := &componentHealth{
+		Name:    "eth1_synced",
+		Healthy: connected && timeutils.Since(headTime) <= eth1HeadMaxAge,
+		Details: fmt.Sprintf("Latest eth1 block seen at %s", headTime.UTC().Format(time.RFC3339)),
+	}
+
+	return []*componentHealth{eth1Conn, eth1Sync, beaconSync, peers}
+}
+
+// HealthHandler serves the readiness of the node along with the health of each of its components,
+// with a 200 status code when all of them are healthy and a 503 otherwise, so that it can be used
+// as a readiness probe.
+func (h *healthChecker) HealthHandler(w http.ResponseWriter, _ *http.Request) {
+	resp := &healthResponse{Status: healthStatusReady, Components: h.components()}
+	code := http.StatusOK
+	for _, c := range resp.Components {
+		if !c.Healthy {
+			resp.Status = healthStatusNotReady
+			code = http.StatusServiceUnavailable
+			break
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.WithError(err).Error("Could not write health response")
+	}
+}
@@ -0,0 +1,110 @@
+package node
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockEth1Health struct {
+	connected bool
+	headTime  time.Time
+}
+
+func (m *mockEth1Health) IsConnectedToETH1() bool {
+	return m.connected
+}
+
+func (m *mockEth1Health) LatestBlockTime() time.Time {
+	return m.headTime
+}
+
+func TestHealthHandler(t *testing.T) {
+	tests := []struct {
+		name      string
+		checker   *healthChecker
+		wantCode  int
+		unhealthy []string
+	}{
+		{
+			name: "ready",
+			checker: &healthChecker{
+				eth1:     &mockEth1Health{connected: true, headTime: time.Now()},
+				syncer:   &mockSync.Sync{IsSyncing: false},
+				peers:    &mockp2p.MockPeersProvider{},
+				minPeers: 2,
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "eth1 disconnected and stale",
+			checker: &healthChecker{
+				eth1:     &mockEth1Health{connected: false, headTime: time.Now().Add(-time.Hour)},
+				syncer:   &mockSync.Sync{IsSyncing: false},
+				peers:    &mockp2p.MockPeersProvider{},
+				minPeers: 2,
+			},
+			wantCode:  http.StatusServiceUnavailable,
+			unhealthy: []string{"eth1_connected", "eth1_synced"},
+		},
+		{
+			name: "eth1 stale",
+			checker: &healthChecker{
+				eth1:     &mockEth1Health{connected: true, headTime: time.Now().Add(-time.Hour)},
+				syncer:   &mockSync.Sync{IsSyncing: false},
+				peers:    &mockp2p.MockPeersProvider{},
+				minPeers: 2,
+			},
+			wantCode:  http.StatusServiceUnavailable,
+			unhealthy: []string{"eth1_synced"},
+		},
+		{
+			name: "syncing with too few peers",
+			checker: &healthChecker{
+				eth1:     &mockEth1Health{connected: true, headTime: time.Now()},
+				syncer:   &mockSync.Sync{IsSyncing: true},
+				peers:    &mockp2p.MockPeersProvider{},
+				minPeers: 3,
+			},
+			wantCode:  http.StatusServiceUnavailable,
+			unhealthy: []string{"beacon_synced", "p2p_peers"},
+		},
+		{
+			name: "no eth1 node",
+			checker: &healthChecker{
+				syncer:   &mockSync.Sync{IsSyncing: false},
+				peers:    &mockp2p.MockPeersProvider{},
+				minPeers: 2,
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tt.checker.HealthHandler(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+			assert.Equal(t, tt.wantCode, rec.Code)
+			resp := &healthResponse{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+			var unhealthy []string
+			for _, c := range resp.Components {
+				if !c.Healthy {
+					unhealthy = append(unhealthy, c.Name)
+				}
+			}
+			assert.DeepEqual(t, tt.unhealthy, unhealthy)
+			if tt.wantCode == http.StatusOK {
+				assert.Equal(t, healthStatusReady, resp.Status)
+			} else {
+				assert.Equal(t, healthStatusNotReady, resp.Status)
+			}
+		})
+	}
+}
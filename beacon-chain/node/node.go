@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -32,6 +33,8 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eventsv1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
@@ -42,6 +45,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prereq"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
@@ -152,6 +156,21 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		networkCfg.ContractDeploymentBlock = uint64(cliCtx.Int(flags.ContractDeploymentBlock.Name))
 		params.OverrideBeaconNetworkConfig(networkCfg)
 	}
+	if cliCtx.IsSet(flags.MaximumGossipClockDisparity.Name) {
+		disparity := cliCtx.Duration(flags.MaximumGossipClockDisparity.Name)
+		if disparity < params.SpecMaximumGossipClockDisparity || disparity > params.MaxGossipClockDisparityTolerance {
+			return nil, fmt.Errorf(
+				"--%s must be between %s and %s, got %s",
+				flags.MaximumGossipClockDisparity.Name,
+				params.SpecMaximumGossipClockDisparity,
+				params.MaxGossipClockDisparityTolerance,
+				disparity,
+			)
+		}
+		networkCfg := params.BeaconNetworkConfig()
+		networkCfg.MaximumGossipClockDisparity = disparity
+		params.OverrideBeaconNetworkConfig(networkCfg)
+	}
 
 	registry := shared.NewServiceRegistry()
 
@@ -169,12 +188,25 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		exitPool:        voluntaryexits.NewPool(),
 		slashingsPool:   slashings.NewPool(),
 	}
+	if cliCtx.Bool(flags.AttestationPoolLowMemory.Name) {
+		beacon.attestationPool = attestations.NewLowMemoryPool()
+	}
 
 	if err := beacon.startDB(cliCtx); err != nil {
 		return nil, err
 	}
 
-	beacon.startStateGen()
+	if stateURL := cliCtx.String(flags.CheckpointStateURL.Name); stateURL != "" {
+		blockURL := cliCtx.String(flags.CheckpointBlockURL.Name)
+		blockRoot := cliCtx.String(flags.CheckpointBlockRoot.Name)
+		if err := startFromCheckpoint(ctx, beacon.db, stateURL, blockURL, blockRoot); err != nil {
+			return nil, errors.Wrap(err, "could not start from checkpoint sync origin")
+		}
+	}
+
+	if err := beacon.startStateGen(); err != nil {
+		return nil, err
+	}
 
 	if err := beacon.registerP2P(cliCtx); err != nil {
 		return nil, err
@@ -346,8 +378,15 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 	return nil
 }
 
-func (b *BeaconNode) startStateGen() {
+func (b *BeaconNode) startStateGen() error {
 	b.stateGen = stategen.New(b.db)
+	if retention := b.cliCtx.Int(flags.StateRetentionEpochs.Name); retention > 0 {
+		b.stateGen.EnableStatePruning(types.Epoch(retention))
+	}
+	if b.cliCtx.IsSet(flags.HotStateCacheSize.Name) {
+		return b.stateGen.SetHotStateCacheSize(b.cliCtx.Int(flags.HotStateCacheSize.Name))
+	}
+	return nil
 }
 
 func readbootNodes(fileName string) ([]string, error) {
@@ -390,25 +429,55 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		}
 	}
 
+	gossipThreshold, err := scoreThresholdFlag(cliCtx, flags.P2PGossipScoreThreshold)
+	if err != nil {
+		return err
+	}
+	publishThreshold, err := scoreThresholdFlag(cliCtx, flags.P2PPublishScoreThreshold)
+	if err != nil {
+		return err
+	}
+	graylistThreshold, err := scoreThresholdFlag(cliCtx, flags.P2PGraylistScoreThreshold)
+	if err != nil {
+		return err
+	}
+
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
-		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
-		StaticPeers:       sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
-		BootstrapNodeAddr: bootnodeAddrs,
-		RelayNodeAddr:     cliCtx.String(cmd.RelayNode.Name),
-		DataDir:           datadir,
-		LocalIP:           cliCtx.String(cmd.P2PIP.Name),
-		HostAddress:       cliCtx.String(cmd.P2PHost.Name),
-		HostDNS:           cliCtx.String(cmd.P2PHostDNS.Name),
-		PrivateKey:        cliCtx.String(cmd.P2PPrivKey.Name),
-		MetaDataDir:       cliCtx.String(cmd.P2PMetadata.Name),
-		TCPPort:           cliCtx.Uint(cmd.P2PTCPPort.Name),
-		UDPPort:           cliCtx.Uint(cmd.P2PUDPPort.Name),
-		MaxPeers:          cliCtx.Uint(cmd.P2PMaxPeers.Name),
-		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
-		DenyListCIDR:      sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
-		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
-		DisableDiscv5:     cliCtx.Bool(flags.DisableDiscv5.Name),
-		StateNotifier:     b,
+		NoDiscovery:             cliCtx.Bool(cmd.NoDiscovery.Name),
+		StaticPeers:             sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		BootstrapNodeAddr:       bootnodeAddrs,
+		RelayNodeAddr:           cliCtx.String(cmd.RelayNode.Name),
+		DataDir:                 datadir,
+		LocalIP:                 cliCtx.String(cmd.P2PIP.Name),
+		HostAddress:             cliCtx.String(cmd.P2PHost.Name),
+		HostDNS:                 cliCtx.String(cmd.P2PHostDNS.Name),
+		PrivateKey:              cliCtx.String(cmd.P2PPrivKey.Name),
+		MetaDataDir:             cliCtx.String(cmd.P2PMetadata.Name),
+		TCPPort:                 cliCtx.Uint(cmd.P2PTCPPort.Name),
+		UDPPort:                 cliCtx.Uint(cmd.P2PUDPPort.Name),
+		MaxPeers:                cliCtx.Uint(cmd.P2PMaxPeers.Name),
+		AllowListCIDR:           cliCtx.String(cmd.P2PAllowList.Name),
+		DenyListCIDR:            sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		EnableUPnP:              cliCtx.Bool(cmd.EnableUPnPFlag.Name),
+		DisableDiscv5:           cliCtx.Bool(flags.DisableDiscv5.Name),
+		SeenCacheTTL:            cliCtx.Duration(flags.GossipSeenCacheTTL.Name),
+		GossipHistoryLength:     cliCtx.Int(flags.GossipHistoryLength.Name),
+		GeoIPDatabase:           cliCtx.String(flags.P2PGeoIPDatabase.Name),
+		GossipOutboundRateLimit: cliCtx.Uint64(flags.P2PGossipOutboundRateLimit.Name),
+		BootnodeRefreshInterval: cliCtx.Duration(flags.P2PBootnodeRefreshInterval.Name),
+		DialTimeout:             cliCtx.Duration(flags.P2PDialTimeout.Name),
+		DialBackoff:             cliCtx.Duration(flags.P2PDialBackoff.Name),
+		MaxDialBackoff:          cliCtx.Duration(flags.P2PMaxDialBackoff.Name),
+		PublishRetries:          cliCtx.Int(flags.P2PPublishRetries.Name),
+		PublishRetryBackoff:     cliCtx.Duration(flags.P2PPublishRetryBackoff.Name),
+		DisableGossipRelay:      cliCtx.Bool(flags.P2PDisableGossipRelay.Name),
+		GossipScoreThreshold:    gossipThreshold,
+		PublishScoreThreshold:   publishThreshold,
+		GraylistScoreThreshold:  graylistThreshold,
+		GossipMeshDegree:        cliCtx.Int(flags.GossipMeshDegree.Name),
+		GossipMeshDegreeLow:     cliCtx.Int(flags.GossipMeshDegreeLow.Name),
+		GossipMeshDegreeHigh:    cliCtx.Int(flags.GossipMeshDegreeHigh.Name),
+		StateNotifier:           b,
 	})
 	if err != nil {
 		return err
@@ -416,6 +485,19 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	return b.services.RegisterService(svc)
 }
 
+// scoreThresholdFlag returns the value of a gossip score threshold flag, or zero to keep the
+// default threshold when the flag is not set. A threshold which is set must be negative.
+func scoreThresholdFlag(cliCtx *cli.Context, flag *cli.Float64Flag) (float64, error) {
+	if !cliCtx.IsSet(flag.Name) {
+		return 0, nil
+	}
+	threshold := cliCtx.Float64(flag.Name)
+	if threshold >= 0 {
+		return 0, fmt.Errorf("--%s must be negative, got %f", flag.Name, threshold)
+	}
+	return threshold, nil
+}
+
 func (b *BeaconNode) fetchP2P() p2p.P2P {
 	var p *p2p.Service
 	if err := b.services.FetchService(&p); err != nil {
@@ -426,7 +508,9 @@ func (b *BeaconNode) fetchP2P() p2p.P2P {
 
 func (b *BeaconNode) registerAttestationPool() error {
 	s, err := attestations.NewService(b.ctx, &attestations.Config{
-		Pool: b.attestationPool,
+		Pool:                b.attestationPool,
+		MaxAggregatedAtts:   b.cliCtx.Int(flags.MaxAggregatedAttestationsInPool.Name),
+		MaxUnaggregatedAtts: b.cliCtx.Int(flags.MaxUnaggregatedAttestationsInPool.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register atts pool service")
@@ -451,6 +535,14 @@ func (b *BeaconNode) registerBlockchainService() error {
 		return err
 	}
 
+	trackedValidators := make([]types.ValidatorIndex, 0, len(b.cliCtx.Int64Slice(flags.TrackedValidatorIndices.Name)))
+	for _, idx := range b.cliCtx.Int64Slice(flags.TrackedValidatorIndices.Name) {
+		if idx < 0 {
+			return fmt.Errorf("invalid tracked validator index %d", idx)
+		}
+		trackedValidators = append(trackedValidators, types.ValidatorIndex(idx))
+	}
+
 	maxRoutines := b.cliCtx.Int(cmd.MaxGoroutines.Name)
 	blockchainService, err := blockchain.NewService(b.ctx, &blockchain.Config{
 		BeaconDB:          b.db,
@@ -467,6 +559,10 @@ func (b *BeaconNode) registerBlockchainService() error {
 		StateGen:          b.stateGen,
 		WspBlockRoot:      bRoot,
 		WspEpoch:          epoch,
+		TrackedValidators: trackedValidators,
+		BlsBatchSize:      b.cliCtx.Int(flags.BlsBatchSize.Name),
+		SigVerifyWorkers:  b.cliCtx.Int(flags.BlockBatchVerifyWorkers.Name),
+		HeadEventInterval: b.cliCtx.Duration(flags.HeadEventDebounceInterval.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register blockchain service")
@@ -495,13 +591,14 @@ func (b *BeaconNode) registerPOWChainService() error {
 	endpoints = append(endpoints, b.cliCtx.StringSlice(flags.FallbackWeb3ProviderFlag.Name)...)
 
 	cfg := &powchain.Web3ServiceConfig{
-		HTTPEndpoints:      endpoints,
-		DepositContract:    common.HexToAddress(depAddress),
-		BeaconDB:           b.db,
-		DepositCache:       b.depositCache,
-		StateNotifier:      b,
-		StateGen:           b.stateGen,
-		Eth1HeaderReqLimit: b.cliCtx.Uint64(flags.Eth1HeaderReqLimit.Name),
+		HTTPEndpoints:         endpoints,
+		DepositContract:       common.HexToAddress(depAddress),
+		BeaconDB:              b.db,
+		DepositCache:          b.depositCache,
+		StateNotifier:         b,
+		StateGen:              b.stateGen,
+		Eth1HeaderReqLimit:    b.cliCtx.Uint64(flags.Eth1HeaderReqLimit.Name),
+		DepositCacheRetention: b.cliCtx.Uint64(flags.DepositCacheRetention.Name),
 	}
 	web3Service, err := powchain.NewService(b.ctx, cfg)
 	if err != nil {
@@ -615,45 +712,59 @@ func (b *BeaconNode) registerRPCService() error {
 	cert := b.cliCtx.String(flags.CertFlag.Name)
 	key := b.cliCtx.String(flags.KeyFlag.Name)
 	mockEth1DataVotes := b.cliCtx.Bool(flags.InteropMockEth1DataVotesFlag.Name)
+	eth1DataVoteStrategy := b.cliCtx.String(flags.Eth1DataVoteStrategy.Name)
+	if eth1DataVoteStrategy != validator.LocalEth1DataVoteStrategy &&
+		eth1DataVoteStrategy != validator.FollowMajorityEth1DataVoteStrategy {
+		return fmt.Errorf("%s is not a valid eth1 data vote strategy", eth1DataVoteStrategy)
+	}
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
+	enableGRPCReflection := b.cliCtx.Bool(flags.EnableGRPCReflection.Name)
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	p2pService := b.fetchP2P()
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
-		Host:                    host,
-		Port:                    port,
-		BeaconMonitoringHost:    beaconMonitoringHost,
-		BeaconMonitoringPort:    beaconMonitoringPort,
-		CertFlag:                cert,
-		KeyFlag:                 key,
-		BeaconDB:                b.db,
-		Broadcaster:             p2pService,
-		PeersFetcher:            p2pService,
-		PeerManager:             p2pService,
-		MetadataProvider:        p2pService,
-		ChainInfoFetcher:        chainService,
-		HeadFetcher:             chainService,
-		CanonicalFetcher:        chainService,
-		ForkFetcher:             chainService,
-		FinalizationFetcher:     chainService,
-		BlockReceiver:           chainService,
-		AttestationReceiver:     chainService,
-		GenesisTimeFetcher:      chainService,
-		GenesisFetcher:          chainService,
-		AttestationsPool:        b.attestationPool,
-		ExitPool:                b.exitPool,
-		SlashingsPool:           b.slashingsPool,
-		POWChainService:         web3Service,
-		ChainStartFetcher:       chainStartFetcher,
-		MockEth1Votes:           mockEth1DataVotes,
-		SyncService:             syncService,
-		DepositFetcher:          depositFetcher,
-		PendingDepositFetcher:   b.depositCache,
-		BlockNotifier:           b,
-		StateNotifier:           b,
-		OperationNotifier:       b,
-		StateGen:                b.stateGen,
-		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
-		MaxMsgSize:              maxMsgSize,
+		Host:                     host,
+		Port:                     port,
+		BeaconMonitoringHost:     beaconMonitoringHost,
+		BeaconMonitoringPort:     beaconMonitoringPort,
+		CertFlag:                 cert,
+		KeyFlag:                  key,
+		BeaconDB:                 b.db,
+		Broadcaster:              p2pService,
+		PeersFetcher:             p2pService,
+		PeerManager:              p2pService,
+		MetadataProvider:         p2pService,
+		ChainInfoFetcher:         chainService,
+		HeadFetcher:              chainService,
+		CanonicalFetcher:         chainService,
+		ForkFetcher:              chainService,
+		FinalizationFetcher:      chainService,
+		BlockReceiver:            chainService,
+		AttestationReceiver:      chainService,
+		HeadUpdater:              chainService,
+		BalanceDeltasFetcher:     chainService,
+		EffectivenessFetcher:     chainService,
+		CheckpointHistoryFetcher: chainService,
+		GenesisTimeFetcher:       chainService,
+		GenesisFetcher:           chainService,
+		AttestationsPool:         b.attestationPool,
+		ExitPool:                 b.exitPool,
+		SlashingsPool:            b.slashingsPool,
+		POWChainService:          web3Service,
+		ChainStartFetcher:        chainStartFetcher,
+		MockEth1Votes:            mockEth1DataVotes,
+		Eth1DataVoteStrategy:     eth1DataVoteStrategy,
+		HistoricalStateCacheSize: b.cliCtx.Int(flags.HistoricalStateCacheSize.Name),
+		SyncService:              syncService,
+		DepositFetcher:           depositFetcher,
+		PendingDepositFetcher:    b.depositCache,
+		BlockNotifier:            b,
+		StateNotifier:            b,
+		OperationNotifier:        b,
+		StateGen:                 b.stateGen,
+		EnableDebugRPCEndpoints:  enableDebugRPCEndpoints,
+		EnableGRPCReflection:     enableGRPCReflection,
+		MaxMsgSize:               maxMsgSize,
+		AttestationCutoff:        b.cliCtx.Duration(flags.AttestationGatheringCutoff.Name),
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -666,6 +777,7 @@ func (b *BeaconNode) registerPrometheusService(cliCtx *cli.Context) error {
 		panic(err)
 	}
 	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/p2p", Handler: p.InfoHandler})
+	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/p2p/scores", Handler: p.PeerScoresHandler})
 
 	var c *blockchain.Service
 	if err := b.services.FetchService(&c); err != nil {
@@ -682,15 +794,43 @@ func (b *BeaconNode) registerPrometheusService(cliCtx *cli.Context) error {
 		)
 	}
 
+	var syncService *initialsync.Service
+	if err := b.services.FetchService(&syncService); err != nil {
+		panic(err)
+	}
+	health := &healthChecker{
+		syncer:   syncService,
+		peers:    p,
+		minPeers: flags.Get().MinimumSyncPeers,
+	}
+	// Nodes started from an interop genesis or without the proof of work chain do not follow
+	// an eth1 node.
+	followsEth1 := !b.cliCtx.Bool(testSkipPowFlag) &&
+		b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name) == 0 &&
+		b.cliCtx.String(flags.InteropGenesisStateFlag.Name) == ""
+	if followsEth1 {
+		var web3Service *powchain.Service
+		if err := b.services.FetchService(&web3Service); err != nil {
+			panic(err)
+		}
+		health.eth1 = web3Service
+	}
+	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/health", Handler: health.HealthHandler})
+
 	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/tree", Handler: c.TreeHandler})
+	additionalHandlers = append(additionalHandlers, prometheus.Handler{Path: "/forkchoice", Handler: c.ForkChoiceDumpHandler})
 
-	service := prometheus.NewService(
+	newService := prometheus.NewService
+	if b.cliCtx.Bool(cmd.EnableTracingFlag.Name) {
+		// Trace IDs are attached to the observations of latency histograms as exemplars.
+		newService = prometheus.NewServiceWithExemplars
+	}
+	service := newService(
 		fmt.Sprintf("%s:%d", b.cliCtx.String(cmd.MonitoringHostFlag.Name), b.cliCtx.Int(flags.MonitoringPortFlag.Name)),
 		b.services,
 		additionalHandlers...,
 	)
-	hook := prometheus.NewLogrusCollector()
-	logrus.AddHook(hook)
+	logutil.AddHook(prometheus.NewLogrusCollector())
 	return b.services.RegisterService(service)
 }
 
@@ -706,14 +846,31 @@ func (b *BeaconNode) registerGRPCGateway() error {
 	allowedOrigins := strings.Split(b.cliCtx.String(flags.GPRCGatewayCorsDomain.Name), ",")
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
 	selfCert := b.cliCtx.String(flags.CertFlag.Name)
+
+	// The event stream is served next to the gateway, as it cannot be proxied to gRPC.
+	eventsServer := eventsv1.NewServer(b.ctx, b)
+	if err := b.services.RegisterService(eventsServer); err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/eth/v1/events", eventsServer)
+	// The randao, liveness and v2 block endpoints are not defined by the API protos either, so the RPC
+	// service serves them in front of the gateway, on their exact paths.
+	var rpcService *rpc.Service
+	if err := b.services.FetchService(&rpcService); err != nil {
+		return err
+	}
 	return b.services.RegisterService(
 		gateway.New(
 			b.ctx,
 			selfAddress,
 			selfCert,
 			gatewayAddress,
-			nil, /*optional mux*/
+			mux,
+			rpcService.HTTPHandler,
 			allowedOrigins,
+			sliceutil.SplitCommaSeparated(b.cliCtx.StringSlice(flags.GRPCGatewayAllowedPaths.Name)),
+			sliceutil.SplitCommaSeparated(b.cliCtx.StringSlice(flags.GRPCGatewayDeniedPaths.Name)),
 			enableDebugRPCEndpoints,
 			b.cliCtx.Uint64(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
 		),
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -81,3 +82,24 @@ func TestClearDB(t *testing.T) {
 	require.LogsContain(t, hook, "Removing database")
 	require.NoError(t, os.RemoveAll(tmp))
 }
+
+func TestScoreThresholdFlag(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Float64(flags.P2PGossipScoreThreshold.Name, flags.P2PGossipScoreThreshold.Value, "")
+	set.Float64(flags.P2PPublishScoreThreshold.Name, flags.P2PPublishScoreThreshold.Value, "")
+	set.Float64(flags.P2PGraylistScoreThreshold.Name, flags.P2PGraylistScoreThreshold.Value, "")
+	require.NoError(t, set.Set(flags.P2PPublishScoreThreshold.Name, "-10000"))
+	require.NoError(t, set.Set(flags.P2PGraylistScoreThreshold.Name, "0"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	// Flags which are not set keep the default threshold of the p2p service.
+	threshold, err := scoreThresholdFlag(cliCtx, flags.P2PGossipScoreThreshold)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, threshold)
+	threshold, err = scoreThresholdFlag(cliCtx, flags.P2PPublishScoreThreshold)
+	require.NoError(t, err)
+	assert.Equal(t, -10000.0, threshold)
+	_, err = scoreThresholdFlag(cliCtx, flags.P2PGraylistScoreThreshold)
+	assert.ErrorContains(t, "--p2p-graylist-score-threshold must be negative", err)
+}
@@ -0,0 +1,86 @@
+package attestations
+
+import (
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// This evicts the attestations of the oldest slots from the pool once it holds more
+// attestations than its caps allow. Attestations which can be included in a block
+// proposed by a local validator at the current or next slot are never evicted.
+func (s *Service) evictExcessAtts() {
+	proposalSlots := s.upcomingLocalProposals()
+
+	if s.maxAggregatedAtts > 0 && s.pool.AggregatedAttestationCount() > s.maxAggregatedAtts {
+		for _, att := range oldestFirst(s.pool.AggregatedAttestations()) {
+			if s.pool.AggregatedAttestationCount() <= s.maxAggregatedAtts {
+				break
+			}
+			if includableInProposals(att, proposalSlots) {
+				continue
+			}
+			if err := s.pool.DeleteAggregatedAttestation(att); err != nil {
+				log.WithError(err).Error("Could not evict aggregated attestation")
+				continue
+			}
+			evictedAggregatedAtts.Inc()
+		}
+	}
+
+	if s.maxUnaggregatedAtts > 0 && s.pool.UnaggregatedAttestationCount() > s.maxUnaggregatedAtts {
+		unAggregatedAtts, err := s.pool.UnaggregatedAttestations()
+		if err != nil {
+			log.WithError(err).Error("Could not get unaggregated attestations")
+			return
+		}
+		for _, att := range oldestFirst(unAggregatedAtts) {
+			if s.pool.UnaggregatedAttestationCount() <= s.maxUnaggregatedAtts {
+				break
+			}
+			if includableInProposals(att, proposalSlots) {
+				continue
+			}
+			if err := s.pool.DeleteUnaggregatedAttestation(att); err != nil {
+				log.WithError(err).Error("Could not evict unaggregated attestation")
+				continue
+			}
+			evictedUnaggregatedAtts.Inc()
+		}
+	}
+}
+
+// Returns the current and next slots at which a local validator proposes a block.
+func (s *Service) upcomingLocalProposals() []types.Slot {
+	currentSlot := helpers.CurrentSlot(s.genesisTime)
+	var slots []types.Slot
+	for _, slot := range []types.Slot{currentSlot, currentSlot + 1} {
+		if cache.LocalProposalSlots.Has(slot) {
+			slots = append(slots, slot)
+		}
+	}
+	return slots
+}
+
+// Sorts the attestations by ascending slot, so the oldest ones are evicted first.
+func oldestFirst(atts []*ethpb.Attestation) []*ethpb.Attestation {
+	sort.SliceStable(atts, func(i, j int) bool {
+		return atts[i].Data.Slot < atts[j].Data.Slot
+	})
+	return atts
+}
+
+// Return true if the attestation can be included in a block proposed at one of the input slots.
+func includableInProposals(att *ethpb.Attestation, proposalSlots []types.Slot) bool {
+	for _, slot := range proposalSlots {
+		if att.Data.Slot+params.BeaconConfig().MinAttestationInclusionDelay <= slot &&
+			slot <= att.Data.Slot+params.BeaconConfig().SlotsPerEpoch {
+			return true
+		}
+	}
+	return false
+}
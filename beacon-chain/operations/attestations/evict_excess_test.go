@@ -0,0 +1,96 @@
+package attestations
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+func fillPool(t *testing.T, s *Service, slots ...types.Slot) {
+	for _, slot := range slots {
+		ad := testutil.HydrateAttestationData(&ethpb.AttestationData{Slot: slot})
+		require.NoError(t, s.pool.SaveUnaggregatedAttestation(&ethpb.Attestation{
+			Data: ad, AggregationBits: bitfield.Bitlist{0b1000, 0b1}, Signature: make([]byte, 96),
+		}))
+		require.NoError(t, s.pool.SaveAggregatedAttestation(&ethpb.Attestation{
+			Data: ad, AggregationBits: bitfield.Bitlist{0b1101, 0b1}, Signature: make([]byte, 96),
+		}))
+	}
+}
+
+func poolSlots(t *testing.T, s *Service) (aggregated, unaggregated map[types.Slot]bool) {
+	aggregated = make(map[types.Slot]bool)
+	for _, att := range s.pool.AggregatedAttestations() {
+		aggregated[att.Data.Slot] = true
+	}
+	unaggregated = make(map[types.Slot]bool)
+	atts, err := s.pool.UnaggregatedAttestations()
+	require.NoError(t, err)
+	for _, att := range atts {
+		unaggregated[att.Data.Slot] = true
+	}
+	return aggregated, unaggregated
+}
+
+func TestEvictExcessAtts_KeepsMostRecentSlots(t *testing.T) {
+	s, err := NewService(context.Background(), &Config{
+		Pool:                NewPool(),
+		MaxAggregatedAtts:   4,
+		MaxUnaggregatedAtts: 3,
+	})
+	require.NoError(t, err)
+	s.genesisTime = uint64(timeutils.Now().Unix()) - uint64(types.Slot(100).Mul(params.BeaconConfig().SecondsPerSlot))
+
+	fillPool(t, s, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99)
+	require.Equal(t, 10, s.pool.AggregatedAttestationCount())
+	require.Equal(t, 10, s.pool.UnaggregatedAttestationCount())
+
+	s.evictExcessAtts()
+	assert.Equal(t, 4, s.pool.AggregatedAttestationCount())
+	assert.Equal(t, 3, s.pool.UnaggregatedAttestationCount())
+	aggregated, unaggregated := poolSlots(t, s)
+	assert.DeepEqual(t, map[types.Slot]bool{96: true, 97: true, 98: true, 99: true}, aggregated)
+	assert.DeepEqual(t, map[types.Slot]bool{97: true, 98: true, 99: true}, unaggregated)
+}
+
+func TestEvictExcessAtts_NoCap(t *testing.T) {
+	s, err := NewService(context.Background(), &Config{Pool: NewPool()})
+	require.NoError(t, err)
+	s.genesisTime = uint64(timeutils.Now().Unix()) - uint64(types.Slot(150).Mul(params.BeaconConfig().SecondsPerSlot))
+
+	fillPool(t, s, 140, 141, 142, 143, 144)
+	s.evictExcessAtts()
+	assert.Equal(t, 5, s.pool.AggregatedAttestationCount())
+	assert.Equal(t, 5, s.pool.UnaggregatedAttestationCount())
+}
+
+func TestEvictExcessAtts_KeepsAttsForUpcomingProposal(t *testing.T) {
+	s, err := NewService(context.Background(), &Config{
+		Pool:                NewPool(),
+		MaxAggregatedAtts:   2,
+		MaxUnaggregatedAtts: 2,
+	})
+	require.NoError(t, err)
+	s.genesisTime = uint64(timeutils.Now().Unix()) - uint64(types.Slot(200).Mul(params.BeaconConfig().SecondsPerSlot))
+	// A local validator proposes at the next slot.
+	cache.LocalProposalSlots.Add(201)
+
+	// Attestations of slots 160 to 163 can no longer be included at slot 201.
+	fillPool(t, s, 160, 161, 162, 163, 190, 195, 199, 200)
+	s.evictExcessAtts()
+
+	// The pool overflows its caps rather than dropping what the proposal needs.
+	want := map[types.Slot]bool{190: true, 195: true, 199: true, 200: true}
+	aggregated, unaggregated := poolSlots(t, s)
+	assert.DeepEqual(t, want, aggregated)
+	assert.DeepEqual(t, want, unaggregated)
+}
@@ -130,6 +130,15 @@ func (c *AttCaches) SaveAggregatedAttestation(att *ethpb.Attestation) error {
 		return nil
 	}
 
+	if c.lowMemory {
+		best, err := mergeBestAggregate(atts, copiedAtt)
+		if err != nil {
+			return err
+		}
+		c.aggregatedAtt[r] = []*ethpb.Attestation{best}
+		return nil
+	}
+
 	atts, err = attaggregation.Aggregate(append(atts, copiedAtt))
 	if err != nil {
 		return err
@@ -28,6 +28,7 @@ type AttCaches struct {
 	blockAttLock       sync.RWMutex
 	blockAtt           map[[32]byte][]*ethpb.Attestation
 	seenAtt            *cache.Cache
+	lowMemory          bool
 }
 
 // NewAttCaches initializes a new attestation pool consists of multiple KV store in cache for
@@ -45,3 +46,50 @@ func NewAttCaches() *AttCaches {
 
 	return pool
 }
+
+// NewLowMemoryAttCaches initializes a new attestation pool which trades CPU for memory: it keeps a
+// single aggregate per attestation data, merging or replacing overlapping aggregates on insert
+// instead of keeping them side by side.
+func NewLowMemoryAttCaches() *AttCaches {
+	pool := NewAttCaches()
+	pool.lowMemory = true
+	return pool
+}
+
+// MemoryEstimate returns an estimate in bytes of the memory used by the attestations in the pool,
+// based on their SSZ encoded size.
+func (c *AttCaches) MemoryEstimate() uint64 {
+	var size uint64
+	c.aggregatedAttLock.RLock()
+	for _, atts := range c.aggregatedAtt {
+		size += attestationsSize(atts)
+	}
+	c.aggregatedAttLock.RUnlock()
+
+	c.unAggregateAttLock.RLock()
+	for _, att := range c.unAggregatedAtt {
+		size += uint64(att.SizeSSZ())
+	}
+	c.unAggregateAttLock.RUnlock()
+
+	c.forkchoiceAttLock.RLock()
+	for _, att := range c.forkchoiceAtt {
+		size += uint64(att.SizeSSZ())
+	}
+	c.forkchoiceAttLock.RUnlock()
+
+	c.blockAttLock.RLock()
+	for _, atts := range c.blockAtt {
+		size += attestationsSize(atts)
+	}
+	c.blockAttLock.RUnlock()
+	return size
+}
+
+func attestationsSize(atts []*ethpb.Attestation) uint64 {
+	var size uint64
+	for _, att := range atts {
+		size += uint64(att.SizeSSZ())
+	}
+	return size
+}
@@ -0,0 +1,31 @@
+package kv
+
+import (
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	attaggregation "github.com/prysmaticlabs/prysm/shared/aggregation/attestations"
+)
+
+// mergeBestAggregate folds the aggregates of the same attestation data into a single one, as done by
+// the low memory pool. Aggregates which don't overlap are merged together. When two aggregates
+// overlap their signatures can't be combined, so the one with the most aggregation bits is kept and
+// the other is dropped. This way the aggregate with the best coverage is always kept for proposals.
+func mergeBestAggregate(atts []*ethpb.Attestation, att *ethpb.Attestation) (*ethpb.Attestation, error) {
+	best := att
+	for _, a := range atts {
+		if a.AggregationBits.Len() != best.AggregationBits.Len() {
+			continue
+		}
+		if !a.AggregationBits.Overlaps(best.AggregationBits) {
+			merged, err := attaggregation.AggregatePair(a, best)
+			if err != nil {
+				return nil, err
+			}
+			best = merged
+			continue
+		}
+		if a.AggregationBits.Count() >= best.AggregationBits.Count() {
+			best = a
+		}
+	}
+	return best, nil
+}
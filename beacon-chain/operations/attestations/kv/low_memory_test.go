@@ -0,0 +1,59 @@
+package kv
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestKV_LowMemory_SaveAggregatedAttestation(t *testing.T) {
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte{'a'}).Marshal()
+	att1 := testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1000011}, Signature: sig})
+	att2 := testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1001110}, Signature: sig})
+	att3 := testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1110000}, Signature: sig})
+
+	cache := NewAttCaches()
+	require.NoError(t, cache.SaveAggregatedAttestations([]*ethpb.Attestation{att1, att2, att3}))
+	assert.Equal(t, 2, len(cache.AggregatedAttestations()), "Overlapping aggregates should be kept apart")
+
+	cache = NewLowMemoryAttCaches()
+	require.NoError(t, cache.SaveAggregatedAttestation(att1))
+	require.NoError(t, cache.SaveAggregatedAttestation(att2))
+	atts := cache.AggregatedAttestations()
+	require.Equal(t, 1, len(atts))
+	assert.DeepEqual(t, att2.AggregationBits, atts[0].AggregationBits, "Should keep the aggregate with the most bits")
+
+	require.NoError(t, cache.SaveAggregatedAttestation(att3))
+	atts = cache.AggregatedAttestations()
+	require.Equal(t, 1, len(atts))
+	assert.DeepEqual(t, bitfield.Bitlist{0b1111110}, atts[0].AggregationBits, "Disjoint aggregates should be merged")
+
+	// A smaller overlapping aggregate does not replace the best one.
+	require.NoError(t, cache.SaveAggregatedAttestation(att1))
+	atts = cache.AggregatedAttestations()
+	require.Equal(t, 1, len(atts))
+	assert.DeepEqual(t, bitfield.Bitlist{0b1111110}, atts[0].AggregationBits)
+}
+
+func TestKV_MemoryEstimate(t *testing.T) {
+	cache := NewAttCaches()
+	assert.Equal(t, uint64(0), cache.MemoryEstimate())
+
+	aggregated := testutil.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1101}})
+	unaggregated := testutil.HydrateAttestation(&ethpb.Attestation{
+		Data:            &ethpb.AttestationData{Slot: 1},
+		AggregationBits: bitfield.Bitlist{0b1001},
+	})
+	require.NoError(t, cache.SaveAggregatedAttestation(aggregated))
+	require.NoError(t, cache.SaveUnaggregatedAttestation(unaggregated))
+	require.NoError(t, cache.SaveBlockAttestation(aggregated))
+	want := 2*aggregated.SizeSSZ() + unaggregated.SizeSSZ()
+	assert.Equal(t, uint64(want), cache.MemoryEstimate())
+}
@@ -18,6 +18,12 @@ var (
 			Help: "The number of unaggregated attestations in the pool.",
 		},
 	)
+	poolMemoryEstimate = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "attestation_pool_memory_estimate_bytes",
+			Help: "The estimated memory used by the attestations in the pool, based on their SSZ encoded size.",
+		},
+	)
 	expiredAggregatedAtts = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "expired_aggregated_atts_total",
 		Help: "The number of expired and deleted aggregated attestations in the pool.",
@@ -30,9 +36,18 @@ var (
 		Name: "expired_block_atts_total",
 		Help: "The number of expired and deleted block attestations in the pool.",
 	})
+	evictedAggregatedAtts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "evicted_aggregated_atts_total",
+		Help: "The number of aggregated attestations evicted from the pool to respect its cap.",
+	})
+	evictedUnaggregatedAtts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "evicted_unaggregated_atts_total",
+		Help: "The number of unaggregated attestations evicted from the pool to respect its cap.",
+	})
 )
 
 func (s *Service) updateMetrics() {
 	aggregatedAttsCount.Set(float64(s.pool.AggregatedAttestationCount()))
 	unaggregatedAttsCount.Set(float64(s.pool.UnaggregatedAttestationCount()))
+	poolMemoryEstimate.Set(float64(s.pool.MemoryEstimate()))
 }
@@ -111,3 +111,7 @@ func (*PoolMock) DeleteForkchoiceAttestation(_ *ethpb.Attestation) error {
 func (*PoolMock) ForkchoiceAttestationCount() int {
 	panic("implement me")
 }
+
+func (*PoolMock) MemoryEstimate() uint64 {
+	panic("implement me")
+}
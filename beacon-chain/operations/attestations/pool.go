@@ -42,9 +42,17 @@ type Pool interface {
 	ForkchoiceAttestations() []*ethpb.Attestation
 	DeleteForkchoiceAttestation(att *ethpb.Attestation) error
 	ForkchoiceAttestationCount() int
+	// MemoryEstimate returns an estimate in bytes of the memory used by the attestations in the pool.
+	MemoryEstimate() uint64
 }
 
 // NewPool initializes a new attestation pool.
 func NewPool() *kv.AttCaches {
 	return kv.NewAttCaches()
 }
+
+// NewLowMemoryPool initializes a new attestation pool which keeps a single aggregate per
+// attestation data, trading CPU on insert for a smaller memory footprint.
+func NewLowMemoryPool() *kv.AttCaches {
+	return kv.NewLowMemoryAttCaches()
+}
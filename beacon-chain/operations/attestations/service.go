@@ -22,12 +22,18 @@ type Service struct {
 	forkChoiceProcessedRoots *lru.Cache
 	genesisTime              uint64
 	pruneInterval            time.Duration
+	maxAggregatedAtts        int
+	maxUnaggregatedAtts      int
 }
 
 // Config options for the service.
 type Config struct {
-	Pool          Pool
-	pruneInterval time.Duration
+	Pool Pool
+	// MaxAggregatedAtts caps the number of aggregated attestations kept in the pool, 0 means no cap.
+	MaxAggregatedAtts int
+	// MaxUnaggregatedAtts caps the number of unaggregated attestations kept in the pool, 0 means no cap.
+	MaxUnaggregatedAtts int
+	pruneInterval       time.Duration
 }
 
 // NewService instantiates a new attestation pool service instance that will
@@ -51,6 +57,8 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		pool:                     cfg.Pool,
 		forkChoiceProcessedRoots: cache,
 		pruneInterval:            pruneInterval,
+		maxAggregatedAtts:        cfg.MaxAggregatedAtts,
+		maxUnaggregatedAtts:      cfg.MaxUnaggregatedAtts,
 	}, nil
 }
 
@@ -8,10 +8,14 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/pkg/errors"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"go.opencensus.io/trace"
 )
@@ -127,7 +131,10 @@ func (s *Service) broadcastObject(ctx context.Context, obj interface{}, topic st
 		span.AddMessageSendEvent(int64(id), messageLen /*uncompressed*/, messageLen /*compressed*/)
 	}
 
-	if err := s.PublishToTopic(ctx, topic+s.Encoding().ProtocolSuffix(), buf.Bytes()); err != nil {
+	publish := func() error {
+		return s.PublishToTopic(ctx, topic+s.Encoding().ProtocolSuffix(), buf.Bytes())
+	}
+	if err := s.publishWithRetries(ctx, publish); err != nil {
 		err := errors.Wrap(err, "could not publish message")
 		traceutil.AnnotateError(span, err)
 		return err
@@ -135,6 +142,59 @@ func (s *Service) broadcastObject(ctx context.Context, obj interface{}, topic st
 	return nil
 }
 
+// publishWithRetries publishes a gossip message, retrying transient failures with an exponential
+// back-off up to the configured number of retries. Retries never go past the start of the next
+// slot, as the message is stale by then, and permanent failures are not retried.
+func (s *Service) publishWithRetries(ctx context.Context, publish func() error) error {
+	backoff := s.cfg.PublishRetryBackoff
+	deadline := s.nextSlotStart()
+	for attempt := 0; ; attempt++ {
+		err := publish()
+		if err == nil {
+			if attempt > 0 {
+				gossipPublishRetrySuccesses.Inc()
+			}
+			return nil
+		}
+		if !isRetryablePublishError(err) {
+			gossipPublishFailures.WithLabelValues("permanent").Inc()
+			return err
+		}
+		gossipPublishFailures.WithLabelValues("retryable").Inc()
+		if attempt >= s.cfg.PublishRetries || (!deadline.IsZero() && timeutils.Now().Add(backoff).After(deadline)) {
+			return err
+		}
+		log.WithError(err).WithField("attempt", attempt+1).Debug("Retrying failed gossip publication")
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// nextSlotStart returns the start time of the slot after the current one, or the zero time
+// before the genesis time is known.
+func (s *Service) nextSlotStart() time.Time {
+	if s.genesisTime.IsZero() {
+		return time.Time{}
+	}
+	return slotutil.SlotStartTime(uint64(s.genesisTime.Unix()), helpers.SlotsSince(s.genesisTime)+1)
+}
+
+// isRetryablePublishError returns false for the publication failures which would fail again,
+// when the message was rejected by the local validators, the topic was closed or the context of
+// the publication expired.
+func isRetryablePublishError(err error) bool {
+	var validationErr pubsub.ValidationError
+	if errors.As(err, &validationErr) {
+		return false
+	}
+	return !errors.Is(err, pubsub.ErrTopicClosed) && !errors.Is(err, context.Canceled) &&
+		!errors.Is(err, context.DeadlineExceeded)
+}
+
 func attestationToTopic(subnet uint64, forkDigest [4]byte) string {
 	return fmt.Sprintf(AttestationSubnetTopicFormat, forkDigest, subnet)
 }
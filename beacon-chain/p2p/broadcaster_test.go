@@ -13,6 +13,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/libp2p/go-libp2p-core/host"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -157,6 +158,7 @@ func TestService_BroadcastAttestation(t *testing.T) {
 		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
 		subnetsLock:           make(map[uint64]*sync.RWMutex),
 		subnetsLockLock:       sync.Mutex{},
+		neededSubnets:         newNeededSubnets(),
 		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
 			ScorerParams: &scorers.Config{},
 		}),
@@ -300,6 +302,7 @@ func TestService_BroadcastAttestationWithDiscoveryAttempts(t *testing.T) {
 		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
 		subnetsLock:           make(map[uint64]*sync.RWMutex),
 		subnetsLockLock:       sync.Mutex{},
+		neededSubnets:         newNeededSubnets(),
 		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
 			ScorerParams: &scorers.Config{},
 		}),
@@ -315,6 +318,7 @@ func TestService_BroadcastAttestationWithDiscoveryAttempts(t *testing.T) {
 		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
 		subnetsLock:           make(map[uint64]*sync.RWMutex),
 		subnetsLockLock:       sync.Mutex{},
+		neededSubnets:         newNeededSubnets(),
 		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
 			ScorerParams: &scorers.Config{},
 		}),
@@ -358,3 +362,82 @@ func TestService_BroadcastAttestationWithDiscoveryAttempts(t *testing.T) {
 		t.Error("Failed to receive pubsub within 5s")
 	}
 }
+
+func TestService_PublishWithRetries(t *testing.T) {
+	transient := errors.New("transient failure")
+	tests := []struct {
+		name      string
+		cfg       *Config
+		genesis   time.Time
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "success",
+			cfg:       &Config{PublishRetries: 2, PublishRetryBackoff: time.Millisecond},
+			wantCalls: 1,
+		},
+		{
+			name:      "transient failures retried",
+			cfg:       &Config{PublishRetries: 2, PublishRetryBackoff: time.Millisecond},
+			errs:      []error{transient, transient},
+			wantCalls: 3,
+		},
+		{
+			name:      "retries exhausted",
+			cfg:       &Config{PublishRetries: 2, PublishRetryBackoff: time.Millisecond},
+			errs:      []error{transient, transient, transient, transient},
+			wantCalls: 3,
+			wantErr:   transient,
+		},
+		{
+			name:      "retries disabled",
+			cfg:       &Config{PublishRetryBackoff: time.Millisecond},
+			errs:      []error{transient},
+			wantCalls: 1,
+			wantErr:   transient,
+		},
+		{
+			name:      "permanent failure",
+			cfg:       &Config{PublishRetries: 2, PublishRetryBackoff: time.Millisecond},
+			errs:      []error{pubsub.ErrTopicClosed},
+			wantCalls: 1,
+			wantErr:   pubsub.ErrTopicClosed,
+		},
+		{
+			name:      "rejected by validation",
+			cfg:       &Config{PublishRetries: 2, PublishRetryBackoff: time.Millisecond},
+			errs:      []error{pubsub.ValidationError{Reason: pubsub.RejectValidationFailed}},
+			wantCalls: 1,
+			wantErr:   pubsub.ValidationError{Reason: pubsub.RejectValidationFailed},
+		},
+		{
+			name:      "retry past the next slot",
+			cfg:       &Config{PublishRetries: 2, PublishRetryBackoff: time.Hour},
+			genesis:   time.Now(),
+			errs:      []error{transient},
+			wantCalls: 1,
+			wantErr:   transient,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{cfg: tt.cfg, genesisTime: tt.genesis}
+			calls := 0
+			err := s.publishWithRetries(context.Background(), func() error {
+				calls++
+				if calls <= len(tt.errs) {
+					return tt.errs[calls-1]
+				}
+				return nil
+			})
+			assert.Equal(t, tt.wantCalls, calls)
+			if tt.wantErr != nil {
+				assert.ErrorContains(t, tt.wantErr.Error(), err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package p2p
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+const (
+	// defaultDialBackoff is the time a peer is not dialed after a first failed dial.
+	defaultDialBackoff = 30 * time.Second
+	// defaultMaxDialBackoff caps the back-off from a peer whose dials keep failing.
+	defaultMaxDialBackoff = 10 * time.Minute
+)
+
+// dialTimeout returns the timeout for a single peer dial.
+func (s *Service) dialTimeout() time.Duration {
+	if s.cfg.DialTimeout > 0 {
+		return s.cfg.DialTimeout
+	}
+	return maxDialTimeout
+}
+
+// backOffDial records a failed dial to the peer and sets the time before which it is not dialed
+// again, which grows exponentially with its consecutive failed dials.
+func (s *Service) backOffDial(pid peer.ID) {
+	base, max := s.cfg.DialBackoff, s.cfg.MaxDialBackoff
+	if base <= 0 {
+		base = defaultDialBackoff
+	}
+	if max <= 0 {
+		max = defaultMaxDialBackoff
+	}
+	failures := s.Peers().IncrementDialFailures(pid)
+	s.Peers().SetNextValidTime(pid, timeutils.Now().Add(dialBackoff(failures, base, max)))
+}
+
+// dialBackoff returns how long to wait before dialing a peer again after the given number of
+// consecutive failed dials: the base back-off doubles with each failure, up to the maximum.
+func dialBackoff(failures int, base, max time.Duration) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+	backoff := base
+	for i := 1; i < failures && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		return max
+	}
+	return backoff
+}
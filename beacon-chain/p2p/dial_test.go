@@ -0,0 +1,66 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestDialBackoff(t *testing.T) {
+	base, max := 10*time.Second, 100*time.Second
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{failures: 0, want: 0},
+		{failures: 1, want: 10 * time.Second},
+		{failures: 2, want: 20 * time.Second},
+		{failures: 4, want: 80 * time.Second},
+		{failures: 5, want: 100 * time.Second},
+		{failures: 1000, want: 100 * time.Second},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, dialBackoff(tt.failures, base, max))
+	}
+}
+
+func TestService_BackOffDial(t *testing.T) {
+	s := &Service{
+		cfg: &Config{DialBackoff: time.Minute},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	pid := peer.ID("unreachable")
+	require.Equal(t, true, s.Peers().IsReadyToDial(pid))
+
+	s.backOffDial(pid)
+	s.backOffDial(pid)
+	failures, err := s.Peers().DialFailures(pid)
+	require.NoError(t, err)
+	assert.Equal(t, 2, failures)
+	assert.Equal(t, false, s.Peers().IsReadyToDial(pid))
+	next, err := s.Peers().NextValidTime(pid)
+	require.NoError(t, err)
+	assert.Equal(t, true, next.After(time.Now().Add(time.Minute)), "Back-off did not grow")
+	assert.Equal(t, true, next.Before(time.Now().Add(2*time.Minute+time.Second)), "Back-off grew too much")
+
+	s.Peers().ResetDialFailures(pid)
+	failures, err = s.Peers().DialFailures(pid)
+	require.NoError(t, err)
+	assert.Equal(t, 0, failures)
+	assert.Equal(t, true, s.Peers().IsReadyToDial(pid))
+}
+
+func TestService_DialTimeout(t *testing.T) {
+	s := &Service{cfg: &Config{}}
+	assert.Equal(t, maxDialTimeout, s.dialTimeout())
+	s.cfg.DialTimeout = 2 * time.Second
+	assert.Equal(t, 2*time.Second, s.dialTimeout())
+}
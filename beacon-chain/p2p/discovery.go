@@ -80,6 +80,12 @@ func (s *Service) listenForNewNodes() {
 			break
 		}
 		node := iterator.Node()
+		// The peer slots left are kept for the nodes advertising the
+		// subnets needed for upcoming duties, one for each subnet, so
+		// that these nodes are dialed before any other.
+		if s.peerSlotsLeft() <= s.neededSubnets.count() && !s.neededSubnets.advertisedBy(node) {
+			continue
+		}
 		peerInfo, _, err := convertToAddrInfo(node)
 		if err != nil {
 			log.WithError(err).Error("Could not convert to peer info")
@@ -270,6 +276,7 @@ func (s *Service) filterPeer(node *enode.Node) bool {
 	if s.genesisValidatorsRoot != nil {
 		if err := s.compareForkENR(nodeENR); err != nil {
 			log.WithError(err).Trace("Fork ENR mismatches between peer and local node")
+			filteredForkDigestENRs.Inc()
 			return false
 		}
 	}
@@ -299,6 +306,16 @@ func (s *Service) isPeerAtLimit(inbound bool) bool {
 	return activePeers >= maxPeers || numOfConns >= maxPeers
 }
 
+// peerSlotsLeft returns the number of peers which can still be dialed
+// before reaching the peer limit.
+func (s *Service) peerSlotsLeft() int {
+	numOfConns := len(s.host.Network().Peers())
+	if activePeers := len(s.Peers().Active()); activePeers > numOfConns {
+		numOfConns = activePeers
+	}
+	return int(s.cfg.MaxPeers) - numOfConns
+}
+
 func parseBootStrapAddrs(addrs []string) (discv5Nodes []string) {
 	discv5Nodes, _ = parseGenericAddrs(addrs)
 	if len(discv5Nodes) == 0 {
@@ -420,6 +437,26 @@ func peersFromStringAddrs(addrs []string) ([]ma.Multiaddr, error) {
 	return allAddrs, nil
 }
 
+// trustedPeerIDs returns the peer IDs of the given static peer addresses. Static
+// peers are trusted and are never pruned to satisfy the peer limit.
+func trustedPeerIDs(addrs []string) []peer.ID {
+	multiAddrs, err := peersFromStringAddrs(addrs)
+	if err != nil {
+		log.WithError(err).Error("Could not parse static peer addresses")
+		return nil
+	}
+	pids := make([]peer.ID, 0, len(multiAddrs))
+	for _, addr := range multiAddrs {
+		info, err := peer.AddrInfoFromP2pAddr(addr)
+		if err != nil {
+			log.WithError(err).WithField("addr", addr).Error("Could not get peer ID of static peer")
+			continue
+		}
+		pids = append(pids, info.ID)
+	}
+	return pids
+}
+
 func multiAddrFromString(address string) (ma.Multiaddr, error) {
 	addr, err := iaddr.ParseString(address)
 	if err != nil {
@@ -3,6 +3,7 @@ package p2p
 import (
 	"context"
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/rand"
 	"net"
@@ -339,3 +340,55 @@ func addPeer(t *testing.T, p *peers.Status, state peerdata.PeerConnectionState)
 	})
 	return id
 }
+
+type bootnodeRefreshListener struct {
+	mockListener
+	reachable map[enode.ID]bool
+	pinged    []enode.ID
+	lookups   int
+}
+
+func (l *bootnodeRefreshListener) Ping(n *enode.Node) error {
+	l.pinged = append(l.pinged, n.ID())
+	if !l.reachable[n.ID()] {
+		return errors.New("timeout")
+	}
+	return nil
+}
+
+func (l *bootnodeRefreshListener) Lookup(enode.ID) []*enode.Node {
+	l.lookups++
+	return nil
+}
+
+func TestRefreshBootnodes(t *testing.T) {
+	db, err := enode.OpenDB(t.TempDir())
+	require.NoError(t, err)
+	var bootnodes []*enode.Node
+	for i := 0; i < 2; i++ {
+		_, key := createAddrAndPrivKey(t)
+		node := enode.NewLocalNode(db, key)
+		node.Set(enr.IPv4{127, 0, 0, 1})
+		node.Set(enr.UDP(3000 + i))
+		bootnodes = append(bootnodes, node.Node())
+	}
+	_, key := createAddrAndPrivKey(t)
+	listener := &bootnodeRefreshListener{
+		mockListener: mockListener{localNode: enode.NewLocalNode(db, key)},
+		reachable:    map[enode.ID]bool{},
+	}
+	s := &Service{
+		cfg:         &Config{Discv5BootStrapAddr: []string{bootnodes[0].String(), bootnodes[1].String()}},
+		dv5Listener: listener,
+	}
+
+	// No lookup is made while all the bootnodes are unreachable.
+	s.refreshBootnodes()
+	assert.DeepEqual(t, []enode.ID{bootnodes[0].ID(), bootnodes[1].ID()}, listener.pinged)
+	assert.Equal(t, 0, listener.lookups)
+
+	listener.reachable[bootnodes[1].ID()] = true
+	s.refreshBootnodes()
+	assert.Equal(t, 4, len(listener.pinged))
+	assert.Equal(t, 1, listener.lookups)
+}
@@ -11,6 +11,9 @@ type NetworkEncoding interface {
 	// DecodeWithMaxLength a bytes from a reader with a varint length prefix. The interface must be a pointer to the
 	// decoding destination. The length of the message should not be more than the provided limit.
 	DecodeWithMaxLength(io.Reader, interface{}) error
+	// DecodeWithLimit a bytes from a reader with a varint length prefix, like DecodeWithMaxLength. The message is
+	// rejected before being read if its length is more than the given limit.
+	DecodeWithLimit(io.Reader, interface{}, uint64) error
 	// EncodeGossip an arbitrary gossip message to the provided writer. The interface must be a pointer object to encode.
 	EncodeGossip(io.Writer, interface{}) (int, error)
 	// EncodeWithMaxLength an arbitrary message to the provided writer with a varint length prefix. The interface must be
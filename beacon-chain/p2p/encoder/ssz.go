@@ -18,6 +18,9 @@ var _ NetworkEncoding = (*SszNetworkEncoder)(nil)
 // MaxGossipSize allowed for gossip messages.
 var MaxGossipSize = params.BeaconNetworkConfig().GossipMaxSize // 1 Mib
 
+// ErrMaxLengthExceeded is returned when the length prefix of a message goes over the accepted limit.
+var ErrMaxLengthExceeded = errors.New("max length exceeded")
+
 // This pool defines the sync pool for our buffered snappy writers, so that they
 // can be constantly reused.
 var bufWriterPool = new(sync.Pool)
@@ -116,15 +119,26 @@ func DecodeSnappy(msg []byte, maxSize uint64) ([]byte, error) {
 // DecodeWithMaxLength the bytes from io.Reader to the protobuf message provided.
 // This checks that the decoded message isn't larger than the provided max limit.
 func (e SszNetworkEncoder) DecodeWithMaxLength(r io.Reader, to interface{}) error {
+	return e.DecodeWithLimit(r, to, params.BeaconNetworkConfig().MaxChunkSize)
+}
+
+// DecodeWithLimit the bytes from io.Reader to the protobuf message provided. The length prefix of
+// the message is checked against the given limit, capped to the max chunk size, before the message
+// is read and decoded.
+func (e SszNetworkEncoder) DecodeWithLimit(r io.Reader, to interface{}, limit uint64) error {
+	if maxChunkSize := params.BeaconNetworkConfig().MaxChunkSize; limit > maxChunkSize {
+		limit = maxChunkSize
+	}
 	msgLen, err := readVarint(r)
 	if err != nil {
 		return err
 	}
-	if msgLen > params.BeaconNetworkConfig().MaxChunkSize {
-		return fmt.Errorf(
+	if msgLen > limit {
+		return errors.Wrapf(
+			ErrMaxLengthExceeded,
 			"remaining bytes %d goes over the provided max limit of %d",
 			msgLen,
-			params.BeaconNetworkConfig().MaxChunkSize,
+			limit,
 		)
 	}
 	msgMax, err := e.MaxLength(msgLen)
@@ -3,6 +3,7 @@ package encoder_test
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"math"
@@ -104,6 +105,27 @@ func TestSszNetworkEncoder_DecodeWithMaxLength(t *testing.T) {
 	assert.ErrorContains(t, wanted, err)
 }
 
+func TestSszNetworkEncoder_DecodeWithLimit(t *testing.T) {
+	buf := new(bytes.Buffer)
+	msg := &pb.Fork{
+		PreviousVersion: []byte("fooo"),
+		CurrentVersion:  []byte("barr"),
+		Epoch:           4242,
+	}
+	e := &encoder.SszNetworkEncoder{}
+	_, err := e.EncodeWithMaxLength(buf, msg)
+	require.NoError(t, err)
+	encoded := buf.Bytes()
+
+	decoded := &pb.Fork{}
+	err = e.DecodeWithLimit(bytes.NewReader(encoded), decoded, uint64(msg.SizeSSZ()-1))
+	assert.ErrorContains(t, fmt.Sprintf("goes over the provided max limit of %d", msg.SizeSSZ()-1), err)
+	assert.Equal(t, true, errors.Is(err, encoder.ErrMaxLengthExceeded))
+
+	require.NoError(t, e.DecodeWithLimit(bytes.NewReader(encoded), decoded, uint64(msg.SizeSSZ())))
+	assert.DeepEqual(t, msg, decoded)
+}
+
 func TestSszNetworkEncoder_DecodeWithMultipleFrames(t *testing.T) {
 	buf := new(bytes.Buffer)
 	st, _ := testutil.DeterministicGenesisState(t, 100)
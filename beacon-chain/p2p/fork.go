@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/p2putils"
@@ -19,6 +20,11 @@ import (
 // ENR key used for eth2-related fork data.
 var eth2ENRKey = params.BeaconNetworkConfig().ETH2Key
 
+// forkDigestGraceEpochs is the number of epochs on either side of a fork boundary
+// during which peers advertising either the pre-fork or the post-fork digest are
+// still considered compatible, as not every node switches over at the same moment.
+const forkDigestGraceEpochs = types.Epoch(2)
+
 // ForkDigest returns the current fork digest of
 // the node.
 func (s *Service) forkDigest() ([4]byte, error) {
@@ -49,8 +55,10 @@ func (s *Service) compareForkENR(record *enr.Record) error {
 		return err
 	}
 	// Clients SHOULD connect to peers with current_fork_digest, next_fork_version,
-	// and next_fork_epoch that match local values.
-	if !bytes.Equal(peerForkENR.CurrentForkDigest, currentForkENR.CurrentForkDigest) {
+	// and next_fork_epoch that match local values. Around a fork boundary the digest
+	// of the neighbouring fork is accepted as well.
+	if !bytes.Equal(peerForkENR.CurrentForkDigest, currentForkENR.CurrentForkDigest) &&
+		!s.isGraceForkDigest(peerForkENR.CurrentForkDigest) {
 		return fmt.Errorf(
 			"fork digest of peer with ENR %s: %v, does not match local value: %v",
 			enrString,
@@ -78,6 +86,62 @@ func (s *Service) compareForkENR(record *enr.Record) error {
 	return nil
 }
 
+// isGraceForkDigest returns true if the digest belongs to a fork that is active at
+// any epoch within the grace window around the current epoch.
+func (s *Service) isGraceForkDigest(digest []byte) bool {
+	return IsGraceForkDigest(digest, s.genesisTime, s.genesisValidatorsRoot)
+}
+
+// IsGraceForkDigest returns true if the digest belongs to a fork that is active at
+// any epoch within the grace window around the current epoch of the given chain.
+// Peers advertising such a digest are compatible with the local node even though
+// they are on the other side of a fork boundary.
+func IsGraceForkDigest(digest []byte, genesisTime time.Time, genesisValidatorsRoot []byte) bool {
+	if genesisTime.IsZero() || len(genesisValidatorsRoot) == 0 {
+		return false
+	}
+	currentEpoch := helpers.SlotToEpoch(helpers.SlotsSince(genesisTime))
+	if timeutils.Now().Before(genesisTime) {
+		currentEpoch = 0
+	}
+	digests, err := graceForkDigests(currentEpoch, genesisValidatorsRoot)
+	if err != nil {
+		log.WithError(err).Debug("Could not compute fork digests in grace window")
+		return false
+	}
+	for _, d := range digests {
+		if bytes.Equal(digest, d[:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// graceForkDigests returns the digests of the forks that are active at the start and
+// the end of the grace window around the given epoch. As the window is much shorter
+// than the distance between two forks, this covers both the previous fork right after
+// a fork boundary and the next fork right before it.
+func graceForkDigests(currentEpoch types.Epoch, genesisValidatorsRoot []byte) ([][4]byte, error) {
+	windowStart := types.Epoch(0)
+	if currentEpoch > forkDigestGraceEpochs {
+		windowStart = currentEpoch - forkDigestGraceEpochs
+	}
+	windowEnd := currentEpoch + forkDigestGraceEpochs
+	digests := make([][4]byte, 0, 2)
+	for _, epoch := range []types.Epoch{windowStart, windowEnd} {
+		fork, err := p2putils.Fork(epoch)
+		if err != nil {
+			return nil, err
+		}
+		digest, err := helpers.ComputeForkDigest(fork.CurrentVersion, genesisValidatorsRoot)
+		if err != nil {
+			return nil, err
+		}
+		digests = append(digests, digest)
+	}
+	return digests, nil
+}
+
 // Adds a fork entry as an ENR record under the eth2EnrKey for
 // the local node. The fork entry is an ssz-encoded enrForkID type
 // which takes into account the current fork version from the current
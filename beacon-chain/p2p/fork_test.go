@@ -273,3 +273,68 @@ func TestAddForkEntry_Genesis(t *testing.T) {
 		params.BeaconConfig().GenesisForkVersion, forkEntry.NextForkVersion,
 		"Wanted Next Fork Version to be equal to genesis fork version")
 }
+
+func TestGraceForkDigests(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig()
+	c.ForkVersionSchedule = map[types.Epoch][]byte{
+		0:   params.BeaconConfig().GenesisForkVersion,
+		100: {0, 0, 0, 1},
+	}
+	params.OverrideBeaconConfig(c)
+
+	genesisValidatorsRoot := bytesutil.PadTo([]byte{'A'}, 32)
+	genesisDigest, err := helpers.ComputeForkDigest(params.BeaconConfig().GenesisForkVersion, genesisValidatorsRoot)
+	require.NoError(t, err)
+	nextDigest, err := helpers.ComputeForkDigest([]byte{0, 0, 0, 1}, genesisValidatorsRoot)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		epoch types.Epoch
+		want  [][4]byte
+	}{
+		{name: "far from fork", epoch: 50, want: [][4]byte{genesisDigest, genesisDigest}},
+		{name: "right before fork", epoch: 99, want: [][4]byte{genesisDigest, nextDigest}},
+		{name: "right after fork", epoch: 101, want: [][4]byte{genesisDigest, nextDigest}},
+		{name: "after grace window", epoch: 100 + forkDigestGraceEpochs, want: [][4]byte{nextDigest, nextDigest}},
+		{name: "genesis", epoch: 0, want: [][4]byte{genesisDigest, genesisDigest}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			digests, err := graceForkDigests(tt.epoch, genesisValidatorsRoot)
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.want, digests)
+		})
+	}
+}
+
+func TestService_IsGraceForkDigest(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig()
+	c.ForkVersionSchedule = map[types.Epoch][]byte{
+		0: params.BeaconConfig().GenesisForkVersion,
+		1: {0, 0, 0, 1},
+		// Far enough in the future to never be within the grace window.
+		1000: {0, 0, 0, 2},
+	}
+	params.OverrideBeaconConfig(c)
+
+	// The node is in epoch 0, one epoch before the fork to version {0, 0, 0, 1}.
+	genesisValidatorsRoot := bytesutil.PadTo([]byte{'A'}, 32)
+	s := &Service{
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: genesisValidatorsRoot,
+	}
+	nextDigest, err := helpers.ComputeForkDigest([]byte{0, 0, 0, 1}, genesisValidatorsRoot)
+	require.NoError(t, err)
+	farDigest, err := helpers.ComputeForkDigest([]byte{0, 0, 0, 2}, genesisValidatorsRoot)
+	require.NoError(t, err)
+	assert.Equal(t, true, s.isGraceForkDigest(nextDigest[:]), "Next fork digest rejected within grace window")
+	assert.Equal(t, false, s.isGraceForkDigest(farDigest[:]), "Distant fork digest accepted")
+	assert.Equal(t, false, s.isGraceForkDigest([]byte{'f', 'o', 'o', 'o'}), "Unknown fork digest accepted")
+
+	// Without genesis data no digest is accepted on grace.
+	s.genesisValidatorsRoot = nil
+	assert.Equal(t, false, s.isGraceForkDigest(nextDigest[:]))
+}
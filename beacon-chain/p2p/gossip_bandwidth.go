@@ -0,0 +1,174 @@
+package p2p
+
+import (
+	"context"
+	"encoding/binary"
+	"sync/atomic"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pubsub_pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"golang.org/x/time/rate"
+)
+
+// publishedMessagesSize is the number of locally published messages remembered to exempt them
+// from the gossip limit, enough for the attestations of many validators within an epoch.
+const publishedMessagesSize = 4096
+
+// gossipHost wraps the host given to gossipsub. Gossipsub only writes to the streams it
+// opens itself, so wrapping them accounts for all the gossip sent to peers, whether published
+// by the node or relayed after validation. When a limiter is set, the writes of relayed gossip
+// wait for it, the per peer outbound queues of gossipsub fill up, and the messages which do not
+// fit are not relayed to that peer. Received messages are still validated and imported, and neither
+// the messages published by the node itself nor the control messages of gossipsub are throttled.
+type gossipHost struct {
+	host.Host
+	ctx       context.Context
+	limiter   *rate.Limiter
+	meter     *gossipRateMeter
+	published *lru.Cache
+}
+
+// newGossipHost wraps the host, limiting the gossip sent to peers to the given number of bytes
+// per second. A limit of 0 leaves the gossip unlimited, while still counting it.
+func newGossipHost(ctx context.Context, h host.Host, bytesPerSecond uint64, meter *gossipRateMeter) *gossipHost {
+	published, err := lru.New(publishedMessagesSize)
+	if err != nil {
+		panic(err) // Only returns an error for a non positive size.
+	}
+	gh := &gossipHost{Host: h, ctx: ctx, meter: meter, published: published}
+	if bytesPerSecond > 0 {
+		gh.limiter = rate.NewLimiter(rate.Limit(bytesPerSecond), int(bytesPerSecond))
+	}
+	return gh
+}
+
+// NewStream opens a stream to the peer, whose writes are counted and limited.
+func (h *gossipHost) NewStream(ctx context.Context, p peer.ID, pids ...protocol.ID) (network.Stream, error) {
+	s, err := h.Host.NewStream(ctx, p, pids...)
+	if err != nil {
+		return nil, err
+	}
+	return &gossipStream{Stream: s, host: h}, nil
+}
+
+// markPublished records the data of a message published by the node, so that the gossip carrying
+// it is not throttled.
+func (h *gossipHost) markPublished(data []byte) {
+	if h == nil || h.limiter == nil {
+		return
+	}
+	h.published.Add(hashutil.Hash(data), true)
+}
+
+// carriesPublished returns whether an RPC sent to a peer carries a message published by the node.
+func (h *gossipHost) carriesPublished(rpc *pubsub_pb.RPC) bool {
+	for _, msg := range rpc.Publish {
+		if h.published.Contains(hashutil.Hash(msg.Data)) {
+			return true
+		}
+	}
+	return false
+}
+
+// gossipStream is a stream opened by gossipsub, which writes each RPC as a varint length prefixed
+// frame and flushes after each one. The frames are buffered until complete so that the ones
+// carrying messages published by the node can bypass the limiter.
+type gossipStream struct {
+	network.Stream
+	host    *gossipHost
+	pending []byte
+}
+
+// Write buffers the bytes until they complete a frame, then writes the frame. The frames of
+// relayed gossip wait for the limiter, while the frames carrying no message, such as subscriptions
+// and mesh control, are written right away. Anything which cannot be parsed as a frame is limited.
+func (s *gossipStream) Write(b []byte) (int, error) {
+	if s.host.limiter == nil {
+		return s.write(b, false)
+	}
+	s.pending = append(s.pending, b...)
+	for len(s.pending) > 0 {
+		size, n := binary.Uvarint(s.pending)
+		if n <= 0 {
+			if n < 0 {
+				// Not a frame length, there is no telling which messages follow.
+				pending := s.pending
+				s.pending = nil
+				if _, err := s.write(pending, true); err != nil {
+					return 0, err
+				}
+			}
+			break
+		}
+		end := uint64(n) + size
+		if uint64(len(s.pending)) < end {
+			break
+		}
+		frame := s.pending[:end]
+		s.pending = s.pending[end:]
+		limited := true
+		rpc := &pubsub_pb.RPC{}
+		if err := rpc.Unmarshal(frame[n:]); err == nil && (len(rpc.Publish) == 0 || s.host.carriesPublished(rpc)) {
+			limited = false
+		}
+		if _, err := s.write(frame, limited); err != nil {
+			return 0, err
+		}
+	}
+	if len(s.pending) == 0 {
+		s.pending = nil
+	}
+	return len(b), nil
+}
+
+// write writes to the stream, waiting for the limiter if the bytes are limited. Buffers larger
+// than the limiter burst, which it can never allow at once, are written in several chunks.
+func (s *gossipStream) write(b []byte, limited bool) (int, error) {
+	written := 0
+	for len(b) > 0 {
+		chunk := b
+		if limited && s.host.limiter != nil {
+			if burst := s.host.limiter.Burst(); len(chunk) > burst {
+				chunk = chunk[:burst]
+			}
+			if err := s.host.limiter.WaitN(s.host.ctx, len(chunk)); err != nil {
+				return written, err
+			}
+		}
+		n, err := s.Stream.Write(chunk)
+		written += n
+		atomic.AddUint64(&s.host.meter.written, uint64(n))
+		if err != nil {
+			return written, err
+		}
+		b = b[n:]
+	}
+	return written, nil
+}
+
+// gossipRateMeter turns the counted gossip bytes into a rate over the interval between updates.
+type gossipRateMeter struct {
+	written     uint64 // Accessed atomically, first for its 64-bit alignment.
+	lastWritten uint64
+	lastUpdate  time.Time
+}
+
+// rate returns the bytes per second written since the previous call.
+func (m *gossipRateMeter) rate(now time.Time) float64 {
+	written := atomic.LoadUint64(&m.written)
+	elapsed := now.Sub(m.lastUpdate).Seconds()
+	defer func() {
+		m.lastWritten = written
+		m.lastUpdate = now
+	}()
+	if m.lastUpdate.IsZero() || elapsed <= 0 {
+		return 0
+	}
+	return float64(written-m.lastWritten) / elapsed
+}
@@ -0,0 +1,137 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	pubsub_pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type chunkRecordingStream struct {
+	network.Stream
+	buf    bytes.Buffer
+	chunks []int
+}
+
+func (s *chunkRecordingStream) Write(b []byte) (int, error) {
+	s.chunks = append(s.chunks, len(b))
+	return s.buf.Write(b)
+}
+
+// gossipFrame encodes an RPC publishing the data as gossipsub writes it to a stream.
+func gossipFrame(t *testing.T, data []byte) []byte {
+	enc, err := (&pubsub_pb.RPC{Publish: []*pubsub_pb.Message{{Data: data}}}).Marshal()
+	require.NoError(t, err)
+	frame := make([]byte, binary.MaxVarintLen64)
+	frame = append(frame[:binary.PutUvarint(frame, uint64(len(enc)))], enc...)
+	return frame
+}
+
+func TestGossipStream_WriteInBurstChunks(t *testing.T) {
+	meter := &gossipRateMeter{}
+	gh := newGossipHost(context.Background(), nil, 1<<20, meter)
+	stream := &chunkRecordingStream{}
+	gs := &gossipStream{Stream: stream, host: gh}
+
+	msg := gossipFrame(t, bytes.Repeat([]byte{'a'}, 1<<20+100))
+	n, err := gs.Write(msg)
+	require.NoError(t, err)
+	assert.Equal(t, len(msg), n)
+	assert.DeepEqual(t, msg, stream.buf.Bytes())
+	assert.DeepEqual(t, []int{1 << 20, len(msg) - 1<<20}, stream.chunks)
+	assert.Equal(t, uint64(len(msg)), meter.written)
+}
+
+func TestGossipStream_WriteUnlimited(t *testing.T) {
+	meter := &gossipRateMeter{}
+	gh := newGossipHost(context.Background(), nil, 0, meter)
+	stream := &chunkRecordingStream{}
+	gs := &gossipStream{Stream: stream, host: gh}
+
+	n, err := gs.Write(make([]byte, 5000))
+	require.NoError(t, err)
+	assert.Equal(t, 5000, n)
+	assert.DeepEqual(t, []int{5000}, stream.chunks)
+	assert.Equal(t, uint64(5000), meter.written)
+}
+
+func TestGossipStream_WriteCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	gh := newGossipHost(ctx, nil, 10, &gossipRateMeter{})
+	gs := &gossipStream{Stream: &chunkRecordingStream{}, host: gh}
+
+	_, err := gs.Write(gossipFrame(t, make([]byte, 100)))
+	assert.NotNil(t, err)
+}
+
+func TestGossipStream_PublishedNotLimited(t *testing.T) {
+	// The limiter never allows a write, as its context is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	gh := newGossipHost(ctx, nil, 10, &gossipRateMeter{})
+	stream := &chunkRecordingStream{}
+	gs := &gossipStream{Stream: stream, host: gh}
+
+	published := []byte("published by the node")
+	gh.markPublished(published)
+	frame := gossipFrame(t, published)
+	// Frames are written once complete, however gossipsub splits its writes.
+	n, err := gs.Write(frame[:5])
+	require.NoError(t, err)
+	assert.Equal(t, 5, n)
+	assert.Equal(t, 0, stream.buf.Len())
+	n, err = gs.Write(frame[5:])
+	require.NoError(t, err)
+	assert.Equal(t, len(frame)-5, n)
+	assert.DeepEqual(t, frame, stream.buf.Bytes())
+
+	_, err = gs.Write(gossipFrame(t, []byte("relayed")))
+	assert.NotNil(t, err)
+}
+
+func TestGossipStream_ControlNotLimited(t *testing.T) {
+	// The limiter never allows a write, as its context is done.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	gh := newGossipHost(ctx, nil, 10, &gossipRateMeter{})
+	stream := &chunkRecordingStream{}
+	gs := &gossipStream{Stream: stream, host: gh}
+
+	topic := "/eth2/beacon_block"
+	subscribe := true
+	enc, err := (&pubsub_pb.RPC{
+		Subscriptions: []*pubsub_pb.RPC_SubOpts{{Subscribe: &subscribe, Topicid: &topic}},
+		Control: &pubsub_pb.ControlMessage{
+			Ihave: []*pubsub_pb.ControlIHave{{TopicID: &topic, MessageIDs: []string{"a", "b"}}},
+			Graft: []*pubsub_pb.ControlGraft{{TopicID: &topic}},
+		},
+	}).Marshal()
+	require.NoError(t, err)
+	frame := make([]byte, binary.MaxVarintLen64)
+	frame = append(frame[:binary.PutUvarint(frame, uint64(len(enc)))], enc...)
+	n, err := gs.Write(frame)
+	require.NoError(t, err)
+	assert.Equal(t, len(frame), n)
+	assert.DeepEqual(t, frame, stream.buf.Bytes())
+
+	_, err = gs.Write(gossipFrame(t, []byte("relayed")))
+	assert.NotNil(t, err)
+}
+
+func TestGossipRateMeter_Rate(t *testing.T) {
+	meter := &gossipRateMeter{}
+	start := time.Now()
+	assert.Equal(t, float64(0), meter.rate(start), "First update has no interval")
+
+	meter.written = 3000
+	assert.Equal(t, float64(1000), meter.rate(start.Add(3*time.Second)))
+	assert.Equal(t, float64(0), meter.rate(start.Add(4*time.Second)))
+	assert.Equal(t, float64(0), meter.rate(start.Add(4*time.Second)), "Empty interval has no rate")
+}
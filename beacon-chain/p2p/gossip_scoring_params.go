@@ -7,8 +7,10 @@ import (
 
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
 )
 
 const (
@@ -24,16 +26,38 @@ const (
 	// decayToZero specifies the terminal value that we will use when decaying
 	// a value.
 	decayToZero = 0.01
+
+	// defaultGossipThreshold is the score below which gossip is neither emitted to nor
+	// accepted from a peer.
+	defaultGossipThreshold = -4000
+	// defaultPublishThreshold is the score below which the messages published by this node
+	// are not sent to a peer.
+	defaultPublishThreshold = -8000
+	// defaultGraylistThreshold is the score below which all the messages of a peer are ignored.
+	defaultGraylistThreshold = -16000
 )
 
-func peerScoringParams() (*pubsub.PeerScoreParams, *pubsub.PeerScoreThresholds) {
+func peerScoringParams(cfg *Config) (*pubsub.PeerScoreParams, *pubsub.PeerScoreThresholds) {
 	thresholds := &pubsub.PeerScoreThresholds{
-		GossipThreshold:             -4000,
-		PublishThreshold:            -8000,
-		GraylistThreshold:           -16000,
+		GossipThreshold:             defaultGossipThreshold,
+		PublishThreshold:            defaultPublishThreshold,
+		GraylistThreshold:           defaultGraylistThreshold,
 		AcceptPXThreshold:           100,
 		OpportunisticGraftThreshold: 5,
 	}
+	// The thresholds left at zero in the config keep their default, as a threshold must be
+	// negative.
+	if cfg != nil {
+		if cfg.GossipScoreThreshold != 0 {
+			thresholds.GossipThreshold = cfg.GossipScoreThreshold
+		}
+		if cfg.PublishScoreThreshold != 0 {
+			thresholds.PublishThreshold = cfg.PublishScoreThreshold
+		}
+		if cfg.GraylistScoreThreshold != 0 {
+			thresholds.GraylistThreshold = cfg.GraylistScoreThreshold
+		}
+	}
 	scoreParams := &pubsub.PeerScoreParams{
 		Topics:        make(map[string]*pubsub.TopicScoreParams),
 		TopicScoreCap: 32.72,
@@ -54,6 +78,35 @@ func peerScoringParams() (*pubsub.PeerScoreParams, *pubsub.PeerScoreThresholds)
 	return scoreParams, thresholds
 }
 
+// validateScoreThresholds checks that the configured score thresholds are ordered as
+// gossipsub requires, and warns when they are looser than the defaults.
+func validateScoreThresholds(cfg *Config) error {
+	_, thresholds := peerScoringParams(cfg)
+	if thresholds.GossipThreshold >= 0 {
+		return errors.Errorf("gossip score threshold %f must be negative", thresholds.GossipThreshold)
+	}
+	if thresholds.PublishThreshold > thresholds.GossipThreshold {
+		return errors.Errorf("publish score threshold %f must not be higher than the gossip score threshold %f",
+			thresholds.PublishThreshold, thresholds.GossipThreshold)
+	}
+	if thresholds.GraylistThreshold > thresholds.PublishThreshold {
+		return errors.Errorf("graylist score threshold %f must not be higher than the publish score threshold %f",
+			thresholds.GraylistThreshold, thresholds.PublishThreshold)
+	}
+	if thresholds.GossipThreshold < defaultGossipThreshold ||
+		thresholds.PublishThreshold < defaultPublishThreshold ||
+		thresholds.GraylistThreshold < defaultGraylistThreshold {
+		log.WithFields(logrus.Fields{
+			"gossipThreshold":   thresholds.GossipThreshold,
+			"publishThreshold":  thresholds.PublishThreshold,
+			"graylistThreshold": thresholds.GraylistThreshold,
+		}).Warn("Gossip score thresholds are looser than the defaults. Misbehaving peers are kept for longer, " +
+			"which reduces the protection of this node against spam and eclipse attacks. Only loosen them " +
+			"temporarily during network incidents")
+	}
+	return nil
+}
+
 func topicScoreParams(topic string) *pubsub.TopicScoreParams {
 	switch {
 	case strings.Contains(topic, "beacon_block"):
@@ -0,0 +1,83 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestPeerScoringParams_Thresholds(t *testing.T) {
+	_, thresholds := peerScoringParams(&Config{})
+	assert.Equal(t, float64(defaultGossipThreshold), thresholds.GossipThreshold)
+	assert.Equal(t, float64(defaultPublishThreshold), thresholds.PublishThreshold)
+	assert.Equal(t, float64(defaultGraylistThreshold), thresholds.GraylistThreshold)
+
+	_, thresholds = peerScoringParams(&Config{
+		GossipScoreThreshold:   -5000,
+		PublishScoreThreshold:  -10000,
+		GraylistScoreThreshold: -20000,
+	})
+	assert.Equal(t, -5000.0, thresholds.GossipThreshold)
+	assert.Equal(t, -10000.0, thresholds.PublishThreshold)
+	assert.Equal(t, -20000.0, thresholds.GraylistThreshold)
+}
+
+func TestValidateScoreThresholds(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *Config
+		wantErr string
+		warns   bool
+	}{
+		{
+			name: "defaults",
+			cfg:  &Config{},
+		},
+		{
+			name: "tighter",
+			cfg: &Config{
+				GossipScoreThreshold:   -1000,
+				PublishScoreThreshold:  -2000,
+				GraylistScoreThreshold: -3000,
+			},
+		},
+		{
+			name:  "looser",
+			cfg:   &Config{GraylistScoreThreshold: -32000},
+			warns: true,
+		},
+		{
+			name:    "positive gossip threshold",
+			cfg:     &Config{GossipScoreThreshold: 10},
+			wantErr: "gossip score threshold 10.000000 must be negative",
+		},
+		{
+			name:    "publish above gossip",
+			cfg:     &Config{GossipScoreThreshold: -4000, PublishScoreThreshold: -1000},
+			wantErr: "publish score threshold -1000.000000 must not be higher",
+		},
+		{
+			name:    "graylist above publish",
+			cfg:     &Config{GraylistScoreThreshold: -6000},
+			wantErr: "graylist score threshold -6000.000000 must not be higher",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := logTest.NewGlobal()
+			err := validateScoreThresholds(tt.cfg)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.warns {
+				assert.LogsContain(t, hook, "Gossip score thresholds are looser than the defaults")
+			} else {
+				assert.LogsDoNotContain(t, hook, "Gossip score thresholds are looser than the defaults")
+			}
+		})
+	}
+}
@@ -16,12 +16,14 @@ func (s *Service) InfoHandler(w http.ResponseWriter, _ *http.Request) {
 	buf := new(bytes.Buffer)
 	if _, err := fmt.Fprintf(buf, `bootnode=%s
 self=%s
+gossip_relay=%s
 
 %d peers
 %v
 `,
 		s.cfg.BootstrapNodeAddr,
 		s.selfAddresses(),
+		gossipRelayStatus(s.cfg.DisableGossipRelay),
 		len(s.host.Network().Peers()),
 		formatPeers(s.host), // Must be last. Writes one entry per row.
 	); err != nil {
@@ -35,6 +37,13 @@ self=%s
 	}
 }
 
+func gossipRelayStatus(disabled bool) string {
+	if disabled {
+		return "disabled"
+	}
+	return "enabled"
+}
+
 // selfAddresses formats the host data into dialable strings, comma separated.
 func (s *Service) selfAddresses() string {
 	var addresses []string
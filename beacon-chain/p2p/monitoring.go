@@ -3,9 +3,14 @@ package p2p
 import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 )
 
 var (
+	gossipRelayDisabled = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "p2p_gossip_relay_disabled",
+		Help: "Set to 1 if the node doesn't relay the gossip messages of other peers.",
+	})
 	p2pPeerCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "p2p_peer_count",
 		Help: "The number of peers in a given state.",
@@ -25,6 +30,69 @@ var (
 		Name: "p2p_attestation_subnet_attempted_broadcasts",
 		Help: "The number of attestations that were attempted to be broadcast.",
 	})
+	subnetTimeToFirstPeer = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name: "p2p_subnet_time_to_first_peer_seconds",
+		Help: "The time between an attestation subnet without peers becoming needed for upcoming duties " +
+			"and the first peer found on it.",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+	})
+	filteredForkDigestENRs = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_filtered_fork_digest_enrs",
+		Help: "The number of discovered ENRs that were not dialed because their fork digest " +
+			"is incompatible with the local node.",
+	})
+	peerDials = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_peer_dials_total",
+		Help: "The number of outbound peer dials by result, success or failure. Dials to peers backing off " +
+			"from previous failures are not attempted and not counted.",
+	},
+		[]string{"result"})
+	gossipPublishFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_gossip_publish_failures_total",
+		Help: "The number of failed gossip publication attempts by kind, retryable or permanent. Retryable " +
+			"failures are retried until the configured number of retries or the start of the next slot.",
+	},
+		[]string{"kind"})
+	gossipPublishRetrySuccesses = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_gossip_publish_retry_successes_total",
+		Help: "The number of gossip messages published after retrying transient failures.",
+	})
+	bootnodeReachable = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_bootnode_reachable",
+		Help: "Whether the bootnode answered the last periodic discovery query, 1 if it did and 0 otherwise.",
+	},
+		[]string{"bootnode"})
+	uniquePeerASNs = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "p2p_connected_peer_unique_asns",
+		Help: "The number of distinct autonomous systems of the connected peers, when a geoip database is configured.",
+	})
+	uniquePeerCountries = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "p2p_connected_peer_unique_countries",
+		Help: "The number of distinct countries of the connected peers, when a geoip database is configured.",
+	})
+	unlocatedPeers = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "p2p_connected_peer_unlocated",
+		Help: "The number of connected peers whose address is not in the geoip database.",
+	})
+	gossipOutboundRate = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "p2p_gossip_outbound_bytes_per_second",
+		Help: "The number of bytes per second sent to peers by gossipsub, published or relayed, " +
+			"over the last metrics update interval.",
+	})
+	gossipSeenCacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_gossip_seen_cache_hits_total",
+		Help: "The number of received gossip messages dropped as duplicates by the seen message cache. " +
+			"The hit rate is this counter over the sum of the hits and misses.",
+	})
+	gossipSeenCacheMisses = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_gossip_seen_cache_misses_total",
+		Help: "The number of received gossip messages not found in the seen message cache, which are validated.",
+	})
+	gossipMeshPeers = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_gossip_mesh_peers",
+		Help: "The number of peers in the gossip mesh of a topic, to compare with the configured mesh degrees.",
+	},
+		[]string{"topic"})
 )
 
 func (s *Service) updateMetrics() {
@@ -33,4 +101,28 @@ func (s *Service) updateMetrics() {
 	p2pPeerCount.WithLabelValues("Connecting").Set(float64(len(s.peers.Connecting())))
 	p2pPeerCount.WithLabelValues("Disconnecting").Set(float64(len(s.peers.Disconnecting())))
 	p2pPeerCount.WithLabelValues("Bad").Set(float64(len(s.peers.Bad())))
+	if s.gossipRate != nil {
+		gossipOutboundRate.Set(s.gossipRate.rate(timeutils.Now()))
+	}
+	if s.cfg.GeoIPDatabase != "" {
+		s.updateDiversityMetrics()
+	}
+}
+
+func (s *Service) updateDiversityMetrics() {
+	asns := make(map[uint64]bool)
+	countries := make(map[string]bool)
+	unlocated := 0
+	for _, pid := range s.peers.Connected() {
+		loc := s.peers.Location(pid)
+		if loc == nil {
+			unlocated++
+			continue
+		}
+		asns[loc.ASN] = true
+		countries[loc.Country] = true
+	}
+	uniquePeerASNs.Set(float64(len(asns)))
+	uniquePeerCountries.Set(float64(len(countries)))
+	unlocatedPeers.Set(float64(unlocated))
 }
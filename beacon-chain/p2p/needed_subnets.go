@@ -0,0 +1,112 @@
+package p2p
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// neededSubnets tracks the attestation subnets which have no peer yet while they are needed for
+// upcoming duties, so that discovery dials the nodes advertising them in their attnets ENR entry
+// before any other node.
+type neededSubnets struct {
+	lock   sync.RWMutex
+	since  map[uint64]time.Time
+	expiry time.Duration
+}
+
+func newNeededSubnets() *neededSubnets {
+	// The duties of a subnet are at most one epoch ahead, so a subnet which still has no peer
+	// after an epoch is not needed anymore.
+	expiry := time.Duration(uint64(params.BeaconConfig().SlotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second
+	return &neededSubnets{
+		since:  make(map[uint64]time.Time),
+		expiry: expiry,
+	}
+}
+
+// add marks a subnet as needed, unless it already is.
+func (n *neededSubnets) add(index uint64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for i, t := range n.since {
+		if time.Since(t) > n.expiry {
+			delete(n.since, i)
+		}
+	}
+	if _, ok := n.since[index]; !ok {
+		n.since[index] = time.Now()
+	}
+}
+
+// found records that a subnet has a peer, reporting how long it took to find it if the
+// subnet was needed.
+func (n *neededSubnets) found(index uint64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	t, ok := n.since[index]
+	if !ok {
+		return
+	}
+	delete(n.since, index)
+	if time.Since(t) <= n.expiry {
+		subnetTimeToFirstPeer.Observe(time.Since(t).Seconds())
+	}
+}
+
+// count returns the number of needed subnets.
+func (n *neededSubnets) count() int {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	count := 0
+	for _, t := range n.since {
+		if time.Since(t) <= n.expiry {
+			count++
+		}
+	}
+	return count
+}
+
+// advertisedBy returns whether the node advertises any needed subnet.
+func (n *neededSubnets) advertisedBy(node *enode.Node) bool {
+	subnets, err := attSubnets(node.Record())
+	if err != nil {
+		return false
+	}
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	for _, i := range subnets {
+		if t, ok := n.since[i]; ok && time.Since(t) <= n.expiry {
+			return true
+		}
+	}
+	return false
+}
+
+// trackUpcomingSubnets marks the attestation subnets of the attester and aggregator duties of the
+// next epoch as needed while they have no peer, and records the ones which found a peer.
+func (s *Service) trackUpcomingSubnets() {
+	if s.genesisTime.IsZero() || s.pubsub == nil {
+		return
+	}
+	digest, err := s.forkDigest()
+	if err != nil {
+		log.WithError(err).Debug("Could not compute fork digest")
+		return
+	}
+	currentSlot := helpers.SlotsSince(s.genesisTime)
+	for slot := currentSlot; slot < currentSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		subnets := append(cache.SubnetIDs.GetAttesterSubnetIDs(slot), cache.SubnetIDs.GetAggregatorSubnetIDs(slot)...)
+		for _, subnet := range subnets {
+			if s.hasPeerWithSubnet(attestationToTopic(subnet, digest)) {
+				s.neededSubnets.found(subnet)
+			} else {
+				s.neededSubnets.add(subnet)
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestNeededSubnets(t *testing.T) {
+	db, err := enode.OpenDB(t.TempDir())
+	require.NoError(t, err)
+	_, key := createAddrAndPrivKey(t)
+	localNode := enode.NewLocalNode(db, key)
+	bitV := bitfield.NewBitvector64()
+	bitV.SetBitAt(5, true)
+	localNode.Set(enr.WithEntry(attSubnetEnrKey, &bitV))
+	node := localNode.Node()
+
+	n := newNeededSubnets()
+	assert.Equal(t, 0, n.count())
+	assert.Equal(t, false, n.advertisedBy(node))
+
+	n.add(3)
+	assert.Equal(t, 1, n.count())
+	assert.Equal(t, false, n.advertisedBy(node), "Node does not advertise subnet 3")
+	n.add(5)
+	assert.Equal(t, true, n.advertisedBy(node), "Node advertises subnet 5")
+
+	n.found(5)
+	assert.Equal(t, false, n.advertisedBy(node), "Subnet 5 has a peer")
+	n.found(3)
+	assert.Equal(t, 0, n.count())
+
+	// Subnets needed for longer than an epoch are not prioritized anymore.
+	n.add(5)
+	n.since[5] = time.Now().Add(-2 * n.expiry)
+	assert.Equal(t, 0, n.count())
+	assert.Equal(t, false, n.advertisedBy(node))
+}
+
+func TestService_TrackUpcomingSubnets(t *testing.T) {
+	defer cache.SubnetIDs.EmptyAllCaches()
+	p1 := p2ptest.NewTestP2P(t)
+	s := &Service{
+		pubsub:                p1.PubSub(),
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
+		neededSubnets:         newNeededSubnets(),
+	}
+
+	currentSlot := helpers.SlotsSince(s.genesisTime)
+	cache.SubnetIDs.AddAttesterSubnetID(currentSlot+1, 3)
+	cache.SubnetIDs.AddAggregatorSubnetID(currentSlot+2, 5)
+	// Duties beyond the next epoch are not tracked yet.
+	cache.SubnetIDs.AddAttesterSubnetID(currentSlot+params.BeaconConfig().SlotsPerEpoch, 7)
+	s.trackUpcomingSubnets()
+	assert.Equal(t, 2, s.neededSubnets.count())
+	_, ok := s.neededSubnets.since[7]
+	assert.Equal(t, false, ok, "Subnet 7 is not needed yet")
+}
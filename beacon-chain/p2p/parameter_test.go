@@ -6,6 +6,7 @@ import (
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
 const (
@@ -61,3 +62,79 @@ func TestMiscParameters(t *testing.T) {
 	setPubSubParameters()
 	assert.Equal(t, randomSubD, pubsub.RandomSubD, "randomSubD")
 }
+
+func TestGossipCacheParameters(t *testing.T) {
+	t.Cleanup(setPubSubParameters)
+	setPubSubParameters()
+
+	require.NoError(t, setGossipCacheParameters(&Config{}))
+	assert.Equal(t, gossipSubMcacheLen, pubsub.GossipSubHistoryLength, "gossipSubMcacheLen")
+	assert.Equal(t, gossipSubSeenTTL, int(pubsub.TimeCacheDuration.Milliseconds()/pubsub.GossipSubHeartbeatInterval.Milliseconds()), "gossipSubSeenTtl")
+
+	require.NoError(t, setGossipCacheParameters(&Config{SeenCacheTTL: 10 * time.Minute, GossipHistoryLength: 10}))
+	assert.Equal(t, 10, pubsub.GossipSubHistoryLength, "gossipSubMcacheLen")
+	assert.Equal(t, 10*time.Minute, pubsub.TimeCacheDuration, "gossipSubSeenTtl")
+
+	err := setGossipCacheParameters(&Config{GossipHistoryLength: 2})
+	assert.ErrorContains(t, "lower than the 3 gossiped heartbeats", err)
+}
+
+func TestGossipMeshParameters(t *testing.T) {
+	d, dlo, dhi := pubsub.GossipSubD, pubsub.GossipSubDlo, pubsub.GossipSubDhi
+	t.Cleanup(func() {
+		pubsub.GossipSubD, pubsub.GossipSubDlo, pubsub.GossipSubDhi = d, dlo, dhi
+	})
+	setPubSubParameters()
+
+	require.NoError(t, setGossipMeshParameters(&Config{}))
+	assert.Equal(t, gossipSubD, pubsub.GossipSubD, "gossipSubD")
+	assert.Equal(t, gossipSubDlo, pubsub.GossipSubDlo, "gossipSubDlo")
+	assert.Equal(t, gossipSubDhi, pubsub.GossipSubDhi, "gossipSubDhi")
+
+	require.NoError(t, setGossipMeshParameters(&Config{GossipMeshDegree: 10, GossipMeshDegreeLow: 8, GossipMeshDegreeHigh: 16}))
+	assert.Equal(t, 10, pubsub.GossipSubD, "gossipSubD")
+	assert.Equal(t, 8, pubsub.GossipSubDlo, "gossipSubDlo")
+	assert.Equal(t, 16, pubsub.GossipSubDhi, "gossipSubDhi")
+
+	tests := []struct {
+		cfg     *Config
+		wantErr string
+	}{
+		{
+			cfg:     &Config{GossipMeshDegreeLow: 2},
+			wantErr: "low gossip mesh degree 2 must be greater than the 2 outbound mesh peers",
+		},
+		{
+			cfg:     &Config{GossipMeshDegree: 20},
+			wantErr: "gossip mesh degree 20 must be between the low and high mesh degrees 8 and 16",
+		},
+		{
+			cfg:     &Config{GossipMeshDegree: 3, GossipMeshDegreeLow: 3},
+			wantErr: "gossip mesh degree 3 must be at least twice the 2 outbound mesh peers",
+		},
+		{
+			cfg:     &Config{GossipMeshDegreeHigh: 40},
+			wantErr: "high gossip mesh degree 40 must not be greater than 32",
+		},
+	}
+	for _, tt := range tests {
+		assert.ErrorContains(t, tt.wantErr, setGossipMeshParameters(tt.cfg))
+	}
+	// Invalid parameters are not applied.
+	assert.Equal(t, 10, pubsub.GossipSubD, "gossipSubD")
+}
+
+func TestGossipRelayParameters(t *testing.T) {
+	d, dlo, dhi, dscore, dout, dlazy, factor := pubsub.GossipSubD, pubsub.GossipSubDlo, pubsub.GossipSubDhi,
+		pubsub.GossipSubDscore, pubsub.GossipSubDout, pubsub.GossipSubDlazy, pubsub.GossipSubGossipFactor
+	t.Cleanup(func() {
+		pubsub.GossipSubD, pubsub.GossipSubDlo, pubsub.GossipSubDhi = d, dlo, dhi
+		pubsub.GossipSubDscore, pubsub.GossipSubDout, pubsub.GossipSubDlazy, pubsub.GossipSubGossipFactor = dscore, dout, dlazy, factor
+	})
+
+	setGossipRelayParameters()
+	assert.Equal(t, 0, pubsub.GossipSubD, "gossipSubD")
+	assert.Equal(t, 0, pubsub.GossipSubDhi, "gossipSubDhi")
+	assert.Equal(t, 0, pubsub.GossipSubDlazy, "gossipSubDlazy")
+	assert.Equal(t, float64(0), pubsub.GossipSubGossipFactor, "gossipSubGossipFactor")
+}
@@ -0,0 +1,184 @@
+package p2p
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+)
+
+// maxPeerScoresPageSize caps the number of peers returned in a single page.
+const maxPeerScoresPageSize = 500
+
+// PeerScoreThresholds are the score boundaries applied to gossip peers. Peers scoring below
+// BadPeer are considered bad by the gossip scorer and are pruned by the peer manager.
+type PeerScoreThresholds struct {
+	BadPeer  float64 `json:"bad_peer"`
+	Gossip   float64 `json:"gossip"`
+	Publish  float64 `json:"publish"`
+	Graylist float64 `json:"graylist"`
+	AcceptPX float64 `json:"accept_px"`
+	TopicCap float64 `json:"topic_score_cap"`
+}
+
+// TopicScoreComponents are the weighted topic specific score components of a peer.
+// P3b, the mesh failure penalty, is not exposed by libp2p and is therefore not reported.
+type TopicScoreComponents struct {
+	P1TimeInMesh               float64 `json:"p1_time_in_mesh"`
+	P2FirstMessageDeliveries   float64 `json:"p2_first_message_deliveries"`
+	P3MeshMessageDeliveries    float64 `json:"p3_mesh_message_deliveries"`
+	P4InvalidMessageDeliveries float64 `json:"p4_invalid_message_deliveries"`
+}
+
+// PeerScore is the gossipsub score of a single peer along with its weighted components.
+type PeerScore struct {
+	PeerID                     string                           `json:"peer_id"`
+	Score                      float64                          `json:"score"`
+	DistanceToBadPeerThreshold float64                          `json:"distance_to_bad_peer_threshold"`
+	BadPeer                    bool                             `json:"bad_peer"`
+	P1TimeInMesh               float64                          `json:"p1_time_in_mesh"`
+	P2FirstMessageDeliveries   float64                          `json:"p2_first_message_deliveries"`
+	P3MeshMessageDeliveries    float64                          `json:"p3_mesh_message_deliveries"`
+	P4InvalidMessageDeliveries float64                          `json:"p4_invalid_message_deliveries"`
+	P5AppSpecific              float64                          `json:"p5_app_specific"`
+	P6IPColocation             float64                          `json:"p6_ip_colocation"`
+	P7BehaviourPenalty         float64                          `json:"p7_behaviour_penalty"`
+	Topics                     map[string]*TopicScoreComponents `json:"topics"`
+}
+
+// PeerScoresResponse is the response body of the /p2p/scores endpoint.
+type PeerScoresResponse struct {
+	ScoringEnabled bool                 `json:"scoring_enabled"`
+	Thresholds     *PeerScoreThresholds `json:"thresholds"`
+	Peers          []*PeerScore         `json:"peers"`
+	NextPageToken  string               `json:"next_page_token"`
+	TotalSize      int                  `json:"total_size"`
+}
+
+// PeerScoresHandler serves the gossipsub scores of all connected peers, as last reported by
+// the libp2p score inspector, broken down into their weighted P1 to P7 components. Peers are
+// ordered by peer ID and paginated with the page_token and page_size query parameters.
+func (s *Service) PeerScoresHandler(w http.ResponseWriter, r *http.Request) {
+	pageSize := 0
+	if ps := r.URL.Query().Get("page_size"); ps != "" {
+		var err error
+		pageSize, err = strconv.Atoi(ps)
+		if err != nil || pageSize < 0 || pageSize > maxPeerScoresPageSize {
+			http.Error(w, fmt.Sprintf("page size must be between 0 and %d", maxPeerScoresPageSize), http.StatusBadRequest)
+			return
+		}
+	}
+
+	scoreParams, thresholds := peerScoringParams(s.cfg)
+	resp := &PeerScoresResponse{
+		ScoringEnabled: featureconfig.Get().EnablePeerScorer,
+		Thresholds: &PeerScoreThresholds{
+			BadPeer:  s.peers.Scorers().GossipScorer().Params().Threshold,
+			Gossip:   thresholds.GossipThreshold,
+			Publish:  thresholds.PublishThreshold,
+			Graylist: thresholds.GraylistThreshold,
+			AcceptPX: thresholds.AcceptPXThreshold,
+			TopicCap: scoreParams.TopicScoreCap,
+		},
+		Peers: make([]*PeerScore, 0),
+	}
+
+	connected := s.peers.Connected()
+	sort.Slice(connected, func(i, j int) bool {
+		return connected[i] < connected[j]
+	})
+	resp.TotalSize = len(connected)
+	if len(connected) > 0 {
+		start, end, nextPageToken, err := pagination.StartAndEndPage(r.URL.Query().Get("page_token"), pageSize, len(connected))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("could not paginate results: %v", err), http.StatusBadRequest)
+			return
+		}
+		resp.NextPageToken = nextPageToken
+		for _, pid := range connected[start:end] {
+			score, err := s.peerScore(pid, scoreParams)
+			if err != nil {
+				// The peer may have been removed since the connected peers were listed.
+				continue
+			}
+			resp.Peers = append(resp.Peers, score)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.WithError(err).Error("Failed to write peer scores response")
+	}
+}
+
+// peerScore computes the weighted score components of a peer, mirroring the gossipsub
+// scoring function for the parameters configured on this node.
+func (s *Service) peerScore(pid peer.ID, scoreParams *pubsub.PeerScoreParams) (*PeerScore, error) {
+	scorer := s.peers.Scorers().GossipScorer()
+	gScore, bPenalty, topicScores, err := scorer.GossipData(pid)
+	if err != nil {
+		return nil, err
+	}
+	appScore, ipColocation, err := scorer.PeerScoreComponents(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	ps := &PeerScore{
+		PeerID:                     pid.String(),
+		Score:                      gScore,
+		DistanceToBadPeerThreshold: gScore - scorer.Params().Threshold,
+		BadPeer:                    scorer.IsBadPeer(pid),
+		P5AppSpecific:              appScore * scoreParams.AppSpecificWeight,
+		P6IPColocation:             ipColocation * scoreParams.IPColocationFactorWeight,
+		Topics:                     make(map[string]*TopicScoreComponents, len(topicScores)),
+	}
+	if bPenalty > scoreParams.BehaviourPenaltyThreshold {
+		excess := bPenalty - scoreParams.BehaviourPenaltyThreshold
+		ps.P7BehaviourPenalty = excess * excess * scoreParams.BehaviourPenaltyWeight
+	}
+	for topic, snap := range topicScores {
+		topicParams := topicScoreParams(topic)
+		if topicParams == nil {
+			continue
+		}
+		components := weightedTopicComponents(snap, topicParams)
+		ps.Topics[topic] = components
+		ps.P1TimeInMesh += components.P1TimeInMesh
+		ps.P2FirstMessageDeliveries += components.P2FirstMessageDeliveries
+		ps.P3MeshMessageDeliveries += components.P3MeshMessageDeliveries
+		ps.P4InvalidMessageDeliveries += components.P4InvalidMessageDeliveries
+	}
+	return ps, nil
+}
+
+// weightedTopicComponents applies the topic weights to a topic score snapshot.
+func weightedTopicComponents(snap *pbrpc.TopicScoreSnapshot, topicParams *pubsub.TopicScoreParams) *TopicScoreComponents {
+	timeInMesh := time.Duration(snap.TimeInMesh) * time.Millisecond
+	components := &TopicScoreComponents{}
+	if topicParams.TimeInMeshQuantum > 0 {
+		p1 := math.Min(float64(timeInMesh)/float64(topicParams.TimeInMeshQuantum), topicParams.TimeInMeshCap)
+		components.P1TimeInMesh = p1 * topicParams.TimeInMeshWeight * topicParams.TopicWeight
+	}
+	components.P2FirstMessageDeliveries = float64(snap.FirstMessageDeliveries) *
+		topicParams.FirstMessageDeliveriesWeight * topicParams.TopicWeight
+	// The mesh delivery deficit is only penalised once the peer has been in the mesh
+	// for longer than the activation window.
+	meshDeliveries := float64(snap.MeshMessageDeliveries)
+	if timeInMesh > topicParams.MeshMessageDeliveriesActivation && meshDeliveries < topicParams.MeshMessageDeliveriesThreshold {
+		deficit := topicParams.MeshMessageDeliveriesThreshold - meshDeliveries
+		components.P3MeshMessageDeliveries = deficit * deficit * topicParams.MeshMessageDeliveriesWeight * topicParams.TopicWeight
+	}
+	invalid := float64(snap.InvalidMessageDeliveries)
+	components.P4InvalidMessageDeliveries = invalid * invalid * topicParams.InvalidMessageDeliveriesWeight * topicParams.TopicWeight
+	return components
+}
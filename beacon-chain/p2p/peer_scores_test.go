@@ -0,0 +1,147 @@
+package p2p
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_PeerScoresHandler(t *testing.T) {
+	s := &Service{
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	pids := []peer.ID{"peer-a", "peer-b", "peer-c"}
+	for _, pid := range pids {
+		s.peers.Add(nil, pid, nil, network.DirOutbound)
+		s.peers.SetConnectionState(pid, peerdata.PeerConnected)
+	}
+	scorer := s.peers.Scorers().GossipScorer()
+	scorer.SetGossipData("peer-a", -20, 8, map[string]*pbrpc.TopicScoreSnapshot{
+		"/eth2/00000000/beacon_block/ssz_snappy": {InvalidMessageDeliveries: 1},
+	})
+	scorer.SetPeerScoreComponents("peer-a", 0, 2)
+	scorer.SetGossipData("peer-b", 5, 0, nil)
+
+	req, err := http.NewRequest("GET", "/p2p/scores?page_size=2", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(s.PeerScoresHandler).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	resp := &PeerScoresResponse{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), resp))
+	assert.Equal(t, 3, resp.TotalSize)
+	assert.Equal(t, "1", resp.NextPageToken)
+	assert.Equal(t, 0.0, resp.Thresholds.BadPeer)
+	require.Equal(t, 2, len(resp.Peers))
+
+	bad := resp.Peers[0]
+	assert.Equal(t, peer.ID("peer-a").String(), bad.PeerID)
+	assert.Equal(t, -20.0, bad.Score)
+	assert.Equal(t, true, bad.BadPeer)
+	scoreParams, thresholds := peerScoringParams(s.cfg)
+	assert.Equal(t, thresholds.GossipThreshold, resp.Thresholds.Gossip)
+	assert.Equal(t, 2*scoreParams.IPColocationFactorWeight, bad.P6IPColocation)
+	excess := 8 - scoreParams.BehaviourPenaltyThreshold
+	assert.Equal(t, excess*excess*scoreParams.BehaviourPenaltyWeight, bad.P7BehaviourPenalty)
+	blockParams := defaultBlockTopicParams()
+	assert.Equal(t, blockParams.InvalidMessageDeliveriesWeight*blockParams.TopicWeight, bad.P4InvalidMessageDeliveries)
+
+	good := resp.Peers[1]
+	assert.Equal(t, 5.0, good.Score)
+	assert.Equal(t, false, good.BadPeer)
+
+	req, err = http.NewRequest("GET", "/p2p/scores?page_size=2&page_token=1", nil)
+	require.NoError(t, err)
+	rr = httptest.NewRecorder()
+	http.HandlerFunc(s.PeerScoresHandler).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	resp = &PeerScoresResponse{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), resp))
+	assert.Equal(t, "", resp.NextPageToken)
+	assert.Equal(t, 1, len(resp.Peers))
+}
+
+func TestService_PeerScoresHandler_InvalidPageSize(t *testing.T) {
+	s := &Service{
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	req, err := http.NewRequest("GET", "/p2p/scores?page_size=100000", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(s.PeerScoresHandler).ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestService_PeerScoresHandler_ConfiguredThresholds(t *testing.T) {
+	s := &Service{
+		cfg: &Config{
+			GossipScoreThreshold:   -5000,
+			PublishScoreThreshold:  -10000,
+			GraylistScoreThreshold: -20000,
+		},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	req, err := http.NewRequest("GET", "/p2p/scores", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(s.PeerScoresHandler).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	resp := &PeerScoresResponse{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), resp))
+	assert.Equal(t, -5000.0, resp.Thresholds.Gossip)
+	assert.Equal(t, -10000.0, resp.Thresholds.Publish)
+	assert.Equal(t, -20000.0, resp.Thresholds.Graylist)
+}
+
+func TestService_PeerScoresHandler_ConfiguredBadPeerThreshold(t *testing.T) {
+	s := &Service{
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{
+				GossipScorerConfig: &scorers.GossipScorerConfig{Threshold: -10},
+			},
+		}),
+	}
+	pids := []peer.ID{"peer-a", "peer-b"}
+	for _, pid := range pids {
+		s.peers.Add(nil, pid, nil, network.DirOutbound)
+		s.peers.SetConnectionState(pid, peerdata.PeerConnected)
+	}
+	scorer := s.peers.Scorers().GossipScorer()
+	scorer.SetGossipData("peer-a", -20, 0, nil)
+	scorer.SetGossipData("peer-b", -5, 0, nil)
+
+	req, err := http.NewRequest("GET", "/p2p/scores", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	http.HandlerFunc(s.PeerScoresHandler).ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	resp := &PeerScoresResponse{}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), resp))
+	assert.Equal(t, -10.0, resp.Thresholds.BadPeer)
+	require.Equal(t, 2, len(resp.Peers))
+	assert.Equal(t, true, resp.Peers[0].BadPeer)
+	assert.Equal(t, -10.0, resp.Peers[0].DistanceToBadPeerThreshold)
+	// A negative score above the threshold is not bad.
+	assert.Equal(t, false, resp.Peers[1].BadPeer)
+	assert.Equal(t, 5.0, resp.Peers[1].DistanceToBadPeerThreshold)
+}
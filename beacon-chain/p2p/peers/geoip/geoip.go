@@ -0,0 +1,140 @@
+// Package geoip looks up the autonomous system and the country of peer IP addresses in a
+// local database, so the diversity of the peers can be monitored without any external call.
+//
+// The database is an IP to ASN table in the tab separated format published by iptoasn.com,
+// optionally gzipped, with one range per line:
+//
+//	range_start	range_end	AS_number	country_code	AS_description
+package geoip
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Location of an IP address.
+type Location struct {
+	ASN          uint64
+	Organization string
+	Country      string
+}
+
+type ipRange struct {
+	start    net.IP
+	end      net.IP
+	location *Location
+}
+
+// Database of IP ranges sorted by their start address.
+type Database struct {
+	ranges []ipRange
+}
+
+// Load reads the database at the given path, which is gunzipped if its name ends with .gz.
+func Load(path string) (*Database, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open geoip database")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close geoip database")
+		}
+	}()
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not gunzip geoip database")
+		}
+		defer func() {
+			if err := gz.Close(); err != nil {
+				log.WithError(err).Error("Could not close geoip database")
+			}
+		}()
+		r = gz
+	}
+	return Parse(r)
+}
+
+// Parse reads a database from r. Unrouted ranges, with an AS number of 0, are skipped.
+func Parse(r io.Reader) (*Database, error) {
+	db := &Database{}
+	// Ranges share their location object when they belong to the same autonomous system.
+	locations := make(map[uint64]*Location)
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		cols := strings.SplitN(text, "\t", 5)
+		if len(cols) < 4 {
+			return nil, errors.Errorf("line %d: expected at least 4 columns, got %d", line, len(cols))
+		}
+		start, end := net.ParseIP(cols[0]), net.ParseIP(cols[1])
+		if start == nil || end == nil {
+			return nil, errors.Errorf("line %d: invalid IP range %s - %s", line, cols[0], cols[1])
+		}
+		asn, err := strconv.ParseUint(cols[2], 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d: invalid AS number", line)
+		}
+		if asn == 0 {
+			continue
+		}
+		loc, ok := locations[asn]
+		if !ok {
+			loc = &Location{ASN: asn, Country: cols[3]}
+			if len(cols) == 5 {
+				loc.Organization = cols[4]
+			}
+			locations[asn] = loc
+		}
+		db.ranges = append(db.ranges, ipRange{start: start.To16(), end: end.To16(), location: loc})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "could not read geoip database")
+	}
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return bytes.Compare(db.ranges[i].start, db.ranges[j].start) < 0
+	})
+	return db, nil
+}
+
+// Lookup returns the location of the IP address, or nil if it is not in any range.
+// It is safe to call on a nil database.
+func (db *Database) Lookup(ip net.IP) *Location {
+	if db == nil || ip == nil {
+		return nil
+	}
+	ip = ip.To16()
+	// The last range starting at or before the address is the only one which may contain it.
+	i := sort.Search(len(db.ranges), func(i int) bool {
+		return bytes.Compare(db.ranges[i].start, ip) > 0
+	}) - 1
+	if i < 0 || bytes.Compare(ip, db.ranges[i].end) > 0 {
+		return nil
+	}
+	return db.ranges[i].location
+}
+
+// Len returns the number of routed ranges of the database.
+func (db *Database) Len() int {
+	if db == nil {
+		return 0
+	}
+	return len(db.ranges)
+}
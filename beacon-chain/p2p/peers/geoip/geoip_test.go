@@ -0,0 +1,83 @@
+package geoip
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+const testDatabase = `1.0.0.0	1.0.0.255	13335	US	CLOUDFLARENET
+1.0.1.0	1.0.3.255	0	None	Not routed
+8.8.8.0	8.8.8.255	15169	US	GOOGLE
+2a01:4f8::	2a01:4f8:ffff:ffff:ffff:ffff:ffff:ffff	24940	DE	HETZNER-AS
+`
+
+func TestParse_Lookup(t *testing.T) {
+	db, err := Parse(strings.NewReader(testDatabase))
+	require.NoError(t, err)
+	assert.Equal(t, 3, db.Len())
+
+	tests := []struct {
+		ip      string
+		asn     uint64
+		country string
+	}{
+		{ip: "1.0.0.0", asn: 13335, country: "US"},
+		{ip: "1.0.0.255", asn: 13335, country: "US"},
+		{ip: "8.8.8.8", asn: 15169, country: "US"},
+		{ip: "2a01:4f8:10a::1", asn: 24940, country: "DE"},
+		// Unrouted range.
+		{ip: "1.0.2.1"},
+		// Between ranges.
+		{ip: "4.4.4.4"},
+		// After the last range.
+		{ip: "ffff::1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			loc := db.Lookup(net.ParseIP(tt.ip))
+			if tt.asn == 0 {
+				assert.Equal(t, (*Location)(nil), loc)
+				return
+			}
+			require.NotNil(t, loc)
+			assert.Equal(t, tt.asn, loc.ASN)
+			assert.Equal(t, tt.country, loc.Country)
+		})
+	}
+}
+
+func TestParse_InvalidLine(t *testing.T) {
+	_, err := Parse(strings.NewReader("1.0.0.0\tnot-an-ip\t13335\tUS\tCLOUDFLARENET\n"))
+	assert.ErrorContains(t, "line 1: invalid IP range", err)
+	_, err = Parse(strings.NewReader("1.0.0.0\t1.0.0.255\n"))
+	assert.ErrorContains(t, "line 1: expected at least 4 columns", err)
+}
+
+func TestLoad_Gzipped(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(testDatabase))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	path := filepath.Join(t.TempDir(), "ip2asn-combined.tsv.gz")
+	require.NoError(t, ioutil.WriteFile(path, buf.Bytes(), 0600))
+
+	db, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, 3, db.Len())
+	assert.Equal(t, "GOOGLE", db.Lookup(net.ParseIP("8.8.8.8")).Organization)
+}
+
+func TestLookup_NilDatabase(t *testing.T) {
+	var db *Database
+	assert.Equal(t, (*Location)(nil), db.Lookup(net.ParseIP("8.8.8.8")))
+	assert.Equal(t, 0, db.Len())
+}
@@ -0,0 +1,5 @@
+package geoip
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "geoip")
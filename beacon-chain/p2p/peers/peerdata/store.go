@@ -48,6 +48,7 @@ type PeerData struct {
 	ConnState     PeerConnectionState
 	Enr           *enr.Record
 	NextValidTime time.Time
+	DialFailures  int
 	// Chain related data.
 	MetaData                  *pb.MetaData
 	ChainState                *pb.Status
@@ -58,9 +59,11 @@ type PeerData struct {
 	ProcessedBlocks      uint64
 	BlockProviderUpdated time.Time
 	// Gossip Scoring data.
-	TopicScores      map[string]*pbrpc.TopicScoreSnapshot
-	GossipScore      float64
-	BehaviourPenalty float64
+	TopicScores        map[string]*pbrpc.TopicScoreSnapshot
+	GossipScore        float64
+	BehaviourPenalty   float64
+	AppSpecificScore   float64
+	IPColocationFactor float64
 }
 
 // NewStore creates new peer data store.
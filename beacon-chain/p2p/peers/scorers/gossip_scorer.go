@@ -16,7 +16,10 @@ type GossipScorer struct {
 }
 
 // GossipScorerConfig holds configuration parameters for gossip scoring service.
-type GossipScorerConfig struct{}
+type GossipScorerConfig struct {
+	// Threshold specifies the gossip score below which a peer is deemed bad.
+	Threshold float64
+}
 
 // newGossipScorer creates new gossip scoring service.
 func newGossipScorer(store *peerdata.Store, config *GossipScorerConfig) *GossipScorer {
@@ -45,6 +48,11 @@ func (s *GossipScorer) score(pid peer.ID) float64 {
 	return peerData.GossipScore
 }
 
+// Params exposes scorer's parameters.
+func (s *GossipScorer) Params() *GossipScorerConfig {
+	return s.config
+}
+
 // IsBadPeer states if the peer is to be considered bad.
 func (s *GossipScorer) IsBadPeer(pid peer.ID) bool {
 	s.store.RLock()
@@ -58,7 +66,7 @@ func (s *GossipScorer) isBadPeer(pid peer.ID) bool {
 	if !ok {
 		return false
 	}
-	return peerData.GossipScore < 0
+	return peerData.GossipScore < s.config.Threshold
 }
 
 // BadPeers returns the peers that are considered bad.
@@ -87,6 +95,28 @@ func (s *GossipScorer) SetGossipData(pid peer.ID, gScore float64,
 	peerData.TopicScores = topicScores
 }
 
+// SetPeerScoreComponents sets the peer level gossip score components, which are not
+// tied to any particular topic.
+func (s *GossipScorer) SetPeerScoreComponents(pid peer.ID, appSpecificScore, ipColocationFactor float64) {
+	s.store.Lock()
+	defer s.store.Unlock()
+
+	peerData := s.store.PeerDataGetOrCreate(pid)
+	peerData.AppSpecificScore = appSpecificScore
+	peerData.IPColocationFactor = ipColocationFactor
+}
+
+// PeerScoreComponents returns the application specific score and the IP colocation factor
+// of the given remote peer. This will error if the peer does not exist.
+func (s *GossipScorer) PeerScoreComponents(pid peer.ID) (float64, float64, error) {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	if peerData, ok := s.store.PeerData(pid); ok {
+		return peerData.AppSpecificScore, peerData.IPColocationFactor, nil
+	}
+	return 0, 0, peerdata.ErrPeerUnknown
+}
+
 // GossipData gets the gossip related information of the given remote peer.
 // This can return nil if there is no known gossip record the peer.
 // This will error if the peer does not exist.
@@ -50,6 +50,20 @@ func TestScorers_Gossip_Score(t *testing.T) {
 				assert.Equal(t, uint64(100), topicMap["a"].TimeInMesh, "incorrect time in mesh")
 			},
 		},
+		{
+			name: "peer score components",
+			update: func(scorer *scorers.GossipScorer) {
+				scorer.SetPeerScoreComponents("peer1", 2.5, 4)
+			},
+			check: func(scorer *scorers.GossipScorer) {
+				appScore, ipColocation, err := scorer.PeerScoreComponents("peer1")
+				assert.NoError(t, err)
+				assert.Equal(t, 2.5, appScore, "Unexpected app specific score")
+				assert.Equal(t, 4.0, ipColocation, "Unexpected IP colocation factor")
+				_, _, err = scorer.PeerScoreComponents("peer2")
+				assert.ErrorContains(t, "peer unknown", err)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -36,6 +36,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/geoip"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -71,6 +72,8 @@ type Status struct {
 	scorers   *scorers.Service
 	store     *peerdata.Store
 	ipTracker map[string]uint64
+	trusted   map[peer.ID]bool
+	geoIP     *geoip.Database
 }
 
 // StatusConfig represents peer status service params.
@@ -79,6 +82,10 @@ type StatusConfig struct {
 	PeerLimit int
 	// ScorerParams holds peer scorer configuration params.
 	ScorerParams *scorers.Config
+	// TrustedPeers are never pruned to satisfy the peer limit.
+	TrustedPeers []peer.ID
+	// GeoIP locates the peer addresses, when set.
+	GeoIP *geoip.Database
 }
 
 // NewStatus creates a new status entity.
@@ -86,11 +93,17 @@ func NewStatus(ctx context.Context, config *StatusConfig) *Status {
 	store := peerdata.NewStore(ctx, &peerdata.StoreConfig{
 		MaxPeers: maxLimitBuffer + config.PeerLimit,
 	})
+	trusted := make(map[peer.ID]bool, len(config.TrustedPeers))
+	for _, pid := range config.TrustedPeers {
+		trusted[pid] = true
+	}
 	return &Status{
 		ctx:       ctx,
 		store:     store,
 		scorers:   scorers.NewService(ctx, store, config.ScorerParams),
 		ipTracker: map[string]uint64{},
+		trusted:   trusted,
+		geoIP:     config.GeoIP,
 	}
 }
 
@@ -148,6 +161,23 @@ func (p *Status) Address(pid peer.ID) (ma.Multiaddr, error) {
 	return nil, peerdata.ErrPeerUnknown
 }
 
+// Location returns the location of the IP address of the given remote peer. It returns nil
+// if no geoip database is configured, the peer is unknown, or its address is not in the database.
+func (p *Status) Location(pid peer.ID) *geoip.Location {
+	if p.geoIP == nil {
+		return nil
+	}
+	addr, err := p.Address(pid)
+	if err != nil || addr == nil {
+		return nil
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return nil
+	}
+	return p.geoIP.Lookup(ip)
+}
+
 // Direction returns the direction of the given remote peer.
 // This will error if the peer does not exist.
 func (p *Status) Direction(pid peer.ID) (network.Direction, error) {
@@ -313,6 +343,12 @@ func (p *Status) IsBad(pid peer.ID) bool {
 	return p.isfromBadIP(pid) || p.scorers.IsBadPeer(pid)
 }
 
+// IsTrusted returns true if the peer is a trusted peer, which is never
+// pruned to make room for other peers.
+func (p *Status) IsTrusted(pid peer.ID) bool {
+	return p.trusted[pid]
+}
+
 // NextValidTime gets the earliest possible time it is to contact/dial
 // a peer again. This is used to back-off from peers in the event
 // they are 'full' or have banned us.
@@ -336,6 +372,40 @@ func (p *Status) SetNextValidTime(pid peer.ID, nextTime time.Time) {
 	peerData.NextValidTime = nextTime
 }
 
+// IncrementDialFailures increments the number of consecutive failed dials to the peer and
+// returns the new count.
+func (p *Status) IncrementDialFailures(pid peer.ID) int {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	peerData.DialFailures++
+	return peerData.DialFailures
+}
+
+// ResetDialFailures clears the failed dials of the peer after a successful one, along with
+// the dial back-off they set.
+func (p *Status) ResetDialFailures(pid peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok && peerData.DialFailures > 0 {
+		peerData.DialFailures = 0
+		peerData.NextValidTime = time.Time{}
+	}
+}
+
+// DialFailures returns the number of consecutive failed dials to the peer.
+func (p *Status) DialFailures(pid peer.ID) (int, error) {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		return peerData.DialFailures, nil
+	}
+	return 0, peerdata.ErrPeerUnknown
+}
+
 // IsReadyToDial checks where the given peer is ready to be
 // dialed again.
 func (p *Status) IsReadyToDial(pid peer.ID) bool {
@@ -518,7 +588,7 @@ func (p *Status) Prune() {
 	peersToPrune := make([]*peerResp, 0)
 	// Select disconnected peers with a smaller bad response count.
 	for pid, peerData := range p.store.Peers() {
-		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) {
+		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) && !p.trusted[pid] {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
@@ -654,6 +724,7 @@ func (p *Status) BestNonFinalized(minPeers int, ourHeadEpoch types.Epoch) (types
 // the pruning relies on simple heuristics such as
 // bad response count. In the future scoring will be used
 // to determine the most suitable peers to take out.
+// Trusted peers are never selected for pruning.
 func (p *Status) PeersToPrune() []peer.ID {
 	connLimit := p.ConnectedPeerLimit()
 	inBoundLimit := p.InboundLimit()
@@ -675,7 +746,7 @@ func (p *Status) PeersToPrune() []peer.ID {
 	// Select connected and inbound peers to prune.
 	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound && !p.trusted[pid] {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
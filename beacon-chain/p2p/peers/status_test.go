@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/geoip"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -78,6 +80,39 @@ func TestPeerExplicitAdd(t *testing.T) {
 	assert.Equal(t, direction2, resDirection2, "Unexpected direction")
 }
 
+func TestPeerLocation(t *testing.T) {
+	db, err := geoip.Parse(strings.NewReader("213.202.254.0\t213.202.254.255\t12345\tDE\tEXAMPLE-AS\n"))
+	require.NoError(t, err)
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+		GeoIP:        db,
+	})
+
+	located, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	p.Add(new(enr.Record), located, address, network.DirInbound)
+	unlocated, err := peer.Decode("16Uiu2HAm4HgJ9N1o222xK61o7LSgToYWoAy1wNTJRkh9gLZapVAy")
+	require.NoError(t, err)
+	address, err = ma.NewMultiaddr("/ip4/52.23.23.253/tcp/30000")
+	require.NoError(t, err)
+	p.Add(new(enr.Record), unlocated, address, network.DirInbound)
+
+	loc := p.Location(located)
+	require.NotNil(t, loc)
+	assert.Equal(t, uint64(12345), loc.ASN)
+	assert.Equal(t, "DE", loc.Country)
+	assert.Equal(t, (*geoip.Location)(nil), p.Location(unlocated))
+	assert.Equal(t, (*geoip.Location)(nil), p.Location("unknown"))
+
+	// Without a database, no peer is located.
+	p = peers.NewStatus(context.Background(), &peers.StatusConfig{ScorerParams: &scorers.Config{}})
+	p.Add(new(enr.Record), located, address, network.DirInbound)
+	assert.Equal(t, (*geoip.Location)(nil), p.Location(located))
+}
+
 func TestPeerNoENR(t *testing.T) {
 	maxBadResponses := 2
 	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
@@ -468,6 +503,31 @@ func TestPeerValidTime(t *testing.T) {
 	assert.Equal(t, numPeersConnected, len(p.Connected()), "Unexpected number of connected peers")
 }
 
+func TestPeerDialFailures(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+
+	_, err := p.DialFailures("unknown")
+	assert.ErrorContains(t, peerdata.ErrPeerUnknown.Error(), err)
+
+	pid := addPeer(t, p, peers.PeerDisconnected)
+	assert.Equal(t, 1, p.IncrementDialFailures(pid))
+	assert.Equal(t, 2, p.IncrementDialFailures(pid))
+	p.SetNextValidTime(pid, time.Now().Add(time.Minute))
+	failures, err := p.DialFailures(pid)
+	require.NoError(t, err)
+	assert.Equal(t, 2, failures)
+	assert.Equal(t, false, p.IsReadyToDial(pid))
+
+	p.ResetDialFailures(pid)
+	failures, err = p.DialFailures(pid)
+	require.NoError(t, err)
+	assert.Equal(t, 0, failures)
+	assert.Equal(t, true, p.IsReadyToDial(pid))
+}
+
 func TestPrune(t *testing.T) {
 	maxBadResponses := 2
 	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
@@ -719,6 +779,49 @@ func TestPrunePeers(t *testing.T) {
 	}
 }
 
+func TestPrunePeers_TrustedPeers(t *testing.T) {
+	idBytes := []byte{0x11, 0x04, 't', 'r', 'u', 's'}
+	trustedPID, err := peer.IDFromBytes(idBytes)
+	require.NoError(t, err)
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		TrustedPeers: []peer.ID{trustedPID},
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 10,
+			},
+		},
+	})
+	// The trusted peer connects inbound and has the worst bad response count, making it
+	// the first candidate for eviction if it were not trusted.
+	p.Add(new(enr.Record), trustedPID, nil, network.DirInbound)
+	p.SetConnectionState(trustedPID, peers.PeerConnected)
+	for i := 0; i < 5; i++ {
+		p.Scorers().BadResponsesScorer().Increment(trustedPID)
+	}
+	assert.Equal(t, true, p.IsTrusted(trustedPID))
+
+	// Fill the peer store well above the connection limit.
+	for i := 0; i < 60; i++ {
+		createPeer(t, p, nil, network.DirInbound, peers.PeerConnected)
+	}
+	peersToPrune := p.PeersToPrune()
+	excessInbound := len(p.InboundConnected()) - p.InboundLimit()
+	assert.Equal(t, excessInbound, len(peersToPrune))
+	for _, pid := range peersToPrune {
+		assert.NotEqual(t, trustedPID, pid, "Trusted peer selected for pruning")
+	}
+
+	// Disconnected trusted peers are kept in the peer store.
+	p.SetConnectionState(trustedPID, peers.PeerDisconnected)
+	for i := 0; i < p.MaxPeerLimit(); i++ {
+		createPeer(t, p, nil, network.DirInbound, peers.PeerDisconnected)
+	}
+	p.Prune()
+	_, err = p.ConnectionState(trustedPID)
+	require.NoError(t, err)
+}
+
 func TestStatus_BestPeer(t *testing.T) {
 	type peerConfig struct {
 		headSlot       types.Slot
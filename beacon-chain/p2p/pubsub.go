@@ -7,6 +7,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsub_pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
@@ -56,6 +57,7 @@ func (s *Service) PublishToTopic(ctx context.Context, topic string, data []byte,
 	// Wait for at least 1 peer to be available to receive the published message.
 	for {
 		if len(topicHandle.ListPeers()) > 0 || flags.Get().MinimumSyncPeers == 0 {
+			s.gossipHost.markPublished(data)
 			return topicHandle.Publish(ctx, data, opts...)
 		}
 		select {
@@ -94,6 +96,7 @@ func (s *Service) peerInspector(peerMap map[peer.ID]*pubsub.PeerScoreSnapshot) {
 	for pid, snap := range peerMap {
 		s.peers.Scorers().GossipScorer().SetGossipData(pid, snap.Score,
 			snap.BehaviourPenalty, convertTopicScores(snap.Topics))
+		s.peers.Scorers().GossipScorer().SetPeerScoreComponents(pid, snap.AppSpecificScore, snap.IPColocationFactor)
 	}
 }
 
@@ -139,6 +142,73 @@ func setPubSubParameters() {
 	}
 }
 
+// Overrides the default sizes of the gossip caches with the configured ones. The seen message
+// cache is only bounded by its TTL, so its memory usage grows linearly with it.
+func setGossipCacheParameters(cfg *Config) error {
+	if cfg.SeenCacheTTL > 0 {
+		pubsub.TimeCacheDuration = cfg.SeenCacheTTL
+	}
+	if cfg.GossipHistoryLength > 0 {
+		if cfg.GossipHistoryLength < pubsub.GossipSubHistoryGossip {
+			return errors.Errorf("gossip history length %d is lower than the %d gossiped heartbeats",
+				cfg.GossipHistoryLength, pubsub.GossipSubHistoryGossip)
+		}
+		pubsub.GossipSubHistoryLength = cfg.GossipHistoryLength
+	}
+	return nil
+}
+
+// maxGossipMeshDegree bounds the configurable mesh degrees, as every mesh peer is sent the full
+// messages of the topic and the bandwidth grows linearly with the mesh size.
+const maxGossipMeshDegree = 32
+
+// Overrides the default gossip mesh degrees with the configured ones. The degrees apply to the mesh
+// of every topic, gossipsub doesn't support setting them per topic.
+func setGossipMeshParameters(cfg *Config) error {
+	d, dlo, dhi := pubsub.GossipSubD, pubsub.GossipSubDlo, pubsub.GossipSubDhi
+	if cfg.GossipMeshDegree > 0 {
+		d = cfg.GossipMeshDegree
+	}
+	if cfg.GossipMeshDegreeLow > 0 {
+		dlo = cfg.GossipMeshDegreeLow
+	}
+	if cfg.GossipMeshDegreeHigh > 0 {
+		dhi = cfg.GossipMeshDegreeHigh
+	}
+	if dlo <= pubsub.GossipSubDout {
+		return errors.Errorf("low gossip mesh degree %d must be greater than the %d outbound mesh peers",
+			dlo, pubsub.GossipSubDout)
+	}
+	if d < dlo || d > dhi {
+		return errors.Errorf("gossip mesh degree %d must be between the low and high mesh degrees %d and %d", d, dlo, dhi)
+	}
+	if pubsub.GossipSubDout > d/2 {
+		return errors.Errorf("gossip mesh degree %d must be at least twice the %d outbound mesh peers",
+			d, pubsub.GossipSubDout)
+	}
+	if dhi > maxGossipMeshDegree {
+		return errors.Errorf("high gossip mesh degree %d must not be greater than %d", dhi, maxGossipMeshDegree)
+	}
+	pubsub.GossipSubD = d
+	pubsub.GossipSubDlo = dlo
+	pubsub.GossipSubDhi = dhi
+	return nil
+}
+
+// Stops relaying the messages of other peers. The node keeps no mesh peers to forward messages to and
+// doesn't advertise the messages it has seen, so it only receives the messages advertised by its peers,
+// which it requests and validates as usual. Only the messages published by the node itself are sent,
+// flood published to all its peers.
+func setGossipRelayParameters() {
+	pubsub.GossipSubD = 0
+	pubsub.GossipSubDlo = 0
+	pubsub.GossipSubDhi = 0
+	pubsub.GossipSubDscore = 0
+	pubsub.GossipSubDout = 0
+	pubsub.GossipSubDlazy = 0
+	pubsub.GossipSubGossipFactor = 0
+}
+
 // convert from libp2p's internal schema to a compatible prysm protobuf format.
 func convertTopicScores(topicMap map[string]*pubsub.TopicScoreSnapshot) map[string]*pbrpc.TopicScoreSnapshot {
 	newMap := make(map[string]*pbrpc.TopicScoreSnapshot, len(topicMap))
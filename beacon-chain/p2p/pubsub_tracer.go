@@ -0,0 +1,159 @@
+package p2p
+
+import (
+	"sync"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+)
+
+// seenCacheTracer is a raw pubsub tracer which counts the hits and misses of the seen message
+// cache. A received message is a hit when its id is still in the cache, and is dropped as a
+// duplicate. Otherwise it is a miss, and the message is handed to validation.
+type seenCacheTracer struct{}
+
+var _ = pubsub.RawTracer(seenCacheTracer{})
+
+// AddPeer is a no-op.
+func (seenCacheTracer) AddPeer(peer.ID, protocol.ID) {}
+
+// RemovePeer is a no-op.
+func (seenCacheTracer) RemovePeer(peer.ID) {}
+
+// Join is a no-op.
+func (seenCacheTracer) Join(string) {}
+
+// Leave is a no-op.
+func (seenCacheTracer) Leave(string) {}
+
+// Graft is a no-op.
+func (seenCacheTracer) Graft(peer.ID, string) {}
+
+// Prune is a no-op.
+func (seenCacheTracer) Prune(peer.ID, string) {}
+
+// ValidateMessage counts a miss of the seen message cache.
+func (seenCacheTracer) ValidateMessage(*pubsub.Message) {
+	gossipSeenCacheMisses.Inc()
+}
+
+// DeliverMessage is a no-op.
+func (seenCacheTracer) DeliverMessage(*pubsub.Message) {}
+
+// RejectMessage is a no-op.
+func (seenCacheTracer) RejectMessage(*pubsub.Message, string) {}
+
+// DuplicateMessage counts a hit of the seen message cache.
+func (seenCacheTracer) DuplicateMessage(*pubsub.Message) {
+	gossipSeenCacheHits.Inc()
+}
+
+// ThrottlePeer is a no-op.
+func (seenCacheTracer) ThrottlePeer(peer.ID) {}
+
+// RecvRPC is a no-op.
+func (seenCacheTracer) RecvRPC(*pubsub.RPC) {}
+
+// SendRPC is a no-op.
+func (seenCacheTracer) SendRPC(*pubsub.RPC, peer.ID) {}
+
+// DropRPC is a no-op.
+func (seenCacheTracer) DropRPC(*pubsub.RPC, peer.ID) {}
+
+// meshTracer is a raw pubsub tracer which tracks the peers in the gossip mesh of each topic, as
+// grafted and pruned by gossipsub, to report the mesh sizes.
+type meshTracer struct {
+	lock   sync.Mutex
+	meshes map[string]map[peer.ID]bool
+}
+
+var _ = pubsub.RawTracer(&meshTracer{})
+
+func newMeshTracer() *meshTracer {
+	return &meshTracer{
+		meshes: make(map[string]map[peer.ID]bool),
+	}
+}
+
+// AddPeer is a no-op.
+func (*meshTracer) AddPeer(peer.ID, protocol.ID) {}
+
+// RemovePeer removes a disconnected peer from the meshes it was in.
+func (t *meshTracer) RemovePeer(p peer.ID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for topic, mesh := range t.meshes {
+		if mesh[p] {
+			delete(mesh, p)
+			gossipMeshPeers.WithLabelValues(topic).Set(float64(len(mesh)))
+		}
+	}
+}
+
+// Join is a no-op, the mesh of the topic is reported once the first peer is grafted.
+func (*meshTracer) Join(string) {}
+
+// Leave drops the mesh of a topic which is no longer subscribed to.
+func (t *meshTracer) Leave(topic string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.meshes, topic)
+	gossipMeshPeers.DeleteLabelValues(topic)
+}
+
+// Graft adds a peer to the mesh of a topic.
+func (t *meshTracer) Graft(p peer.ID, topic string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	mesh, ok := t.meshes[topic]
+	if !ok {
+		mesh = make(map[peer.ID]bool)
+		t.meshes[topic] = mesh
+	}
+	mesh[p] = true
+	gossipMeshPeers.WithLabelValues(topic).Set(float64(len(mesh)))
+}
+
+// Prune removes a peer from the mesh of a topic.
+func (t *meshTracer) Prune(p peer.ID, topic string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	mesh, ok := t.meshes[topic]
+	if !ok {
+		return
+	}
+	delete(mesh, p)
+	gossipMeshPeers.WithLabelValues(topic).Set(float64(len(mesh)))
+}
+
+// meshSize returns the number of peers in the mesh of a topic.
+func (t *meshTracer) meshSize(topic string) int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return len(t.meshes[topic])
+}
+
+// ValidateMessage is a no-op.
+func (*meshTracer) ValidateMessage(*pubsub.Message) {}
+
+// DeliverMessage is a no-op.
+func (*meshTracer) DeliverMessage(*pubsub.Message) {}
+
+// RejectMessage is a no-op.
+func (*meshTracer) RejectMessage(*pubsub.Message, string) {}
+
+// DuplicateMessage is a no-op.
+func (*meshTracer) DuplicateMessage(*pubsub.Message) {}
+
+// ThrottlePeer is a no-op.
+func (*meshTracer) ThrottlePeer(peer.ID) {}
+
+// RecvRPC is a no-op.
+func (*meshTracer) RecvRPC(*pubsub.RPC) {}
+
+// SendRPC is a no-op.
+func (*meshTracer) SendRPC(*pubsub.RPC, peer.ID) {}
+
+// DropRPC is a no-op.
+func (*meshTracer) DropRPC(*pubsub.RPC, peer.ID) {}
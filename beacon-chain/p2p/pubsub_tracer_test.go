@@ -0,0 +1,33 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestMeshTracer(t *testing.T) {
+	tracer := newMeshTracer()
+	blockTopic := "/eth2/00000000/beacon_block/ssz_snappy"
+	aggTopic := "/eth2/00000000/beacon_aggregate_and_proof/ssz_snappy"
+
+	tracer.Graft("peer-a", blockTopic)
+	tracer.Graft("peer-b", blockTopic)
+	tracer.Graft("peer-b", blockTopic)
+	tracer.Graft("peer-a", aggTopic)
+	assert.Equal(t, 2, tracer.meshSize(blockTopic))
+	assert.Equal(t, 1, tracer.meshSize(aggTopic))
+
+	tracer.Prune("peer-b", blockTopic)
+	tracer.Prune("peer-c", "unknown")
+	assert.Equal(t, 1, tracer.meshSize(blockTopic))
+
+	tracer.RemovePeer(peer.ID("peer-a"))
+	assert.Equal(t, 0, tracer.meshSize(blockTopic))
+	assert.Equal(t, 0, tracer.meshSize(aggTopic))
+
+	tracer.Graft("peer-a", aggTopic)
+	tracer.Leave(aggTopic)
+	assert.Equal(t, 0, tracer.meshSize(aggTopic))
+}
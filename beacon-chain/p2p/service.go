@@ -6,6 +6,7 @@ package p2p
 import (
 	"context"
 	"crypto/ecdsa"
+	"fmt"
 	"sync"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/geoip"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared"
@@ -51,7 +53,7 @@ var refreshRate = slotutil.DivideSlotBy(2)
 // maxBadResponses is the maximum number of bad responses from a peer before we stop talking to it.
 const maxBadResponses = 5
 
-// maxDialTimeout is the timeout for a single peer dial.
+// maxDialTimeout is the default timeout for a single peer dial.
 var maxDialTimeout = params.BeaconNetworkConfig().RespTimeout
 
 // Service for managing peer to peer (p2p) networking.
@@ -80,6 +82,9 @@ type Service struct {
 	host                  host.Host
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
+	gossipRate            *gossipRateMeter
+	gossipHost            *gossipHost
+	neededSubnets         *neededSubnets
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -97,6 +102,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		isPreGenesis:  true,
 		joinedTopics:  make(map[string]*pubsub.Topic, len(GossipTopicMappings)),
 		subnetsLock:   make(map[uint64]*sync.RWMutex),
+		neededSubnets: newNeededSubnets(),
 	}
 
 	dv5Nodes := parseBootStrapAddrs(s.cfg.BootstrapNodeAddr)
@@ -142,18 +148,38 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		pubsub.WithSubscriptionFilter(s),
 		pubsub.WithPeerOutboundQueueSize(256),
 		pubsub.WithValidateQueueSize(256),
+		pubsub.WithRawTracer(seenCacheTracer{}),
+		pubsub.WithRawTracer(newMeshTracer()),
 	}
 	// Add gossip scoring options.
+	if err := validateScoreThresholds(s.cfg); err != nil {
+		return nil, err
+	}
 	if featureconfig.Get().EnablePeerScorer {
 		psOpts = append(
 			psOpts,
-			pubsub.WithPeerScore(peerScoringParams()),
+			pubsub.WithPeerScore(peerScoringParams(s.cfg)),
 			pubsub.WithPeerScoreInspect(s.peerInspector, time.Minute))
 	}
 	// Set the pubsub global parameters that we require.
 	setPubSubParameters()
+	if err := setGossipCacheParameters(s.cfg); err != nil {
+		return nil, err
+	}
+	if err := setGossipMeshParameters(s.cfg); err != nil {
+		return nil, err
+	}
+	if s.cfg.DisableGossipRelay {
+		log.Warn("Gossip relay is disabled, this node does not forward the messages of other peers. " +
+			"Only run it behind well connected nodes")
+		setGossipRelayParameters()
+		psOpts = append(psOpts, pubsub.WithFloodPublish(true))
+		gossipRelayDisabled.Set(1)
+	}
 
-	gs, err := pubsub.NewGossipSub(s.ctx, s.host, psOpts...)
+	s.gossipRate = &gossipRateMeter{}
+	s.gossipHost = newGossipHost(s.ctx, s.host, s.cfg.GossipOutboundRateLimit, s.gossipRate)
+	gs, err := pubsub.NewGossipSub(s.ctx, s.gossipHost, psOpts...)
 	if err != nil {
 		log.WithError(err).Error("Failed to start pubsub")
 		return nil, err
@@ -161,7 +187,9 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	s.pubsub = gs
 
 	s.peers = peers.NewStatus(ctx, &peers.StatusConfig{
-		PeerLimit: int(s.cfg.MaxPeers),
+		PeerLimit:    int(s.cfg.MaxPeers),
+		TrustedPeers: trustedPeerIDs(s.cfg.StaticPeers),
+		GeoIP:        loadGeoIPDatabase(s.cfg.GeoIPDatabase),
 		ScorerParams: &scorers.Config{
 			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
 				Threshold:     maxBadResponses,
@@ -212,6 +240,9 @@ func (s *Service) Start() {
 		}
 		s.dv5Listener = listener
 		go s.listenForNewNodes()
+		if s.cfg.BootnodeRefreshInterval > 0 {
+			runutil.RunEvery(s.ctx, s.cfg.BootnodeRefreshInterval, s.refreshBootnodes)
+		}
 	}
 
 	s.started = true
@@ -232,6 +263,7 @@ func (s *Service) Start() {
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	runutil.RunEvery(s.ctx, refreshRate, func() {
 		s.RefreshENR()
+		s.trackUpcomingSubnets()
 	})
 	runutil.RunEvery(s.ctx, 1*time.Minute, func() {
 		log.WithFields(logrus.Fields{
@@ -442,12 +474,19 @@ func (s *Service) connectWithPeer(ctx context.Context, info peer.AddrInfo) error
 	if s.Peers().IsBad(info.ID) {
 		return errors.New("refused to connect to bad peer")
 	}
-	ctx, cancel := context.WithTimeout(ctx, maxDialTimeout)
+	if !s.Peers().IsReadyToDial(info.ID) {
+		return errors.New("refused to connect to peer before the end of its back-off")
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.dialTimeout())
 	defer cancel()
 	if err := s.host.Connect(ctx, info); err != nil {
 		s.Peers().Scorers().BadResponsesScorer().Increment(info.ID)
+		s.backOffDial(info.ID)
+		peerDials.WithLabelValues("failure").Inc()
 		return err
 	}
+	s.Peers().ResetDialFailures(info.ID)
+	peerDials.WithLabelValues("success").Inc()
 	return nil
 }
 
@@ -472,8 +511,49 @@ func (s *Service) connectToBootnodes() error {
 	return nil
 }
 
+// refreshBootnodes queries the bootnodes again after the initial bootstrap. A successful handshake
+// adds a bootnode back to the discovery table, and the lookup which follows refills the table from
+// it, so the node recovers peer discovery if its table emptied while the bootnodes were offline.
+func (s *Service) refreshBootnodes() {
+	var reachable int
+	for _, addr := range s.cfg.Discv5BootStrapAddr {
+		bootNode, err := enode.Parse(enode.ValidSchemes, addr)
+		if err != nil {
+			log.WithError(err).Error("Could not parse bootnode address")
+			continue
+		}
+		label := fmt.Sprintf("%s:%d", bootNode.IP(), bootNode.UDP())
+		if err := s.dv5Listener.Ping(bootNode); err != nil {
+			log.WithError(err).WithField("bootnode", label).Debug("Bootnode is unreachable")
+			bootnodeReachable.WithLabelValues(label).Set(0)
+			continue
+		}
+		bootnodeReachable.WithLabelValues(label).Set(1)
+		reachable++
+	}
+	if reachable == 0 {
+		return
+	}
+	s.dv5Listener.Lookup(s.dv5Listener.Self().ID())
+}
+
 // Returns true if the service is aware of the genesis time and genesis validator root. This is
 // required for discovery and pubsub validation.
 func (s *Service) isInitialized() bool {
 	return !s.genesisTime.IsZero() && len(s.genesisValidatorsRoot) == 32
 }
+
+// loadGeoIPDatabase loads the geoip database at the given path, if any. Peers are simply
+// not located when it can not be loaded, as this only serves monitoring.
+func loadGeoIPDatabase(path string) *geoip.Database {
+	if path == "" {
+		return nil
+	}
+	db, err := geoip.Load(path)
+	if err != nil {
+		log.WithError(err).Warn("Could not load geoip database, peers will not be located")
+		return nil
+	}
+	log.WithField("ranges", db.Len()).Info("Loaded geoip database")
+	return db
+}
@@ -339,6 +339,18 @@ func TestService_connectWithPeer(t *testing.T) {
 			info:    peer.AddrInfo{ID: "bad"},
 			wantErr: "refused to connect to bad peer",
 		},
+		{
+			name: "peer backing off",
+			peers: func() *peers.Status {
+				ps := peers.NewStatus(context.Background(), &peers.StatusConfig{
+					ScorerParams: &scorers.Config{},
+				})
+				ps.SetNextValidTime("backing-off", time.Now().Add(time.Minute))
+				return ps
+			}(),
+			info:    peer.AddrInfo{ID: "backing-off"},
+			wantErr: "refused to connect to peer before the end of its back-off",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
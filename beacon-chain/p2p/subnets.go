@@ -21,6 +21,8 @@ var attSubnetEnrKey = params.BeaconNetworkConfig().AttSubnetKey
 // subscribed to a particular subnet. Then we try to connect
 // with those peers. This method will block until the required amount of
 // peers are found, the method only exits in the event of context timeouts.
+// Until the subnet has a peer, the discovery of new peers also dials the
+// nodes advertising it first.
 func (s *Service) FindPeersWithSubnet(ctx context.Context, topic string,
 	index, threshold uint64) (bool, error) {
 	ctx, span := trace.StartSpan(ctx, "p2p.FindPeersWithSubnet")
@@ -38,11 +40,18 @@ func (s *Service) FindPeersWithSubnet(ctx context.Context, topic string,
 	iterator = filterNodes(ctx, iterator, s.filterPeerForSubnet(index))
 
 	currNum := uint64(len(s.pubsub.ListPeers(topic)))
+	if currNum == 0 {
+		// Let discovery dial the nodes of the subnet first until it has a peer.
+		s.neededSubnets.add(index)
+	}
 	wg := new(sync.WaitGroup)
 	for {
 		if err := ctx.Err(); err != nil {
 			return false, err
 		}
+		if currNum > 0 {
+			s.neededSubnets.found(index)
+		}
 		if currNum >= threshold {
 			break
 		}
@@ -30,6 +30,7 @@ var (
 )
 
 const eth1LookBackPeriod = 100
+const maxMissingLogsLookBack = 16 * eth1LookBackPeriod
 const eth1DataSavingInterval = 100
 const maxTolerableDifference = 50
 const defaultEth1HeaderReqLimit = uint64(1000)
@@ -375,10 +376,24 @@ func (s *Service) processPastLogs(ctx context.Context) error {
 	}
 	if fState != nil && fState.Eth1DepositIndex() > 0 {
 		s.depositCache.PrunePendingDeposits(ctx, int64(fState.Eth1DepositIndex()))
+		s.pruneDeposits(ctx, fState.Eth1DepositIndex())
 	}
 	return nil
 }
 
+// pruneDeposits removes the deposits processed into the finalized state from the deposit cache,
+// once their eth1 block is older than the retention period beyond the eth1 follow distance.
+func (s *Service) pruneDeposits(ctx context.Context, eth1DepositIndex uint64) {
+	followHeight, err := s.followBlockHeight(ctx)
+	if err != nil || followHeight <= s.depositCacheRetention {
+		return
+	}
+	pruned := s.depositCache.PruneDeposits(ctx, int64(eth1DepositIndex)-1, followHeight-s.depositCacheRetention)
+	if pruned > 0 {
+		log.WithField("pruned", pruned).Debug("Pruned processed deposits from the deposit cache")
+	}
+}
+
 // requestBatchedHeadersAndLogs requests and processes all the headers and
 // logs from the period last polled to now.
 func (s *Service) requestBatchedHeadersAndLogs(ctx context.Context) error {
@@ -410,48 +425,101 @@ func (s *Service) requestBatchedHeadersAndLogs(ctx context.Context) error {
 	return nil
 }
 
-// requestMissingLogs requests any logs that were missed by requesting from previous blocks
-// until the current block(exclusive).
+// requestMissingLogs backfills a gap in the received deposit logs. The deposit logs preceding the
+// given block are requested again from the eth1 endpoint in a single range query, widening the range
+// up to maxMissingLogsLookBack blocks until it covers the first missing merkle index, and are then
+// processed up to the wanted index. Before chainstart, the blocks of the backfilled logs are checked
+// for chainstart as they would have been had the logs been received in the first place.
 func (s *Service) requestMissingLogs(ctx context.Context, blkNumber uint64, wantedIndex int64) error {
 	// Prevent this method from being called recursively
 	s.requestingOldLogs = true
 	defer func() {
 		s.requestingOldLogs = false
 	}()
-	// We request from the last requested block till the current block(exclusive)
-	beforeCurrentBlk := big.NewInt(int64(blkNumber) - 1)
-	startBlock := s.latestEth1Data.LastRequestedBlock + 1
+	if blkNumber == 0 {
+		return errors.New("no previous blocks to request missing logs from")
+	}
+	log.WithFields(logrus.Fields{
+		"lastReceivedIndex": s.lastReceivedMerkleIndex,
+		"wantedIndex":       wantedIndex,
+		"blockNumber":       blkNumber,
+	}).Warn("Detected a gap in the deposit logs, requesting the missing logs")
+
+	deploymentBlock := params.BeaconNetworkConfig().ContractDeploymentBlock
+	endBlk := blkNumber - 1
+	lookback := uint64(eth1LookBackPeriod)
 	for {
-		err := s.processBlksInRange(ctx, startBlock, beforeCurrentBlk.Uint64())
+		startBlk := deploymentBlock
+		if endBlk > lookback && endBlk-lookback > deploymentBlock {
+			startBlk = endBlk - lookback
+		}
+		if startBlk > endBlk {
+			startBlk = endBlk
+		}
+		refetchedDepositLogsCount.Inc()
+		logs, err := s.httpLogger.FilterLogs(ctx, ethereum.FilterQuery{
+			Addresses: []common.Address{
+				s.depositContractAddress,
+			},
+			FromBlock: big.NewInt(int64(startBlk)),
+			ToBlock:   big.NewInt(int64(endBlk)),
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not request missing deposit logs")
+		}
+		firstIndex, err := s.firstUnseenDepositIndex(logs)
 		if err != nil {
 			return err
 		}
-
-		if s.lastReceivedMerkleIndex == wantedIndex {
-			break
+		// Widen the requested range if it does not reach back to the first missing log.
+		if firstIndex != s.lastReceivedMerkleIndex+1 && startBlk > deploymentBlock && lookback < maxMissingLogsLookBack {
+			lookback *= 2
+			continue
 		}
-
-		// If the required logs still do not exist after the lookback period, then we return an error.
-		if startBlock < s.latestEth1Data.LastRequestedBlock-eth1LookBackPeriod {
-			return fmt.Errorf(
-				"latest index observed is not accurate, wanted %d, but received  %d",
-				wantedIndex,
-				s.lastReceivedMerkleIndex,
-			)
+		for i, filterLog := range logs {
+			if filterLog.Topics[0] != depositEventSignature {
+				continue
+			}
+			if err := s.ProcessDepositLog(ctx, filterLog); err != nil {
+				return errors.Wrap(err, "could not process missing deposit log")
+			}
+			// Check the block for chainstart once all of its logs are processed.
+			lastOfBlock := i == len(logs)-1 || logs[i+1].BlockNumber != filterLog.BlockNumber
+			if !s.chainStartData.Chainstarted && (lastOfBlock || s.lastReceivedMerkleIndex == wantedIndex) {
+				if err := s.checkBlockNumberForChainStart(ctx, new(big.Int).SetUint64(filterLog.BlockNumber)); err != nil {
+					return err
+				}
+			}
+			if s.lastReceivedMerkleIndex == wantedIndex {
+				return nil
+			}
 		}
-		startBlock--
+		return fmt.Errorf(
+			"latest index observed is not accurate, wanted %d, but received  %d",
+			wantedIndex,
+			s.lastReceivedMerkleIndex,
+		)
 	}
-	return nil
 }
 
-func (s *Service) processBlksInRange(ctx context.Context, startBlk, endBlk uint64) error {
-	for i := startBlk; i <= endBlk; i++ {
-		err := s.ProcessETH1Block(ctx, big.NewInt(int64(i)))
+// firstUnseenDepositIndex returns the lowest merkle index above the last received one amongst the
+// given deposit logs, or -1 if there is none.
+func (s *Service) firstUnseenDepositIndex(logs []gethTypes.Log) (int64, error) {
+	firstIndex := int64(-1)
+	for _, filterLog := range logs {
+		if filterLog.Topics[0] != depositEventSignature {
+			continue
+		}
+		_, _, _, _, merkleTreeIndex, err := contracts.UnpackDepositLogData(filterLog.Data)
 		if err != nil {
-			return err
+			return 0, errors.Wrap(err, "could not unpack log")
+		}
+		index := int64(binary.LittleEndian.Uint64(merkleTreeIndex))
+		if index > s.lastReceivedMerkleIndex && (firstIndex == -1 || index < firstIndex) {
+			firstIndex = index
 		}
 	}
-	return nil
+	return firstIndex, nil
 }
 
 func (s *Service) retrieveBlockHashAndTime(ctx context.Context, blkNum *big.Int) ([32]byte, uint64, error) {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
@@ -640,6 +641,76 @@ func TestWeb3ServiceProcessDepositLog_RequestMissedDeposits(t *testing.T) {
 	hook.Reset()
 }
 
+func TestWeb3ServiceProcessDepositLog_RequestMissedDepositsBeyondLookback(t *testing.T) {
+	testAcc, err := contracts.Setup()
+	require.NoError(t, err, "Unable to set up simulated backend")
+	beaconDB := testDB.SetupDB(t)
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+
+	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
+		HTTPEndpoints:   []string{endpoint},
+		DepositContract: testAcc.ContractAddr,
+		BeaconDB:        beaconDB,
+		DepositCache:    depositCache,
+	})
+	require.NoError(t, err, "unable to setup web3 ETH1.0 chain service")
+	web3Service = setDefaultMocks(web3Service)
+	web3Service.depositContractCaller, err = contracts.NewDepositContractCaller(testAcc.ContractAddr, testAcc.Backend)
+	require.NoError(t, err)
+	web3Service.httpLogger = testAcc.Backend
+	params.SetupTestConfigCleanup(t)
+	bConfig := params.MinimalSpecConfig()
+	bConfig.MinGenesisTime = 0
+	depositsWanted := 4
+	// Chainstart is reached with the last of the missed logs.
+	bConfig.MinGenesisActiveValidatorCount = uint64(depositsWanted - 1)
+	params.OverrideBeaconConfig(bConfig)
+
+	testAcc.Backend.Commit()
+	require.NoError(t, testAcc.Backend.AdjustTime(time.Duration(int64(time.Now().Nanosecond()))))
+	testutil.ResetCache()
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(uint64(depositsWanted))
+	require.NoError(t, err)
+	_, depositRoots, err := testutil.DeterministicDepositTrie(len(deposits))
+	require.NoError(t, err)
+
+	for i := 0; i < depositsWanted; i++ {
+		// Leave more than the lookback period between the missed logs and the last one.
+		if i == depositsWanted-1 {
+			for j := 0; j < 2*eth1LookBackPeriod; j++ {
+				testAcc.Backend.Commit()
+			}
+		}
+		data := deposits[i].Data
+		testAcc.TxOpts.Value = contracts.Amount32Eth()
+		testAcc.TxOpts.GasLimit = 1000000
+		_, err = testAcc.Contract.Deposit(testAcc.TxOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature, depositRoots[i])
+		require.NoError(t, err, "Could not deposit to deposit contract")
+
+		testAcc.Backend.Commit()
+	}
+
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{
+			web3Service.depositContractAddress,
+		},
+	}
+	logs, err := testAcc.Backend.FilterLogs(web3Service.ctx, query)
+	require.NoError(t, err, "Unable to retrieve logs")
+	require.Equal(t, depositsWanted, len(logs), "Did not receive enough logs")
+
+	// We purposely miss processing the middle logs so that the service re-requests them.
+	for _, log := range []gethTypes.Log{logs[0], logs[depositsWanted-1]} {
+		require.NoError(t, web3Service.ProcessLog(context.Background(), log))
+		web3Service.latestEth1Data.LastRequestedBlock = log.BlockNumber
+	}
+	assert.Equal(t, int64(depositsWanted-1), web3Service.lastReceivedMerkleIndex, "missing logs were not re-requested")
+	assert.Equal(t, depositsWanted, len(depositCache.AllDeposits(context.Background(), nil)))
+	assert.Equal(t, true, web3Service.chainStartData.Chainstarted, "chainstart was not checked for the missed logs")
+	assert.Equal(t, logs[depositsWanted-2].BlockNumber, web3Service.chainStartData.GenesisBlock)
+}
+
 func TestCheckForChainstart_NoValidator(t *testing.T) {
 	hook := logTest.NewGlobal()
 	testAcc, err := contracts.Setup()
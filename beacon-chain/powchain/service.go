@@ -55,6 +55,14 @@ var (
 		Name: "powchain_missed_deposit_logs",
 		Help: "The number of times a missed deposit log is detected",
 	})
+	activeHTTPEndpointIndex = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "powchain_active_http_endpoint_index",
+		Help: "The index of the eth1 endpoint currently in use, 0 being the primary endpoint",
+	})
+	refetchedDepositLogsCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_refetched_deposit_logs",
+		Help: "The number of times deposit logs are requested again to fill a gap in the deposit indices",
+	})
 )
 
 // time to wait before trying to reconnect with the eth1 node.
@@ -154,6 +162,7 @@ type Service struct {
 	preGenesisState         iface.BeaconState
 	stateGen                *stategen.State
 	eth1HeaderReqLimit      uint64
+	depositCacheRetention   uint64
 }
 
 // Web3ServiceConfig defines a config struct for web3 service to use through its life cycle.
@@ -165,6 +174,9 @@ type Web3ServiceConfig struct {
 	StateNotifier      statefeed.Notifier
 	StateGen           *stategen.State
 	Eth1HeaderReqLimit uint64
+	// DepositCacheRetention is the number of eth1 blocks, beyond the follow distance, for which
+	// processed deposits are kept in the deposit cache.
+	DepositCacheRetention uint64
 }
 
 // NewService sets up a new instance with an ethclient when
@@ -193,6 +205,7 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 	if len(endpoints) > 0 {
 		currEndpoint = endpoints[0]
 	}
+	activeHTTPEndpointIndex.Set(0)
 	s := &Service{
 		ctx:              ctx,
 		cancel:           cancel,
@@ -219,6 +232,7 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		headTicker:              time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerETH1Block) * time.Second),
 		stateGen:                config.StateGen,
 		eth1HeaderReqLimit:      eth1HeaderReqLimit,
+		depositCacheRetention:   config.DepositCacheRetention,
 	}
 
 	eth1Data, err := config.BeaconDB.PowchainData(ctx)
@@ -346,6 +360,15 @@ func (s *Service) LatestBlockHash() common.Hash {
 	return bytesutil.ToBytes32(s.latestEth1Data.BlockHash)
 }
 
+// LatestBlockTime is the time of the latest block seen in the ETH1.0 chain, or the zero time
+// if no block has been seen yet.
+func (s *Service) LatestBlockTime() time.Time {
+	if s.latestEth1Data == nil {
+		return time.Time{}
+	}
+	return time.Unix(int64(s.latestEth1Data.BlockTime), 0)
+}
+
 // AreAllDepositsProcessed determines if all the logs from the deposit contract
 // are processed.
 func (s *Service) AreAllDepositsProcessed() (bool, error) {
@@ -356,8 +379,7 @@ func (s *Service) AreAllDepositsProcessed() (bool, error) {
 		return false, errors.Wrap(err, "could not get deposit count")
 	}
 	count := bytesutil.FromBytes8(countByte)
-	deposits := s.depositCache.AllDeposits(s.ctx, nil)
-	if count != uint64(len(deposits)) {
+	if count != s.depositCache.NumberOfDeposits(s.ctx) {
 		return false, nil
 	}
 	return true, nil
@@ -556,6 +578,18 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*protodb.Deposit
 		return nil
 	}
 	s.depositCache.InsertDepositContainers(ctx, ctrs)
+	// The containers are sorted by index once inserted. If the earliest deposits were pruned from the
+	// cache before it was saved, the finalized deposits trie is rebuilt from the deposit trie.
+	offset := uint64(ctrs[0].Index)
+	if offset > 0 {
+		items := s.depositTrie.Items()
+		if uint64(len(items)) < offset {
+			return errors.Errorf("deposit trie has %d items, but %d deposits were pruned", len(items), offset)
+		}
+		if err := s.depositCache.InsertFinalizedDepositLeaves(ctx, items[:offset]); err != nil {
+			return errors.Wrap(err, "could not rebuild finalized deposits")
+		}
+	}
 	if !s.chainStartData.Chainstarted {
 		// do not add to pending cache
 		// if no genesis state exists.
@@ -587,9 +621,10 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*protodb.Deposit
 	}
 	validDepositsCount.Add(float64(currIndex))
 	// Only add pending deposits if the container slice length
-	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
+	// is more than the current index in state. Pruned deposits
+	// are always processed in the finalized state.
+	if offset <= currIndex && uint64(len(ctrs))+offset > currIndex {
+		for _, c := range ctrs[currIndex-offset:] {
 			s.depositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 		}
 	}
@@ -869,7 +904,7 @@ func (s *Service) checkDefaultEndpoint() {
 		log.Debugf("Primary endpoint not ready: %v", err)
 		return
 	}
-	log.Info("Primary endpoint ready again, switching back to it")
+	log.WithField("endpoint", logutil.MaskCredentialsLogging(primaryEndpoint)).Info("Primary endpoint ready again, switching back to it")
 	// Close the clients and let our main connection routine
 	// properly connect with it.
 	httpClient.Close()
@@ -878,8 +913,9 @@ func (s *Service) checkDefaultEndpoint() {
 	s.closeClients()
 
 	// Switch back to primary endpoint and try connecting
-	// to it again.
-	s.currHttpEndpoint = primaryEndpoint
+	// to it again. The deposit follower resumes from the
+	// last requested block, as it is kept across endpoints.
+	s.switchEndpoint(0)
 	s.retryETH1Node(nil)
 }
 
@@ -904,8 +940,17 @@ func (s *Service) fallbackToNextEndpoint() {
 	if nextIndex == currIndex {
 		return
 	}
-	s.currHttpEndpoint = s.httpEndpoints[nextIndex]
-	log.Infof("Falling back to alternative endpoint: %s", s.currHttpEndpoint)
+	s.switchEndpoint(nextIndex)
+	log.WithFields(logrus.Fields{
+		"endpoint":           logutil.MaskCredentialsLogging(s.currHttpEndpoint),
+		"lastRequestedBlock": s.latestEth1Data.LastRequestedBlock,
+	}).Info("Falling back to alternative endpoint")
+}
+
+// switchEndpoint sets the endpoint at the given index of our endpoints as the one in use.
+func (s *Service) switchEndpoint(index int) {
+	s.currHttpEndpoint = s.httpEndpoints[index]
+	activeHTTPEndpointIndex.Set(float64(index))
 }
 
 func dedupEndpoints(endpoints []string) []string {
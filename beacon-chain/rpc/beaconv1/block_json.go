@@ -0,0 +1,221 @@
+package beaconv1
+
+import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// The types below are the JSON encoding of a phase 0 block in the beacon API, where integers
+// are decimal strings and byte arrays are 0x prefixed hex strings.
+
+type signedBeaconBlockJson struct {
+	Message   *beaconBlockJson `json:"message"`
+	Signature string           `json:"signature"`
+}
+
+type beaconBlockJson struct {
+	Slot          string               `json:"slot"`
+	ProposerIndex string               `json:"proposer_index"`
+	ParentRoot    string               `json:"parent_root"`
+	StateRoot     string               `json:"state_root"`
+	Body          *beaconBlockBodyJson `json:"body"`
+}
+
+type beaconBlockBodyJson struct {
+	RandaoReveal      string                     `json:"randao_reveal"`
+	Eth1Data          *eth1DataJson              `json:"eth1_data"`
+	Graffiti          string                     `json:"graffiti"`
+	ProposerSlashings []*proposerSlashingJson    `json:"proposer_slashings"`
+	AttesterSlashings []*attesterSlashingJson    `json:"attester_slashings"`
+	Attestations      []*attestationJson         `json:"attestations"`
+	Deposits          []*depositJson             `json:"deposits"`
+	VoluntaryExits    []*signedVoluntaryExitJson `json:"voluntary_exits"`
+}
+
+type eth1DataJson struct {
+	DepositRoot  string `json:"deposit_root"`
+	DepositCount string `json:"deposit_count"`
+	BlockHash    string `json:"block_hash"`
+}
+
+type signedBeaconBlockHeaderJson struct {
+	Message   *beaconBlockHeaderJson `json:"message"`
+	Signature string                 `json:"signature"`
+}
+
+type beaconBlockHeaderJson struct {
+	Slot          string `json:"slot"`
+	ProposerIndex string `json:"proposer_index"`
+	ParentRoot    string `json:"parent_root"`
+	StateRoot     string `json:"state_root"`
+	BodyRoot      string `json:"body_root"`
+}
+
+type proposerSlashingJson struct {
+	SignedHeader1 *signedBeaconBlockHeaderJson `json:"signed_header_1"`
+	SignedHeader2 *signedBeaconBlockHeaderJson `json:"signed_header_2"`
+}
+
+type attesterSlashingJson struct {
+	Attestation1 *indexedAttestationJson `json:"attestation_1"`
+	Attestation2 *indexedAttestationJson `json:"attestation_2"`
+}
+
+type indexedAttestationJson struct {
+	AttestingIndices []string             `json:"attesting_indices"`
+	Data             *attestationDataJson `json:"data"`
+	Signature        string               `json:"signature"`
+}
+
+type attestationJson struct {
+	AggregationBits string               `json:"aggregation_bits"`
+	Data            *attestationDataJson `json:"data"`
+	Signature       string               `json:"signature"`
+}
+
+type attestationDataJson struct {
+	Slot            string          `json:"slot"`
+	Index           string          `json:"index"`
+	BeaconBlockRoot string          `json:"beacon_block_root"`
+	Source          *checkpointJson `json:"source"`
+	Target          *checkpointJson `json:"target"`
+}
+
+type checkpointJson struct {
+	Epoch string `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+type depositJson struct {
+	Proof []string         `json:"proof"`
+	Data  *depositDataJson `json:"data"`
+}
+
+type depositDataJson struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	Signature             string `json:"signature"`
+}
+
+type signedVoluntaryExitJson struct {
+	Message   *voluntaryExitJson `json:"message"`
+	Signature string             `json:"signature"`
+}
+
+type voluntaryExitJson struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+func uintToJson(i uint64) string {
+	return strconv.FormatUint(i, 10)
+}
+
+func signedBlockToJson(blk *ethpb_alpha.SignedBeaconBlock) *signedBeaconBlockJson {
+	b := blk.Block
+	body := &beaconBlockBodyJson{
+		RandaoReveal: hexutil.Encode(b.Body.RandaoReveal),
+		Eth1Data: &eth1DataJson{
+			DepositRoot:  hexutil.Encode(b.Body.Eth1Data.DepositRoot),
+			DepositCount: uintToJson(b.Body.Eth1Data.DepositCount),
+			BlockHash:    hexutil.Encode(b.Body.Eth1Data.BlockHash),
+		},
+		Graffiti:          hexutil.Encode(b.Body.Graffiti),
+		ProposerSlashings: make([]*proposerSlashingJson, len(b.Body.ProposerSlashings)),
+		AttesterSlashings: make([]*attesterSlashingJson, len(b.Body.AttesterSlashings)),
+		Attestations:      make([]*attestationJson, len(b.Body.Attestations)),
+		Deposits:          make([]*depositJson, len(b.Body.Deposits)),
+		VoluntaryExits:    make([]*signedVoluntaryExitJson, len(b.Body.VoluntaryExits)),
+	}
+	for i, s := range b.Body.ProposerSlashings {
+		body.ProposerSlashings[i] = &proposerSlashingJson{
+			SignedHeader1: signedHeaderToJson(s.Header_1),
+			SignedHeader2: signedHeaderToJson(s.Header_2),
+		}
+	}
+	for i, s := range b.Body.AttesterSlashings {
+		body.AttesterSlashings[i] = &attesterSlashingJson{
+			Attestation1: indexedAttestationToJson(s.Attestation_1),
+			Attestation2: indexedAttestationToJson(s.Attestation_2),
+		}
+	}
+	for i, att := range b.Body.Attestations {
+		body.Attestations[i] = &attestationJson{
+			AggregationBits: hexutil.Encode(att.AggregationBits),
+			Data:            attestationDataToJson(att.Data),
+			Signature:       hexutil.Encode(att.Signature),
+		}
+	}
+	for i, d := range b.Body.Deposits {
+		proof := make([]string, len(d.Proof))
+		for j, p := range d.Proof {
+			proof[j] = hexutil.Encode(p)
+		}
+		body.Deposits[i] = &depositJson{
+			Proof: proof,
+			Data: &depositDataJson{
+				Pubkey:                hexutil.Encode(d.Data.PublicKey),
+				WithdrawalCredentials: hexutil.Encode(d.Data.WithdrawalCredentials),
+				Amount:                uintToJson(d.Data.Amount),
+				Signature:             hexutil.Encode(d.Data.Signature),
+			},
+		}
+	}
+	for i, e := range b.Body.VoluntaryExits {
+		body.VoluntaryExits[i] = &signedVoluntaryExitJson{
+			Message: &voluntaryExitJson{
+				Epoch:          uintToJson(uint64(e.Exit.Epoch)),
+				ValidatorIndex: uintToJson(uint64(e.Exit.ValidatorIndex)),
+			},
+			Signature: hexutil.Encode(e.Signature),
+		}
+	}
+	return &signedBeaconBlockJson{
+		Message: &beaconBlockJson{
+			Slot:          uintToJson(uint64(b.Slot)),
+			ProposerIndex: uintToJson(uint64(b.ProposerIndex)),
+			ParentRoot:    hexutil.Encode(b.ParentRoot),
+			StateRoot:     hexutil.Encode(b.StateRoot),
+			Body:          body,
+		},
+		Signature: hexutil.Encode(blk.Signature),
+	}
+}
+
+func signedHeaderToJson(h *ethpb_alpha.SignedBeaconBlockHeader) *signedBeaconBlockHeaderJson {
+	return &signedBeaconBlockHeaderJson{
+		Message: &beaconBlockHeaderJson{
+			Slot:          uintToJson(uint64(h.Header.Slot)),
+			ProposerIndex: uintToJson(uint64(h.Header.ProposerIndex)),
+			ParentRoot:    hexutil.Encode(h.Header.ParentRoot),
+			StateRoot:     hexutil.Encode(h.Header.StateRoot),
+			BodyRoot:      hexutil.Encode(h.Header.BodyRoot),
+		},
+		Signature: hexutil.Encode(h.Signature),
+	}
+}
+
+func indexedAttestationToJson(att *ethpb_alpha.IndexedAttestation) *indexedAttestationJson {
+	indices := make([]string, len(att.AttestingIndices))
+	for i, index := range att.AttestingIndices {
+		indices[i] = uintToJson(index)
+	}
+	return &indexedAttestationJson{
+		AttestingIndices: indices,
+		Data:             attestationDataToJson(att.Data),
+		Signature:        hexutil.Encode(att.Signature),
+	}
+}
+
+func attestationDataToJson(data *ethpb_alpha.AttestationData) *attestationDataJson {
+	return &attestationDataJson{
+		Slot:            uintToJson(uint64(data.Slot)),
+		Index:           uintToJson(uint64(data.CommitteeIndex)),
+		BeaconBlockRoot: hexutil.Encode(data.BeaconBlockRoot),
+		Source:          &checkpointJson{Epoch: uintToJson(uint64(data.Source.Epoch)), Root: hexutil.Encode(data.Source.Root)},
+		Target:          &checkpointJson{Epoch: uintToJson(uint64(data.Target.Epoch)), Root: hexutil.Encode(data.Target.Root)},
+	}
+}
@@ -218,28 +218,13 @@ func (bs *Server) GetBlockRoot(ctx context.Context, req *ethpb.BlockRequest) (*e
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "could not decode block id: %v", err)
 			}
-			hasRoots, roots, err := bs.BeaconDB.BlockRootsBySlot(ctx, types.Slot(slot))
+			root, err = bs.blockRootBySlot(ctx, types.Slot(slot))
 			if err != nil {
-				return nil, status.Errorf(codes.Internal, "Could not retrieve blocks for slot %d: %v", slot, err)
+				return nil, err
 			}
-
-			if !hasRoots {
+			if root == nil {
 				return nil, status.Error(codes.NotFound, "Could not find any blocks with given slot")
 			}
-			root = roots[0][:]
-			if len(roots) == 1 {
-				break
-			}
-			for _, blockRoot := range roots {
-				canonical, err := bs.ChainInfoFetcher.IsCanonical(ctx, blockRoot)
-				if err != nil {
-					return nil, status.Errorf(codes.Internal, "Could not determine if block root is canonical: %v", err)
-				}
-				if canonical {
-					root = blockRoot[:]
-					break
-				}
-			}
 		}
 	}
 
@@ -301,34 +286,56 @@ func (bs *Server) blockFromBlockID(ctx context.Context, blockId []byte) (*ethpb_
 			if err != nil {
 				return nil, errors.Wrap(err, "could not decode block id")
 			}
-			_, blks, err := bs.BeaconDB.BlocksBySlot(ctx, types.Slot(slot))
+			root, err := bs.blockRootBySlot(ctx, types.Slot(slot))
 			if err != nil {
-				return nil, errors.Wrapf(err, "could not retrieve blocks for slot %d", slot)
+				return nil, err
 			}
-			_, roots, err := bs.BeaconDB.BlockRootsBySlot(ctx, types.Slot(slot))
-			if err != nil {
-				return nil, errors.Wrapf(err, "could not retrieve block roots for slot %d", slot)
-			}
-
-			numBlks := len(blks)
-			if numBlks == 0 {
+			if root == nil {
 				return nil, nil
 			}
-			blk = blks[0]
-			if numBlks == 1 {
-				break
-			}
-			for i, block := range blks {
-				canonical, err := bs.ChainInfoFetcher.IsCanonical(ctx, roots[i])
-				if err != nil {
-					return nil, status.Errorf(codes.Internal, "Could not determine if block root is canonical: %v", err)
-				}
-				if canonical {
-					blk = block
-					break
-				}
+			blk, err = bs.BeaconDB.Block(ctx, bytesutil.ToBytes32(root))
+			if err != nil {
+				return nil, errors.Wrap(err, "could not retrieve block")
 			}
 		}
 	}
 	return blk, nil
 }
+
+// blockRootBySlot returns the canonical block root of the slot, or the first block root of the
+// slot if none of them is canonical. It returns nil if there is no block at this slot. The roots
+// of the slots before the head are cached, as they only change on chain reorgs.
+func (bs *Server) blockRootBySlot(ctx context.Context, slot types.Slot) ([]byte, error) {
+	cacheable := bs.SlotRootCache != nil && slot < bs.ChainInfoFetcher.HeadSlot()
+	if cacheable {
+		if root, ok := bs.SlotRootCache.RootBySlot(slot); ok {
+			return root[:], nil
+		}
+	}
+
+	hasRoots, roots, err := bs.BeaconDB.BlockRootsBySlot(ctx, slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve blocks for slot %d: %v", slot, err)
+	}
+	if !hasRoots {
+		return nil, nil
+	}
+	root := roots[0]
+	// A single root only needs to be checked before caching it, as it is returned either way.
+	if len(roots) == 1 && !cacheable {
+		return root[:], nil
+	}
+	for _, blockRoot := range roots {
+		canonical, err := bs.ChainInfoFetcher.IsCanonical(ctx, blockRoot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not determine if block root is canonical: %v", err)
+		}
+		if canonical {
+			if cacheable {
+				bs.SlotRootCache.AddRoot(slot, blockRoot)
+			}
+			return blockRoot[:], nil
+		}
+	}
+	return root[:], nil
+}
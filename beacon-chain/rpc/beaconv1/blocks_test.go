@@ -2,6 +2,7 @@ package beaconv1
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
 	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
@@ -492,6 +494,55 @@ func TestServer_GetBlockRoot(t *testing.T) {
 	}
 }
 
+func TestServer_GetBlockRoot_SlotRootCache(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	_, blkContainers := fillDBTestBlocks(ctx, t, beaconDB)
+	headBlock := blkContainers[len(blkContainers)-1]
+	// A non canonical block at slot 30.
+	b2 := testutil.NewBeaconBlock()
+	b2.Block.Slot = 30
+	b2.Block.ParentRoot = bytesutil.PadTo([]byte{1}, 32)
+	require.NoError(t, beaconDB.SaveBlock(ctx, b2))
+	headState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetSlot(headBlock.Block.Block.Slot))
+
+	canonicalRoots := make(map[[32]byte]bool)
+	for _, c := range blkContainers {
+		canonicalRoots[bytesutil.ToBytes32(c.BlockRoot)] = true
+	}
+	bs := &Server{
+		BeaconDB: beaconDB,
+		ChainInfoFetcher: &mock.ChainService{
+			DB:             beaconDB,
+			State:          headState,
+			CanonicalRoots: canonicalRoots,
+		},
+		SlotRootCache: cache.NewSlotRootCache(),
+	}
+
+	resp, err := bs.GetBlockRoot(ctx, &ethpb.BlockRequest{BlockId: []byte("30")})
+	require.NoError(t, err)
+	assert.DeepEqual(t, blkContainers[30].BlockRoot, resp.Data.Root)
+	cachedRoot, ok := bs.SlotRootCache.RootBySlot(30)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, blkContainers[30].BlockRoot, cachedRoot[:])
+
+	// The block of a cached slot is resolved from the cache.
+	bs.SlotRootCache.AddRoot(31, bytesutil.ToBytes32(blkContainers[20].BlockRoot))
+	blk, err := bs.blockFromBlockID(ctx, []byte("31"))
+	require.NoError(t, err)
+	assert.DeepEqual(t, blkContainers[20].Block, blk)
+
+	// The head slot is not cached, as a block may still be received for it.
+	_, err = bs.GetBlockRoot(ctx, &ethpb.BlockRequest{BlockId: []byte(fmt.Sprintf("%d", headBlock.Block.Block.Slot))})
+	require.NoError(t, err)
+	_, ok = bs.SlotRootCache.RootBySlot(headBlock.Block.Block.Slot)
+	assert.Equal(t, false, ok)
+}
+
 func TestServer_ListBlockAttestations(t *testing.T) {
 	beaconDB := dbTest.SetupDB(t)
 	ctx := context.Background()
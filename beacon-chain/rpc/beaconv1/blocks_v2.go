@@ -0,0 +1,127 @@
+package beaconv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// blockV2Path is the path template of the v2 block endpoint.
+const blockV2Path = "/eth/v2/beacon/blocks/{block_id}"
+
+const (
+	// versionHeader is the response header naming the fork of the returned object.
+	versionHeader = "Eth-Consensus-Version"
+	// phase0Version is the name of the only fork the node runs.
+	phase0Version = "phase0"
+	sszMediaType  = "application/octet-stream"
+	jsonMediaType = "application/json"
+)
+
+type blockV2Response struct {
+	Version string                 `json:"version"`
+	Data    *signedBeaconBlockJson `json:"data"`
+}
+
+// BlockV2Handler serves GET /eth/v2/beacon/blocks/{block_id}, which returns the block with
+// the name of its fork in the Eth-Consensus-Version header. The block is SSZ encoded when the
+// request prefers application/octet-stream in its Accept header, and JSON encoded otherwise.
+func (bs *Server) BlockV2Handler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	blockId := pathParam(blockV2Path, r, "block_id")
+	if blockId == "" {
+		http.NotFound(w, r)
+		return
+	}
+	rawBlockId, err := parseBlockId(blockId)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	blk, err := bs.blockFromBlockID(r.Context(), rawBlockId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Could not get block from block ID: %v", err), http.StatusInternalServerError)
+		return
+	}
+	if blk == nil {
+		http.Error(w, "Could not find requested block", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set(versionHeader, phase0Version)
+	if prefersSSZ(r.Header.Get("Accept")) {
+		enc, err := blk.MarshalSSZ()
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Could not marshal block: %v", err), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", sszMediaType)
+		if _, err := w.Write(enc); err != nil {
+			log.WithError(err).Error("Could not write block response")
+		}
+		return
+	}
+	resp := &blockV2Response{Version: phase0Version, Data: signedBlockToJson(blk)}
+	w.Header().Set("Content-Type", jsonMediaType)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.WithError(err).Error("Could not write block response")
+	}
+}
+
+// parseBlockId validates a block ID of the API path and returns it in the form taken by
+// blockFromBlockID, where block roots are raw bytes.
+func parseBlockId(blockId string) ([]byte, error) {
+	switch blockId {
+	case "head", "finalized", "genesis":
+		return []byte(blockId), nil
+	}
+	if strings.HasPrefix(blockId, "0x") {
+		root, err := hexutil.Decode(blockId)
+		if err != nil || len(root) != 32 {
+			return nil, fmt.Errorf("invalid block ID: %q", blockId)
+		}
+		return root, nil
+	}
+	if _, err := strconv.ParseUint(blockId, 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid block ID: %q", blockId)
+	}
+	return []byte(blockId), nil
+}
+
+// prefersSSZ returns true if the Accept header gives application/octet-stream a higher quality
+// than JSON, which is the default encoding when the header is missing or names neither of them.
+func prefersSSZ(accept string) bool {
+	var sszQuality, jsonQuality float64
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		switch mediaType {
+		case sszMediaType:
+			if quality > sszQuality {
+				sszQuality = quality
+			}
+		case jsonMediaType, "application/*", "*/*":
+			if quality > jsonQuality {
+				jsonQuality = quality
+			}
+		}
+	}
+	return sszQuality > jsonQuality
+}
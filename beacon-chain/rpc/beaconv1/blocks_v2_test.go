@@ -0,0 +1,142 @@
+package beaconv1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestBlockV2Handler(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	_, blkContainers := fillDBTestBlocks(ctx, t, beaconDB)
+	headBlock := blkContainers[len(blkContainers)-1]
+	bs := &Server{
+		BeaconDB: beaconDB,
+		ChainInfoFetcher: &mock.ChainService{
+			DB:                  beaconDB,
+			Block:               headBlock.Block,
+			Root:                headBlock.BlockRoot,
+			FinalizedCheckPoint: &ethpb_alpha.Checkpoint{Root: blkContainers[64].BlockRoot},
+		},
+	}
+	wantSSZ, err := blkContainers[20].Block.MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		path     string
+		accept   string
+		wantCode int
+		wantSSZ  bool
+	}{
+		{
+			name:     "no accept header",
+			path:     "/eth/v2/beacon/blocks/20",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "json",
+			path:     "/eth/v2/beacon/blocks/20",
+			accept:   "application/json",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "ssz",
+			path:     "/eth/v2/beacon/blocks/20",
+			accept:   "application/octet-stream",
+			wantCode: http.StatusOK,
+			wantSSZ:  true,
+		},
+		{
+			name:     "ssz by root",
+			path:     "/eth/v2/beacon/blocks/" + hexutil.Encode(blkContainers[20].BlockRoot),
+			accept:   "application/octet-stream",
+			wantCode: http.StatusOK,
+			wantSSZ:  true,
+		},
+		{
+			name:     "json preferred over ssz",
+			path:     "/eth/v2/beacon/blocks/20",
+			accept:   "application/octet-stream;q=0.5, application/json",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "unknown block",
+			path:     "/eth/v2/beacon/blocks/1000",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "malformed root",
+			path:     "/eth/v2/beacon/blocks/0x1234",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "malformed block ID",
+			path:     "/eth/v2/beacon/blocks/foo",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "other endpoint",
+			path:     "/eth/v2/beacon/blocks/20/root",
+			wantCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			bs.BlockV2Handler(rec, req)
+			require.Equal(t, tt.wantCode, rec.Code, rec.Body.String())
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			assert.Equal(t, "phase0", rec.Header().Get("Eth-Consensus-Version"))
+			if tt.wantSSZ {
+				assert.Equal(t, "application/octet-stream", rec.Header().Get("Content-Type"))
+				assert.DeepEqual(t, wantSSZ, rec.Body.Bytes())
+				return
+			}
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			resp := &blockV2Response{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+			assert.Equal(t, "phase0", resp.Version)
+			assert.Equal(t, "20", resp.Data.Message.Slot)
+			assert.Equal(t, hexutil.Encode(blkContainers[20].Block.Block.ParentRoot), resp.Data.Message.ParentRoot)
+			assert.Equal(t, 2, len(resp.Data.Message.Body.Attestations))
+			assert.Equal(t, "21", resp.Data.Message.Body.Attestations[1].Data.Index)
+		})
+	}
+}
+
+func TestPrefersSSZ(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   bool
+	}{
+		{accept: "", want: false},
+		{accept: "*/*", want: false},
+		{accept: "application/json", want: false},
+		{accept: "application/octet-stream", want: true},
+		{accept: "application/octet-stream, application/json;q=0.9", want: true},
+		{accept: "application/json, application/octet-stream", want: false},
+		{accept: "application/octet-stream;q=0.5, */*;q=0.1", want: true},
+		{accept: "text/html", want: false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, prefersSSZ(tt.accept), tt.accept)
+	}
+}
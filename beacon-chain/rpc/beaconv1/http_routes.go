@@ -0,0 +1,70 @@
+package beaconv1
+
+import (
+	"net/http"
+	"strings"
+)
+
+// httpRoute is an endpoint of the standard API which the pinned API protos do not define, so it is
+// served over HTTP next to the gateway. Each {param} segment of its path template matches exactly
+// one non empty path segment, and all other segments match literally.
+type httpRoute struct {
+	template string
+	handler  http.HandlerFunc
+}
+
+func (bs *Server) httpRoutes() []httpRoute {
+	return []httpRoute{
+		{template: randaoPath, handler: bs.RandaoHandler},
+		{template: livenessPath, handler: bs.LivenessHandler},
+		{template: blockV2Path, handler: bs.BlockV2Handler},
+	}
+}
+
+// HTTPHandler serves the standard API endpoints which the gateway cannot proxy to gRPC on their
+// exact paths, and passes any other request to next, the gateway.
+func (bs *Server) HTTPHandler(next http.Handler) http.Handler {
+	routes := bs.httpRoutes()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, route := range routes {
+			if _, ok := matchPath(route.template, r.URL.Path); ok {
+				route.handler(w, r)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// matchPath matches the path against a route template and returns the values of its parameters.
+func matchPath(template, path string) (map[string]string, bool) {
+	templateSegments := strings.Split(strings.Trim(template, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(templateSegments) != len(pathSegments) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, segment := range templateSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if pathSegments[i] == "" {
+				return nil, false
+			}
+			params[strings.Trim(segment, "{}")] = pathSegments[i]
+			continue
+		}
+		if segment != pathSegments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// pathParam returns the value of a parameter of the route template in the request path, or an empty
+// string if the path does not match the template.
+func pathParam(template string, r *http.Request, name string) string {
+	params, ok := matchPath(template, r.URL.Path)
+	if !ok {
+		return ""
+	}
+	return params[name]
+}
@@ -0,0 +1,52 @@
+package beaconv1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestServer_HTTPHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	handler := (&Server{}).HTTPHandler(next)
+
+	tests := []struct {
+		method string
+		path   string
+		code   int
+	}{
+		// The routes only accept one method, which tells them apart from the gateway.
+		{method: http.MethodPost, path: "/eth/v1/beacon/states/head/randao", code: http.StatusMethodNotAllowed},
+		{method: http.MethodGet, path: "/eth/v1/validator/liveness/1", code: http.StatusMethodNotAllowed},
+		{method: http.MethodPost, path: "/eth/v2/beacon/blocks/head", code: http.StatusMethodNotAllowed},
+		// Other paths under the same prefixes are left to the gateway.
+		{method: http.MethodGet, path: "/eth/v1/beacon/states/head/root", code: http.StatusTeapot},
+		{method: http.MethodGet, path: "/eth/v1/beacon/states/head/randao/1", code: http.StatusTeapot},
+		{method: http.MethodGet, path: "/eth/v2/beacon/blocks/head/root", code: http.StatusTeapot},
+		{method: http.MethodGet, path: "/eth/v1/node/version", code: http.StatusTeapot},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+			assert.Equal(t, tt.code, rec.Code)
+		})
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	params, ok := matchPath(randaoPath, "/eth/v1/beacon/states/0x01/randao")
+	assert.Equal(t, true, ok)
+	assert.DeepEqual(t, map[string]string{"state_id": "0x01"}, params)
+
+	_, ok = matchPath(randaoPath, "/eth/v1/beacon/states//randao")
+	assert.Equal(t, false, ok)
+	_, ok = matchPath(randaoPath, "/eth/v1/beacon/states/head/root")
+	assert.Equal(t, false, ok)
+	_, ok = matchPath(blockV2Path, "/eth/v2/beacon/blocks")
+	assert.Equal(t, false, ok)
+}
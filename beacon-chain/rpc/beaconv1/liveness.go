@@ -0,0 +1,239 @@
+package beaconv1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// livenessPath is the path template of the validator liveness endpoint.
+const livenessPath = "/eth/v1/validator/liveness/{epoch}"
+
+type validatorLiveness struct {
+	Index  string `json:"index"`
+	IsLive bool   `json:"is_live"`
+}
+
+type livenessResponse struct {
+	Data []*validatorLiveness `json:"data"`
+}
+
+// LivenessHandler serves POST /eth/v1/validator/liveness/{epoch}, whose body is the JSON list of
+// the requested validator indices. A validator is live in an epoch if one of its attestations
+// targeting the epoch was included on chain, or if it proposed a canonical block during the epoch.
+// The attestations of an epoch can be included until the end of the next epoch, so the liveness
+// of the current and previous epochs is still accumulating: a validator which is not live yet may
+// become live later.
+func (bs *Server) LivenessHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	rawEpoch := pathParam(livenessPath, r, "epoch")
+	epoch, err := strconv.ParseUint(rawEpoch, 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid epoch: %q", rawEpoch), http.StatusBadRequest)
+		return
+	}
+	var rawIndices []string
+	if err := json.NewDecoder(r.Body).Decode(&rawIndices); err != nil {
+		http.Error(w, "Invalid request body, expected a list of validator indices", http.StatusBadRequest)
+		return
+	}
+	indices := make([]types.ValidatorIndex, len(rawIndices))
+	for i, rawIndex := range rawIndices {
+		idx, err := strconv.ParseUint(rawIndex, 10, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Invalid validator index: %q", rawIndex), http.StatusBadRequest)
+			return
+		}
+		indices[i] = types.ValidatorIndex(idx)
+	}
+
+	live, err := bs.liveness(r.Context(), types.Epoch(epoch), indices)
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), httpStatus(err))
+		return
+	}
+	resp := &livenessResponse{Data: make([]*validatorLiveness, len(indices))}
+	for i, idx := range indices {
+		resp.Data[i] = &validatorLiveness{
+			Index:  strconv.FormatUint(uint64(idx), 10),
+			IsLive: live[i],
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.WithError(err).Error("Could not write liveness response")
+	}
+}
+
+// GetValidatorLiveness returns the requested validators which were live in the epoch, as defined
+// for the liveness endpoint. It lets a validator client check whether its keys are in use by
+// another validator client without streaming every attestation the node receives.
+func (bs *Server) GetValidatorLiveness(
+	ctx context.Context, req *pbrpc.ValidatorLivenessRequest,
+) (*pbrpc.ValidatorLivenessResponse, error) {
+	indices := make([]types.ValidatorIndex, len(req.Indices))
+	for i, idx := range req.Indices {
+		indices[i] = types.ValidatorIndex(idx)
+	}
+	live, err := bs.liveness(ctx, types.Epoch(req.Epoch), indices)
+	if err != nil {
+		return nil, err
+	}
+	liveIndices := make([]uint64, 0, len(indices))
+	for i, idx := range req.Indices {
+		if live[i] {
+			liveIndices = append(liveIndices, idx)
+		}
+	}
+	return &pbrpc.ValidatorLivenessResponse{LiveIndices: liveIndices}, nil
+}
+
+// liveness returns whether each of the validators was live in the epoch.
+func (bs *Server) liveness(ctx context.Context, epoch types.Epoch, indices []types.ValidatorIndex) ([]bool, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.liveness")
+	defer span.End()
+
+	currentEpoch := helpers.SlotToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
+	if epoch > currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve the liveness of a future epoch, current epoch %d, requesting %d",
+			currentEpoch,
+			epoch,
+		)
+	}
+	st, err := bs.livenessState(ctx, epoch, currentEpoch)
+	if err != nil {
+		return nil, err
+	}
+	// Only the states of the epoch and of the next one hold the attestations targeting the epoch,
+	// the head state is behind when the node is syncing.
+	if stateEpoch := helpers.CurrentEpoch(st); stateEpoch != epoch && stateEpoch != epoch+1 {
+		return nil, status.Errorf(
+			codes.Unavailable,
+			"Cannot retrieve the liveness of epoch %d from a state at epoch %d, the node may be syncing",
+			epoch,
+			stateEpoch,
+		)
+	}
+	for _, idx := range indices {
+		if uint64(idx) >= uint64(st.NumValidators()) {
+			return nil, status.Errorf(codes.InvalidArgument, "Validator index %d does not exist", idx)
+		}
+	}
+	liveValidators, err := bs.liveValidators(ctx, st, epoch)
+	if err != nil {
+		return nil, err
+	}
+	live := make([]bool, len(indices))
+	for i, idx := range indices {
+		live[i] = liveValidators[idx]
+	}
+	return live, nil
+}
+
+// livenessState returns the state holding the pending attestations which target the epoch. The
+// attestations of the current and previous epochs are in the head state, those of older epochs
+// are in the state at the last slot of the following epoch.
+func (bs *Server) livenessState(ctx context.Context, epoch, currentEpoch types.Epoch) (iface.BeaconState, error) {
+	if epoch+1 >= currentEpoch {
+		st, err := bs.ChainInfoFetcher.HeadState(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+		}
+		return st, nil
+	}
+	startSlot, err := helpers.StartSlot(epoch + 2)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute start slot: %v", err)
+	}
+	st, err := bs.StateGenService.StateBySlot(ctx, startSlot-1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
+	}
+	return st, nil
+}
+
+// liveValidators returns the validators whose attestations targeting the epoch are included in the
+// state, along with the proposers of the blocks of the epoch up to the state's slot.
+func (bs *Server) liveValidators(
+	ctx context.Context, st iface.ReadOnlyBeaconState, epoch types.Epoch,
+) (map[types.ValidatorIndex]bool, error) {
+	live := make(map[types.ValidatorIndex]bool)
+
+	var atts []*pbp2p.PendingAttestation
+	switch epoch {
+	case helpers.CurrentEpoch(st):
+		atts = st.CurrentEpochAttestations()
+	case helpers.PrevEpoch(st):
+		atts = st.PreviousEpochAttestations()
+	}
+	for _, att := range atts {
+		committee, err := helpers.BeaconCommitteeFromState(st, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get committee: %v", err)
+		}
+		indices, err := attestationutil.AttestingIndices(att.AggregationBits, committee)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get attesting indices: %v", err)
+		}
+		for _, idx := range indices {
+			live[types.ValidatorIndex(idx)] = true
+		}
+	}
+
+	// The block of the state's own slot is not in its block roots yet, only in its latest header.
+	header := st.LatestBlockHeader()
+	if header != nil && header.Slot > 0 && helpers.SlotToEpoch(header.Slot) == epoch {
+		live[header.ProposerIndex] = true
+	}
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute start slot: %v", err)
+	}
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch && slot < st.Slot(); slot++ {
+		// The genesis block has no proposer.
+		if slot == 0 {
+			continue
+		}
+		root, err := helpers.BlockRootAtSlot(st, slot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get block root of slot %d: %v", slot, err)
+		}
+		parentRoot, err := helpers.BlockRootAtSlot(st, slot-1)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get block root of slot %d: %v", slot-1, err)
+		}
+		// A skipped slot repeats the block root of the previous slot.
+		if bytes.Equal(root, parentRoot) {
+			continue
+		}
+		blk, err := bs.BeaconDB.Block(ctx, bytesutil.ToBytes32(root))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get block of slot %d: %v", slot, err)
+		}
+		if blk == nil || blk.Block == nil {
+			continue
+		}
+		live[blk.Block.ProposerIndex] = true
+	}
+	return live, nil
+}
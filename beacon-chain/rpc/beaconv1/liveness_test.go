@@ -0,0 +1,158 @@
+package beaconv1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestLivenessHandler(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbTest.SetupDB(t)
+	helpers.ClearCache()
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	currentSlot := params.BeaconConfig().SlotsPerEpoch + 5
+	require.NoError(t, st.SetSlot(currentSlot))
+
+	// An attestation of the current epoch, from the first member of its committee.
+	attSlot := params.BeaconConfig().SlotsPerEpoch + 1
+	committee, err := helpers.BeaconCommitteeFromState(st, attSlot, 0)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	bits.SetBitAt(0, true)
+	require.NoError(t, st.AppendCurrentEpochAttestations(&pbp2p.PendingAttestation{
+		AggregationBits: bits,
+		Data: &ethpb.AttestationData{
+			Slot:            attSlot,
+			CommitteeIndex:  0,
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+		},
+	}))
+	attester := committee[0]
+
+	// A block proposed earlier in the epoch, and the block of the state's own slot.
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = params.BeaconConfig().SlotsPerEpoch + 2
+	blk.Block.ProposerIndex = 7
+	require.NoError(t, beaconDB.SaveBlock(ctx, blk))
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	for slot := blk.Block.Slot; slot < currentSlot; slot++ {
+		require.NoError(t, st.UpdateBlockRootAtIndex(uint64(slot%params.BeaconConfig().SlotsPerHistoricalRoot), root))
+	}
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:          currentSlot,
+		ProposerIndex: 9,
+		ParentRoot:    root[:],
+		StateRoot:     make([]byte, 32),
+		BodyRoot:      make([]byte, 32),
+	}))
+
+	var idle types.ValidatorIndex
+	for idle == attester || idle == 7 || idle == 9 {
+		idle++
+	}
+	bs := &Server{
+		BeaconDB:           beaconDB,
+		ChainInfoFetcher:   &chainMock.ChainService{State: st},
+		GenesisTimeFetcher: &chainMock.ChainService{Slot: &currentSlot},
+	}
+	indexList := func(indices ...types.ValidatorIndex) string {
+		raw := make([]string, len(indices))
+		for i, idx := range indices {
+			raw[i] = strconv.Quote(strconv.FormatUint(uint64(idx), 10))
+		}
+		return "[" + strings.Join(raw, ",") + "]"
+	}
+
+	t.Run("current epoch", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		body := indexList(attester, 7, 9, idle)
+		bs.LivenessHandler(rec, httptest.NewRequest(http.MethodPost, "/eth/v1/validator/liveness/1", strings.NewReader(body)))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		resp := &livenessResponse{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+		require.Equal(t, 4, len(resp.Data))
+		assert.DeepEqual(t, &validatorLiveness{Index: strconv.FormatUint(uint64(attester), 10), IsLive: true}, resp.Data[0])
+		assert.DeepEqual(t, &validatorLiveness{Index: "7", IsLive: true}, resp.Data[1])
+		assert.DeepEqual(t, &validatorLiveness{Index: "9", IsLive: true}, resp.Data[2])
+		assert.DeepEqual(t, &validatorLiveness{Index: strconv.FormatUint(uint64(idle), 10), IsLive: false}, resp.Data[3])
+	})
+
+	t.Run("previous epoch", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		bs.LivenessHandler(rec, httptest.NewRequest(http.MethodPost, "/eth/v1/validator/liveness/0", strings.NewReader(indexList(attester, 7, 9))))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		resp := &livenessResponse{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+		for _, l := range resp.Data {
+			assert.Equal(t, false, l.IsLive, "Validator %s unexpectedly live", l.Index)
+		}
+	})
+
+	t.Run("gRPC", func(t *testing.T) {
+		resp, err := bs.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{
+			Epoch:   1,
+			Indices: []uint64{uint64(idle), 7, uint64(attester)},
+		})
+		require.NoError(t, err)
+		assert.DeepEqual(t, []uint64{7, uint64(attester)}, resp.LiveIndices)
+
+		_, err = bs.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{Epoch: 2, Indices: []uint64{1}})
+		assert.ErrorContains(t, "Cannot retrieve the liveness of a future epoch", err)
+	})
+
+	errorTests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		wantCode int
+	}{
+		{"future epoch", http.MethodPost, "/eth/v1/validator/liveness/2", `["1"]`, http.StatusBadRequest},
+		{"malformed epoch", http.MethodPost, "/eth/v1/validator/liveness/foo", `["1"]`, http.StatusBadRequest},
+		{"unknown validator", http.MethodPost, "/eth/v1/validator/liveness/1", `["64"]`, http.StatusBadRequest},
+		{"malformed index", http.MethodPost, "/eth/v1/validator/liveness/1", `["foo"]`, http.StatusBadRequest},
+		{"malformed body", http.MethodPost, "/eth/v1/validator/liveness/1", `{}`, http.StatusBadRequest},
+		{"wrong method", http.MethodGet, "/eth/v1/validator/liveness/1", "", http.StatusMethodNotAllowed},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			bs.LivenessHandler(rec, httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+			assert.Equal(t, tt.wantCode, rec.Code, rec.Body.String())
+		})
+	}
+
+	t.Run("head behind", func(t *testing.T) {
+		laterSlot := 2*params.BeaconConfig().SlotsPerEpoch + 1
+		behind := &Server{
+			BeaconDB:           beaconDB,
+			ChainInfoFetcher:   &chainMock.ChainService{State: st},
+			GenesisTimeFetcher: &chainMock.ChainService{Slot: &laterSlot},
+		}
+		rec := httptest.NewRecorder()
+		behind.LivenessHandler(rec, httptest.NewRequest(http.MethodPost, "/eth/v1/validator/liveness/2", strings.NewReader(`["1"]`)))
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code, rec.Body.String())
+		assert.Equal(t, true, strings.Contains(rec.Body.String(), "the node may be syncing"), rec.Body.String())
+	})
+}
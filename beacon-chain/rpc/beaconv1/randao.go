@@ -0,0 +1,119 @@
+package beaconv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// randaoPath is the path template of the randao endpoint.
+const randaoPath = "/eth/v1/beacon/states/{state_id}/randao"
+
+type randaoResponse struct {
+	Data struct {
+		Randao string `json:"randao"`
+	} `json:"data"`
+}
+
+// RandaoHandler serves GET /eth/v1/beacon/states/{state_id}/randao?epoch={epoch}, which
+// returns the randao mix of the state at the requested epoch, or at the state's epoch
+// when none is requested.
+func (bs *Server) RandaoHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	stateId := pathParam(randaoPath, r, "state_id")
+	if stateId == "" {
+		http.NotFound(w, r)
+		return
+	}
+	rawStateId := []byte(stateId)
+	if strings.HasPrefix(stateId, "0x") {
+		root, err := hexutil.Decode(stateId)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Invalid state ID: %q", stateId), http.StatusBadRequest)
+			return
+		}
+		rawStateId = root
+	}
+	var epoch *types.Epoch
+	if e := r.URL.Query().Get("epoch"); e != "" {
+		parsed, err := strconv.ParseUint(e, 10, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Invalid epoch: %q", e), http.StatusBadRequest)
+			return
+		}
+		requested := types.Epoch(parsed)
+		epoch = &requested
+	}
+
+	mix, err := bs.randaoMix(r.Context(), rawStateId, epoch)
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), httpStatus(err))
+		return
+	}
+	resp := &randaoResponse{}
+	resp.Data.Randao = hexutil.Encode(mix)
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.WithError(err).Error("Could not write randao response")
+	}
+}
+
+// randaoMix returns the randao mix of the state at the given epoch, which defaults to the
+// state's epoch. The state only holds the mixes of its last EPOCHS_PER_HISTORICAL_VECTOR epochs.
+func (bs *Server) randaoMix(ctx context.Context, stateId []byte, epoch *types.Epoch) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "beaconv1.randaoMix")
+	defer span.End()
+
+	state, err := bs.state(ctx, stateId)
+	if err != nil {
+		return nil, err
+	}
+	stateEpoch := helpers.CurrentEpoch(state)
+	if epoch == nil {
+		epoch = &stateEpoch
+	}
+	var minEpoch types.Epoch
+	if stateEpoch+1 > params.BeaconConfig().EpochsPerHistoricalVector {
+		minEpoch = stateEpoch + 1 - params.BeaconConfig().EpochsPerHistoricalVector
+	}
+	if *epoch < minEpoch || *epoch > stateEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Epoch %d is out of range for the randao mixes of the state at epoch %d, it must be between %d and %d",
+			*epoch, stateEpoch, minEpoch, stateEpoch,
+		)
+	}
+	mix, err := helpers.RandaoMix(state, *epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get randao mix: %v", err)
+	}
+	return mix, nil
+}
+
+// httpStatus maps the gRPC status of an error to the matching HTTP status code.
+func httpStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,83 @@
+package beaconv1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestRandaoHandler(t *testing.T) {
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	epoch := params.BeaconConfig().EpochsPerHistoricalVector + 10
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch))))
+	mixes := make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector)
+	for i := range mixes {
+		mixes[i] = bytesutil.PadTo(bytesutil.Bytes8(uint64(i)), 32)
+	}
+	require.NoError(t, st.SetRandaoMixes(mixes))
+	bs := &Server{ChainInfoFetcher: &chainMock.ChainService{State: st}}
+
+	tests := []struct {
+		name     string
+		path     string
+		wantCode int
+		wantMix  []byte
+	}{
+		{
+			name:     "state epoch",
+			path:     "/eth/v1/beacon/states/head/randao",
+			wantCode: http.StatusOK,
+			wantMix:  mixes[epoch%params.BeaconConfig().EpochsPerHistoricalVector],
+		},
+		{
+			name:     "requested epoch",
+			path:     "/eth/v1/beacon/states/head/randao?epoch=20",
+			wantCode: http.StatusOK,
+			wantMix:  mixes[20],
+		},
+		{
+			name:     "epoch too old",
+			path:     "/eth/v1/beacon/states/head/randao?epoch=10",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "future epoch",
+			path:     "/eth/v1/beacon/states/head/randao?epoch=" + strconv.FormatUint(uint64(epoch+1), 10),
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "malformed epoch",
+			path:     "/eth/v1/beacon/states/head/randao?epoch=foo",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "other endpoint",
+			path:     "/eth/v1/beacon/states/head/root",
+			wantCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			bs.RandaoHandler(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			require.Equal(t, tt.wantCode, rec.Code, rec.Body.String())
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			resp := &randaoResponse{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+			assert.Equal(t, hexutil.Encode(tt.wantMix), resp.Data.Randao)
+		})
+	}
+}
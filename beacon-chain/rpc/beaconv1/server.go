@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
@@ -27,23 +28,25 @@ import (
 // providing RPC endpoints to access data relevant to the Ethereum 2.0 phase 0
 // beacon chain.
 type Server struct {
-	BeaconDB            db.ReadOnlyDatabase
-	Ctx                 context.Context
-	ChainStartFetcher   powchain.ChainStartFetcher
-	ChainInfoFetcher    blockchain.ChainInfoFetcher
-	DepositFetcher      depositcache.DepositFetcher
-	BlockFetcher        powchain.POWBlockFetcher
-	GenesisTimeFetcher  blockchain.TimeFetcher
-	BlockReceiver       blockchain.BlockReceiver
-	StateNotifier       statefeed.Notifier
-	BlockNotifier       blockfeed.Notifier
-	AttestationNotifier operation.Notifier
-	Broadcaster         p2p.Broadcaster
-	AttestationsPool    attestations.Pool
-	SlashingsPool       slashings.PoolManager
-	VoluntaryExitsPool  voluntaryexits.PoolManager
-	CanonicalStateChan  chan *pbp2p.BeaconState
-	ChainStartChan      chan time.Time
-	StateGenService     stategen.StateManager
-	SyncChecker         sync.Checker
+	BeaconDB             db.ReadOnlyDatabase
+	Ctx                  context.Context
+	ChainStartFetcher    powchain.ChainStartFetcher
+	ChainInfoFetcher     blockchain.ChainInfoFetcher
+	DepositFetcher       depositcache.DepositFetcher
+	BlockFetcher         powchain.POWBlockFetcher
+	GenesisTimeFetcher   blockchain.TimeFetcher
+	BlockReceiver        blockchain.BlockReceiver
+	StateNotifier        statefeed.Notifier
+	BlockNotifier        blockfeed.Notifier
+	AttestationNotifier  operation.Notifier
+	Broadcaster          p2p.Broadcaster
+	AttestationsPool     attestations.Pool
+	SlashingsPool        slashings.PoolManager
+	VoluntaryExitsPool   voluntaryexits.PoolManager
+	CanonicalStateChan   chan *pbp2p.BeaconState
+	ChainStartChan       chan time.Time
+	StateGenService      stategen.StateManager
+	SyncChecker          sync.Checker
+	HistoricalStateCache *cache.HistoricalStateCache
+	SlotRootCache        *cache.SlotRootCache
 }
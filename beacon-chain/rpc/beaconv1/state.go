@@ -315,10 +315,21 @@ func (bs *Server) stateBySlot(ctx context.Context, slot types.Slot) (iface.Beaco
 	if slot > currentSlot {
 		return nil, status.Errorf(codes.Internal, "Slot cannot be in the future")
 	}
+	// Only states before the head are cached, as the state at a later slot changes
+	// when a block is received for it.
+	cacheable := bs.HistoricalStateCache != nil && slot < bs.ChainInfoFetcher.HeadSlot()
+	if cacheable {
+		if state := bs.HistoricalStateCache.StateBySlot(slot); state != nil {
+			return state, nil
+		}
+	}
 	state, err := bs.StateGenService.StateBySlot(ctx, slot)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
 	}
+	if cacheable {
+		bs.HistoricalStateCache.AddState(slot, state)
+	}
 	return state, nil
 }
 
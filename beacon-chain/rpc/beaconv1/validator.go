@@ -3,18 +3,73 @@ package beaconv1
 import (
 	"context"
 	"errors"
+	"strconv"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetValidator returns a validator specified by state and id or public key along with status and balance.
 func (bs *Server) GetValidator(ctx context.Context, req *ethpb.StateValidatorRequest) (*ethpb.StateValidatorResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.GetValidator")
+	defer span.End()
+
+	if len(req.ValidatorId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Validator ID is required")
+	}
+	state, err := bs.state(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	containers, err := valContainersByRequestIds(state, [][]byte{req.ValidatorId})
+	if err != nil {
+		return nil, err
+	}
+	if len(containers) == 0 {
+		return nil, status.Error(codes.NotFound, "Could not find validator")
+	}
+	return &ethpb.StateValidatorResponse{Data: containers[0]}, nil
 }
 
 // ListValidators returns filterable list of validators with their balance, status and index.
 func (bs *Server) ListValidators(ctx context.Context, req *ethpb.StateValidatorsRequest) (*ethpb.StateValidatorsResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconv1.ListValidators")
+	defer span.End()
+
+	state, err := bs.state(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	containers, err := valContainersByRequestIds(state, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Status) == 0 {
+		return &ethpb.StateValidatorsResponse{Data: containers}, nil
+	}
+	filterStatus := make(map[ethpb.ValidatorStatus]bool, len(req.Status))
+	for _, st := range req.Status {
+		filterStatus[st] = true
+	}
+	filtered := make([]*ethpb.ValidatorContainer, 0, len(containers))
+	for _, c := range containers {
+		if filterStatus[c.Status] {
+			filtered = append(filtered, c)
+		}
+	}
+	return &ethpb.StateValidatorsResponse{Data: filtered}, nil
 }
 
 // ListValidatorBalances returns a filterable list of validator balances.
@@ -26,3 +81,112 @@ func (bs *Server) ListValidatorBalances(ctx context.Context, req *ethpb.Validato
 func (bs *Server) ListCommittees(ctx context.Context, req *ethpb.StateCommitteesRequest) (*ethpb.StateCommitteesResponse, error) {
 	return nil, errors.New("unimplemented")
 }
+
+// This returns the validator containers of the requested ids, which are either public keys
+// or validator indices. All validators are returned when no id is requested, and unknown
+// public keys and out of range indices are skipped.
+func valContainersByRequestIds(state iface.BeaconState, validatorIds [][]byte) ([]*ethpb.ValidatorContainer, error) {
+	epoch := helpers.CurrentEpoch(state)
+	if len(validatorIds) == 0 {
+		containers := make([]*ethpb.ValidatorContainer, 0, state.NumValidators())
+		for i := 0; i < state.NumValidators(); i++ {
+			c, err := valContainerAtIndex(state, types.ValidatorIndex(i), epoch)
+			if err != nil {
+				return nil, err
+			}
+			containers = append(containers, c)
+		}
+		return containers, nil
+	}
+
+	containers := make([]*ethpb.ValidatorContainer, 0, len(validatorIds))
+	for _, id := range validatorIds {
+		index, ok, err := validatorIndexById(state, id)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		c, err := valContainerAtIndex(state, index, epoch)
+		if err != nil {
+			return nil, err
+		}
+		containers = append(containers, c)
+	}
+	return containers, nil
+}
+
+// This resolves a validator id to its index in the state. The id is either a raw or 0x
+// prefixed hex public key, or a decimal validator index.
+func validatorIndexById(state iface.BeaconState, id []byte) (types.ValidatorIndex, bool, error) {
+	var pubkey []byte
+	switch {
+	case len(id) == params.BeaconConfig().BLSPubkeyLength:
+		pubkey = id
+	case strings.HasPrefix(string(id), "0x"):
+		decoded, err := hexutil.Decode(string(id))
+		if err != nil || len(decoded) != params.BeaconConfig().BLSPubkeyLength {
+			return 0, false, status.Errorf(codes.InvalidArgument, "Invalid validator public key: %s", string(id))
+		}
+		pubkey = decoded
+	default:
+		index, err := strconv.ParseUint(string(id), 10, 64)
+		if err != nil {
+			return 0, false, status.Errorf(codes.InvalidArgument, "Invalid validator ID: %s", string(id))
+		}
+		if index >= uint64(state.NumValidators()) {
+			return 0, false, nil
+		}
+		return types.ValidatorIndex(index), true, nil
+	}
+	index, ok := state.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+	return index, ok, nil
+}
+
+func valContainerAtIndex(state iface.BeaconState, index types.ValidatorIndex, epoch types.Epoch) (*ethpb.ValidatorContainer, error) {
+	v, err := state.ValidatorAtIndex(index)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get validator: %v", err)
+	}
+	balance, err := state.BalanceAtIndex(index)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get validator balance: %v", err)
+	}
+	return &ethpb.ValidatorContainer{
+		Index:     index,
+		Balance:   balance,
+		Status:    validatorStatus(v, balance, epoch),
+		Validator: migration.V1Alpha1ValidatorToV1(v),
+	}, nil
+}
+
+// This returns the status of the validator at the given epoch, as defined by the
+// beacon node API.
+func validatorStatus(v *eth.Validator, balance uint64, epoch types.Epoch) ethpb.ValidatorStatus {
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	switch {
+	case v.ActivationEpoch > epoch:
+		if v.ActivationEligibilityEpoch == farFutureEpoch {
+			return ethpb.ValidatorStatus_PENDING_INITIALIZED
+		}
+		return ethpb.ValidatorStatus_PENDING_QUEUED
+	case epoch < v.ExitEpoch:
+		if v.ExitEpoch == farFutureEpoch {
+			return ethpb.ValidatorStatus_ACTIVE_ONGOING
+		}
+		if v.Slashed {
+			return ethpb.ValidatorStatus_ACTIVE_SLASHED
+		}
+		return ethpb.ValidatorStatus_ACTIVE_EXITING
+	case epoch < v.WithdrawableEpoch:
+		if v.Slashed {
+			return ethpb.ValidatorStatus_EXITED_SLASHED
+		}
+		return ethpb.ValidatorStatus_EXITED_UNSLASHED
+	case balance != 0:
+		return ethpb.ValidatorStatus_WITHDRAWAL_POSSIBLE
+	default:
+		return ethpb.ValidatorStatus_WITHDRAWAL_DONE
+	}
+}
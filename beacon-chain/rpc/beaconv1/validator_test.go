@@ -0,0 +1,215 @@
+package beaconv1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestGetValidator(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 8)
+	s := Server{ChainInfoFetcher: &chainMock.ChainService{State: st}}
+
+	t.Run("Hex public key", func(t *testing.T) {
+		pubkey := st.PubkeyAtIndex(3)
+		resp, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{
+			StateId:     []byte("head"),
+			ValidatorId: []byte(hexutil.Encode(pubkey[:])),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, types.ValidatorIndex(3), resp.Data.Index)
+		assert.DeepEqual(t, pubkey[:], resp.Data.Validator.Pubkey)
+		assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, resp.Data.Validator.EffectiveBalance)
+		assert.Equal(t, false, resp.Data.Validator.Slashed)
+		assert.Equal(t, params.BeaconConfig().FarFutureEpoch, resp.Data.Validator.ExitEpoch)
+		assert.Equal(t, ethpb.ValidatorStatus_ACTIVE_ONGOING, resp.Data.Status)
+	})
+
+	t.Run("Raw public key", func(t *testing.T) {
+		pubkey := st.PubkeyAtIndex(5)
+		resp, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{
+			StateId:     []byte("head"),
+			ValidatorId: pubkey[:],
+		})
+		require.NoError(t, err)
+		assert.Equal(t, types.ValidatorIndex(5), resp.Data.Index)
+	})
+
+	t.Run("Index", func(t *testing.T) {
+		resp, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{
+			StateId:     []byte("head"),
+			ValidatorId: []byte("7"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, types.ValidatorIndex(7), resp.Data.Index)
+	})
+
+	t.Run("Unknown public key", func(t *testing.T) {
+		_, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{
+			StateId:     []byte("head"),
+			ValidatorId: []byte(hexutil.Encode(make([]byte, 48))),
+		})
+		assert.ErrorContains(t, "Could not find validator", err)
+	})
+
+	t.Run("Malformed public key", func(t *testing.T) {
+		_, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{
+			StateId:     []byte("head"),
+			ValidatorId: []byte("0x1234"),
+		})
+		assert.ErrorContains(t, "Invalid validator public key", err)
+	})
+
+	t.Run("No ID", func(t *testing.T) {
+		_, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{StateId: []byte("head")})
+		assert.ErrorContains(t, "Validator ID is required", err)
+	})
+}
+
+func TestListValidators(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 8)
+	s := Server{ChainInfoFetcher: &chainMock.ChainService{State: st}}
+
+	t.Run("All validators", func(t *testing.T) {
+		resp, err := s.ListValidators(ctx, &ethpb.StateValidatorsRequest{StateId: []byte("head")})
+		require.NoError(t, err)
+		require.Equal(t, 8, len(resp.Data))
+		for i, c := range resp.Data {
+			assert.Equal(t, types.ValidatorIndex(i), c.Index)
+		}
+	})
+
+	t.Run("Batch of public keys and indices", func(t *testing.T) {
+		pubkey1 := st.PubkeyAtIndex(1)
+		pubkey4 := st.PubkeyAtIndex(4)
+		resp, err := s.ListValidators(ctx, &ethpb.StateValidatorsRequest{
+			StateId: []byte("head"),
+			Id: [][]byte{
+				[]byte(hexutil.Encode(pubkey4[:])),
+				[]byte(hexutil.Encode(make([]byte, 48))),
+				pubkey1[:],
+				[]byte("6"),
+				[]byte("100"),
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, len(resp.Data))
+		assert.Equal(t, types.ValidatorIndex(4), resp.Data[0].Index)
+		assert.Equal(t, types.ValidatorIndex(1), resp.Data[1].Index)
+		assert.Equal(t, types.ValidatorIndex(6), resp.Data[2].Index)
+	})
+
+	t.Run("Malformed ID", func(t *testing.T) {
+		_, err := s.ListValidators(ctx, &ethpb.StateValidatorsRequest{
+			StateId: []byte("head"),
+			Id:      [][]byte{[]byte("foo")},
+		})
+		assert.ErrorContains(t, "Invalid validator ID", err)
+	})
+}
+
+func TestValidatorStatus(t *testing.T) {
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	tests := []struct {
+		name      string
+		validator *eth.Validator
+		balance   uint64
+		want      ethpb.ValidatorStatus
+	}{
+		{
+			name: "pending initialized",
+			validator: &eth.Validator{
+				ActivationEligibilityEpoch: farFutureEpoch,
+				ActivationEpoch:            farFutureEpoch,
+			},
+			want: ethpb.ValidatorStatus_PENDING_INITIALIZED,
+		},
+		{
+			name: "pending queued",
+			validator: &eth.Validator{
+				ActivationEligibilityEpoch: 5,
+				ActivationEpoch:            15,
+			},
+			want: ethpb.ValidatorStatus_PENDING_QUEUED,
+		},
+		{
+			name: "active ongoing",
+			validator: &eth.Validator{
+				ActivationEpoch: 5,
+				ExitEpoch:       farFutureEpoch,
+			},
+			want: ethpb.ValidatorStatus_ACTIVE_ONGOING,
+		},
+		{
+			name: "active exiting",
+			validator: &eth.Validator{
+				ActivationEpoch: 5,
+				ExitEpoch:       15,
+			},
+			want: ethpb.ValidatorStatus_ACTIVE_EXITING,
+		},
+		{
+			name: "active slashed",
+			validator: &eth.Validator{
+				ActivationEpoch: 5,
+				ExitEpoch:       15,
+				Slashed:         true,
+			},
+			want: ethpb.ValidatorStatus_ACTIVE_SLASHED,
+		},
+		{
+			name: "exited unslashed",
+			validator: &eth.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         5,
+				WithdrawableEpoch: 15,
+			},
+			want: ethpb.ValidatorStatus_EXITED_UNSLASHED,
+		},
+		{
+			name: "exited slashed",
+			validator: &eth.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         5,
+				WithdrawableEpoch: 15,
+				Slashed:           true,
+			},
+			want: ethpb.ValidatorStatus_EXITED_SLASHED,
+		},
+		{
+			name: "withdrawal possible",
+			validator: &eth.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         5,
+				WithdrawableEpoch: 8,
+			},
+			balance: 1,
+			want:    ethpb.ValidatorStatus_WITHDRAWAL_POSSIBLE,
+		},
+		{
+			name: "withdrawal done",
+			validator: &eth.Validator{
+				ActivationEpoch:   1,
+				ExitEpoch:         5,
+				WithdrawableEpoch: 8,
+			},
+			want: ethpb.ValidatorStatus_WITHDRAWAL_DONE,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validatorStatus(tt.validator, tt.balance, 10))
+		})
+	}
+}
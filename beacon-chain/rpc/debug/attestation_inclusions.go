@@ -0,0 +1,144 @@
+package debug
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxAttestationInclusionEpochs bounds the epoch range scanned for the attestation inclusions of
+// a validator, as every page reads all the blocks of the range.
+const maxAttestationInclusionEpochs = 32
+
+// ListValidatorAttestationInclusions returns the attestations of a validator which were included in
+// the canonical blocks of an epoch range, ordered by inclusion slot and then by attestation slot. An
+// attestation included in several blocks, or by several aggregates, is returned once per inclusion.
+//
+// This is read heavy: there is no index of the attestations by validator, so every page reads all the
+// blocks of the range from the database and loads the state of every attestation target to compute
+// the committees, which may require regenerating states for ranges far behind the head. The range is
+// therefore capped to maxAttestationInclusionEpochs epochs.
+func (ds *Server) ListValidatorAttestationInclusions(
+	ctx context.Context, req *pbrpc.ValidatorAttestationInclusionsRequest,
+) (*pbrpc.ValidatorAttestationInclusionsResponse, error) {
+	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested page size %d can not be greater than max size %d",
+			req.PageSize,
+			cmd.Get().MaxRPCPageSize,
+		)
+	}
+	if req.EndEpoch < req.StartEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "End epoch %d is before start epoch %d", req.EndEpoch, req.StartEpoch,
+		)
+	}
+	if req.EndEpoch-req.StartEpoch >= maxAttestationInclusionEpochs {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "Epoch range can not span more than %d epochs", maxAttestationInclusionEpochs,
+		)
+	}
+	currentEpoch := helpers.SlotToEpoch(ds.GenesisTimeFetcher.CurrentSlot())
+	if req.EndEpoch > currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve information about an epoch in the future, current epoch %d, requesting %d",
+			currentEpoch,
+			req.EndEpoch,
+		)
+	}
+
+	inclusions, err := ds.attestationInclusions(ctx, req.ValidatorIndex, req.StartEpoch, req.EndEpoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not find attestation inclusions: %v", err)
+	}
+	if len(inclusions) == 0 {
+		return &pbrpc.ValidatorAttestationInclusionsResponse{
+			Inclusions:    make([]*pbrpc.AttestationInclusion, 0),
+			TotalSize:     0,
+			NextPageToken: strconv.Itoa(0),
+		}, nil
+	}
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), len(inclusions))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not paginate results: %v", err)
+	}
+	return &pbrpc.ValidatorAttestationInclusionsResponse{
+		Inclusions:    inclusions[start:end],
+		NextPageToken: nextPageToken,
+		TotalSize:     int32(len(inclusions)),
+	}, nil
+}
+
+// attestationInclusions scans the canonical blocks of the epoch range for the attestations
+// of the validator.
+func (ds *Server) attestationInclusions(
+	ctx context.Context, idx types.ValidatorIndex, startEpoch, endEpoch types.Epoch,
+) ([]*pbrpc.AttestationInclusion, error) {
+	blks, roots, err := ds.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(startEpoch).SetEndEpoch(endEpoch))
+	if err != nil {
+		return nil, err
+	}
+	// The committees of an epoch are the same in all the states of the epoch, so one
+	// state is loaded for each attestation target.
+	targetStates := make(map[[32]byte]iface.ReadOnlyBeaconState)
+	inclusions := make([]*pbrpc.AttestationInclusion, 0)
+	for i, blk := range blks {
+		canonical, err := ds.CanonicalFetcher.IsCanonical(ctx, roots[i])
+		if err != nil {
+			return nil, err
+		}
+		if !canonical {
+			continue
+		}
+		for _, att := range blk.Block.Body.Attestations {
+			targetRoot := bytesutil.ToBytes32(att.Data.Target.Root)
+			st, ok := targetStates[targetRoot]
+			if !ok {
+				st, err = ds.StateGen.StateByRoot(ctx, targetRoot)
+				if err != nil {
+					return nil, err
+				}
+				targetStates[targetRoot] = st
+			}
+			committee, err := helpers.BeaconCommitteeFromState(st, att.Data.Slot, att.Data.CommitteeIndex)
+			if err != nil {
+				return nil, err
+			}
+			for j, v := range committee {
+				if v != idx {
+					continue
+				}
+				if uint64(j) < att.AggregationBits.Len() && att.AggregationBits.BitAt(uint64(j)) {
+					inclusions = append(inclusions, &pbrpc.AttestationInclusion{
+						AttestationSlot:   att.Data.Slot,
+						CommitteeIndex:    att.Data.CommitteeIndex,
+						InclusionSlot:     blk.Block.Slot,
+						BlockRoot:         bytesutil.SafeCopyBytes(roots[i][:]),
+						InclusionDistance: blk.Block.Slot - att.Data.Slot,
+					})
+				}
+				break
+			}
+		}
+	}
+	sort.Slice(inclusions, func(i, j int) bool {
+		if inclusions[i].InclusionSlot != inclusions[j].InclusionSlot {
+			return inclusions[i].InclusionSlot < inclusions[j].InclusionSlot
+		}
+		return inclusions[i].AttestationSlot < inclusions[j].AttestationSlot
+	})
+	return inclusions, nil
+}
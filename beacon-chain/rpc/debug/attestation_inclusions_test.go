@@ -0,0 +1,116 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListValidatorAttestationInclusions(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	helpers.ClearCache()
+	ctx := context.Background()
+
+	st, _ := testutil.DeterministicGenesisState(t, 256)
+	gBlk := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, gBlk))
+	gRoot, err := gBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, gRoot))
+
+	// With 256 validators, each slot of the epoch has a single committee.
+	committee, err := helpers.BeaconCommitteeFromState(st, 1, 0)
+	require.NoError(t, err)
+	idx := committee[3]
+	newAtt := func(bit uint64) *ethpb.Attestation {
+		bits := bitfield.NewBitlist(uint64(len(committee)))
+		bits.SetBitAt(bit, true)
+		return &ethpb.Attestation{
+			Data: &ethpb.AttestationData{
+				Slot:            1,
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Root: gRoot[:]},
+			},
+			AggregationBits: bits,
+			Signature:       make([]byte, 96),
+		}
+	}
+	canonicalRoots := make(map[[32]byte]bool)
+	for slot, atts := range map[types.Slot][]*ethpb.Attestation{
+		3: {newAtt(3), newAtt(4)},
+		4: {newAtt(4)},
+		5: {newAtt(3)},
+		6: {newAtt(3)},
+	} {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.Body.Attestations = atts
+		require.NoError(t, db.SaveBlock(ctx, blk))
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		// The block of slot 6 is on a fork.
+		if slot != 6 {
+			canonicalRoots[root] = true
+		}
+	}
+
+	currentSlot := types.Slot(6)
+	ds := &Server{
+		BeaconDB:           db,
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		CanonicalFetcher:   &mock.ChainService{CanonicalRoots: canonicalRoots},
+		StateGen:           stategen.New(db),
+	}
+	res, err := ds.ListValidatorAttestationInclusions(ctx, &pbrpc.ValidatorAttestationInclusionsRequest{
+		ValidatorIndex: idx,
+		PageSize:       1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), res.TotalSize)
+	assert.Equal(t, "1", res.NextPageToken)
+	require.Equal(t, 1, len(res.Inclusions))
+	assert.Equal(t, types.Slot(1), res.Inclusions[0].AttestationSlot)
+	assert.Equal(t, types.Slot(3), res.Inclusions[0].InclusionSlot)
+	assert.Equal(t, types.Slot(2), res.Inclusions[0].InclusionDistance)
+
+	res, err = ds.ListValidatorAttestationInclusions(ctx, &pbrpc.ValidatorAttestationInclusionsRequest{
+		ValidatorIndex: idx,
+		PageSize:       1,
+		PageToken:      res.NextPageToken,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "", res.NextPageToken)
+	require.Equal(t, 1, len(res.Inclusions))
+	assert.Equal(t, types.Slot(5), res.Inclusions[0].InclusionSlot)
+	assert.Equal(t, types.Slot(4), res.Inclusions[0].InclusionDistance)
+}
+
+func TestServer_ListValidatorAttestationInclusions_InvalidRange(t *testing.T) {
+	currentSlot := types.Slot(0)
+	ds := &Server{GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot}}
+	_, err := ds.ListValidatorAttestationInclusions(context.Background(), &pbrpc.ValidatorAttestationInclusionsRequest{
+		StartEpoch: 2,
+		EndEpoch:   1,
+	})
+	assert.ErrorContains(t, "End epoch 1 is before start epoch 2", err)
+	_, err = ds.ListValidatorAttestationInclusions(context.Background(), &pbrpc.ValidatorAttestationInclusionsRequest{
+		EndEpoch: maxAttestationInclusionEpochs,
+	})
+	assert.ErrorContains(t, "Epoch range can not span more than", err)
+	_, err = ds.ListValidatorAttestationInclusions(context.Background(), &pbrpc.ValidatorAttestationInclusionsRequest{
+		EndEpoch: 1,
+	})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future", err)
+}
@@ -0,0 +1,102 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strconv"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPoolAttestations returns summaries of the aggregated and unaggregated attestations currently
+// in the attestation pool, optionally filtered by slot and committee index. The attestations are
+// ordered by slot, committee index and data root, with the aggregated ones first.
+func (ds *Server) ListPoolAttestations(
+	_ context.Context, req *pbrpc.AttestationPoolRequest,
+) (*pbrpc.AttestationPoolResponse, error) {
+	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested page size %d can not be greater than max size %d",
+			req.PageSize,
+			cmd.Get().MaxRPCPageSize,
+		)
+	}
+	if ds.AttestationsPool == nil {
+		return nil, status.Error(codes.Unavailable, "Attestation pool is not available")
+	}
+
+	unaggregated, err := ds.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get unaggregated attestations: %v", err)
+	}
+	pooled := make([]*pbrpc.PooledAttestation, 0)
+	for _, atts := range []struct {
+		list       []*ethpb.Attestation
+		aggregated bool
+	}{
+		{list: ds.AttestationsPool.AggregatedAttestations(), aggregated: true},
+		{list: unaggregated, aggregated: false},
+	} {
+		for _, att := range atts.list {
+			if req.FilterBySlot && att.Data.Slot != req.Slot {
+				continue
+			}
+			if req.FilterByCommitteeIndex && att.Data.CommitteeIndex != req.CommitteeIndex {
+				continue
+			}
+			dataRoot, err := att.Data.HashTreeRoot()
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not hash attestation data: %v", err)
+			}
+			pooled = append(pooled, &pbrpc.PooledAttestation{
+				Slot:           att.Data.Slot,
+				CommitteeIndex: att.Data.CommitteeIndex,
+				DataRoot:       dataRoot[:],
+				Aggregated:     atts.aggregated,
+				Participants:   att.AggregationBits.Count(),
+				CommitteeSize:  att.AggregationBits.Len(),
+			})
+		}
+	}
+	if len(pooled) == 0 {
+		return &pbrpc.AttestationPoolResponse{
+			Attestations:  make([]*pbrpc.PooledAttestation, 0),
+			TotalSize:     0,
+			NextPageToken: strconv.Itoa(0),
+		}, nil
+	}
+	// The pool is backed by maps, so the attestations are sorted to keep pages consistent
+	// across calls.
+	sort.SliceStable(pooled, func(i, j int) bool {
+		a, b := pooled[i], pooled[j]
+		if a.Slot != b.Slot {
+			return a.Slot < b.Slot
+		}
+		if a.CommitteeIndex != b.CommitteeIndex {
+			return a.CommitteeIndex < b.CommitteeIndex
+		}
+		if c := bytes.Compare(a.DataRoot, b.DataRoot); c != 0 {
+			return c < 0
+		}
+		if a.Aggregated != b.Aggregated {
+			return a.Aggregated
+		}
+		return a.Participants > b.Participants
+	})
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), len(pooled))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not paginate results: %v", err)
+	}
+	return &pbrpc.AttestationPoolResponse{
+		Attestations:  pooled[start:end],
+		NextPageToken: nextPageToken,
+		TotalSize:     int32(len(pooled)),
+	}, nil
+}
@@ -0,0 +1,107 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListPoolAttestations(t *testing.T) {
+	ctx := context.Background()
+	newAtt := func(slot types.Slot, committeeIndex types.CommitteeIndex, bits ...uint64) *ethpb.Attestation {
+		aggregationBits := bitfield.NewBitlist(8)
+		for _, bit := range bits {
+			aggregationBits.SetBitAt(bit, true)
+		}
+		return &ethpb.Attestation{
+			Data: &ethpb.AttestationData{
+				Slot:            slot,
+				CommitteeIndex:  committeeIndex,
+				BeaconBlockRoot: make([]byte, 32),
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			},
+			AggregationBits: aggregationBits,
+			Signature:       make([]byte, 96),
+		}
+	}
+	pool := attestations.NewPool()
+	require.NoError(t, pool.SaveAggregatedAttestations([]*ethpb.Attestation{
+		newAtt(2, 0, 0, 1, 2),
+		newAtt(1, 1, 3, 4),
+	}))
+	require.NoError(t, pool.SaveUnaggregatedAttestations([]*ethpb.Attestation{
+		newAtt(1, 0, 5),
+		newAtt(1, 1, 6),
+		newAtt(2, 1, 7),
+	}))
+	ds := &Server{AttestationsPool: pool}
+
+	res, err := ds.ListPoolAttestations(ctx, &pbrpc.AttestationPoolRequest{PageSize: 3})
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), res.TotalSize)
+	assert.Equal(t, "1", res.NextPageToken)
+	require.Equal(t, 3, len(res.Attestations))
+	dataRoot, err := newAtt(1, 0).Data.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, &pbrpc.PooledAttestation{
+		Slot:           1,
+		CommitteeIndex: 0,
+		DataRoot:       dataRoot[:],
+		Aggregated:     false,
+		Participants:   1,
+		CommitteeSize:  8,
+	}, res.Attestations[0])
+	// The aggregate comes before the unaggregated attestation with the same data.
+	assert.Equal(t, types.CommitteeIndex(1), res.Attestations[1].CommitteeIndex)
+	assert.Equal(t, true, res.Attestations[1].Aggregated)
+	assert.Equal(t, uint64(2), res.Attestations[1].Participants)
+	assert.Equal(t, false, res.Attestations[2].Aggregated)
+
+	res, err = ds.ListPoolAttestations(ctx, &pbrpc.AttestationPoolRequest{PageSize: 3, PageToken: "1"})
+	require.NoError(t, err)
+	assert.Equal(t, "", res.NextPageToken)
+	require.Equal(t, 2, len(res.Attestations))
+	assert.Equal(t, types.Slot(2), res.Attestations[0].Slot)
+	assert.Equal(t, uint64(3), res.Attestations[0].Participants)
+
+	res, err = ds.ListPoolAttestations(ctx, &pbrpc.AttestationPoolRequest{
+		Slot:                   1,
+		FilterBySlot:           true,
+		CommitteeIndex:         1,
+		FilterByCommitteeIndex: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), res.TotalSize)
+
+	res, err = ds.ListPoolAttestations(ctx, &pbrpc.AttestationPoolRequest{CommitteeIndex: 0, FilterByCommitteeIndex: true})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), res.TotalSize)
+
+	res, err = ds.ListPoolAttestations(ctx, &pbrpc.AttestationPoolRequest{Slot: 3, FilterBySlot: true})
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), res.TotalSize)
+	assert.Equal(t, 0, len(res.Attestations))
+}
+
+func TestServer_ListPoolAttestations_Errors(t *testing.T) {
+	ctx := context.Background()
+	ds := &Server{AttestationsPool: attestations.NewPool()}
+	req := &pbrpc.AttestationPoolRequest{PageSize: int32(cmd.Get().MaxRPCPageSize + 1)}
+	wanted := fmt.Sprintf("Requested page size %d can not be greater than max size %d", cmd.Get().MaxRPCPageSize+1, cmd.Get().MaxRPCPageSize)
+	_, err := ds.ListPoolAttestations(ctx, req)
+	assert.ErrorContains(t, wanted, err)
+
+	ds = &Server{}
+	_, err = ds.ListPoolAttestations(ctx, &pbrpc.AttestationPoolRequest{})
+	assert.ErrorContains(t, "Attestation pool is not available", err)
+}
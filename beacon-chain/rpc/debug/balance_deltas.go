@@ -0,0 +1,44 @@
+package debug
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetValidatorBalanceDeltas returns the balance changes of the tracked validators over the recent
+// epoch transitions, attributed to attestation rewards, proposer rewards and penalties.
+func (ds *Server) GetValidatorBalanceDeltas(
+	_ context.Context, req *pbrpc.ValidatorBalanceDeltasRequest,
+) (*pbrpc.ValidatorBalanceDeltasResponse, error) {
+	if ds.BalanceDeltasFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Balance deltas are not tracked")
+	}
+	requested := make(map[types.ValidatorIndex]bool, len(req.Indices))
+	for _, idx := range req.Indices {
+		requested[types.ValidatorIndex(idx)] = true
+	}
+
+	deltas := ds.BalanceDeltasFetcher.ValidatorBalanceDeltas()
+	resp := &pbrpc.ValidatorBalanceDeltasResponse{
+		Deltas: make([]*pbrpc.ValidatorBalanceDelta, 0, len(deltas)),
+	}
+	for _, d := range deltas {
+		if len(requested) > 0 && !requested[d.ValidatorIndex] {
+			continue
+		}
+		resp.Deltas = append(resp.Deltas, &pbrpc.ValidatorBalanceDelta{
+			ValidatorIndex:    d.ValidatorIndex,
+			Epoch:             d.Epoch,
+			BalanceBefore:     d.BalanceBefore,
+			BalanceAfter:      d.BalanceAfter,
+			AttestationReward: d.AttestationReward,
+			ProposerReward:    d.ProposerReward,
+			Penalty:           d.Penalty,
+		})
+	}
+	return resp, nil
+}
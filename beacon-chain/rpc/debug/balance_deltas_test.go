@@ -0,0 +1,38 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockBalanceDeltasFetcher []*blockchain.ValidatorBalanceDelta
+
+func (m mockBalanceDeltasFetcher) ValidatorBalanceDeltas() []*blockchain.ValidatorBalanceDelta {
+	return m
+}
+
+func TestServer_GetValidatorBalanceDeltas(t *testing.T) {
+	bs := &Server{BalanceDeltasFetcher: mockBalanceDeltasFetcher{
+		{ValidatorIndex: 1, Epoch: 5, BalanceBefore: 100, BalanceAfter: 110, AttestationReward: 12, Penalty: 2},
+		{ValidatorIndex: 2, Epoch: 5, BalanceBefore: 100, BalanceAfter: 95, Penalty: 5},
+		{ValidatorIndex: 1, Epoch: 6, BalanceBefore: 110, BalanceAfter: 130, ProposerReward: 20},
+	}}
+
+	res, err := bs.GetValidatorBalanceDeltas(context.Background(), &pbrpc.ValidatorBalanceDeltasRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(res.Deltas))
+
+	res, err = bs.GetValidatorBalanceDeltas(context.Background(), &pbrpc.ValidatorBalanceDeltasRequest{Indices: []uint64{1}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Deltas))
+	assert.Equal(t, types.Epoch(5), res.Deltas[0].Epoch)
+	assert.Equal(t, uint64(12), res.Deltas[0].AttestationReward)
+	assert.Equal(t, types.Epoch(6), res.Deltas[1].Epoch)
+	assert.Equal(t, uint64(20), res.Deltas[1].ProposerReward)
+}
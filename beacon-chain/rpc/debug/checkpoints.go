@@ -0,0 +1,35 @@
+package debug
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetCheckpointHistory returns the changes of the justified and finalized checkpoints observed by
+// the node, from the oldest to the most recent one kept in memory.
+func (ds *Server) GetCheckpointHistory(_ context.Context, _ *ptypes.Empty) (*pbrpc.CheckpointHistoryResponse, error) {
+	if ds.CheckpointHistoryFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Checkpoint history is not recorded")
+	}
+	records := ds.CheckpointHistoryFetcher.CheckpointHistory()
+	resp := &pbrpc.CheckpointHistoryResponse{
+		Entries: make([]*pbrpc.CheckpointHistoryEntry, len(records)),
+	}
+	for i, r := range records {
+		resp.Entries[i] = &pbrpc.CheckpointHistoryEntry{
+			Timestamp:         uint64(r.Time.UnixNano() / 1e6),
+			Slot:              r.Slot,
+			JustifiedEpoch:    r.Justified.Epoch,
+			JustifiedRoot:     bytesutil.SafeCopyBytes(r.Justified.Root),
+			FinalizedEpoch:    r.Finalized.Epoch,
+			FinalizedRoot:     bytesutil.SafeCopyBytes(r.Finalized.Root),
+			JustificationBits: bytesutil.SafeCopyBytes(r.JustificationBits),
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,55 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockCheckpointHistoryFetcher []*blockchain.CheckpointRecord
+
+func (m mockCheckpointHistoryFetcher) CheckpointHistory() []*blockchain.CheckpointRecord {
+	return m
+}
+
+func TestServer_GetCheckpointHistory(t *testing.T) {
+	_, err := (&Server{}).GetCheckpointHistory(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "Checkpoint history is not recorded", err)
+
+	now := time.Unix(1600000000, 500*int64(time.Millisecond))
+	bs := &Server{CheckpointHistoryFetcher: mockCheckpointHistoryFetcher{
+		{
+			Time:      now,
+			Slot:      64,
+			Justified: &ethpb.Checkpoint{Epoch: 1, Root: []byte{'a'}},
+			Finalized: &ethpb.Checkpoint{Epoch: 0, Root: []byte{'g'}},
+		},
+		{
+			Time:              now.Add(time.Minute),
+			Slot:              96,
+			Justified:         &ethpb.Checkpoint{Epoch: 2, Root: []byte{'b'}},
+			Finalized:         &ethpb.Checkpoint{Epoch: 1, Root: []byte{'a'}},
+			JustificationBits: bitfield.Bitvector4{0x03},
+		},
+	}}
+	res, err := bs.GetCheckpointHistory(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Entries))
+	assert.Equal(t, uint64(1600000000500), res.Entries[0].Timestamp)
+	assert.Equal(t, types.Slot(64), res.Entries[0].Slot)
+	assert.Equal(t, 0, len(res.Entries[0].JustificationBits))
+	assert.Equal(t, uint64(1600000060500), res.Entries[1].Timestamp)
+	assert.Equal(t, types.Epoch(2), res.Entries[1].JustifiedEpoch)
+	assert.DeepEqual(t, []byte{'b'}, res.Entries[1].JustifiedRoot)
+	assert.Equal(t, types.Epoch(1), res.Entries[1].FinalizedEpoch)
+	assert.DeepEqual(t, []byte{'a'}, res.Entries[1].FinalizedRoot)
+	assert.DeepEqual(t, []byte{0x03}, res.Entries[1].JustificationBits)
+}
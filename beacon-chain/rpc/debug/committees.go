@@ -0,0 +1,92 @@
+package debug
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListCommitteeAssignments returns the beacon committees of an epoch with their validator indices,
+// ordered by slot and then by committee index. The committees are computed from the state at the
+// start of the epoch, and only the ones of the requested page are computed. The shuffling of the
+// epoch is kept in the committee cache, so that the following pages reuse it.
+func (ds *Server) ListCommitteeAssignments(
+	ctx context.Context, req *pbrpc.CommitteeAssignmentsRequest,
+) (*pbrpc.CommitteeAssignmentsResponse, error) {
+	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested page size %d can not be greater than max size %d",
+			req.PageSize,
+			cmd.Get().MaxRPCPageSize,
+		)
+	}
+	currentEpoch := helpers.SlotToEpoch(ds.GenesisTimeFetcher.CurrentSlot())
+	if req.Epoch > currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve information about an epoch in the future, current epoch %d, requesting %d",
+			currentEpoch,
+			req.Epoch,
+		)
+	}
+
+	startSlot, err := helpers.StartSlot(req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute start slot: %v", err)
+	}
+	requestedState, err := ds.StateGen.StateBySlot(ctx, startSlot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
+	}
+	seed, err := helpers.Seed(requestedState, req.Epoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get seed: %v", err)
+	}
+	// Computing the active indices adds the shuffling of the epoch to the committee cache if missing.
+	activeIndices, err := helpers.ActiveValidatorIndices(requestedState, req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active indices: %v", err)
+	}
+
+	committeesPerSlot := helpers.SlotCommitteeCount(uint64(len(activeIndices)))
+	totalSize := int(uint64(params.BeaconConfig().SlotsPerEpoch) * committeesPerSlot)
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), totalSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not paginate results: %v", err)
+	}
+
+	committees := make([]*pbrpc.EpochCommittee, 0, end-start)
+	for i := uint64(start); i < uint64(end); i++ {
+		slot := startSlot + types.Slot(i/committeesPerSlot)
+		committeeIndex := types.CommitteeIndex(i % committeesPerSlot)
+		committee, err := helpers.BeaconCommittee(activeIndices, seed, slot, committeeIndex)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute committee for slot %d: %v", slot, err)
+		}
+		indices := make([]uint64, len(committee))
+		for j, idx := range committee {
+			indices[j] = uint64(idx)
+		}
+		committees = append(committees, &pbrpc.EpochCommittee{
+			Slot:             slot,
+			CommitteeIndex:   committeeIndex,
+			ValidatorIndices: indices,
+		})
+	}
+
+	return &pbrpc.CommitteeAssignmentsResponse{
+		Epoch:                req.Epoch,
+		Committees:           committees,
+		NextPageToken:        nextPageToken,
+		TotalSize:            int32(totalSize),
+		ActiveValidatorCount: uint64(len(activeIndices)),
+	}, nil
+}
@@ -0,0 +1,76 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListCommitteeAssignments(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	helpers.ClearCache()
+	ctx := context.Background()
+
+	st, _ := testutil.DeterministicGenesisState(t, 256)
+	b := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, b))
+	gRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, gRoot))
+	require.NoError(t, db.SaveState(ctx, st, gRoot))
+	currentSlot := types.Slot(0)
+	bs := &Server{
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		StateGen:           stategen.New(db),
+	}
+
+	// With 256 validators, each slot of the epoch has a single committee.
+	res, err := bs.ListCommitteeAssignments(ctx, &pbrpc.CommitteeAssignmentsRequest{PageSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int32(32), res.TotalSize)
+	assert.Equal(t, uint64(256), res.ActiveValidatorCount)
+	assert.Equal(t, "1", res.NextPageToken)
+	require.Equal(t, 10, len(res.Committees))
+	for i, c := range res.Committees {
+		assert.Equal(t, types.Slot(i), c.Slot)
+		assert.Equal(t, types.CommitteeIndex(0), c.CommitteeIndex)
+		committee, err := helpers.BeaconCommitteeFromState(st, c.Slot, c.CommitteeIndex)
+		require.NoError(t, err)
+		require.Equal(t, len(committee), len(c.ValidatorIndices))
+		for j, idx := range committee {
+			assert.Equal(t, uint64(idx), c.ValidatorIndices[j])
+		}
+	}
+
+	res, err = bs.ListCommitteeAssignments(ctx, &pbrpc.CommitteeAssignmentsRequest{PageSize: 10, PageToken: "3"})
+	require.NoError(t, err)
+	assert.Equal(t, "", res.NextPageToken)
+	require.Equal(t, 2, len(res.Committees))
+	assert.Equal(t, types.Slot(30), res.Committees[0].Slot)
+	assert.Equal(t, types.Slot(31), res.Committees[1].Slot)
+}
+
+func TestServer_ListCommitteeAssignments_InvalidRequest(t *testing.T) {
+	currentSlot := types.Slot(0)
+	bs := &Server{GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot}}
+
+	_, err := bs.ListCommitteeAssignments(context.Background(), &pbrpc.CommitteeAssignmentsRequest{
+		PageSize: int32(cmd.Get().MaxRPCPageSize + 1),
+	})
+	wanted := fmt.Sprintf("Requested page size %d can not be greater than max size %d", cmd.Get().MaxRPCPageSize+1, cmd.Get().MaxRPCPageSize)
+	assert.ErrorContains(t, wanted, err)
+
+	_, err = bs.ListCommitteeAssignments(context.Background(), &pbrpc.CommitteeAssignmentsRequest{Epoch: 1})
+	assert.ErrorContains(t, "Cannot retrieve information about an epoch in the future, current epoch 0, requesting 1", err)
+}
@@ -0,0 +1,44 @@
+package debug
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetAttestationEffectiveness returns the attestation effectiveness scores of the tracked validators
+// over the recent epochs. See blockchain.AttestationEffectiveness for the scoring formula.
+func (ds *Server) GetAttestationEffectiveness(
+	_ context.Context, req *pbrpc.AttestationEffectivenessRequest,
+) (*pbrpc.AttestationEffectivenessResponse, error) {
+	if ds.EffectivenessFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Attestation effectiveness is not tracked")
+	}
+	requested := make(map[types.ValidatorIndex]bool, len(req.Indices))
+	for _, idx := range req.Indices {
+		requested[types.ValidatorIndex(idx)] = true
+	}
+
+	scores := ds.EffectivenessFetcher.AttestationEffectiveness()
+	resp := &pbrpc.AttestationEffectivenessResponse{
+		Scores: make([]*pbrpc.AttestationEffectiveness, 0, len(scores)),
+	}
+	for _, e := range scores {
+		if len(requested) > 0 && !requested[e.ValidatorIndex] {
+			continue
+		}
+		resp.Scores = append(resp.Scores, &pbrpc.AttestationEffectiveness{
+			ValidatorIndex:    e.ValidatorIndex,
+			Epoch:             e.Epoch,
+			Included:          e.Included,
+			CorrectTarget:     e.CorrectTarget,
+			CorrectHead:       e.CorrectHead,
+			InclusionDistance: e.InclusionDistance,
+			Score:             float32(e.Score),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,41 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockEffectivenessFetcher []*blockchain.AttestationEffectiveness
+
+func (m mockEffectivenessFetcher) AttestationEffectiveness() []*blockchain.AttestationEffectiveness {
+	return m
+}
+
+func TestServer_GetAttestationEffectiveness(t *testing.T) {
+	bs := &Server{}
+	_, err := bs.GetAttestationEffectiveness(context.Background(), &pbrpc.AttestationEffectivenessRequest{})
+	assert.ErrorContains(t, "Attestation effectiveness is not tracked", err)
+
+	bs.EffectivenessFetcher = mockEffectivenessFetcher{
+		{ValidatorIndex: 1, Epoch: 5, Included: true, CorrectTarget: true, CorrectHead: true, InclusionDistance: 1, Score: 1},
+		{ValidatorIndex: 2, Epoch: 5},
+		{ValidatorIndex: 1, Epoch: 6, Included: true, InclusionDistance: 2, Score: 0.375},
+	}
+	res, err := bs.GetAttestationEffectiveness(context.Background(), &pbrpc.AttestationEffectivenessRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(res.Scores))
+
+	res, err = bs.GetAttestationEffectiveness(context.Background(), &pbrpc.AttestationEffectivenessRequest{Indices: []uint64{1}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Scores))
+	assert.Equal(t, types.Epoch(5), res.Scores[0].Epoch)
+	assert.Equal(t, float32(1), res.Scores[0].Score)
+	assert.Equal(t, types.Slot(2), res.Scores[1].InclusionDistance)
+	assert.Equal(t, float32(0.375), res.Scores[1].Score)
+}
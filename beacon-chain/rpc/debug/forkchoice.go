@@ -6,6 +6,8 @@ import (
 
 	ptypes "github.com/gogo/protobuf/types"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetProtoArrayForkChoice returns proto array fork choice store.
@@ -42,3 +44,13 @@ func (ds *Server) GetProtoArrayForkChoice(_ context.Context, _ *ptypes.Empty) (*
 		Indices:         indices,
 	}, nil
 }
+
+// UpdateHead runs fork choice immediately, accounting for the attestations currently in the pool,
+// instead of waiting for the next slot tick. It returns the resulting head root.
+func (ds *Server) UpdateHead(ctx context.Context, _ *ptypes.Empty) (*pbrpc.UpdateHeadResponse, error) {
+	headRoot, err := ds.HeadUpdater.UpdateHead(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not update head: %v", err)
+	}
+	return &pbrpc.UpdateHeadResponse{HeadRoot: headRoot}, nil
+}
@@ -137,6 +137,14 @@ func (ds *Server) getPeer(pid peer.ID) (*pbrpc.DebugPeerResponse, error) {
 		BehaviourPenalty:   float32(bPenalty),
 		ValidationError:    errorToString(peers.Scorers().ValidationError(pid)),
 	}
+	var location *pbrpc.PeerLocation
+	if loc := peers.Location(pid); loc != nil {
+		location = &pbrpc.PeerLocation{
+			Asn:            loc.ASN,
+			AsOrganization: loc.Organization,
+			Country:        loc.Country,
+		}
+	}
 	return &pbrpc.DebugPeerResponse{
 		ListeningAddresses: stringAddrs,
 		Direction:          pbDirection,
@@ -147,6 +155,7 @@ func (ds *Server) getPeer(pid peer.ID) (*pbrpc.DebugPeerResponse, error) {
 		PeerStatus:         pStatus,
 		LastUpdated:        unixTime,
 		ScoreInfo:          scoreInfo,
+		Location:           location,
 	}, nil
 }
 
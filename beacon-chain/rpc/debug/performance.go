@@ -0,0 +1,182 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch/precompute"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxPerformanceLookbackEpochs bounds the window of a performance summary, as each epoch
+// missing from the cache requires regenerating two states.
+const maxPerformanceLookbackEpochs = 32
+
+// GetValidatorPerformanceSummary returns the participation rate, missed attestations, missed proposals
+// and average inclusion distance of the given validators over the most recent epochs whose attestations
+// can no longer be included in blocks.
+func (ds *Server) GetValidatorPerformanceSummary(
+	ctx context.Context, req *pbrpc.ValidatorPerformanceSummaryRequest,
+) (*pbrpc.ValidatorPerformanceSummaryResponse, error) {
+	if len(req.Indices) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Must request at least one validator index")
+	}
+	if req.LookbackEpochs == 0 || req.LookbackEpochs > maxPerformanceLookbackEpochs {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "Lookback must be between 1 and %d epochs", maxPerformanceLookbackEpochs,
+		)
+	}
+	// The attestations of an epoch can be included until the end of the next epoch.
+	headEpoch := helpers.SlotToEpoch(ds.HeadFetcher.HeadSlot())
+	if headEpoch < 2 {
+		return nil, status.Error(codes.FailedPrecondition, "No epoch can have all its attestations included yet")
+	}
+	endEpoch := headEpoch - 2
+	startEpoch := types.Epoch(0)
+	if uint64(endEpoch) >= req.LookbackEpochs {
+		startEpoch = endEpoch + 1 - types.Epoch(req.LookbackEpochs)
+	}
+
+	summaries := make([]*pbrpc.ValidatorPerformanceSummary, len(req.Indices))
+	inclusionDistances := make([]types.Slot, len(req.Indices))
+	for i, idx := range req.Indices {
+		summaries[i] = &pbrpc.ValidatorPerformanceSummary{ValidatorIndex: types.ValidatorIndex(idx)}
+	}
+	for epoch := startEpoch; epoch <= endEpoch; epoch++ {
+		p, err := ds.epochPerformance(ctx, epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute performance of epoch %d: %v", epoch, err)
+		}
+		for i, s := range summaries {
+			idx := s.ValidatorIndex
+			s.AssignedProposals += p.AssignedProposals[idx]
+			s.MissedProposals += p.MissedProposals[idx]
+			if uint64(idx) >= uint64(len(p.Active)) || !p.Active[idx] {
+				continue
+			}
+			s.ActiveEpochs++
+			if p.InclusionDistances[idx] == 0 {
+				s.MissedAttestations++
+				continue
+			}
+			inclusionDistances[i] += p.InclusionDistances[idx]
+		}
+	}
+	for i, s := range summaries {
+		if s.ActiveEpochs == 0 {
+			continue
+		}
+		included := s.ActiveEpochs - s.MissedAttestations
+		s.ParticipationRate = float32(included) / float32(s.ActiveEpochs)
+		if included > 0 {
+			s.AverageInclusionDistance = float32(inclusionDistances[i]) / float32(included)
+		}
+	}
+
+	return &pbrpc.ValidatorPerformanceSummaryResponse{
+		StartEpoch: startEpoch,
+		EndEpoch:   endEpoch,
+		Summaries:  summaries,
+	}, nil
+}
+
+// epochPerformance returns the performance record of the validators during the given epoch,
+// computing it from the canonical states if it is not cached.
+func (ds *Server) epochPerformance(ctx context.Context, epoch types.Epoch) (*cache.EpochPerformance, error) {
+	if ds.ValidatorPerformanceCache != nil {
+		if p, ok := ds.ValidatorPerformanceCache.EpochPerformance(epoch); ok {
+			return p, nil
+		}
+	}
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, err
+	}
+	dutiesState, err := ds.StateGen.StateBySlot(ctx, startSlot)
+	if err != nil {
+		return nil, err
+	}
+	// The last slot of the next epoch, before the epoch transition discards the attestations of the epoch.
+	inclusionSlot, err := helpers.EndSlot(epoch + 1)
+	if err != nil {
+		return nil, err
+	}
+	inclusionState, err := ds.StateGen.StateBySlot(ctx, inclusionSlot)
+	if err != nil {
+		return nil, err
+	}
+	p, err := computeEpochPerformance(ctx, epoch, dutiesState, inclusionState)
+	if err != nil {
+		return nil, err
+	}
+	if ds.ValidatorPerformanceCache != nil {
+		ds.ValidatorPerformanceCache.AddEpochPerformance(epoch, p)
+	}
+	return p, nil
+}
+
+// computeEpochPerformance records the duties of the validators during the given epoch. The proposers
+// are assigned with the state at the start of the epoch, while the included blocks and attestations
+// are read from a state of the next epoch, whose previous epoch attestations are those of the epoch.
+func computeEpochPerformance(
+	ctx context.Context, epoch types.Epoch, dutiesState, inclusionState iface.BeaconState,
+) (*cache.EpochPerformance, error) {
+	vp, bp, err := precompute.New(ctx, inclusionState)
+	if err != nil {
+		return nil, err
+	}
+	vp, _, err = precompute.ProcessAttestations(ctx, inclusionState, vp, bp)
+	if err != nil {
+		return nil, err
+	}
+	p := &cache.EpochPerformance{
+		Active:             make([]bool, len(vp)),
+		InclusionDistances: make([]types.Slot, len(vp)),
+		AssignedProposals:  make(map[types.ValidatorIndex]uint64),
+		MissedProposals:    make(map[types.ValidatorIndex]uint64),
+	}
+	for i, v := range vp {
+		p.Active[i] = v.IsActivePrevEpoch
+		if v.IsPrevEpochAttester {
+			p.InclusionDistances[i] = v.InclusionDistance
+		}
+	}
+
+	_, proposerSlots, err := helpers.CommitteeAssignments(dutiesState, epoch)
+	if err != nil {
+		return nil, err
+	}
+	for idx, slots := range proposerSlots {
+		for _, slot := range slots {
+			p.AssignedProposals[idx]++
+			proposed, err := hasBlockAtSlot(inclusionState, slot)
+			if err != nil {
+				return nil, err
+			}
+			if !proposed {
+				p.MissedProposals[idx]++
+			}
+		}
+	}
+	return p, nil
+}
+
+// hasBlockAtSlot returns whether the chain of the state has a block at the given slot, that is
+// whether the block root recorded for the slot differs from the one of the previous slot.
+func hasBlockAtSlot(st iface.ReadOnlyBeaconState, slot types.Slot) (bool, error) {
+	root, err := helpers.BlockRootAtSlot(st, slot)
+	if err != nil {
+		return false, err
+	}
+	prevRoot, err := helpers.BlockRootAtSlot(st, slot-1)
+	if err != nil {
+		return false, err
+	}
+	return !bytes.Equal(root, prevRoot), nil
+}
@@ -0,0 +1,143 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetValidatorPerformanceSummary(t *testing.T) {
+	ctx := context.Background()
+	headState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetSlot(params.BeaconConfig().SlotsPerEpoch*5))
+	performanceCache := cache.NewValidatorPerformanceCache()
+	performanceCache.AddEpochPerformance(2, &cache.EpochPerformance{
+		Active:             []bool{true, true, false},
+		InclusionDistances: []types.Slot{1, 0, 0},
+		AssignedProposals:  map[types.ValidatorIndex]uint64{1: 1},
+		MissedProposals:    map[types.ValidatorIndex]uint64{1: 1},
+	})
+	performanceCache.AddEpochPerformance(3, &cache.EpochPerformance{
+		Active:             []bool{true, true, true},
+		InclusionDistances: []types.Slot{3, 0, 2},
+		AssignedProposals:  map[types.ValidatorIndex]uint64{0: 1},
+		MissedProposals:    map[types.ValidatorIndex]uint64{},
+	})
+	bs := &Server{
+		HeadFetcher:               &mock.ChainService{State: headState},
+		ValidatorPerformanceCache: performanceCache,
+	}
+
+	res, err := bs.GetValidatorPerformanceSummary(ctx, &pbrpc.ValidatorPerformanceSummaryRequest{
+		Indices:        []uint64{0, 1, 2, 7},
+		LookbackEpochs: 2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(2), res.StartEpoch)
+	assert.Equal(t, types.Epoch(3), res.EndEpoch)
+	want := []*pbrpc.ValidatorPerformanceSummary{
+		{ValidatorIndex: 0, ActiveEpochs: 2, ParticipationRate: 1, AverageInclusionDistance: 2, AssignedProposals: 1},
+		{ValidatorIndex: 1, ActiveEpochs: 2, MissedAttestations: 2, AssignedProposals: 1, MissedProposals: 1},
+		{ValidatorIndex: 2, ActiveEpochs: 1, ParticipationRate: 1, AverageInclusionDistance: 2},
+		{ValidatorIndex: 7},
+	}
+	assert.DeepEqual(t, want, res.Summaries)
+}
+
+func TestServer_GetValidatorPerformanceSummary_InvalidRequest(t *testing.T) {
+	ctx := context.Background()
+	headState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	bs := &Server{HeadFetcher: &mock.ChainService{State: headState}}
+
+	_, err = bs.GetValidatorPerformanceSummary(ctx, &pbrpc.ValidatorPerformanceSummaryRequest{LookbackEpochs: 1})
+	assert.ErrorContains(t, "Must request at least one validator index", err)
+	_, err = bs.GetValidatorPerformanceSummary(ctx, &pbrpc.ValidatorPerformanceSummaryRequest{Indices: []uint64{1}})
+	assert.ErrorContains(t, "Lookback must be between 1 and 32 epochs", err)
+	_, err = bs.GetValidatorPerformanceSummary(ctx, &pbrpc.ValidatorPerformanceSummaryRequest{
+		Indices:        []uint64{1},
+		LookbackEpochs: maxPerformanceLookbackEpochs + 1,
+	})
+	assert.ErrorContains(t, "Lookback must be between 1 and 32 epochs", err)
+	_, err = bs.GetValidatorPerformanceSummary(ctx, &pbrpc.ValidatorPerformanceSummaryRequest{
+		Indices:        []uint64{1},
+		LookbackEpochs: 1,
+	})
+	assert.ErrorContains(t, "No epoch can have all its attestations included yet", err)
+}
+
+func TestComputeEpochPerformance(t *testing.T) {
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	dutiesState, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, dutiesState.SetSlot(slotsPerEpoch))
+	inclusionState := dutiesState.Copy()
+	require.NoError(t, inclusionState.SetSlot(slotsPerEpoch*3-1))
+
+	// Every slot of epoch 1 has a block, except the missed one.
+	missedSlot := slotsPerEpoch + 5
+	roots := inclusionState.BlockRoots()
+	for slot := slotsPerEpoch; slot < slotsPerEpoch*2; slot++ {
+		roots[slot] = []byte{byte(slot), 1}
+	}
+	roots[missedSlot] = roots[missedSlot-1]
+	require.NoError(t, inclusionState.SetBlockRoots(roots))
+	missedProposerState := dutiesState.Copy()
+	require.NoError(t, missedProposerState.SetSlot(missedSlot))
+	missedProposer, err := helpers.BeaconProposerIndex(missedProposerState)
+	require.NoError(t, err)
+
+	// A committee of epoch 1 attested, and was included two slots later.
+	attSlot := slotsPerEpoch + 3
+	committee, err := helpers.BeaconCommitteeFromState(inclusionState, attSlot, 0)
+	require.NoError(t, err)
+	bits := bitfield.NewBitlist(uint64(len(committee)))
+	for i := range committee {
+		bits.SetBitAt(uint64(i), true)
+	}
+	require.NoError(t, inclusionState.SetPreviousEpochAttestations([]*pb.PendingAttestation{{
+		AggregationBits: bits,
+		Data:            testutil.HydrateAttestationData(&ethpb.AttestationData{Slot: attSlot, Target: &ethpb.Checkpoint{Epoch: 1}}),
+		InclusionDelay:  2,
+	}}))
+
+	p, err := computeEpochPerformance(ctx, 1, dutiesState, inclusionState)
+	require.NoError(t, err)
+	attesters := make(map[types.ValidatorIndex]bool)
+	for _, idx := range committee {
+		attesters[idx] = true
+	}
+	require.Equal(t, 64, len(p.Active))
+	for i := range p.Active {
+		assert.Equal(t, true, p.Active[i])
+		if attesters[types.ValidatorIndex(i)] {
+			assert.Equal(t, types.Slot(2), p.InclusionDistances[i])
+		} else {
+			assert.Equal(t, types.Slot(0), p.InclusionDistances[i])
+		}
+	}
+	var assigned, missed uint64
+	for _, n := range p.AssignedProposals {
+		assigned += n
+	}
+	for _, n := range p.MissedProposals {
+		missed += n
+	}
+	assert.Equal(t, uint64(slotsPerEpoch), assigned)
+	assert.Equal(t, uint64(1), missed)
+	assert.Equal(t, uint64(1), p.MissedProposals[missedProposer])
+}
@@ -0,0 +1,138 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxProposerScheduleLookbackEpochs bounds how far back the proposer schedule can be requested, as
+// the state at the start of an older epoch may have to be regenerated by replaying many blocks.
+const maxProposerScheduleLookbackEpochs = 32
+
+// tentativeProposerSchedule is the proposer schedule of the next epoch computed on top of a head block.
+type tentativeProposerSchedule struct {
+	headRoot []byte
+	resp     *pbrpc.ProposerScheduleResponse
+}
+
+// GetProposerSchedule returns the proposer index of each slot of an epoch. The proposers of past
+// and current epochs are computed from the state at the start of the epoch. The proposers of the
+// next epoch are computed from the head state advanced to the start of that epoch, and are marked
+// as tentative: the blocks of the rest of the current epoch may still change the effective balances
+// the proposers are sampled by, or slash them. The proposer indices of an epoch are kept in the
+// proposer indices cache, so only the first slot computes the shuffling, and the tentative schedule
+// is kept until the head changes, so the epoch transition is only processed once per head.
+func (ds *Server) GetProposerSchedule(
+	ctx context.Context, req *pbrpc.ProposerScheduleRequest,
+) (*pbrpc.ProposerScheduleResponse, error) {
+	currentEpoch := helpers.SlotToEpoch(ds.GenesisTimeFetcher.CurrentSlot())
+	if req.Epoch > currentEpoch+1 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve the proposers of an epoch after the next epoch, current epoch %d, requesting %d",
+			currentEpoch,
+			req.Epoch,
+		)
+	}
+	if req.Epoch+maxProposerScheduleLookbackEpochs < currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve the proposers of an epoch more than %d epochs old, current epoch %d, requesting %d",
+			maxProposerScheduleLookbackEpochs,
+			currentEpoch,
+			req.Epoch,
+		)
+	}
+	if req.Epoch > currentEpoch {
+		return ds.tentativeProposerSchedule(ctx, req.Epoch)
+	}
+	startSlot, err := helpers.StartSlot(req.Epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute start slot: %v", err)
+	}
+	st, err := ds.StateGen.StateBySlot(ctx, startSlot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
+	}
+	proposers, err := proposerSchedule(st, startSlot)
+	if err != nil {
+		return nil, err
+	}
+	return &pbrpc.ProposerScheduleResponse{
+		Epoch:     req.Epoch,
+		Proposers: proposers,
+	}, nil
+}
+
+// tentativeProposerSchedule returns the proposer schedule of the next epoch, computed on top of the
+// current head, from the cache if the head has not changed since the schedule was last computed.
+func (ds *Server) tentativeProposerSchedule(ctx context.Context, epoch types.Epoch) (*pbrpc.ProposerScheduleResponse, error) {
+	headRoot, err := ds.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head root: %v", err)
+	}
+	ds.proposerScheduleLock.Lock()
+	defer ds.proposerScheduleLock.Unlock()
+	if cached := ds.tentativeSchedule; cached != nil && cached.resp.Epoch == epoch && bytes.Equal(cached.headRoot, headRoot) {
+		return cached.resp, nil
+	}
+
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute start slot: %v", err)
+	}
+	st, err := ds.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if st.Slot() < startSlot {
+		st, err = state.ProcessSlots(ctx, st, startSlot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not process slots up to %d: %v", startSlot, err)
+		}
+	}
+	proposers, err := proposerSchedule(st, startSlot)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbrpc.ProposerScheduleResponse{
+		Epoch:     epoch,
+		Proposers: proposers,
+		Tentative: true,
+	}
+	ds.tentativeSchedule = &tentativeProposerSchedule{headRoot: headRoot, resp: resp}
+	return resp, nil
+}
+
+// proposerSchedule computes the proposer of each slot of the epoch starting at the given slot, from
+// a state of that epoch. The slot of the state is modified.
+func proposerSchedule(st iface.BeaconState, startSlot types.Slot) ([]*pbrpc.SlotProposer, error) {
+	proposers := make([]*pbrpc.SlotProposer, 0, params.BeaconConfig().SlotsPerEpoch)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		// The genesis block has no proposer.
+		if slot == 0 {
+			continue
+		}
+		if err := st.SetSlot(slot); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not set slot: %v", err)
+		}
+		idx, err := helpers.BeaconProposerIndex(st)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not compute proposer of slot %d: %v", slot, err)
+		}
+		proposers = append(proposers, &pbrpc.SlotProposer{
+			Slot:           slot,
+			ValidatorIndex: idx,
+		})
+	}
+	return proposers, nil
+}
@@ -0,0 +1,90 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetProposerSchedule(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	helpers.ClearCache()
+	ctx := context.Background()
+
+	st, _ := testutil.DeterministicGenesisState(t, 256)
+	b := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, b))
+	gRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, gRoot))
+	require.NoError(t, db.SaveState(ctx, st, gRoot))
+	currentSlot := types.Slot(0)
+	headFetcher := &mock.ChainService{State: st.Copy(), Root: gRoot[:]}
+	bs := &Server{
+		GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot},
+		HeadFetcher:        headFetcher,
+		StateGen:           stategen.New(db),
+	}
+
+	res, err := bs.GetProposerSchedule(ctx, &pbrpc.ProposerScheduleRequest{Epoch: 0})
+	require.NoError(t, err)
+	assert.Equal(t, false, res.Tentative)
+	// The genesis slot has no proposer.
+	require.Equal(t, int(params.BeaconConfig().SlotsPerEpoch)-1, len(res.Proposers))
+	expected := st.Copy()
+	for i, p := range res.Proposers {
+		assert.Equal(t, types.Slot(i+1), p.Slot)
+		require.NoError(t, expected.SetSlot(p.Slot))
+		idx, err := helpers.BeaconProposerIndex(expected)
+		require.NoError(t, err)
+		assert.Equal(t, idx, p.ValidatorIndex)
+	}
+
+	res, err = bs.GetProposerSchedule(ctx, &pbrpc.ProposerScheduleRequest{Epoch: 1})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Tentative)
+	require.Equal(t, int(params.BeaconConfig().SlotsPerEpoch), len(res.Proposers))
+	expected, err = state.ProcessSlots(ctx, st.Copy(), params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+	for i, p := range res.Proposers {
+		assert.Equal(t, params.BeaconConfig().SlotsPerEpoch+types.Slot(i), p.Slot)
+		require.NoError(t, expected.SetSlot(p.Slot))
+		idx, err := helpers.BeaconProposerIndex(expected)
+		require.NoError(t, err)
+		assert.Equal(t, idx, p.ValidatorIndex)
+	}
+
+	// The tentative schedule is kept until the head changes.
+	cached, err := bs.GetProposerSchedule(ctx, &pbrpc.ProposerScheduleRequest{Epoch: 1})
+	require.NoError(t, err)
+	assert.Equal(t, res, cached)
+	headFetcher.Root = bytesutil.PadTo([]byte{'a'}, 32)
+	recomputed, err := bs.GetProposerSchedule(ctx, &pbrpc.ProposerScheduleRequest{Epoch: 1})
+	require.NoError(t, err)
+	assert.NotEqual(t, res, recomputed)
+	assert.DeepEqual(t, res.Proposers, recomputed.Proposers)
+}
+
+func TestServer_GetProposerSchedule_OutOfRange(t *testing.T) {
+	currentSlot := types.Slot(0)
+	bs := &Server{GenesisTimeFetcher: &mock.ChainService{Slot: &currentSlot}}
+
+	_, err := bs.GetProposerSchedule(context.Background(), &pbrpc.ProposerScheduleRequest{Epoch: 2})
+	assert.ErrorContains(t, "Cannot retrieve the proposers of an epoch after the next epoch, current epoch 0, requesting 2", err)
+
+	currentSlot = params.BeaconConfig().SlotsPerEpoch.Mul(uint64(maxProposerScheduleLookbackEpochs + 1))
+	_, err = bs.GetProposerSchedule(context.Background(), &pbrpc.ProposerScheduleRequest{Epoch: 0})
+	assert.ErrorContains(t, "Cannot retrieve the proposers of an epoch more than 32 epochs old", err)
+}
@@ -6,15 +6,20 @@ package debug
 import (
 	"context"
 	"os"
+	"sync"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 	ptypes "github.com/gogo/protobuf/types"
 	golog "github.com/ipfs/go-log/v2"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -24,16 +29,28 @@ import (
 // providing RPC endpoints for runtime debugging of a node, this server is
 // gated behind the feature flag --enable-debug-rpc-endpoints.
 type Server struct {
-	BeaconDB           db.NoHeadAccessDatabase
-	GenesisTimeFetcher blockchain.TimeFetcher
-	StateGen           *stategen.State
-	HeadFetcher        blockchain.HeadFetcher
-	PeerManager        p2p.PeerManager
-	PeersFetcher       p2p.PeersProvider
+	BeaconDB                  db.NoHeadAccessDatabase
+	GenesisTimeFetcher        blockchain.TimeFetcher
+	StateGen                  *stategen.State
+	HeadFetcher               blockchain.HeadFetcher
+	PeerManager               p2p.PeerManager
+	PeersFetcher              p2p.PeersProvider
+	HeadUpdater               blockchain.HeadUpdater
+	BalanceDeltasFetcher      blockchain.BalanceDeltasFetcher
+	EffectivenessFetcher      blockchain.EffectivenessFetcher
+	CheckpointHistoryFetcher  blockchain.CheckpointHistoryFetcher
+	ValidatorPerformanceCache *cache.ValidatorPerformanceCache
+	ValidatorPubkeyCache      *cache.ValidatorPubkeyCache
+	DepositFetcher            depositcache.DepositFetcher
+	CanonicalFetcher          blockchain.CanonicalFetcher
+	AttestationsPool          attestations.Pool
+	proposerScheduleLock      sync.Mutex
+	tentativeSchedule         *tentativeProposerSchedule
 }
 
 // SetLoggingLevel of a beacon node according to a request type,
-// either INFO, DEBUG, or TRACE.
+// either INFO, DEBUG, or TRACE. If the request names a subsystem,
+// only the level of that subsystem is set.
 func (ds *Server) SetLoggingLevel(_ context.Context, req *pbrpc.LoggingLevelRequest) (*ptypes.Empty, error) {
 	var verbosity string
 	switch req.Level {
@@ -50,7 +67,11 @@ func (ds *Server) SetLoggingLevel(_ context.Context, req *pbrpc.LoggingLevelRequ
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not parse verbosity level")
 	}
-	logrus.SetLevel(level)
+	if req.Subsystem != "" {
+		logutil.SetSubsystemLevel(req.Subsystem, level)
+		return &ptypes.Empty{}, nil
+	}
+	logutil.SetLevel(level)
 	if level == logrus.TraceLevel {
 		// Libp2p specific logging.
 		golog.SetAllLoggers(golog.LevelDebug)
@@ -61,3 +82,17 @@ func (ds *Server) SetLoggingLevel(_ context.Context, req *pbrpc.LoggingLevelRequ
 	}
 	return &ptypes.Empty{}, nil
 }
+
+// GetLoggingLevels returns the log level of the beacon node and the log levels
+// set for single subsystems.
+func (ds *Server) GetLoggingLevels(_ context.Context, _ *ptypes.Empty) (*pbrpc.LogLevelsResponse, error) {
+	level, subsystems := logutil.Levels()
+	res := &pbrpc.LogLevelsResponse{
+		Level:      level.String(),
+		Subsystems: make([]*pbrpc.SubsystemLogLevel, len(subsystems)),
+	}
+	for i, s := range subsystems {
+		res.Subsystems[i] = &pbrpc.SubsystemLogLevel{Subsystem: s.Subsystem, Level: s.Level.String()}
+	}
+	return res, nil
+}
@@ -0,0 +1,49 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+)
+
+func TestServer_SetLoggingLevel(t *testing.T) {
+	level := logrus.GetLevel()
+	defer logutil.SetLevel(level)
+	ds := &Server{}
+
+	_, err := ds.SetLoggingLevel(context.Background(), &pbrpc.LoggingLevelRequest{Level: pbrpc.LoggingLevelRequest_INFO})
+	require.NoError(t, err)
+	res, err := ds.GetLoggingLevels(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, "info", res.Level)
+	assert.Equal(t, 0, len(res.Subsystems))
+
+	_, err = ds.SetLoggingLevel(context.Background(), &pbrpc.LoggingLevelRequest{
+		Level:     pbrpc.LoggingLevelRequest_DEBUG,
+		Subsystem: "p2p",
+	})
+	require.NoError(t, err)
+	res, err = ds.GetLoggingLevels(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, "info", res.Level)
+	require.Equal(t, 1, len(res.Subsystems))
+	assert.Equal(t, "p2p", res.Subsystems[0].Subsystem)
+	assert.Equal(t, "debug", res.Subsystems[0].Level)
+
+	_, err = ds.SetLoggingLevel(context.Background(), &pbrpc.LoggingLevelRequest{Level: 10, Subsystem: "p2p"})
+	assert.ErrorContains(t, "Expected valid verbosity level", err)
+
+	// Setting the level of the whole beacon node clears the subsystem levels.
+	_, err = ds.SetLoggingLevel(context.Background(), &pbrpc.LoggingLevelRequest{Level: pbrpc.LoggingLevelRequest_DEBUG})
+	require.NoError(t, err)
+	res, err = ds.GetLoggingLevels(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, "debug", res.Level)
+	assert.Equal(t, 0, len(res.Subsystems))
+}
@@ -0,0 +1,83 @@
+package debug
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// simulateBlockTimeout bounds the time spent regenerating the parent state of a simulated block and
+// running its state transition, which may have to replay many blocks, or process many empty slots
+// and epoch transitions if the block is far ahead of its parent state.
+const simulateBlockTimeout = 10 * time.Second
+
+// SimulateBlock runs the state transition of a signed block against the post state of its parent,
+// or of the block given in the request, without importing the block or persisting the resulting
+// state. It returns the resulting state root, and the reason the block is invalid if it is, such as
+// an invalid signature, operation or state root.
+func (ds *Server) SimulateBlock(
+	ctx context.Context, req *pbrpc.SimulateBlockRequest,
+) (*pbrpc.SimulateBlockResponse, error) {
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(req.BlockSsz); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not decode block: %v", err)
+	}
+	parentRoot := blk.Block.ParentRoot
+	if len(req.ParentRoot) != 0 {
+		parentRoot = req.ParentRoot
+	}
+	if len(parentRoot) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Parent root must be 32 bytes, got %d", len(parentRoot))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, simulateBlockTimeout)
+	defer cancel()
+	hasState, err := ds.StateGen.HasState(ctx, bytesutil.ToBytes32(parentRoot))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not check parent state: %v", err)
+	}
+	if !hasState {
+		return nil, status.Errorf(codes.NotFound, "No state found for parent block %#x", parentRoot)
+	}
+	// The state returned by the state generator is a copy, which can be modified freely.
+	parentState, err := ds.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(parentRoot))
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, status.Errorf(codes.DeadlineExceeded, "Could not simulate block in %v", simulateBlockTimeout)
+		}
+		return nil, status.Errorf(codes.Internal, "Could not get parent state: %v", err)
+	}
+	// The steps of state.ExecuteStateTransition are run one by one, as it may write the block
+	// and state to disk for interop debugging.
+	st, err := state.ProcessSlots(ctx, parentState, blk.Block.Slot)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, status.Errorf(codes.DeadlineExceeded, "Could not simulate block in %v", simulateBlockTimeout)
+		}
+		return &pbrpc.SimulateBlockResponse{ValidationError: fmt.Sprintf("could not process slots: %v", err)}, nil
+	}
+	st, err = state.ProcessBlock(ctx, st, blk)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, status.Errorf(codes.DeadlineExceeded, "Could not simulate block in %v", simulateBlockTimeout)
+		}
+		return &pbrpc.SimulateBlockResponse{ValidationError: fmt.Sprintf("could not process block: %v", err)}, nil
+	}
+	root, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
+	}
+	res := &pbrpc.SimulateBlockResponse{StateRoot: root[:]}
+	if !bytes.Equal(root[:], blk.Block.StateRoot) {
+		res.ValidationError = fmt.Sprintf("state root mismatch, block has %#x", blk.Block.StateRoot)
+	}
+	return res, nil
+}
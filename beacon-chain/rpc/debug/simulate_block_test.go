@@ -0,0 +1,69 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_SimulateBlock(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	st, keys := testutil.DeterministicGenesisState(t, 64)
+	blk, err := testutil.GenerateFullBlock(st, keys, testutil.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, bytesutil.ToBytes32(blk.Block.ParentRoot)))
+	bs := &Server{
+		BeaconDB: db,
+		StateGen: stategen.New(db),
+	}
+
+	enc, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	res, err := bs.SimulateBlock(ctx, &pbrpc.SimulateBlockRequest{BlockSsz: enc})
+	require.NoError(t, err)
+	assert.Equal(t, "", res.ValidationError)
+	assert.DeepEqual(t, blk.Block.StateRoot, res.StateRoot)
+
+	// The block is not saved, and neither is its post state.
+	blkRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, false, db.HasBlock(ctx, blkRoot))
+	assert.Equal(t, false, db.HasState(ctx, blkRoot))
+
+	// A block signed by the wrong proposer is reported as invalid.
+	blk.Signature = make([]byte, 96)
+	enc, err = blk.MarshalSSZ()
+	require.NoError(t, err)
+	res, err = bs.SimulateBlock(ctx, &pbrpc.SimulateBlockRequest{BlockSsz: enc})
+	require.NoError(t, err)
+	assert.NotEqual(t, "", res.ValidationError)
+	assert.Equal(t, 0, len(res.StateRoot))
+}
+
+func TestServer_SimulateBlock_InvalidRequest(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+	bs := &Server{
+		BeaconDB: db,
+		StateGen: stategen.New(db),
+	}
+
+	_, err := bs.SimulateBlock(ctx, &pbrpc.SimulateBlockRequest{BlockSsz: []byte{'a'}})
+	assert.ErrorContains(t, "Could not decode block", err)
+
+	enc, err := testutil.NewBeaconBlock().MarshalSSZ()
+	require.NoError(t, err)
+	_, err = bs.SimulateBlock(ctx, &pbrpc.SimulateBlockRequest{BlockSsz: enc, ParentRoot: []byte{'a'}})
+	assert.ErrorContains(t, "Parent root must be 32 bytes", err)
+	_, err = bs.SimulateBlock(ctx, &pbrpc.SimulateBlockRequest{BlockSsz: enc, ParentRoot: make([]byte, 32)})
+	assert.ErrorContains(t, "No state found for parent block", err)
+}
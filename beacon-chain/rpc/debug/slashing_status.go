@@ -0,0 +1,95 @@
+package debug
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/pagination"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetSlashingStatus returns the on-chain slashing status of the requested validator indices, as seen
+// in the current head state. Duplicate indices are dropped while preserving the requested order, and
+// unknown indices are rejected rather than silently dropped.
+//
+// The slashed epoch is not stored in the beacon state. It is derived from the withdrawable
+// epoch, which slashing pushes to at least EPOCHS_PER_SLASHINGS_VECTOR epochs after the
+// slashing, so it is only exact if the validator was not already exiting with a later
+// withdrawable epoch.
+func (ds *Server) GetSlashingStatus(
+	ctx context.Context, req *pbrpc.SlashingStatusRequest,
+) (*pbrpc.SlashingStatusResponse, error) {
+	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Requested page size %d can not be greater than max size %d",
+			req.PageSize,
+			cmd.Get().MaxRPCPageSize,
+		)
+	}
+	headState, err := ds.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Unavailable, "Unavailable during initial syncing")
+	}
+
+	indices := make([]types.ValidatorIndex, 0, len(req.Indices))
+	seen := make(map[types.ValidatorIndex]bool, len(req.Indices))
+	for _, idx := range req.Indices {
+		if seen[types.ValidatorIndex(idx)] {
+			continue
+		}
+		seen[types.ValidatorIndex(idx)] = true
+		indices = append(indices, types.ValidatorIndex(idx))
+	}
+	resp := &pbrpc.SlashingStatusResponse{
+		Epoch:     helpers.CurrentEpoch(headState),
+		Statuses:  make([]*pbrpc.ValidatorSlashingStatus, 0),
+		TotalSize: int32(len(indices)),
+	}
+	if len(indices) == 0 {
+		return resp, nil
+	}
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), len(indices))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not paginate results: %v", err)
+	}
+	resp.NextPageToken = nextPageToken
+	numValidators := types.ValidatorIndex(headState.NumValidators())
+	for _, idx := range indices[start:end] {
+		if idx >= numValidators {
+			return nil, status.Errorf(codes.NotFound, "Validator index %d does not exist", idx)
+		}
+		// Read only access avoids copying the validator out of the state for every index.
+		val, err := headState.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator %d: %v", idx, err)
+		}
+		s := &pbrpc.ValidatorSlashingStatus{
+			Index:             idx,
+			Slashed:           val.Slashed(),
+			ExitEpoch:         val.ExitEpoch(),
+			WithdrawableEpoch: val.WithdrawableEpoch(),
+		}
+		if s.Slashed {
+			s.SlashedEpoch = slashedEpochFromWithdrawable(s.WithdrawableEpoch)
+		}
+		resp.Statuses = append(resp.Statuses, s)
+	}
+	return resp, nil
+}
+
+func slashedEpochFromWithdrawable(withdrawable types.Epoch) types.Epoch {
+	vectorLength := params.BeaconConfig().EpochsPerSlashingsVector
+	if withdrawable < vectorLength {
+		return 0
+	}
+	return withdrawable - vectorLength
+}
@@ -0,0 +1,63 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func slashingStatusServer(t *testing.T) *Server {
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	vals := headState.Validators()
+	vals[3].Slashed = true
+	vals[3].ExitEpoch = 10
+	vals[3].WithdrawableEpoch = 5 + params.BeaconConfig().EpochsPerSlashingsVector
+	require.NoError(t, headState.SetValidators(vals))
+	return &Server{HeadFetcher: &mock.ChainService{State: headState}}
+}
+
+func TestServer_GetSlashingStatus(t *testing.T) {
+	ctx := context.Background()
+	ds := slashingStatusServer(t)
+
+	res, err := ds.GetSlashingStatus(ctx, &pbrpc.SlashingStatusRequest{Indices: []uint64{1, 3, 3, 7}, PageSize: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), res.TotalSize)
+	assert.Equal(t, "1", res.NextPageToken)
+	require.Equal(t, 2, len(res.Statuses))
+	assert.DeepEqual(t, &pbrpc.ValidatorSlashingStatus{
+		Index:             1,
+		ExitEpoch:         params.BeaconConfig().FarFutureEpoch,
+		WithdrawableEpoch: params.BeaconConfig().FarFutureEpoch,
+	}, res.Statuses[0])
+	assert.Equal(t, types.ValidatorIndex(3), res.Statuses[1].Index)
+	assert.Equal(t, true, res.Statuses[1].Slashed)
+	assert.Equal(t, types.Epoch(5), res.Statuses[1].SlashedEpoch)
+	assert.Equal(t, types.Epoch(10), res.Statuses[1].ExitEpoch)
+
+	res, err = ds.GetSlashingStatus(ctx, &pbrpc.SlashingStatusRequest{Indices: []uint64{1, 3, 3, 7}, PageSize: 2, PageToken: "1"})
+	require.NoError(t, err)
+	assert.Equal(t, "", res.NextPageToken)
+	require.Equal(t, 1, len(res.Statuses))
+	assert.Equal(t, types.ValidatorIndex(7), res.Statuses[0].Index)
+}
+
+func TestServer_GetSlashingStatus_Errors(t *testing.T) {
+	ctx := context.Background()
+	ds := slashingStatusServer(t)
+
+	_, err := ds.GetSlashingStatus(ctx, &pbrpc.SlashingStatusRequest{Indices: []uint64{1000}})
+	assert.ErrorContains(t, "Validator index 1000 does not exist", err)
+	_, err = ds.GetSlashingStatus(ctx, &pbrpc.SlashingStatusRequest{Indices: []uint64{1}, PageToken: "5"})
+	assert.ErrorContains(t, "Could not paginate results", err)
+	_, err = ds.GetSlashingStatus(ctx, &pbrpc.SlashingStatusRequest{PageSize: int32(cmd.Get().MaxRPCPageSize + 1)})
+	assert.ErrorContains(t, "can not be greater than max size", err)
+}
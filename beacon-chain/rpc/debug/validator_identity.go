@@ -0,0 +1,79 @@
+package debug
+
+import (
+	"context"
+
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetValidatorIdentity returns the public key of a validator index, or the index of a validator public key,
+// as found in the validator registry of the head state. A public key only known from a deposit which is not
+// processed into the registry yet is reported with a pending deposit status.
+func (ds *Server) GetValidatorIdentity(
+	ctx context.Context, req *pbrpc.ValidatorIdentityRequest,
+) (*pbrpc.ValidatorIdentityResponse, error) {
+	if len(req.PublicKey) == 0 {
+		pubkey, ok := ds.ValidatorPubkeyCache.PublicKey(req.Index)
+		if !ok {
+			// The validator may have been added to the registry since the cache was last refreshed.
+			if err := ds.updateValidatorPubkeyCache(ctx); err != nil {
+				return nil, err
+			}
+			pubkey, ok = ds.ValidatorPubkeyCache.PublicKey(req.Index)
+		}
+		if !ok {
+			return &pbrpc.ValidatorIdentityResponse{Status: pbrpc.ValidatorIdentityResponse_UNKNOWN}, nil
+		}
+		return &pbrpc.ValidatorIdentityResponse{
+			Status:    pbrpc.ValidatorIdentityResponse_IN_REGISTRY,
+			PublicKey: pubkey[:],
+			Index:     req.Index,
+		}, nil
+	}
+
+	if len(req.PublicKey) != params.BeaconConfig().BLSPubkeyLength {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "Public key must be %d bytes long", params.BeaconConfig().BLSPubkeyLength,
+		)
+	}
+	idx, ok := ds.ValidatorPubkeyCache.Index(bytesutil.ToBytes48(req.PublicKey))
+	if !ok {
+		if err := ds.updateValidatorPubkeyCache(ctx); err != nil {
+			return nil, err
+		}
+		idx, ok = ds.ValidatorPubkeyCache.Index(bytesutil.ToBytes48(req.PublicKey))
+	}
+	if ok {
+		return &pbrpc.ValidatorIdentityResponse{
+			Status:    pbrpc.ValidatorIdentityResponse_IN_REGISTRY,
+			PublicKey: req.PublicKey,
+			Index:     idx,
+		}, nil
+	}
+	if deposit, _ := ds.DepositFetcher.DepositByPubkey(ctx, req.PublicKey); deposit != nil {
+		return &pbrpc.ValidatorIdentityResponse{
+			Status:    pbrpc.ValidatorIdentityResponse_PENDING_DEPOSIT,
+			PublicKey: req.PublicKey,
+		}, nil
+	}
+	return &pbrpc.ValidatorIdentityResponse{
+		Status:    pbrpc.ValidatorIdentityResponse_UNKNOWN,
+		PublicKey: req.PublicKey,
+	}, nil
+}
+
+func (ds *Server) updateValidatorPubkeyCache(ctx context.Context) error {
+	headState, err := ds.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return status.Error(codes.Internal, "Head state is nil")
+	}
+	ds.ValidatorPubkeyCache.Update(headState)
+	return nil
+}
@@ -0,0 +1,58 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetValidatorIdentity(t *testing.T) {
+	ctx := context.Background()
+	headState, _ := testutil.DeterministicGenesisState(t, 4)
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	pendingKey := bytesutil.PadTo([]byte{'p'}, 48)
+	depositCache.InsertDeposit(ctx, &ethpb.Deposit{Data: &ethpb.Deposit_Data{PublicKey: pendingKey}}, 0, 0, [32]byte{})
+	ds := &Server{
+		HeadFetcher:          &mock.ChainService{State: headState},
+		ValidatorPubkeyCache: cache.NewValidatorPubkeyCache(),
+		DepositFetcher:       depositCache,
+	}
+	pubkey := headState.PubkeyAtIndex(2)
+
+	res, err := ds.GetValidatorIdentity(ctx, &pbrpc.ValidatorIdentityRequest{Index: 2})
+	require.NoError(t, err)
+	assert.Equal(t, pbrpc.ValidatorIdentityResponse_IN_REGISTRY, res.Status)
+	assert.DeepEqual(t, pubkey[:], res.PublicKey)
+	assert.Equal(t, types.ValidatorIndex(2), res.Index)
+
+	res, err = ds.GetValidatorIdentity(ctx, &pbrpc.ValidatorIdentityRequest{PublicKey: pubkey[:]})
+	require.NoError(t, err)
+	assert.Equal(t, pbrpc.ValidatorIdentityResponse_IN_REGISTRY, res.Status)
+	assert.Equal(t, types.ValidatorIndex(2), res.Index)
+
+	res, err = ds.GetValidatorIdentity(ctx, &pbrpc.ValidatorIdentityRequest{PublicKey: pendingKey})
+	require.NoError(t, err)
+	assert.Equal(t, pbrpc.ValidatorIdentityResponse_PENDING_DEPOSIT, res.Status)
+
+	res, err = ds.GetValidatorIdentity(ctx, &pbrpc.ValidatorIdentityRequest{PublicKey: bytesutil.PadTo([]byte{'u'}, 48)})
+	require.NoError(t, err)
+	assert.Equal(t, pbrpc.ValidatorIdentityResponse_UNKNOWN, res.Status)
+
+	res, err = ds.GetValidatorIdentity(ctx, &pbrpc.ValidatorIdentityRequest{Index: 4})
+	require.NoError(t, err)
+	assert.Equal(t, pbrpc.ValidatorIdentityResponse_UNKNOWN, res.Status)
+
+	_, err = ds.GetValidatorIdentity(ctx, &pbrpc.ValidatorIdentityRequest{PublicKey: []byte{'a'}})
+	assert.ErrorContains(t, "Public key must be 48 bytes long", err)
+}
@@ -0,0 +1,209 @@
+package eventsv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+)
+
+const (
+	// HeadTopic is sent when the node has a new head block.
+	HeadTopic = "head"
+	// BlockTopic is sent when the node has imported a block.
+	BlockTopic = "block"
+	// FinalizedCheckpointTopic is sent when the node has a new finalized checkpoint.
+	FinalizedCheckpointTopic = "finalized_checkpoint"
+	// ChainReorgTopic is sent when the node's head switches to a block which does not descend from the previous head.
+	ChainReorgTopic = "chain_reorg"
+)
+
+var supportedTopics = map[string]bool{
+	HeadTopic:                true,
+	BlockTopic:               true,
+	FinalizedCheckpointTopic: true,
+	ChainReorgTopic:          true,
+}
+
+type headEvent struct {
+	Slot            types.Slot `json:"slot,string"`
+	Block           string     `json:"block"`
+	State           string     `json:"state"`
+	EpochTransition bool       `json:"epoch_transition"`
+}
+
+type blockEvent struct {
+	Slot  types.Slot `json:"slot,string"`
+	Block string     `json:"block"`
+}
+
+type finalizedCheckpointEvent struct {
+	Block string      `json:"block"`
+	State string      `json:"state"`
+	Epoch types.Epoch `json:"epoch,string"`
+}
+
+type chainReorgEvent struct {
+	Slot               types.Slot  `json:"slot,string"`
+	Depth              uint64      `json:"depth,string"`
+	OldHeadBlock       string      `json:"old_head_block"`
+	NewHeadBlock       string      `json:"new_head_block"`
+	OldHeadState       string      `json:"old_head_state"`
+	NewHeadState       string      `json:"new_head_state"`
+	Epoch              types.Epoch `json:"epoch,string"`
+	CommonAncestorSlot types.Slot  `json:"common_ancestor_slot,string"`
+}
+
+// encodeStateEvent returns the topic and JSON data of a state feed event.
+// The topic is empty if the event is not part of the stream.
+func encodeStateEvent(ev *feed.Event) (string, []byte, error) {
+	var topic string
+	var data interface{}
+	switch ev.Type {
+	case statefeed.NewHead:
+		d, ok := ev.Data.(*statefeed.NewHeadData)
+		if !ok || d == nil {
+			return "", nil, nil
+		}
+		topic = HeadTopic
+		data = &headEvent{
+			Slot:            d.Slot,
+			Block:           hexutil.Encode(d.BlockRoot[:]),
+			State:           hexutil.Encode(d.StateRoot[:]),
+			EpochTransition: d.EpochTransition,
+		}
+	case statefeed.BlockProcessed:
+		d, ok := ev.Data.(*statefeed.BlockProcessedData)
+		if !ok || d == nil {
+			return "", nil, nil
+		}
+		topic = BlockTopic
+		data = &blockEvent{
+			Slot:  d.Slot,
+			Block: hexutil.Encode(d.BlockRoot[:]),
+		}
+	case statefeed.FinalizedCheckpoint:
+		d, ok := ev.Data.(*statefeed.FinalizedCheckpointData)
+		if !ok || d == nil {
+			return "", nil, nil
+		}
+		topic = FinalizedCheckpointTopic
+		data = &finalizedCheckpointEvent{
+			Block: hexutil.Encode(d.BlockRoot[:]),
+			State: hexutil.Encode(d.StateRoot[:]),
+			Epoch: d.Epoch,
+		}
+	case statefeed.Reorg:
+		d, ok := ev.Data.(*statefeed.ReorgData)
+		if !ok || d == nil {
+			return "", nil, nil
+		}
+		topic = ChainReorgTopic
+		data = &chainReorgEvent{
+			Slot:               d.NewSlot,
+			Depth:              d.Depth,
+			OldHeadBlock:       hexutil.Encode(d.OldHeadRoot[:]),
+			NewHeadBlock:       hexutil.Encode(d.NewHeadRoot[:]),
+			OldHeadState:       hexutil.Encode(d.OldStateRoot[:]),
+			NewHeadState:       hexutil.Encode(d.NewStateRoot[:]),
+			Epoch:              helpers.SlotToEpoch(d.NewSlot),
+			CommonAncestorSlot: d.CommonAncestorSlot,
+		}
+	default:
+		return "", nil, nil
+	}
+	enc, err := json.Marshal(data)
+	if err != nil {
+		return "", nil, err
+	}
+	return topic, enc, nil
+}
+
+// ServeHTTP streams the events of the requested topics to the client until it disconnects.
+// A client reconnecting with a Last-Event-ID header first receives the events it missed,
+// as long as they are still in the history of the server.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	topics := make(map[string]bool)
+	for _, param := range r.URL.Query()["topics"] {
+		for _, topic := range strings.Split(param, ",") {
+			topic = strings.TrimSpace(topic)
+			if !supportedTopics[topic] {
+				http.Error(w, fmt.Sprintf("Invalid topic: %q", topic), http.StatusBadRequest)
+				return
+			}
+			topics[topic] = true
+		}
+	}
+	if len(topics) == 0 {
+		http.Error(w, "No topics specified", http.StatusBadRequest)
+		return
+	}
+	var replay bool
+	var lastEventID uint64
+	if h := r.Header.Get("Last-Event-ID"); h != "" {
+		id, err := strconv.ParseUint(h, 10, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Invalid Last-Event-ID: %q", h), http.StatusBadRequest)
+			return
+		}
+		replay, lastEventID = true, id
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	ch, missed := s.subscribe(replay, lastEventID)
+	defer s.unsubscribe(ch)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	write := func(ev *streamEvent) error {
+		if !topics[ev.topic] {
+			return nil
+		}
+		if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", ev.id, ev.topic, ev.data); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}
+	for _, ev := range missed {
+		if err := write(ev); err != nil {
+			return
+		}
+	}
+	for {
+		select {
+		case ev, ok := <-ch:
+			if !ok {
+				// The client fell behind and was dropped, it can reconnect with its last event ID.
+				return
+			}
+			if err := write(ev); err != nil {
+				log.WithError(err).Debug("Could not write event")
+				return
+			}
+		case <-r.Context().Done():
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
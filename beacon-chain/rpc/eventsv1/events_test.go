@@ -0,0 +1,152 @@
+package eventsv1
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// readEvent reads the lines of the next event from the stream.
+func readEvent(t *testing.T, r *bufio.Reader) []string {
+	var lines []string
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return lines
+		}
+		lines = append(lines, line)
+	}
+}
+
+func TestServeHTTP_InvalidTopic(t *testing.T) {
+	s := NewServer(context.Background(), &mockChain.MockStateNotifier{})
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{name: "unknown topic", url: "/eth/v1/events?topics=head,foo"},
+		{name: "no topic", url: "/eth/v1/events"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.url, nil))
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+	}
+}
+
+func TestServeHTTP_StreamsEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	notifier := &mockChain.MockStateNotifier{}
+	s := NewServer(ctx, notifier)
+	s.Start()
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/eth/v1/events?topics=head&topics=finalized_checkpoint")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	notifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.BlockProcessed,
+		Data: &statefeed.BlockProcessedData{Slot: 31, BlockRoot: [32]byte{'a'}},
+	})
+	notifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{Slot: 32, BlockRoot: [32]byte{'b'}, StateRoot: [32]byte{'c'}, EpochTransition: true},
+	})
+	notifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.FinalizedCheckpoint,
+		Data: &statefeed.FinalizedCheckpointData{Epoch: 2, BlockRoot: [32]byte{'d'}, StateRoot: [32]byte{'e'}},
+	})
+
+	r := bufio.NewReader(resp.Body)
+	assert.DeepEqual(t, []string{
+		"id: 2",
+		"event: head",
+		`data: {"slot":"32","block":"0x6200000000000000000000000000000000000000000000000000000000000000","state":"0x6300000000000000000000000000000000000000000000000000000000000000","epoch_transition":true}`,
+	}, readEvent(t, r))
+	assert.DeepEqual(t, []string{
+		"id: 3",
+		"event: finalized_checkpoint",
+		`data: {"block":"0x6400000000000000000000000000000000000000000000000000000000000000","state":"0x6500000000000000000000000000000000000000000000000000000000000000","epoch":"2"}`,
+	}, readEvent(t, r))
+}
+
+func TestServeHTTP_LastEventID(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewServer(ctx, &mockChain.MockStateNotifier{})
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	s.broadcast(BlockTopic, []byte("1"))
+	s.broadcast(BlockTopic, []byte("2"))
+	s.broadcast(HeadTopic, []byte("3"))
+	s.broadcast(BlockTopic, []byte("4"))
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/eth/v1/events?topics=block", nil)
+	require.NoError(t, err)
+	req.Header.Set("Last-Event-ID", "1")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+
+	r := bufio.NewReader(resp.Body)
+	assert.DeepEqual(t, []string{"id: 2", "event: block", "data: 2"}, readEvent(t, r))
+	assert.DeepEqual(t, []string{"id: 4", "event: block", "data: 4"}, readEvent(t, r))
+}
+
+func TestBroadcast_DropsSlowSubscriber(t *testing.T) {
+	s := NewServer(context.Background(), &mockChain.MockStateNotifier{})
+	ch, _ := s.subscribe(false, 0)
+	for i := 0; i <= subscriberBufferSize; i++ {
+		s.broadcast(HeadTopic, []byte("{}"))
+	}
+	for range ch {
+	}
+	assert.Equal(t, 0, len(s.subscribers))
+	s.unsubscribe(ch)
+}
+
+func TestEncodeStateEvent_ChainReorg(t *testing.T) {
+	topic, data, err := encodeStateEvent(&feed.Event{
+		Type: statefeed.Reorg,
+		Data: &statefeed.ReorgData{
+			NewSlot:            33,
+			OldSlot:            32,
+			NewHeadRoot:        [32]byte{'a'},
+			OldHeadRoot:        [32]byte{'b'},
+			NewStateRoot:       [32]byte{'c'},
+			OldStateRoot:       [32]byte{'d'},
+			CommonAncestorSlot: 30,
+			Depth:              2,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ChainReorgTopic, topic)
+	assert.Equal(t, `{"slot":"33","depth":"2",`+
+		`"old_head_block":"0x6200000000000000000000000000000000000000000000000000000000000000",`+
+		`"new_head_block":"0x6100000000000000000000000000000000000000000000000000000000000000",`+
+		`"old_head_state":"0x6400000000000000000000000000000000000000000000000000000000000000",`+
+		`"new_head_state":"0x6300000000000000000000000000000000000000000000000000000000000000",`+
+		`"epoch":"1","common_ancestor_slot":"30"}`, string(data))
+}
@@ -0,0 +1,5 @@
+package eventsv1
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "rpc/eventsv1")
@@ -0,0 +1,151 @@
+// Package eventsv1 defines the beacon node event stream served as
+// Server-Sent Events, following the official API standards
+// https://ethereum.github.io/eth2.0-APIs/#/Events.
+package eventsv1
+
+import (
+	"context"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/event"
+)
+
+var _ shared.Service = (*Server)(nil)
+
+const (
+	// maxEventHistory is the number of past events kept to be replayed
+	// to clients reconnecting with a Last-Event-ID header.
+	maxEventHistory = 128
+	// subscriberBufferSize is the number of events a client can fall behind
+	// before it is disconnected.
+	subscriberBufferSize = 16
+)
+
+// streamEvent is a single event of the stream, already encoded for the wire.
+type streamEvent struct {
+	id    uint64
+	topic string
+	data  []byte
+}
+
+// Server serves the beacon node event stream. It listens to the state
+// feed of the node and fans out the events to every connected client.
+type Server struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	stateNotifier statefeed.Notifier
+
+	lock        sync.Mutex
+	lastID      uint64
+	history     []*streamEvent
+	subscribers map[chan *streamEvent]struct{}
+}
+
+// NewServer returns a new event stream server reading from the given state notifier.
+func NewServer(ctx context.Context, stateNotifier statefeed.Notifier) *Server {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Server{
+		ctx:           ctx,
+		cancel:        cancel,
+		stateNotifier: stateNotifier,
+		subscribers:   make(map[chan *streamEvent]struct{}),
+	}
+}
+
+// Start listening to the state feed.
+func (s *Server) Start() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	go s.run(stateChannel, stateSub)
+}
+
+// Stop the server and disconnect all clients.
+func (s *Server) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the event stream server. Always returns nil.
+func (s *Server) Status() error {
+	return nil
+}
+
+func (s *Server) run(stateChannel <-chan *feed.Event, stateSub event.Subscription) {
+	defer stateSub.Unsubscribe()
+
+	for {
+		select {
+		case ev := <-stateChannel:
+			topic, data, err := encodeStateEvent(ev)
+			if err != nil {
+				log.WithError(err).Error("Could not encode event")
+				continue
+			}
+			if topic == "" {
+				continue
+			}
+			s.broadcast(topic, data)
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Subscription to state feed failed")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// broadcast records the event in the history and sends it to every client.
+// Clients which are too slow to keep up are disconnected, so they can
+// reconnect and catch up from the history instead of blocking the stream.
+func (s *Server) broadcast(topic string, data []byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.lastID++
+	ev := &streamEvent{id: s.lastID, topic: topic, data: data}
+	s.history = append(s.history, ev)
+	if len(s.history) > maxEventHistory {
+		s.history = s.history[len(s.history)-maxEventHistory:]
+	}
+	for ch := range s.subscribers {
+		select {
+		case ch <- ev:
+		default:
+			delete(s.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// subscribe registers a new client. When replay is set, the events
+// of the history following lastEventID are returned along with the channel.
+func (s *Server) subscribe(replay bool, lastEventID uint64) (chan *streamEvent, []*streamEvent) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	ch := make(chan *streamEvent, subscriberBufferSize)
+	s.subscribers[ch] = struct{}{}
+	if !replay {
+		return ch, nil
+	}
+	var missed []*streamEvent
+	for _, ev := range s.history {
+		if ev.id > lastEventID {
+			missed = append(missed, ev)
+		}
+	}
+	return ch, missed
+}
+
+func (s *Server) unsubscribe(ch chan *streamEvent) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.subscribers[ch]; ok {
+		delete(s.subscribers, ch)
+		close(ch)
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"sync"
+	"time"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
@@ -18,9 +20,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
@@ -65,10 +69,20 @@ type Service struct {
 	genesisFetcher          blockchain.GenesisFetcher
 	attestationReceiver     blockchain.AttestationReceiver
 	blockReceiver           blockchain.BlockReceiver
+	headUpdater             blockchain.HeadUpdater
+	balanceDeltasFetcher    blockchain.BalanceDeltasFetcher
+	effectivenessFetcher    blockchain.EffectivenessFetcher
+	checkpointsFetcher      blockchain.CheckpointHistoryFetcher
 	powChainService         powchain.Chain
 	chainStartFetcher       powchain.ChainStartFetcher
 	mockEth1Votes           bool
+	eth1DataVoteStrategy    string
+	historicalStateCache    *cache.HistoricalStateCache
+	slotRootCache           *cache.SlotRootCache
+	performanceCache        *cache.ValidatorPerformanceCache
+	pubkeyCache             *cache.ValidatorPubkeyCache
 	enableDebugRPCEndpoints bool
+	enableGRPCReflection    bool
 	attestationsPool        attestations.Pool
 	exitPool                voluntaryexits.PoolManager
 	slashingsPool           slashings.PoolManager
@@ -97,51 +111,65 @@ type Service struct {
 	connectedRPCClients     map[net.Addr]bool
 	clientConnectionLock    sync.Mutex
 	maxMsgSize              int
+	attestationCutoff       time.Duration
+	beaconServerV1          *beaconv1.Server
 }
 
 // Config options for the beacon node RPC server.
 type Config struct {
-	Host                    string
-	Port                    string
-	CertFlag                string
-	KeyFlag                 string
-	BeaconMonitoringHost    string
-	BeaconMonitoringPort    int
-	BeaconDB                db.HeadAccessDatabase
-	ChainInfoFetcher        blockchain.ChainInfoFetcher
-	HeadFetcher             blockchain.HeadFetcher
-	CanonicalFetcher        blockchain.CanonicalFetcher
-	ForkFetcher             blockchain.ForkFetcher
-	FinalizationFetcher     blockchain.FinalizationFetcher
-	AttestationReceiver     blockchain.AttestationReceiver
-	BlockReceiver           blockchain.BlockReceiver
-	POWChainService         powchain.Chain
-	ChainStartFetcher       powchain.ChainStartFetcher
-	GenesisTimeFetcher      blockchain.TimeFetcher
-	GenesisFetcher          blockchain.GenesisFetcher
-	EnableDebugRPCEndpoints bool
-	MockEth1Votes           bool
-	AttestationsPool        attestations.Pool
-	ExitPool                voluntaryexits.PoolManager
-	SlashingsPool           slashings.PoolManager
-	SyncService             chainSync.Checker
-	Broadcaster             p2p.Broadcaster
-	PeersFetcher            p2p.PeersProvider
-	PeerManager             p2p.PeerManager
-	MetadataProvider        p2p.MetadataProvider
-	DepositFetcher          depositcache.DepositFetcher
-	PendingDepositFetcher   depositcache.PendingDepositsFetcher
-	StateNotifier           statefeed.Notifier
-	BlockNotifier           blockfeed.Notifier
-	OperationNotifier       opfeed.Notifier
-	StateGen                *stategen.State
-	MaxMsgSize              int
+	Host                     string
+	Port                     string
+	CertFlag                 string
+	KeyFlag                  string
+	BeaconMonitoringHost     string
+	BeaconMonitoringPort     int
+	BeaconDB                 db.HeadAccessDatabase
+	ChainInfoFetcher         blockchain.ChainInfoFetcher
+	HeadFetcher              blockchain.HeadFetcher
+	CanonicalFetcher         blockchain.CanonicalFetcher
+	ForkFetcher              blockchain.ForkFetcher
+	FinalizationFetcher      blockchain.FinalizationFetcher
+	AttestationReceiver      blockchain.AttestationReceiver
+	BlockReceiver            blockchain.BlockReceiver
+	HeadUpdater              blockchain.HeadUpdater
+	BalanceDeltasFetcher     blockchain.BalanceDeltasFetcher
+	EffectivenessFetcher     blockchain.EffectivenessFetcher
+	CheckpointHistoryFetcher blockchain.CheckpointHistoryFetcher
+	POWChainService          powchain.Chain
+	ChainStartFetcher        powchain.ChainStartFetcher
+	GenesisTimeFetcher       blockchain.TimeFetcher
+	GenesisFetcher           blockchain.GenesisFetcher
+	EnableDebugRPCEndpoints  bool
+	EnableGRPCReflection     bool
+	MockEth1Votes            bool
+	Eth1DataVoteStrategy     string
+	HistoricalStateCacheSize int
+	AttestationsPool         attestations.Pool
+	ExitPool                 voluntaryexits.PoolManager
+	SlashingsPool            slashings.PoolManager
+	SyncService              chainSync.Checker
+	Broadcaster              p2p.Broadcaster
+	PeersFetcher             p2p.PeersProvider
+	PeerManager              p2p.PeerManager
+	MetadataProvider         p2p.MetadataProvider
+	DepositFetcher           depositcache.DepositFetcher
+	PendingDepositFetcher    depositcache.PendingDepositsFetcher
+	StateNotifier            statefeed.Notifier
+	BlockNotifier            blockfeed.Notifier
+	OperationNotifier        opfeed.Notifier
+	StateGen                 *stategen.State
+	MaxMsgSize               int
+	AttestationCutoff        time.Duration
 }
 
 // NewService instantiates a new RPC service instance that will
 // be registered into a running beacon node.
 func NewService(ctx context.Context, cfg *Config) *Service {
 	ctx, cancel := context.WithCancel(ctx)
+	var historicalStateCache *cache.HistoricalStateCache
+	if cfg.HistoricalStateCacheSize > 0 {
+		historicalStateCache = cache.NewHistoricalStateCache(cfg.HistoricalStateCacheSize)
+	}
 	return &Service{
 		ctx:                     ctx,
 		cancel:                  cancel,
@@ -155,6 +183,10 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		genesisFetcher:          cfg.GenesisFetcher,
 		attestationReceiver:     cfg.AttestationReceiver,
 		blockReceiver:           cfg.BlockReceiver,
+		headUpdater:             cfg.HeadUpdater,
+		balanceDeltasFetcher:    cfg.BalanceDeltasFetcher,
+		effectivenessFetcher:    cfg.EffectivenessFetcher,
+		checkpointsFetcher:      cfg.CheckpointHistoryFetcher,
 		p2p:                     cfg.Broadcaster,
 		peersFetcher:            cfg.PeersFetcher,
 		peerManager:             cfg.PeerManager,
@@ -162,6 +194,11 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		powChainService:         cfg.POWChainService,
 		chainStartFetcher:       cfg.ChainStartFetcher,
 		mockEth1Votes:           cfg.MockEth1Votes,
+		eth1DataVoteStrategy:    cfg.Eth1DataVoteStrategy,
+		historicalStateCache:    historicalStateCache,
+		slotRootCache:           cache.NewSlotRootCache(),
+		performanceCache:        cache.NewValidatorPerformanceCache(),
+		pubkeyCache:             cache.NewValidatorPubkeyCache(),
 		attestationsPool:        cfg.AttestationsPool,
 		exitPool:                cfg.ExitPool,
 		slashingsPool:           cfg.SlashingsPool,
@@ -181,8 +218,10 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		operationNotifier:       cfg.OperationNotifier,
 		stateGen:                cfg.StateGen,
 		enableDebugRPCEndpoints: cfg.EnableDebugRPCEndpoints,
+		enableGRPCReflection:    cfg.EnableGRPCReflection,
 		connectedRPCClients:     make(map[net.Addr]bool),
 		maxMsgSize:              cfg.MaxMsgSize,
+		attestationCutoff:       cfg.AttestationCutoff,
 	}
 }
 
@@ -252,10 +291,12 @@ func (s *Service) Start() {
 		P2P:                    s.p2p,
 		BlockReceiver:          s.blockReceiver,
 		MockEth1Votes:          s.mockEth1Votes,
+		Eth1DataVoteStrategy:   s.eth1DataVoteStrategy,
 		Eth1BlockFetcher:       s.powChainService,
 		PendingDepositsFetcher: s.pendingDepositFetcher,
 		SlashingsPool:          s.slashingsPool,
 		StateGen:               s.stateGen,
+		AttestationCutoff:      s.attestationCutoff,
 	}
 	nodeServer := &node.Server{
 		LogsStreamer:         logutil.NewStreamServer(),
@@ -305,44 +346,63 @@ func (s *Service) Start() {
 		CollectedAttestationsBuffer: make(chan []*ethpb.Attestation, attestationBufferSize),
 	}
 	beaconChainServerV1 := &beaconv1.Server{
-		Ctx:                 s.ctx,
-		BeaconDB:            s.beaconDB,
-		AttestationsPool:    s.attestationsPool,
-		SlashingsPool:       s.slashingsPool,
-		ChainInfoFetcher:    s.chainInfoFetcher,
-		ChainStartFetcher:   s.chainStartFetcher,
-		DepositFetcher:      s.depositFetcher,
-		BlockFetcher:        s.powChainService,
-		CanonicalStateChan:  s.canonicalStateChan,
-		GenesisTimeFetcher:  s.timeFetcher,
-		StateNotifier:       s.stateNotifier,
-		BlockNotifier:       s.blockNotifier,
-		AttestationNotifier: s.operationNotifier,
-		Broadcaster:         s.p2p,
-		StateGenService:     s.stateGen,
-		SyncChecker:         s.syncService,
+		Ctx:                  s.ctx,
+		BeaconDB:             s.beaconDB,
+		AttestationsPool:     s.attestationsPool,
+		SlashingsPool:        s.slashingsPool,
+		ChainInfoFetcher:     s.chainInfoFetcher,
+		ChainStartFetcher:    s.chainStartFetcher,
+		DepositFetcher:       s.depositFetcher,
+		BlockFetcher:         s.powChainService,
+		CanonicalStateChan:   s.canonicalStateChan,
+		GenesisTimeFetcher:   s.timeFetcher,
+		StateNotifier:        s.stateNotifier,
+		BlockNotifier:        s.blockNotifier,
+		AttestationNotifier:  s.operationNotifier,
+		Broadcaster:          s.p2p,
+		StateGenService:      s.stateGen,
+		SyncChecker:          s.syncService,
+		HistoricalStateCache: s.historicalStateCache,
+		SlotRootCache:        s.slotRootCache,
 	}
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpbv1.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
 	pbrpc.RegisterHealthServer(s.grpcServer, nodeServer)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 	ethpbv1.RegisterBeaconChainServer(s.grpcServer, beaconChainServerV1)
+	pbrpc.RegisterLivenessServer(s.grpcServer, beaconChainServerV1)
+	s.beaconServerV1 = beaconChainServerV1
 	if s.enableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
 		debugServer := &debug.Server{
-			GenesisTimeFetcher: s.timeFetcher,
-			BeaconDB:           s.beaconDB,
-			StateGen:           s.stateGen,
-			HeadFetcher:        s.headFetcher,
-			PeerManager:        s.peerManager,
-			PeersFetcher:       s.peersFetcher,
+			GenesisTimeFetcher:        s.timeFetcher,
+			BeaconDB:                  s.beaconDB,
+			StateGen:                  s.stateGen,
+			HeadFetcher:               s.headFetcher,
+			PeerManager:               s.peerManager,
+			PeersFetcher:              s.peersFetcher,
+			HeadUpdater:               s.headUpdater,
+			BalanceDeltasFetcher:      s.balanceDeltasFetcher,
+			EffectivenessFetcher:      s.effectivenessFetcher,
+			CheckpointHistoryFetcher:  s.checkpointsFetcher,
+			ValidatorPerformanceCache: s.performanceCache,
+			ValidatorPubkeyCache:      s.pubkeyCache,
+			DepositFetcher:            s.depositFetcher,
+			CanonicalFetcher:          s.canonicalFetcher,
+			AttestationsPool:          s.attestationsPool,
 		}
 		pbrpc.RegisterDebugServer(s.grpcServer, debugServer)
+		go s.updatePubkeyCacheOnEpochTransition()
 	}
 	ethpb.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
 
-	// Register reflection service on gRPC server.
-	reflection.Register(s.grpcServer)
+	// Reflection exposes the full service surface to any client, so it is opt-in only.
+	if s.enableGRPCReflection {
+		log.Info("Enabled gRPC server reflection")
+		reflection.Register(s.grpcServer)
+	}
+
+	go s.pruneCachesOnReorg()
 
 	go func() {
 		if s.listener != nil {
@@ -353,6 +413,80 @@ func (s *Service) Start() {
 	}()
 }
 
+// updatePubkeyCacheOnEpochTransition adds the validators of the head state's registry to the
+// validator public key cache at each epoch transition, when pending validators get activated.
+func (s *Service) updatePubkeyCacheOnEpochTransition() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case ev := <-stateChannel:
+			if ev.Type != statefeed.NewHead {
+				continue
+			}
+			data, ok := ev.Data.(*statefeed.NewHeadData)
+			if !ok || !data.EpochTransition {
+				continue
+			}
+			headState, err := s.headFetcher.HeadState(s.ctx)
+			if err != nil || headState == nil {
+				log.WithError(err).Debug("Could not get head state to update validator public key cache")
+				continue
+			}
+			s.pubkeyCache.Update(headState)
+		case <-stateSub.Err():
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// pruneCachesOnReorg removes the cached historical states, block roots and validator performance
+// records which may no longer be canonical after a chain reorg, that is all the ones depending on
+// blocks after the finalized checkpoint.
+func (s *Service) pruneCachesOnReorg() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case ev := <-stateChannel:
+			if ev.Type != statefeed.Reorg {
+				continue
+			}
+			finalizedSlot, err := helpers.StartSlot(s.chainInfoFetcher.FinalizedCheckpt().Epoch)
+			if err != nil {
+				log.WithError(err).Error("Could not compute finalized slot")
+				continue
+			}
+			if s.historicalStateCache != nil {
+				s.historicalStateCache.PruneAfter(finalizedSlot)
+			}
+			s.slotRootCache.PruneAfter(finalizedSlot)
+			s.performanceCache.PruneAfter(finalizedSlot)
+		case <-stateSub.Err():
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// HTTPHandler serves the standard API endpoints which the gateway cannot proxy to gRPC, from the same
+// server as the gRPC endpoints, and passes any other request to next. It must only serve requests
+// once the service is started.
+func (s *Service) HTTPHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.beaconServerV1 == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		s.beaconServerV1.HTTPHandler(next).ServeHTTP(w, r)
+	})
+}
+
 // Stop the service.
 func (s *Service) Stop() error {
 	s.cancel()
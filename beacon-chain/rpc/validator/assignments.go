@@ -161,6 +161,10 @@ func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.
 			assignment.ValidatorIndex = idx
 			assignment.Status = s
 			assignment.ProposerSlots = proposerIndexToSlots[idx]
+			// Record the proposals of the local validators so the attestation pool keeps what they need.
+			for _, slot := range assignment.ProposerSlots {
+				cache.LocalProposalSlots.Add(slot)
+			}
 
 			// The next epoch has no lookup for proposer indexes.
 			nextAssignment.ValidatorIndex = idx
@@ -0,0 +1,21 @@
+package validator
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	proposerAttsAvailable = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "proposer_attestations_available",
+		Help: "The number of aggregated and unaggregated attestations in the pool when the last block was built.",
+	})
+	proposerAttsIncluded = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "proposer_attestations_included",
+		Help: "The number of attestations included in the last block built.",
+	})
+	proposerAttsCutoffs = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "proposer_attestation_cutoffs_total",
+		Help: "The number of blocks built for which the attestation gathering cutoff stopped gathering attestations.",
+	})
+)
@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"fmt"
@@ -26,6 +27,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -38,6 +40,19 @@ var eth1DataNotification bool
 
 const eth1dataTimeout = 2 * time.Second
 
+const (
+	// LocalEth1DataVoteStrategy votes for the eth1 data seen by the eth1 node of the beacon node,
+	// following the majority vote only amongst the votes for blocks known to it.
+	LocalEth1DataVoteStrategy = "local"
+	// FollowMajorityEth1DataVoteStrategy votes for the eth1 data held by a majority of the votes of the
+	// current voting period, even if its block is outside of the range the beacon node would vote for.
+	// The majority is only followed once it holds enough votes, and if its block and deposits are known
+	// locally, as the proposed block must include the deposits it commits to. Eth1 data votes are not
+	// slashable, so following the majority puts no funds at risk, but an incorrect eth1 data delays
+	// deposits processing.
+	FollowMajorityEth1DataVoteStrategy = "follow-majority"
+)
+
 type eth1DataSingleVote struct {
 	eth1Data    ethpb.Eth1Data
 	blockHeight *big.Int
@@ -198,6 +213,11 @@ func (vs *Server) eth1DataMajorityVote(ctx context.Context, beaconState iface.Be
 	if !vs.Eth1InfoFetcher.IsConnectedToETH1() {
 		return vs.randomETH1DataVote(ctx)
 	}
+	if vs.Eth1DataVoteStrategy == FollowMajorityEth1DataVoteStrategy {
+		if vote := vs.followedMajorityVote(ctx, beaconState); vote != nil {
+			return vote, nil
+		}
+	}
 	eth1DataNotification = false
 
 	eth1FollowDistance := params.BeaconConfig().Eth1FollowDistance
@@ -318,6 +338,45 @@ func chosenEth1DataMajorityVote(votes []eth1DataSingleVote) eth1DataAggregatedVo
 	return currentVote
 }
 
+// followedMajorityVote returns the eth1 data held by a strict majority of the votes of the current
+// voting period, provided it gathered at least an epoch worth of votes and does not undo deposit
+// progress. The block of the vote must be known to the eth1 node and the deposit cache must hold
+// the deposits it commits to, as the proposed block could not include them otherwise. It returns
+// nil if no vote satisfies these bounds.
+func (vs *Server) followedMajorityVote(ctx context.Context, beaconState iface.ReadOnlyBeaconState) *ethpb.Eth1Data {
+	currentETH1Data := vs.HeadFetcher.HeadETH1Data()
+	votes := beaconState.Eth1DataVotes()
+	singleVotes := make([]eth1DataSingleVote, 0, len(votes))
+	for _, eth1Data := range votes {
+		// Make sure we don't "undo deposit progress". See https://github.com/ethereum/eth2.0-specs/pull/1836
+		if eth1Data.DepositCount < currentETH1Data.DepositCount {
+			continue
+		}
+		// The block height does not break ties here, as only a strict majority is followed.
+		singleVotes = append(singleVotes, eth1DataSingleVote{eth1Data: *eth1Data, blockHeight: big.NewInt(0)})
+	}
+	chosenVote := chosenEth1DataMajorityVote(singleVotes)
+	if chosenVote.votes < int(params.BeaconConfig().SlotsPerEpoch) || 2*chosenVote.votes <= len(votes) {
+		return nil
+	}
+	vote := &chosenVote.data.eth1Data
+	exists, height, err := vs.BlockFetcher.BlockExistsWithCache(ctx, bytesutil.ToBytes32(vote.BlockHash))
+	if err != nil || !exists {
+		log.WithField("blockHash", fmt.Sprintf("%#x", vote.BlockHash)).Debug("Not following majority eth1 data vote on an unknown block")
+		return nil
+	}
+	depositCount, depositRoot := vs.DepositFetcher.DepositsNumberAndRootAtHeight(ctx, height)
+	if depositCount != vote.DepositCount || !bytes.Equal(depositRoot[:], vote.DepositRoot) {
+		log.WithFields(logrus.Fields{
+			"blockHash":    fmt.Sprintf("%#x", vote.BlockHash),
+			"depositCount": vote.DepositCount,
+			"cachedCount":  depositCount,
+		}).Debug("Not following majority eth1 data vote not matching the deposit cache")
+		return nil
+	}
+	return vote
+}
+
 func (vs *Server) mockETH1DataVote(ctx context.Context, slot types.Slot) (*ethpb.Eth1Data, error) {
 	if !eth1DataNotification {
 		log.Warn("Beacon Node is no longer connected to an ETH1 chain, so ETH1 data votes are now mocked.")
@@ -546,11 +605,30 @@ func (vs *Server) filterAttestationsForBlockInclusion(ctx context.Context, st if
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.filterAttestationsForBlockInclusion")
 	defer span.End()
 
-	validAtts, invalidAtts := proposerAtts(atts).filter(ctx, st)
+	validAtts, _, err := vs.filterAttestationsUntil(ctx, st, atts, time.Time{})
+	return validAtts, err
+}
+
+// filterAttestationsUntil is filterAttestationsForBlockInclusion, stopping once the deadline passed.
+// It also returns whether the deadline stopped the filtering.
+func (vs *Server) filterAttestationsUntil(
+	ctx context.Context, st iface.BeaconState, atts []*ethpb.Attestation, deadline time.Time,
+) ([]*ethpb.Attestation, bool, error) {
+	validAtts, invalidAtts, cutoff := proposerAtts(atts).filterUntil(ctx, st, deadline)
 	if err := vs.deleteAttsInPool(ctx, invalidAtts); err != nil {
-		return nil, err
+		return nil, false, err
 	}
-	return validAtts.dedup().sortByProfitability().limitToMaxAttestations(), nil
+	return validAtts.dedup().sortByProfitability().limitToMaxAttestations(), cutoff, nil
+}
+
+// attestationDeadline returns the time into the slot of the block after which attestations are no
+// longer gathered for it, or the zero time if the attestation gathering cutoff is disabled.
+func (vs *Server) attestationDeadline(slot types.Slot) time.Time {
+	if vs.AttestationCutoff <= 0 {
+		return time.Time{}
+	}
+	genesisTime := uint64(vs.TimeFetcher.GenesisTime().Unix())
+	return slotutil.SlotStartTime(genesisTime, slot).Add(vs.AttestationCutoff)
 }
 
 // The input attestations are processed and seen by the node, this deletes them from pool
@@ -593,19 +671,24 @@ func (vs *Server) packAttestations(ctx context.Context, latestState iface.Beacon
 	defer span.End()
 
 	atts := vs.AttPool.AggregatedAttestations()
+	available := len(atts) + vs.AttPool.UnaggregatedAttestationCount()
 	atts, err := vs.filterAttestationsForBlockInclusion(ctx, latestState, atts)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not filter attestations")
 	}
 
-	// If there is any room left in the block, consider unaggregated attestations as well.
+	// If there is any room left in the block, consider unaggregated attestations as well until the
+	// attestation gathering cutoff. The aggregates are always considered, as they are few and carry
+	// most of the votes.
+	deadline := vs.attestationDeadline(latestState.Slot())
 	numAtts := uint64(len(atts))
-	if numAtts < params.BeaconConfig().MaxAttestations {
+	cutoff := numAtts < params.BeaconConfig().MaxAttestations && pastDeadline(deadline)
+	if numAtts < params.BeaconConfig().MaxAttestations && !cutoff {
 		uAtts, err := vs.AttPool.UnaggregatedAttestations()
 		if err != nil {
 			return nil, errors.Wrap(err, "could not get unaggregated attestations")
 		}
-		uAtts, err = vs.filterAttestationsForBlockInclusion(ctx, latestState, uAtts)
+		uAtts, cutoff, err = vs.filterAttestationsUntil(ctx, latestState, uAtts, deadline)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not filter attestations")
 		}
@@ -630,5 +713,16 @@ func (vs *Server) packAttestations(ctx context.Context, latestState iface.Beacon
 		}
 		atts = attsForInclusion.dedup().sortByProfitability().limitToMaxAttestations()
 	}
+
+	if cutoff {
+		proposerAttsCutoffs.Inc()
+		log.WithFields(logrus.Fields{
+			"slot":      latestState.Slot(),
+			"available": available,
+			"included":  len(atts),
+		}).Debug("Attestation gathering cutoff reached while building block")
+	}
+	proposerAttsAvailable.Set(float64(available))
+	proposerAttsIncluded.Set(float64(len(atts)))
 	return atts, nil
 }
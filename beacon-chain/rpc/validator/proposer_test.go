@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	types "github.com/prysmaticlabs/eth2-types"
@@ -1305,6 +1306,91 @@ func TestProposer_Eth1Data_MajorityVote(t *testing.T) {
 		assert.DeepEqual(t, expectedHash, hash)
 	})
 
+	t.Run("follow majority strategy - choose known block outside of range with majority", func(t *testing.T) {
+		p := mockPOW.NewPOWChain().
+			InsertBlock(50, earliestValidTime, []byte("earliest")).
+			InsertBlock(51, earliestValidTime+1, []byte("first")).
+			InsertBlock(100, latestValidTime, []byte("latest")).
+			InsertBlock(120, latestValidTime+20, []byte("after_range"))
+
+		depositRoot := depositTrie.Root()
+		var votes []*ethpb.Eth1Data
+		for i := types.Slot(0); i < params.BeaconConfig().SlotsPerEpoch; i++ {
+			votes = append(votes, &ethpb.Eth1Data{BlockHash: []byte("after_range"), DepositCount: 1, DepositRoot: depositRoot[:]})
+		}
+		votes = append(votes, &ethpb.Eth1Data{BlockHash: []byte("first"), DepositCount: 1})
+		beaconState, err := beaconstate.InitializeFromProto(&pbp2p.BeaconState{
+			Slot:          slot,
+			Eth1DataVotes: votes,
+		})
+		require.NoError(t, err)
+
+		ps := &Server{
+			ChainStartFetcher:    p,
+			Eth1InfoFetcher:      p,
+			Eth1BlockFetcher:     p,
+			BlockFetcher:         p,
+			DepositFetcher:       depositCache,
+			HeadFetcher:          &mock.ChainService{ETH1Data: &ethpb.Eth1Data{DepositCount: 1}},
+			Eth1DataVoteStrategy: FollowMajorityEth1DataVoteStrategy,
+		}
+
+		ctx := context.Background()
+		majorityVoteEth1Data, err := ps.eth1DataMajorityVote(ctx, beaconState)
+		require.NoError(t, err)
+		assert.DeepEqual(t, []byte("after_range"), majorityVoteEth1Data.BlockHash)
+
+		// Without an epoch worth of votes, the majority is not followed.
+		beaconState, err = beaconstate.InitializeFromProto(&pbp2p.BeaconState{
+			Slot:          slot,
+			Eth1DataVotes: votes[len(votes)-3:],
+		})
+		require.NoError(t, err)
+		majorityVoteEth1Data, err = ps.eth1DataMajorityVote(ctx, beaconState)
+		require.NoError(t, err)
+		assert.DeepEqual(t, []byte("first"), majorityVoteEth1Data.BlockHash)
+	})
+
+	t.Run("follow majority strategy - ignore majority on unknown block or deposits", func(t *testing.T) {
+		p := mockPOW.NewPOWChain().
+			InsertBlock(50, earliestValidTime, []byte("earliest")).
+			InsertBlock(51, earliestValidTime+1, []byte("first")).
+			InsertBlock(100, latestValidTime, []byte("latest")).
+			InsertBlock(120, latestValidTime+20, []byte("after_range"))
+
+		depositRoot := depositTrie.Root()
+		for name, majority := range map[string]*ethpb.Eth1Data{
+			"unknown block":          {BlockHash: []byte("unknown"), DepositCount: 1, DepositRoot: depositRoot[:]},
+			"unknown deposits":       {BlockHash: []byte("after_range"), DepositCount: 2, DepositRoot: depositRoot[:]},
+			"different deposit root": {BlockHash: []byte("after_range"), DepositCount: 1, DepositRoot: []byte("other")},
+		} {
+			var votes []*ethpb.Eth1Data
+			for i := types.Slot(0); i < params.BeaconConfig().SlotsPerEpoch; i++ {
+				votes = append(votes, majority)
+			}
+			votes = append(votes, &ethpb.Eth1Data{BlockHash: []byte("first"), DepositCount: 1})
+			beaconState, err := beaconstate.InitializeFromProto(&pbp2p.BeaconState{
+				Slot:          slot,
+				Eth1DataVotes: votes,
+			})
+			require.NoError(t, err)
+
+			ps := &Server{
+				ChainStartFetcher:    p,
+				Eth1InfoFetcher:      p,
+				Eth1BlockFetcher:     p,
+				BlockFetcher:         p,
+				DepositFetcher:       depositCache,
+				HeadFetcher:          &mock.ChainService{ETH1Data: &ethpb.Eth1Data{DepositCount: 1}},
+				Eth1DataVoteStrategy: FollowMajorityEth1DataVoteStrategy,
+			}
+
+			majorityVoteEth1Data, err := ps.eth1DataMajorityVote(context.Background(), beaconState)
+			require.NoError(t, err)
+			assert.DeepEqual(t, []byte("first"), majorityVoteEth1Data.BlockHash, name)
+		}
+	})
+
 	t.Run("no blocks in range - choose current eth1data", func(t *testing.T) {
 		p := mockPOW.NewPOWChain().
 			InsertBlock(49, earliestValidTime-1, []byte("before_range")).
@@ -1718,6 +1804,59 @@ func TestProposer_FilterAttestation(t *testing.T) {
 	}
 }
 
+func TestProposer_PackAttestations_Cutoff(t *testing.T) {
+	ctx := context.Background()
+	testutil.ResetCache()
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	committee, err := helpers.BeaconCommitteeFromState(st, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(1))
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte("foo")).Marshal()
+
+	newServer := func(cutoff time.Duration, genesis time.Time) *Server {
+		pool := attestations.NewPool()
+		for i := range committee {
+			bits := bitfield.NewBitlist(uint64(len(committee)))
+			bits.SetBitAt(uint64(i), true)
+			require.NoError(t, pool.SaveUnaggregatedAttestation(testutil.HydrateAttestation(&ethpb.Attestation{
+				AggregationBits: bits,
+				Signature:       sig,
+			})))
+		}
+		return &Server{
+			AttPool:           pool,
+			TimeFetcher:       &mock.ChainService{Genesis: genesis},
+			AttestationCutoff: cutoff,
+		}
+	}
+	longAgo := time.Now().Add(-time.Hour)
+
+	// The slot of the block started an hour ago, past the cutoff.
+	vs := newServer(time.Second, longAgo)
+	atts, err := vs.packAttestations(ctx, st)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(atts))
+	assert.Equal(t, len(committee), vs.AttPool.UnaggregatedAttestationCount(), "Unchecked attestations were deleted")
+
+	// The slot of the block has not started yet.
+	vs = newServer(time.Second, time.Now())
+	atts, err = vs.packAttestations(ctx, st)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(atts))
+	assert.Equal(t, uint64(len(committee)), atts[0].AggregationBits.Count())
+
+	// Without cutoff, attestations are gathered however late the block is.
+	vs = newServer(0, longAgo)
+	atts, err = vs.packAttestations(ctx, st)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(atts))
+	assert.Equal(t, uint64(len(committee)), atts[0].AggregationBits.Count())
+}
+
 func TestProposer_Deposits_ReturnsEmptyList_IfLatestEth1DataEqGenesisEth1Block(t *testing.T) {
 	ctx := context.Background()
 
@@ -3,6 +3,7 @@ package validator
 import (
 	"context"
 	"sort"
+	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -12,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/aggregation"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 )
 
 type proposerAtts []*ethpb.Attestation
@@ -20,16 +22,34 @@ type proposerAtts []*ethpb.Attestation
 // The first group passes the all the required checks for attestation to be considered for proposing.
 // And attestations from the second group should be deleted.
 func (a proposerAtts) filter(ctx context.Context, state iface.BeaconState) (proposerAtts, proposerAtts) {
+	validAtts, invalidAtts, _ := a.filterUntil(ctx, state, time.Time{})
+	return validAtts, invalidAtts
+}
+
+// filterUntil is filter, stopping once the deadline passed. The attestations left unchecked by then
+// are in neither group, and whether the deadline stopped the filtering is returned. A zero deadline
+// never passes.
+func (a proposerAtts) filterUntil(
+	ctx context.Context, state iface.BeaconState, deadline time.Time,
+) (proposerAtts, proposerAtts, bool) {
 	validAtts := make([]*ethpb.Attestation, 0, len(a))
 	invalidAtts := make([]*ethpb.Attestation, 0, len(a))
 	for _, att := range a {
+		if pastDeadline(deadline) {
+			return validAtts, invalidAtts, true
+		}
 		if _, err := blocks.ProcessAttestationNoVerifySignature(ctx, state, att); err == nil {
 			validAtts = append(validAtts, att)
 			continue
 		}
 		invalidAtts = append(invalidAtts, att)
 	}
-	return validAtts, invalidAtts
+	return validAtts, invalidAtts, false
+}
+
+// pastDeadline returns whether the deadline passed, a zero deadline never passes.
+func pastDeadline(deadline time.Time) bool {
+	return !deadline.IsZero() && timeutils.Now().After(deadline)
 }
 
 // sortByProfitability orders attestations by highest slot and by highest aggregation bit count.
@@ -59,10 +59,12 @@ type Server struct {
 	ExitPool               voluntaryexits.PoolManager
 	BlockReceiver          blockchain.BlockReceiver
 	MockEth1Votes          bool
+	Eth1DataVoteStrategy   string
 	Eth1BlockFetcher       powchain.POWBlockFetcher
 	PendingDepositsFetcher depositcache.PendingDepositsFetcher
 	OperationNotifier      opfeed.Notifier
 	StateGen               *stategen.State
+	AttestationCutoff      time.Duration
 }
 
 // WaitForActivation checks if a validator public key exists in the active validator registry of the current
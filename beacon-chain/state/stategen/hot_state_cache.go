@@ -10,7 +10,7 @@ import (
 )
 
 var (
-	// hotStateCacheSize defines the max number of hot state this can cache.
+	// hotStateCacheSize defines the default max number of hot state this can cache.
 	hotStateCacheSize = 32
 	// Metrics
 	hotStateCacheHit = promauto.NewCounter(prometheus.CounterOpts{
@@ -21,23 +21,69 @@ var (
 		Name: "hot_state_cache_miss",
 		Help: "The total number of cache misses on the hot state cache.",
 	})
+	hotStateCacheEviction = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "hot_state_cache_eviction",
+		Help: "The total number of states evicted from the hot state cache to make room for newer states.",
+	})
+)
+
+// Kinds of states pinned in the hot state cache.
+const (
+	pinnedHead      = "head"
+	pinnedFinalized = "finalized"
 )
 
+// pinnedState is a state kept by the hot state cache regardless of its size.
+type pinnedState struct {
+	root  [32]byte
+	state iface.BeaconState
+}
+
 // hotStateCache is used to store the processed beacon state after finalized check point..
+// The head and finalized states are pinned, so that they are never evicted by the states
+// of the forks processed during reorg heavy periods.
 type hotStateCache struct {
-	cache *lru.Cache
-	lock  sync.RWMutex
+	cache  *lru.Cache
+	pinned map[string]*pinnedState
+	lock   sync.RWMutex
 }
 
 // newHotStateCache initializes the map and underlying cache.
-func newHotStateCache() *hotStateCache {
-	cache, err := lru.New(hotStateCacheSize)
+func newHotStateCache(size int) *hotStateCache {
+	cache, err := lru.New(size)
 	if err != nil {
 		panic(err)
 	}
 	return &hotStateCache{
-		cache: cache,
+		cache:  cache,
+		pinned: make(map[string]*pinnedState),
+	}
+}
+
+// resize changes the max number of states of the cache, evicting the least recently used
+// states if the cache shrinks.
+func (c *hotStateCache) resize(size int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	hotStateCacheEviction.Add(float64(c.cache.Resize(size)))
+}
+
+// pin keeps the state of the given kind in the cache, replacing the state previously pinned for it.
+// The pinned state must not be modified afterwards.
+func (c *hotStateCache) pin(kind string, root [32]byte, state iface.BeaconState) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.pinned[kind] = &pinnedState{root: root, state: state}
+}
+
+// pinnedByRoot returns the state pinned with the given root, if any. The caller must hold the lock.
+func (c *hotStateCache) pinnedByRoot(root [32]byte) iface.BeaconState {
+	for _, p := range c.pinned {
+		if p.root == root {
+			return p.state
+		}
 	}
+	return nil
 }
 
 // Get returns a cached response via input block root, if any.
@@ -45,6 +91,10 @@ func newHotStateCache() *hotStateCache {
 func (c *hotStateCache) get(root [32]byte) iface.BeaconState {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
+	if st := c.pinnedByRoot(root); st != nil {
+		hotStateCacheHit.Inc()
+		return st.Copy()
+	}
 	item, exists := c.cache.Get(root)
 
 	if exists && item != nil {
@@ -56,9 +106,14 @@ func (c *hotStateCache) get(root [32]byte) iface.BeaconState {
 }
 
 // GetWithoutCopy returns a non-copied cached response via input block root.
+// Pinned states are still copied, as they are shared.
 func (c *hotStateCache) getWithoutCopy(root [32]byte) iface.BeaconState {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
+	if st := c.pinnedByRoot(root); st != nil {
+		hotStateCacheHit.Inc()
+		return st.Copy()
+	}
 	item, exists := c.cache.Get(root)
 	if exists && item != nil {
 		hotStateCacheHit.Inc()
@@ -72,17 +127,19 @@ func (c *hotStateCache) getWithoutCopy(root [32]byte) iface.BeaconState {
 func (c *hotStateCache) put(root [32]byte, state iface.BeaconState) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	c.cache.Add(root, state)
+	if evicted := c.cache.Add(root, state); evicted {
+		hotStateCacheEviction.Inc()
+	}
 }
 
 // has returns true if the key exists in the cache.
 func (c *hotStateCache) has(root [32]byte) bool {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
-	return c.cache.Contains(root)
+	return c.pinnedByRoot(root) != nil || c.cache.Contains(root)
 }
 
-// delete deletes the key exists in the cache.
+// delete deletes the key exists in the cache. Pinned states are kept.
 func (c *hotStateCache) delete(root [32]byte) bool {
 	c.lock.Lock()
 	defer c.lock.Unlock()
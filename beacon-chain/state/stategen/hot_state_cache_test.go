@@ -3,6 +3,7 @@ package stategen
 import (
 	"testing"
 
+	types "github.com/prysmaticlabs/eth2-types"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -11,7 +12,7 @@ import (
 )
 
 func TestHotStateCache_RoundTrip(t *testing.T) {
-	c := newHotStateCache()
+	c := newHotStateCache(hotStateCacheSize)
 	root := [32]byte{'A'}
 	state := c.get(root)
 	assert.Equal(t, iface.BeaconState(nil), state)
@@ -32,3 +33,41 @@ func TestHotStateCache_RoundTrip(t *testing.T) {
 	c.delete(root)
 	assert.Equal(t, false, c.has(root), "Cache not supposed to have the object")
 }
+
+func TestHotStateCache_PinnedStatesAreNotEvicted(t *testing.T) {
+	c := newHotStateCache(1)
+	pinned, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: 1})
+	require.NoError(t, err)
+	c.pin(pinnedHead, [32]byte{'A'}, pinned)
+
+	for i := byte(0); i < 3; i++ {
+		st, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: types.Slot(i) + 2})
+		require.NoError(t, err)
+		c.put([32]byte{'B', i}, st)
+	}
+	assert.Equal(t, false, c.has([32]byte{'B', 0}), "Least recently used state was not evicted")
+	assert.Equal(t, true, c.has([32]byte{'B', 2}), "Most recent state was evicted")
+	assert.Equal(t, true, c.has([32]byte{'A'}), "Pinned state was evicted")
+	c.delete([32]byte{'A'})
+	res := c.getWithoutCopy([32]byte{'A'})
+	require.NotNil(t, res)
+	assert.Equal(t, types.Slot(1), res.Slot())
+
+	// Pinning another head state releases the previous one.
+	c.pin(pinnedHead, [32]byte{'C'}, pinned)
+	assert.Equal(t, false, c.has([32]byte{'A'}), "Previous head state is still pinned")
+	assert.Equal(t, true, c.has([32]byte{'C'}), "Head state is not pinned")
+}
+
+func TestState_SetHotStateCacheSize(t *testing.T) {
+	s := New(nil)
+	require.ErrorContains(t, "hot state cache size must be at least 1", s.SetHotStateCacheSize(0))
+	for i := byte(0); i < 3; i++ {
+		st, err := stateTrie.InitializeFromProto(&pb.BeaconState{Slot: types.Slot(i)})
+		require.NoError(t, err)
+		s.hotStateCache.put([32]byte{i}, st)
+	}
+	require.NoError(t, s.SetHotStateCacheSize(1))
+	assert.Equal(t, false, s.hotStateCache.has([32]byte{1}), "State was not evicted on resize")
+	assert.Equal(t, true, s.hotStateCache.has([32]byte{2}), "Most recent state was evicted on resize")
+}
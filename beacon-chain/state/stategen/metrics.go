@@ -13,4 +13,10 @@ var (
 			Buckets: []float64{64, 256, 1024, 2048, 4096},
 		},
 	)
+	prunedStateCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "pruned_states_count",
+			Help: "The number of finalized states pruned from the DB",
+		},
+	)
 )
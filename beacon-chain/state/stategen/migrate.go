@@ -5,8 +5,10 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	types "github.com/prysmaticlabs/eth2-types"
 	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -115,5 +117,38 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 		s.SaveFinalizedState(fSlot, fRoot, fInfo.state)
 	}
 
+	return s.pruneFinalizedStates(ctx, fSlot)
+}
+
+// pruneFinalizedStates deletes the states saved in the DB that are older than the state
+// retention window below the finalized slot, keeping the ones on archived points.
+// States are not pruned while hot states are being saved to the DB, as those are
+// tracked separately and deleted once finality resumes.
+func (s *State) pruneFinalizedStates(ctx context.Context, fSlot types.Slot) error {
+	if s.stateRetentionEpochs == 0 {
+		return nil
+	}
+	s.saveHotStateDB.lock.Lock()
+	savingHotStates := s.saveHotStateDB.enabled
+	s.saveHotStateDB.lock.Unlock()
+	if savingHotStates {
+		return nil
+	}
+
+	retentionSlots := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(s.stateRetentionEpochs))
+	if fSlot <= retentionSlots {
+		return nil
+	}
+	pruned, err := s.beaconDB.PruneStates(ctx, fSlot-retentionSlots, s.slotsPerArchivedPoint)
+	if err != nil {
+		return err
+	}
+	if pruned > 0 {
+		prunedStateCount.Add(float64(pruned))
+		log.WithFields(logrus.Fields{
+			"count":     pruned,
+			"belowSlot": fSlot - retentionSlots,
+		}).Debug("Pruned finalized states")
+	}
 	return nil
 }
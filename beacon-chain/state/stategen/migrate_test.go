@@ -5,9 +5,11 @@ import (
 	"testing"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -134,3 +136,85 @@ func TestMigrateToCold_StateExistsInDB(t *testing.T) {
 	assert.DeepEqual(t, [][32]byte{{1}, {2}, {3}, {4}}, service.saveHotStateDB.savedStateRoots)
 	assert.LogsDoNotContain(t, hook, "Saved state in DB")
 }
+
+func TestMigrateToCold_PrunesStatesOutsideRetention(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+	service.slotsPerArchivedPoint = 4
+	service.EnableStatePruning(1)
+
+	beaconState, pks := testutil.DeterministicGenesisState(t, 32)
+	genesisStateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, beaconState, gRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+
+	b1, err := testutil.GenerateFullBlock(beaconState, pks, testutil.DefaultBlockGenConfig(), 1)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, b1))
+	r1, err := b1.Block.HashTreeRoot()
+	require.NoError(t, err)
+	s1, err := service.ReplayBlocks(ctx, beaconState.Copy(), []*ethpb.SignedBeaconBlock{b1}, 1)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, s1, r1))
+
+	// The state at the archived point is kept.
+	b4 := testutil.NewBeaconBlock()
+	b4.Block.Slot = 4
+	b4.Block.ParentRoot = r1[:]
+	require.NoError(t, beaconDB.SaveBlock(ctx, b4))
+	r4, err := b4.Block.HashTreeRoot()
+	require.NoError(t, err)
+	s4 := s1.Copy()
+	require.NoError(t, s4.SetSlot(4))
+	require.NoError(t, beaconDB.SaveState(ctx, s4, r4))
+
+	fSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	require.NoError(t, service.pruneFinalizedStates(ctx, fSlot))
+	assert.Equal(t, true, beaconDB.HasState(ctx, gRoot), "Genesis state was pruned")
+	assert.Equal(t, false, beaconDB.HasState(ctx, r1), "State outside of retention was not pruned")
+	assert.Equal(t, true, beaconDB.HasState(ctx, r4), "State on archived point was pruned")
+
+	// The pruned state is regenerated by replaying its block.
+	service.finalizedInfo.slot = fSlot
+	loaded, err := service.StateByRoot(ctx, r1)
+	require.NoError(t, err)
+	require.DeepSSZEqual(t, s1.InnerStateUnsafe(), loaded.InnerStateUnsafe())
+}
+
+func TestMigrateToCold_NoPruningWithinRetention(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+	service.slotsPerArchivedPoint = 4
+
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 1
+	require.NoError(t, beaconDB.SaveBlock(ctx, b))
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconState.SetSlot(1))
+	require.NoError(t, beaconDB.SaveState(ctx, beaconState, r))
+
+	fSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	// Pruning is disabled by default.
+	require.NoError(t, service.pruneFinalizedStates(ctx, fSlot))
+	assert.Equal(t, true, beaconDB.HasState(ctx, r))
+
+	// The state is within the retention window.
+	service.EnableStatePruning(2)
+	require.NoError(t, service.pruneFinalizedStates(ctx, fSlot))
+	assert.Equal(t, true, beaconDB.HasState(ctx, r))
+
+	// States are not pruned while hot states are saved to the DB.
+	service.EnableStatePruning(1)
+	service.EnableSaveHotStateToDB(ctx)
+	require.NoError(t, service.pruneFinalizedStates(ctx, fSlot))
+	assert.Equal(t, true, beaconDB.HasState(ctx, r))
+}
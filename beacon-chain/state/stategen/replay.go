@@ -254,6 +254,9 @@ func (s *State) genesisRoot(ctx context.Context) ([32]byte, error) {
 	if err != nil {
 		return [32]byte{}, err
 	}
+	if b == nil {
+		return [32]byte{}, errors.New("no genesis block in db")
+	}
 	return b.Block.HashTreeRoot()
 }
 
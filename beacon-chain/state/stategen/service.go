@@ -6,6 +6,7 @@ package stategen
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 
 	types "github.com/prysmaticlabs/eth2-types"
@@ -44,6 +45,7 @@ type StateManager interface {
 type State struct {
 	beaconDB                db.NoHeadAccessDatabase
 	slotsPerArchivedPoint   types.Slot
+	stateRetentionEpochs    types.Epoch
 	hotStateCache           *hotStateCache
 	finalizedInfo           *finalizedInfo
 	epochBoundaryStateCache *epochBoundaryState
@@ -73,7 +75,7 @@ type finalizedInfo struct {
 func New(beaconDB db.NoHeadAccessDatabase) *State {
 	return &State{
 		beaconDB:                beaconDB,
-		hotStateCache:           newHotStateCache(),
+		hotStateCache:           newHotStateCache(hotStateCacheSize),
 		finalizedInfo:           &finalizedInfo{slot: 0, root: params.BeaconConfig().ZeroHash},
 		slotsPerArchivedPoint:   params.BeaconConfig().SlotsPerArchivedPoint,
 		epochBoundaryStateCache: newBoundaryStateCache(),
@@ -83,6 +85,29 @@ func New(beaconDB db.NoHeadAccessDatabase) *State {
 	}
 }
 
+// EnableStatePruning prunes the finalized states that are older than the given number of epochs
+// and do not lay on an archived point every time the finalized checkpoint advances.
+func (s *State) EnableStatePruning(retentionEpochs types.Epoch) {
+	s.stateRetentionEpochs = retentionEpochs
+}
+
+// SetHotStateCacheSize sets the max number of hot states kept in memory, besides the pinned head and
+// finalized states. A larger cache uses more memory but avoids replaying blocks to regenerate the
+// states of recent forks, which is costly during reorg heavy periods.
+func (s *State) SetHotStateCacheSize(size int) error {
+	if size < 1 {
+		return fmt.Errorf("hot state cache size must be at least 1, got %d", size)
+	}
+	s.hotStateCache.resize(size)
+	return nil
+}
+
+// PinHeadState keeps the head state in the hot state cache until another head state is pinned,
+// regardless of the cache size. The state must not be modified afterwards.
+func (s *State) PinHeadState(root [32]byte, st iface.BeaconState) {
+	s.hotStateCache.pin(pinnedHead, root, st)
+}
+
 // Resume resumes a new state management object from previously saved finalized check point in DB.
 func (s *State) Resume(ctx context.Context) (iface.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.Resume")
@@ -112,6 +137,7 @@ func (s *State) Resume(ctx context.Context) (iface.BeaconState, error) {
 	}()
 
 	s.finalizedInfo = &finalizedInfo{slot: fState.Slot(), root: fRoot, state: fState.Copy()}
+	s.hotStateCache.pin(pinnedFinalized, fRoot, s.finalizedInfo.state)
 
 	return fState, nil
 }
@@ -125,6 +151,7 @@ func (s *State) SaveFinalizedState(fSlot types.Slot, fRoot [32]byte, fState ifac
 	s.finalizedInfo.root = fRoot
 	s.finalizedInfo.state = fState.Copy()
 	s.finalizedInfo.slot = fSlot
+	s.hotStateCache.pin(pinnedFinalized, fRoot, s.finalizedInfo.state)
 }
 
 // Returns true if input root equals to cached finalized root.
@@ -0,0 +1,47 @@
+package sync
+
+import (
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// SSZ encoded sizes of the fixed size parts of a signed beacon block, in bytes.
+const (
+	sszOffsetSize          = 4
+	sszSignatureSize       = 96
+	sszRootSize            = 32
+	sszAttestationDataSize = 128
+	sszBlockHeaderSize     = 112
+	sszEth1DataSize        = 72
+	sszDepositDataSize     = 184
+	sszVoluntaryExitSize   = 16
+)
+
+// maxBlockSSZSize returns the largest SSZ encoded size of a block accepted from peers.
+func maxBlockSSZSize() uint64 {
+	if size := flags.Get().MaxBlockSSZSize; size > 0 {
+		return size
+	}
+	return specMaxBlockSSZSize()
+}
+
+// specMaxBlockSSZSize returns the largest possible SSZ encoded size of a signed beacon block under
+// the current config: every operation list is full and every attestation is from a committee of the
+// maximum size.
+func specMaxBlockSSZSize() uint64 {
+	cfg := params.BeaconConfig()
+	indexedAttestation := sszOffsetSize + sszAttestationDataSize + sszSignatureSize + cfg.MaxValidatorsPerCommittee*8
+	attestation := sszOffsetSize + sszAttestationDataSize + sszSignatureSize + cfg.MaxValidatorsPerCommittee/8 + 1
+	deposit := (cfg.DepositContractTreeDepth+1)*sszRootSize + sszDepositDataSize
+
+	// Each variable size element of a list is prefixed by an offset.
+	body := sszSignatureSize + sszEth1DataSize + sszRootSize + 5*sszOffsetSize +
+		cfg.MaxProposerSlashings*2*(sszBlockHeaderSize+sszSignatureSize) +
+		cfg.MaxAttesterSlashings*(sszOffsetSize+2*(sszOffsetSize+indexedAttestation)) +
+		cfg.MaxAttestations*(sszOffsetSize+attestation) +
+		cfg.MaxDeposits*deposit +
+		cfg.MaxVoluntaryExits*(sszVoluntaryExitSize+sszSignatureSize)
+	// Slot, proposer index, parent root, state root and the offset of the body.
+	block := 8 + 8 + 2*sszRootSize + sszOffsetSize + body
+	return sszOffsetSize + sszSignatureSize + block
+}
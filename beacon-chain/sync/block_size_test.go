@@ -0,0 +1,83 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestSpecMaxBlockSSZSize(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	// Largest phase 0 signed beacon block on mainnet.
+	assert.Equal(t, uint64(157756), specMaxBlockSSZSize())
+
+	resetFlags := flags.Get()
+	t.Cleanup(func() {
+		flags.Init(resetFlags)
+	})
+	assert.Equal(t, uint64(157756), maxBlockSSZSize())
+	flags.Init(&flags.GlobalFlags{MaxBlockSSZSize: 1000})
+	assert.Equal(t, uint64(1000), maxBlockSSZSize())
+}
+
+func TestValidateBeaconBlockPubSub_RejectsOversizedBlock(t *testing.T) {
+	hook := logTest.NewGlobal()
+	p := p2ptest.NewTestP2P(t)
+	r := &Service{
+		p2p:         p,
+		initialSync: &mockSync.Sync{IsSyncing: false},
+	}
+	// Compresses well, so that it passes the gossip size limits but is larger than any valid block.
+	data := snappy.Encode(nil, make([]byte, maxBlockSSZSize()+1))
+	topic := p2p.GossipTypeMapping[reflect.TypeOf(&ethpb.SignedBeaconBlock{})]
+	m := &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data:  data,
+			Topic: &topic,
+		},
+	}
+	pid := peer.ID("oversized")
+	res := r.validateBeaconBlockPubSub(context.Background(), pid, m)
+	assert.Equal(t, pubsub.ValidationReject, res)
+	require.LogsContain(t, hook, "Rejected oversized block")
+	require.LogsDoNotContain(t, hook, "Could not decode message")
+	count, err := p.Peers().Scorers().BadResponsesScorer().Count(pid)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestDecodeBlockChunk_RejectsOversizedBlock(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+
+	// Only the length prefix of an oversized block is sent: it must be rejected without reading the payload.
+	prefix := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(prefix, maxBlockSSZSize()+1)
+	_, err := decodeBlockChunk(bytes.NewReader(prefix[:n]), p.Encoding())
+	assert.ErrorContains(t, ErrInvalidFetchedData.Error(), err)
+
+	blk := testutil.NewBeaconBlock()
+	buf := new(bytes.Buffer)
+	_, err = p.Encoding().EncodeWithMaxLength(buf, blk)
+	require.NoError(t, err)
+	decoded, err := decodeBlockChunk(buf, p.Encoding())
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, decoded)
+}
@@ -0,0 +1,30 @@
+package sync
+
+import (
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+// logIfAcceptedByClockTolerance logs a gossip message of the given slot which passed the slot time
+// checks only because the configured clock disparity is larger than the spec one.
+func logIfAcceptedByClockTolerance(genesisTime time.Time, slot types.Slot, msgType string) {
+	if params.BeaconNetworkConfig().MaximumGossipClockDisparity <= params.SpecMaximumGossipClockDisparity {
+		return
+	}
+	slotTime, err := helpers.SlotToTime(uint64(genesisTime.Unix()), slot)
+	if err != nil {
+		return
+	}
+	if early := slotTime.Sub(timeutils.Now()); early > params.SpecMaximumGossipClockDisparity {
+		log.WithFields(logrus.Fields{
+			"type":  msgType,
+			"slot":  slot,
+			"early": early,
+		}).Debug("Accepted gossip message from the future within the configured clock disparity tolerance")
+	}
+}
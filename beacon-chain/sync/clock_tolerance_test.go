@@ -0,0 +1,32 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestLogIfAcceptedByClockTolerance(t *testing.T) {
+	hook := logTest.NewGlobal()
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	// Slot 1 starts in 1.5 seconds.
+	genesis := timeutils.Now().Add(-slotDuration + 1500*time.Millisecond)
+
+	logIfAcceptedByClockTolerance(genesis, 1, "block")
+	require.LogsDoNotContain(t, hook, "clock disparity tolerance")
+
+	networkCfg := params.BeaconNetworkConfig()
+	defer params.OverrideBeaconNetworkConfig(networkCfg)
+	cfg := networkCfg.Copy()
+	cfg.MaximumGossipClockDisparity = 2 * time.Second
+	params.OverrideBeaconNetworkConfig(cfg)
+
+	logIfAcceptedByClockTolerance(genesis, 0, "block")
+	require.LogsDoNotContain(t, hook, "clock disparity tolerance")
+	logIfAcceptedByClockTolerance(genesis, 1, "block")
+	require.LogsContain(t, hook, "clock disparity tolerance")
+}
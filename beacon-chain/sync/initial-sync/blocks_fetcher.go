@@ -75,6 +75,7 @@ type blocksFetcher struct {
 	p2p             p2p.P2P
 	db              db.ReadOnlyDatabase
 	blocksPerSecond uint64
+	batchSizer      *batchSizer
 	rateLimiter     *leakybucket.Collector
 	peerLocks       map[peer.ID]*peerLock
 	fetchRequests   chan *fetchRequestParams
@@ -116,6 +117,12 @@ func newBlocksFetcher(ctx context.Context, cfg *blocksFetcherConfig) *blocksFetc
 		float64(blocksPerSecond), int64(allowedBlocksBurst-blocksPerSecond),
 		false /* deleteEmptyBuckets */)
 
+	// Batches can not exceed the spec's request limit, nor the rate limiter's capacity.
+	maxBatchSize := params.BeaconNetworkConfig().MaxRequestBlocks
+	if allowedBlocksBurst > blocksPerSecond && uint64(allowedBlocksBurst-blocksPerSecond) < maxBatchSize {
+		maxBatchSize = uint64(allowedBlocksBurst - blocksPerSecond)
+	}
+
 	capacityWeight := cfg.peerFilterCapacityWeight
 	if capacityWeight >= 1 {
 		capacityWeight = peerFilterCapacityWeight
@@ -130,6 +137,7 @@ func newBlocksFetcher(ctx context.Context, cfg *blocksFetcherConfig) *blocksFetc
 		p2p:             cfg.p2p,
 		db:              cfg.db,
 		blocksPerSecond: uint64(blocksPerSecond),
+		batchSizer:      newBatchSizer(uint64(blocksPerSecond), maxBatchSize),
 		rateLimiter:     rateLimiter,
 		peerLocks:       make(map[peer.ID]*peerLock),
 		fetchRequests:   make(chan *fetchRequestParams, maxPendingRequests),
@@ -289,10 +297,17 @@ func (f *blocksFetcher) fetchBlocksFromPeer(
 		Step:      1,
 	}
 	for i := 0; i < len(peers); i++ {
-		if blocks, err := f.requestBlocks(ctx, req, peers[i]); err == nil {
+		requestStart := time.Now()
+		blocks, err := f.requestBlocks(ctx, req, peers[i])
+		if err == nil {
 			f.p2p.Peers().Scorers().BlockProviderScorer().Touch(peers[i])
+			f.batchSizer.onSuccess(count, time.Since(requestStart))
 			return blocks, peers[i], err
 		}
+		// Timeouts and invalid responses suggest batches are too large for the peers to serve.
+		if ctx.Err() == nil {
+			f.batchSizer.onFailure()
+		}
 	}
 	return nil, "", errNoPeersAvailable
 }
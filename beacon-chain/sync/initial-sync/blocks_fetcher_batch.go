@@ -0,0 +1,78 @@
+package initialsync
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+)
+
+const (
+	// fastBatchResponseTime is the response time under which a full batch is considered fast
+	// enough to grow the batch size.
+	fastBatchResponseTime = 2 * time.Second
+	// batchSizeShrinkFactor is the factor by which the batch size is reduced on failures.
+	batchSizeShrinkFactor = 2
+	// minBatchSizeFactor bounds how small the batch size may shrink, relative to the initial size.
+	minBatchSizeFactor = 4
+)
+
+// batchSizer adapts the number of blocks requested in a single blocks by range request.
+// The batch size grows additively whenever a full batch is served quickly, and shrinks
+// multiplicatively on timeouts and penalized responses, so that initial sync needs fewer
+// round-trips with responsive peers without overwhelming slow ones.
+type batchSizer struct {
+	sync.RWMutex
+	size    uint64
+	step    uint64
+	minSize uint64
+	maxSize uint64
+}
+
+// newBatchSizer returns a batch sizer starting at the initial size, never exceeding maxSize.
+func newBatchSizer(initial, maxSize uint64) *batchSizer {
+	if initial == 0 {
+		initial = 1
+	}
+	if maxSize < initial {
+		maxSize = initial
+	}
+	return &batchSizer{
+		size:    initial,
+		step:    initial,
+		minSize: mathutil.Max(initial/minBatchSizeFactor, 1),
+		maxSize: maxSize,
+	}
+}
+
+// current returns the number of blocks to request in the next batch.
+func (b *batchSizer) current() uint64 {
+	b.RLock()
+	defer b.RUnlock()
+	return b.size
+}
+
+// onSuccess records a successful request of count blocks, served within elapsed time.
+func (b *batchSizer) onSuccess(count uint64, elapsed time.Duration) {
+	if !featureconfig.Get().EnableAdaptiveBlockBatching {
+		return
+	}
+	b.Lock()
+	defer b.Unlock()
+	// Only full batches say anything about whether peers can cope with larger ones.
+	if count < b.size || elapsed > fastBatchResponseTime {
+		return
+	}
+	b.size = mathutil.Min(b.size+b.step, b.maxSize)
+}
+
+// onFailure records a timed out or penalized request.
+func (b *batchSizer) onFailure() {
+	if !featureconfig.Get().EnableAdaptiveBlockBatching {
+		return
+	}
+	b.Lock()
+	defer b.Unlock()
+	b.size = mathutil.Max(b.size/batchSizeShrinkFactor, b.minSize)
+}
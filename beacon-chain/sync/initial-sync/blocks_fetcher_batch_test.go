@@ -0,0 +1,58 @@
+package initialsync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestBatchSizer(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{
+		EnableAdaptiveBlockBatching: true,
+	})
+	defer resetCfg()
+
+	b := newBatchSizer(64, 256)
+	assert.Equal(t, uint64(64), b.current())
+
+	// Slow or partial batches do not grow the batch size.
+	b.onSuccess(64, 2*fastBatchResponseTime)
+	assert.Equal(t, uint64(64), b.current())
+	b.onSuccess(32, time.Millisecond)
+	assert.Equal(t, uint64(64), b.current())
+
+	// Fast full batches grow it, up to the cap.
+	b.onSuccess(64, time.Millisecond)
+	assert.Equal(t, uint64(128), b.current())
+	for i := 0; i < 10; i++ {
+		b.onSuccess(b.current(), time.Millisecond)
+	}
+	assert.Equal(t, uint64(256), b.current())
+
+	// Failures halve it, down to the floor.
+	b.onFailure()
+	assert.Equal(t, uint64(128), b.current())
+	for i := 0; i < 10; i++ {
+		b.onFailure()
+	}
+	assert.Equal(t, uint64(16), b.current())
+}
+
+func TestBatchSizer_Disabled(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{})
+	defer resetCfg()
+
+	b := newBatchSizer(64, 256)
+	b.onSuccess(64, time.Millisecond)
+	assert.Equal(t, uint64(64), b.current())
+	b.onFailure()
+	assert.Equal(t, uint64(64), b.current())
+}
+
+func TestBatchSizer_CapBelowInitial(t *testing.T) {
+	b := newBatchSizer(64, 32)
+	assert.Equal(t, uint64(64), b.current())
+	assert.Equal(t, uint64(64), b.maxSize)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	beaconsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/sirupsen/logrus"
 )
 
@@ -238,17 +239,20 @@ func (q *blocksQueue) loop() {
 					}
 				}
 				// Do garbage collection, and advance sliding window forward.
-				if q.chain.HeadSlot() >= fsm.start.Add(blocksPerRequest-1) {
+				if q.chain.HeadSlot() >= fsm.start.Add(q.machineCount(fsm)-1) {
 					highestStartSlot, err := q.smm.highestStartSlot()
 					if err != nil {
 						log.WithError(err).Debug("Cannot obtain highest epoch state number")
 						continue
 					}
+					highestFSM, _ := q.smm.findStateMachine(highestStartSlot)
+					nextStartSlot := highestStartSlot.Add(q.machineCount(highestFSM))
 					if err := q.smm.removeStateMachine(fsm.start); err != nil {
 						log.WithError(err).Debug("Can not remove state machine")
 					}
 					if len(q.smm.machines) < lookaheadSteps {
-						q.smm.addStateMachine(highestStartSlot.Add(blocksPerRequest))
+						// New machines pick up the current (possibly adapted) batch size.
+						q.smm.addStateMachine(nextStartSlot).count = q.blocksFetcher.batchSizer.current()
 					}
 				}
 			}
@@ -287,8 +291,14 @@ func (q *blocksQueue) onScheduleEvent(ctx context.Context) eventHandlerFn {
 			m.setState(stateSkipped)
 			return m.state, errSlotIsTooHigh
 		}
-		blocksPerRequest := q.blocksFetcher.blocksPerSecond
-		if err := q.blocksFetcher.scheduleRequest(ctx, m.start, blocksPerRequest); err != nil {
+		// Hold off fetching more blocks while processing lags behind, until the pending blocks drop
+		// below the limit. The lowest machine that is yet to receive its blocks is exempt, as blocks
+		// are only sent downstream in order.
+		if featureconfig.Get().EnableAdaptiveBlockBatching &&
+			q.pendingBlocks() >= maxPendingBlocks() && q.hasUnfetchedMachineBefore(m) {
+			return m.state, nil
+		}
+		if err := q.blocksFetcher.scheduleRequest(ctx, m.start, q.machineCount(m)); err != nil {
 			return m.state, err
 		}
 		return stateScheduled, nil
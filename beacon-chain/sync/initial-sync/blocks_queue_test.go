@@ -18,6 +18,7 @@ import (
 	beaconsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -385,6 +386,59 @@ func TestBlocksQueue_onScheduleEvent(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, stateScheduled, updatedState)
 	})
+
+	t.Run("backpressure on pending blocks", func(t *testing.T) {
+		resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableAdaptiveBlockBatching: true})
+		defer resetCfg()
+		queue := newBlocksQueue(ctx, &blocksQueueConfig{
+			blocksFetcher:       fetcher,
+			chain:               mc,
+			highestExpectedSlot: types.Slot(10 * blockBatchLimit),
+		})
+		// Fill a machine with more blocks than the queue may hold.
+		full := queue.smm.addStateMachine(types.Slot(3 * blockBatchLimit))
+		full.state = stateDataParsed
+		full.blocks = make([]*eth.SignedBeaconBlock, maxPendingBlocks())
+		first := queue.smm.addStateMachine(0)
+		second := queue.smm.addStateMachine(types.Slot(blockBatchLimit))
+
+		handlerFn := queue.onScheduleEvent(ctx)
+		// Machines above the lowest unfetched one are held back.
+		updatedState, err := handlerFn(second, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, stateNew, updatedState)
+		// The lowest unfetched machine is always scheduled, otherwise pending blocks can not drain.
+		updatedState, err = handlerFn(first, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, stateScheduled, updatedState)
+		first.setState(updatedState)
+		// Machines stay held while the lower machine is being fetched.
+		updatedState, err = handlerFn(second, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, stateNew, updatedState)
+		// Once the pending blocks drop below the limit, they are scheduled.
+		full.blocks = nil
+		updatedState, err = handlerFn(second, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, stateScheduled, updatedState)
+	})
+
+	t.Run("no backpressure without adaptive batching", func(t *testing.T) {
+		queue := newBlocksQueue(ctx, &blocksQueueConfig{
+			blocksFetcher:       fetcher,
+			chain:               mc,
+			highestExpectedSlot: types.Slot(10 * blockBatchLimit),
+		})
+		full := queue.smm.addStateMachine(types.Slot(3 * blockBatchLimit))
+		full.state = stateDataParsed
+		full.blocks = make([]*eth.SignedBeaconBlock, maxPendingBlocks())
+		queue.smm.addStateMachine(0)
+		second := queue.smm.addStateMachine(types.Slot(blockBatchLimit))
+
+		updatedState, err := queue.onScheduleEvent(ctx)(second, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, stateScheduled, updatedState)
+	})
 }
 
 func TestBlocksQueue_onDataReceivedEvent(t *testing.T) {
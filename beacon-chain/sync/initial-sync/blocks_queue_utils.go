@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 // resetWithBlocks removes all state machines, then re-adds enough machines to contain all provided
@@ -73,3 +74,37 @@ func (q *blocksQueue) resetFromSlot(ctx context.Context, startSlot types.Slot) e
 	q.smm.addStateMachine(nonSkippedSlot)
 	return nil
 }
+
+// maxPendingBlocks is the number of fetched blocks the queue may hold before it stops requesting
+// more, so that slow block processing does not let requested blocks pile up in memory.
+func maxPendingBlocks() int {
+	return int(2 * params.BeaconNetworkConfig().MaxRequestBlocks)
+}
+
+// machineCount returns the number of slots covered by a state machine.
+func (q *blocksQueue) machineCount(m *stateMachine) uint64 {
+	if m == nil || m.count == 0 {
+		return q.blocksFetcher.blocksPerSecond
+	}
+	return m.count
+}
+
+// pendingBlocks returns the number of fetched blocks held by state machines.
+func (q *blocksQueue) pendingBlocks() int {
+	pending := 0
+	for _, fsm := range q.smm.machines {
+		pending += len(fsm.blocks)
+	}
+	return pending
+}
+
+// hasUnfetchedMachineBefore checks whether there is a machine with a lower start slot, which is
+// yet to receive its blocks.
+func (q *blocksQueue) hasUnfetchedMachineBefore(m *stateMachine) bool {
+	for _, fsm := range q.smm.machines {
+		if fsm.start < m.start && (fsm.state == stateNew || fsm.state == stateScheduled) {
+			return true
+		}
+	}
+	return false
+}
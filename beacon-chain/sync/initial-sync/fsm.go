@@ -44,6 +44,7 @@ type stateMachineManager struct {
 type stateMachine struct {
 	smm     *stateMachineManager
 	start   types.Slot
+	count   uint64 // number of slots covered, the fetcher's default batch size is used if zero
 	state   stateID
 	pid     peer.ID
 	blocks  []*eth.SignedBeaconBlock
@@ -20,6 +20,13 @@ var (
 			Help: "The number of peers subscribed to a given topic.",
 		}, []string{"topic"},
 	)
+	oversizedBlocksRejected = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_oversized_blocks_rejected_total",
+			Help: "Count of blocks rejected before being decoded because their SSZ encoding is over the max block size.",
+		},
+		[]string{"source"},
+	)
 	messageReceivedCounter = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "p2p_message_received_total",
@@ -41,6 +48,32 @@ var (
 		},
 		[]string{"topic"},
 	)
+	messageValidationLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "p2p_message_validation_latency_seconds",
+			Help:    "Time spent in the gossip validator of a topic, excluding messages received before chain start.",
+			Buckets: []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+		},
+		[]string{"topic"},
+	)
+	attestationValidationQueueDepth = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "p2p_attestation_validation_queue_depth",
+			Help: "The number of subnet attestations waiting for a validation worker.",
+		},
+	)
+	subscribedAttestationSubnets = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "p2p_subscribed_attestation_subnets",
+			Help: "The number of attestation subnets the node is currently subscribed to, including those of upcoming attester duties.",
+		},
+	)
+	attesterSubnetWithoutPeersCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "p2p_attester_subnet_without_peers_total",
+			Help: "Count of attester subnets which had no peers at the slot of the attestation duty.",
+		},
+	)
 	numberOfTimesResyncedCounter = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "number_of_times_resynced",
@@ -98,3 +131,14 @@ func (s *Service) updateMetrics() {
 		topicPeerCount.WithLabelValues(formattedTopic).Set(float64(len(s.p2p.PubSub().ListPeers(formattedTopic))))
 	}
 }
+
+// gossipTopicName returns the name of a gossip topic without its fork digest and encoding,
+// such as beacon_block or beacon_attestation_5, to keep metric labels stable across forks.
+func gossipTopicName(topic string) string {
+	parts := strings.Split(topic, "/")
+	// Gossip topics are formatted as /eth2/<fork digest>/<name>/<encoding>.
+	if len(parts) < 4 || parts[1] != "eth2" {
+		return topic
+	}
+	return parts[3]
+}
@@ -0,0 +1,22 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestGossipTopicName(t *testing.T) {
+	tests := []struct {
+		topic string
+		want  string
+	}{
+		{topic: "/eth2/b5303f2a/beacon_block/ssz_snappy", want: "beacon_block"},
+		{topic: "/eth2/b5303f2a/beacon_attestation_12/ssz_snappy", want: "beacon_attestation_12"},
+		{topic: "/eth2/b5303f2a/voluntary_exit", want: "voluntary_exit"},
+		{topic: "foo", want: "foo"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, gossipTopicName(tt.topic))
+	}
+}
@@ -130,7 +130,7 @@ func (s *Service) writeBlockRangeToStream(ctx context.Context, startSlot, endSlo
 		traceutil.AnnotateError(span, err)
 		return err
 	}
-	// handle genesis case
+	// handle genesis case, a node started from a checkpoint has no genesis block to serve.
 	if startSlot == 0 {
 		genBlock, genRoot, err := s.retrieveGenesisBlock(ctx)
 		if err != nil {
@@ -139,8 +139,10 @@ func (s *Service) writeBlockRangeToStream(ctx context.Context, startSlot, endSlo
 			traceutil.AnnotateError(span, err)
 			return err
 		}
-		blks = append([]*ethpb.SignedBeaconBlock{genBlock}, blks...)
-		roots = append([][32]byte{genRoot}, roots...)
+		if genBlock != nil {
+			blks = append([]*ethpb.SignedBeaconBlock{genBlock}, blks...)
+			roots = append([][32]byte{genRoot}, roots...)
+		}
 	}
 	// Filter and sort our retrieved blocks, so that
 	// we only return valid sets of blocks.
@@ -255,6 +257,9 @@ func (s *Service) retrieveGenesisBlock(ctx context.Context) (*ethpb.SignedBeacon
 	if err != nil {
 		return nil, [32]byte{}, err
 	}
+	if genBlock == nil {
+		return nil, [32]byte{}, nil
+	}
 	genRoot, err := genBlock.Block.HashTreeRoot()
 	if err != nil {
 		return nil, [32]byte{}, err
@@ -272,6 +272,57 @@ func TestRPCBeaconBlocksByRange_ReturnsGenesisBlock(t *testing.T) {
 	}
 }
 
+func TestRPCBeaconBlocksByRange_CheckpointOriginNotReturnedAsGenesis(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+	d := db.SetupDB(t)
+
+	req := &pb.BeaconBlocksByRangeRequest{
+		StartSlot: 0,
+		Step:      1,
+		Count:     128,
+	}
+
+	// Save only the checkpoint block the node was started from, there is no genesis block.
+	originSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = originSlot
+	rt, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveBlock(context.Background(), blk))
+	require.NoError(t, d.SaveOriginCheckpointBlockRoot(context.Background(), rt))
+
+	r := &Service{p2p: p1, db: d, chain: &chainMock.ChainService{}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID("/testing")
+	topic := string(pcl)
+	r.rateLimiter.limiterMap[topic] = leakybucket.NewCollector(10000, 10000, false)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		// The checkpoint block is only served at its own slot.
+		expectSuccess(t, stream)
+		res := &ethpb.SignedBeaconBlock{}
+		assert.NoError(t, r.p2p.Encoding().DecodeWithMaxLength(stream, res))
+		assert.Equal(t, originSlot, res.Block.Slot, "checkpoint block was returned as the genesis block")
+		// Expect EOF
+		b := make([]byte, 1)
+		_, err := stream.Read(b)
+		require.ErrorContains(t, io.EOF.Error(), err)
+	})
+
+	stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.beaconBlocksByRangeRPCHandler(context.Background(), req, stream1))
+
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
 func TestRPCBeaconBlocksByRange_RPCHandlerRateLimitOverflow(t *testing.T) {
 	d := db.SetupDB(t)
 	saveBlocks := func(req *pb.BeaconBlocksByRangeRequest) {
@@ -29,6 +29,9 @@ func (s *Service) sendRecentBeaconBlocksRequest(ctx context.Context, blockRoots
 		s.pendingQueueLock.Unlock()
 		return nil
 	})
+	if errors.Is(err, ErrInvalidFetchedData) {
+		s.p2p.Peers().Scorers().BadResponsesScorer().Increment(id)
+	}
 	return err
 }
 
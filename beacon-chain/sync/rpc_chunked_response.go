@@ -2,6 +2,7 @@ package sync
 
 import (
 	"errors"
+	"io"
 
 	libp2pcore "github.com/libp2p/go-libp2p-core"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -34,17 +35,20 @@ func ReadChunkedBlock(stream libp2pcore.Stream, p2p p2p.P2P, isFirstChunk bool)
 	if isFirstChunk {
 		return readFirstChunkedBlock(stream, p2p)
 	}
-	blk := &eth.SignedBeaconBlock{}
-	if err := readResponseChunk(stream, p2p, blk); err != nil {
+	SetStreamReadDeadline(stream, respTimeout)
+	code, errMsg, err := readStatusCodeNoDeadline(stream, p2p.Encoding())
+	if err != nil {
 		return nil, err
 	}
-	return blk, nil
+	if code != 0 {
+		return nil, errors.New(errMsg)
+	}
+	return decodeBlockChunk(stream, p2p.Encoding())
 }
 
 // readFirstChunkedBlock reads the first chunked block and applies the appropriate deadlines to
 // it.
 func readFirstChunkedBlock(stream libp2pcore.Stream, p2p p2p.P2P) (*eth.SignedBeaconBlock, error) {
-	blk := &eth.SignedBeaconBlock{}
 	code, errMsg, err := ReadStatusCode(stream, p2p.Encoding())
 	if err != nil {
 		return nil, err
@@ -52,21 +56,20 @@ func readFirstChunkedBlock(stream libp2pcore.Stream, p2p p2p.P2P) (*eth.SignedBe
 	if code != 0 {
 		return nil, errors.New(errMsg)
 	}
-	err = p2p.Encoding().DecodeWithMaxLength(stream, blk)
-	return blk, err
+	return decodeBlockChunk(stream, p2p.Encoding())
 }
 
-// readResponseChunk reads the response from the stream and decodes it into the
-// provided message type.
-func readResponseChunk(stream libp2pcore.Stream, p2p p2p.P2P, to interface{}) error {
-	SetStreamReadDeadline(stream, respTimeout)
-	code, errMsg, err := readStatusCodeNoDeadline(stream, p2p.Encoding())
-	if err != nil {
-		return err
-	}
-
-	if code != 0 {
-		return errors.New(errMsg)
+// decodeBlockChunk decodes a block from the stream. A block whose SSZ encoding is over the max block
+// size is rejected before being read, as invalid data so that its sender is penalized.
+func decodeBlockChunk(r io.Reader, encoding encoder.NetworkEncoding) (*eth.SignedBeaconBlock, error) {
+	blk := &eth.SignedBeaconBlock{}
+	if err := encoding.DecodeWithLimit(r, blk, maxBlockSSZSize()); err != nil {
+		if errors.Is(err, encoder.ErrMaxLengthExceeded) {
+			log.WithError(err).Debug("Rejected oversized block")
+			oversizedBlocksRejected.WithLabelValues("rpc").Inc()
+			return nil, ErrInvalidFetchedData
+		}
+		return nil, err
 	}
-	return p2p.Encoding().DecodeWithMaxLength(stream, to)
+	return blk, nil
 }
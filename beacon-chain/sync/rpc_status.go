@@ -278,10 +278,14 @@ func (s *Service) validateStatusMessage(ctx context.Context, msg *pb.Status) err
 	if err != nil {
 		return err
 	}
-	if !bytes.Equal(forkDigest[:], msg.ForkDigest) {
+	genesis := s.chain.GenesisTime()
+	genesisValidatorsRoot := s.chain.GenesisValidatorRoot()
+	// Around a fork boundary peers on the other side of it are still compatible, as discovery
+	// keeps dialing them.
+	if !bytes.Equal(forkDigest[:], msg.ForkDigest) &&
+		!p2p.IsGraceForkDigest(msg.ForkDigest, genesis, genesisValidatorsRoot[:]) {
 		return p2ptypes.ErrWrongForkDigestVersion
 	}
-	genesis := s.chain.GenesisTime()
 	finalizedEpoch := s.chain.FinalizedCheckpt().Epoch
 	maxEpoch := slotutil.EpochsSinceGenesis(genesis)
 	// It would take a minimum of 2 epochs to finalize a
@@ -14,6 +14,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	testingDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
@@ -825,6 +826,44 @@ func TestStatusRPC_ValidGenesisMessage(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestStatusRPC_ValidateStatusMessage_GraceForkDigest(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig()
+	c.ForkVersionSchedule = map[types.Epoch][]byte{
+		0: params.BeaconConfig().GenesisForkVersion,
+		1: {0, 0, 0, 1},
+	}
+	params.OverrideBeaconConfig(c)
+
+	r := &Service{
+		chain: &mock.ChainService{
+			FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 0, Root: params.BeaconConfig().ZeroHash[:]},
+			Genesis:             time.Now(),
+			ValidatorsRoot:      [32]byte{'A'},
+		},
+		ctx: context.Background(),
+	}
+	status := func(digest []byte) *pb.Status {
+		return &pb.Status{
+			ForkDigest:     digest,
+			FinalizedRoot:  params.BeaconConfig().ZeroHash[:],
+			FinalizedEpoch: 0,
+			HeadRoot:       make([]byte, 32),
+		}
+	}
+	digest, err := r.forkDigest()
+	require.NoError(t, err)
+	require.NoError(t, r.validateStatusMessage(r.ctx, status(digest[:])))
+
+	// The node is one epoch before the fork, so peers which already switched over are accepted.
+	validatorsRoot := [32]byte{'A'}
+	nextDigest, err := helpers.ComputeForkDigest([]byte{0, 0, 0, 1}, validatorsRoot[:])
+	require.NoError(t, err)
+	require.NoError(t, r.validateStatusMessage(r.ctx, status(nextDigest[:])))
+
+	assert.ErrorContains(t, p2ptypes.ErrWrongForkDigestVersion.Error(), r.validateStatusMessage(r.ctx, status([]byte{'f', 'o', 'o', 'o'})))
+}
+
 func TestShouldResync(t *testing.T) {
 	type args struct {
 		genesis  time.Time
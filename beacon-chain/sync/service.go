@@ -111,6 +111,7 @@ type Service struct {
 	badBlockCache             *lru.Cache
 	badBlockLock              sync.RWMutex
 	stateGen                  *stategen.State
+	attValidationPool         *validationPool
 }
 
 // NewService initializes new regular sync service.
@@ -138,6 +139,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		blockNotifier:        cfg.BlockNotifier,
 		stateGen:             cfg.StateGen,
 		rateLimiter:          rLimiter,
+		attValidationPool:    newValidationPool(flags.Get().AttestationValidationWorkers),
 	}
 
 	go r.registerHandlers()
@@ -13,6 +13,7 @@ import (
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	types "github.com/prysmaticlabs/eth2-types"
 	pb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/messagehandler"
@@ -67,16 +68,23 @@ func (s *Service) registerSubscribers() {
 		s.validateAttesterSlashing,
 		s.attesterSlashingSubscriber,
 	)
+	// Subnet attestations are validated by a bounded pool of workers shared by all subnets.
+	attValidator := s.attValidationPool.wrap(s.validateCommitteeIndexBeaconAttestation)
 	if flags.Get().SubscribeToAllSubnets {
 		s.subscribeStaticWithSubnets(
 			"/eth2/%x/beacon_attestation_%d",
-			s.validateCommitteeIndexBeaconAttestation,   /* validator */
+			attValidator,                                /* validator */
 			s.committeeIndexBeaconAttestationSubscriber, /* message handler */
 		)
 	} else {
+		if count := flags.Get().BackboneSubnets; count > 0 {
+			backboneSubnets := selectBackboneSubnets(count)
+			log.WithField("subnets", backboneSubnets).Info("Subscribing to backbone attestation subnets")
+			cache.SubnetIDs.SetBackboneSubnets(backboneSubnets)
+		}
 		s.subscribeDynamicWithSubnets(
 			"/eth2/%x/beacon_attestation_%d",
-			s.validateCommitteeIndexBeaconAttestation,   /* validator */
+			attValidator,                                /* validator */
 			s.committeeIndexBeaconAttestationSubscriber, /* message handler */
 		)
 	}
@@ -172,6 +180,8 @@ func (s *Service) subscribeWithBase(topic string, validator pubsub.ValidatorEx,
 // Wrap the pubsub validator with a metric monitoring function. This function increments the
 // appropriate counter if the particular message fails to validate.
 func (s *Service) wrapAndReportValidation(topic string, v pubsub.ValidatorEx) (string, pubsub.ValidatorEx) {
+	// Resolve the histogram of the topic once, to avoid a label lookup for every message.
+	validationLatency := messageValidationLatency.WithLabelValues(gossipTopicName(topic))
 	return topic, func(ctx context.Context, pid peer.ID, msg *pubsub.Message) (res pubsub.ValidationResult) {
 		defer messagehandler.HandlePanic(ctx, msg)
 		res = pubsub.ValidationIgnore // Default: ignore any message that panics.
@@ -187,7 +197,12 @@ func (s *Service) wrapAndReportValidation(topic string, v pubsub.ValidatorEx) (s
 			messageFailedValidationCounter.WithLabelValues(topic).Inc()
 			return pubsub.ValidationIgnore
 		}
+		start := time.Now()
+		ctx, span := trace.StartSpan(ctx, "sync.validateMessage")
+		span.AddAttributes(trace.StringAttribute("topic", topic))
 		b := v(ctx, pid, msg)
+		span.End()
+		traceutil.ObserveWithTraceID(ctx, validationLatency, time.Since(start).Seconds())
 		if b == pubsub.ValidationReject {
 			messageFailedValidationCounter.WithLabelValues(topic).Inc()
 		}
@@ -205,6 +220,7 @@ func (s *Service) subscribeStaticWithSubnets(topic string, validator pubsub.Vali
 	for i := uint64(0); i < params.BeaconNetworkConfig().AttestationSubnetCount; i++ {
 		s.subscribeWithBase(s.addDigestAndIndexToTopic(topic, i), validator, handle)
 	}
+	subscribedAttestationSubnets.Set(float64(params.BeaconNetworkConfig().AttestationSubnetCount))
 	genesis := s.chain.GenesisTime()
 	ticker := slotutil.NewSlotTicker(genesis, params.BeaconConfig().SecondsPerSlot)
 
@@ -273,11 +289,17 @@ func (s *Service) subscribeDynamicWithSubnets(
 				for _, idx := range wantedSubs {
 					s.subscribeAggregatorSubnet(subscriptions, idx, digest, validate, handle)
 				}
+				s.reportAttesterSubnetsWithoutPeers(digest, currentSlot)
 				// find desired subs for attesters
 				attesterSubs := s.attesterSubnetIndices(currentSlot)
 				for _, idx := range attesterSubs {
 					s.lookupAttesterSubnets(digest, idx)
 				}
+				activeSubnets := attesterSubs
+				for idx := range subscriptions {
+					activeSubnets = append(activeSubnets, idx)
+				}
+				subscribedAttestationSubnets.Set(float64(len(sliceutil.SetUint64(activeSubnets))))
 			}
 		}
 	}()
@@ -346,6 +368,18 @@ func (s *Service) lookupAttesterSubnets(digest [4]byte, idx uint64) {
 	}
 }
 
+// counts the attester subnets of the current slot's duties which have no peers, meaning the
+// subnet subscription lookahead was not enough to find peers before the duties came due.
+func (s *Service) reportAttesterSubnetsWithoutPeers(digest [4]byte, currentSlot types.Slot) {
+	topic := p2p.GossipTypeMapping[reflect.TypeOf(&pb.Attestation{})]
+	for _, idx := range sliceutil.SetUint64(cache.SubnetIDs.GetAttesterSubnetIDs(currentSlot)) {
+		subnetTopic := fmt.Sprintf(topic, digest, idx) + s.p2p.Encoding().ProtocolSuffix()
+		if len(s.p2p.PubSub().ListPeers(subnetTopic)) == 0 {
+			attesterSubnetWithoutPeersCounter.Inc()
+		}
+	}
+}
+
 // find if we have peers who are subscribed to the same subnet
 func (s *Service) validPeersExist(subnetTopic string) bool {
 	numOfPeers := s.p2p.PubSub().ListPeers(subnetTopic + s.p2p.Encoding().ProtocolSuffix())
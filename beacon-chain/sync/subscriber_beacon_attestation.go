@@ -12,7 +12,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/rand"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 )
 
@@ -51,9 +53,23 @@ func (s *Service) persistentSubnetIndices() []uint64 {
 	return cache.SubnetIDs.GetAllSubnets()
 }
 
+// selectBackboneSubnets picks the given number of random attestation subnets,
+// which the node stays subscribed to regardless of the duties of its validators.
+func selectBackboneSubnets(count uint64) []uint64 {
+	subnetCount := params.BeaconNetworkConfig().AttestationSubnetCount
+	if count > subnetCount {
+		count = subnetCount
+	}
+	perm := rand.NewGenerator().Perm(int(subnetCount))
+	subnets := make([]uint64, 0, count)
+	for _, idx := range perm[:count] {
+		subnets = append(subnets, uint64(idx))
+	}
+	return subnets
+}
+
 func (s *Service) aggregatorSubnetIndices(currentSlot types.Slot) []uint64 {
-	endEpoch := helpers.SlotToEpoch(currentSlot) + 1
-	endSlot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(endEpoch))
+	endSlot := subnetLookaheadEndSlot(currentSlot)
 	var commIds []uint64
 	for i := currentSlot; i <= endSlot; i++ {
 		commIds = append(commIds, cache.SubnetIDs.GetAggregatorSubnetIDs(i)...)
@@ -62,11 +78,21 @@ func (s *Service) aggregatorSubnetIndices(currentSlot types.Slot) []uint64 {
 }
 
 func (s *Service) attesterSubnetIndices(currentSlot types.Slot) []uint64 {
-	endEpoch := helpers.SlotToEpoch(currentSlot) + 1
-	endSlot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(endEpoch))
+	endSlot := subnetLookaheadEndSlot(currentSlot)
 	var commIds []uint64
 	for i := currentSlot; i <= endSlot; i++ {
 		commIds = append(commIds, cache.SubnetIDs.GetAttesterSubnetIDs(i)...)
 	}
 	return sliceutil.SetUint64(commIds)
 }
+
+// subnetLookaheadEndSlot returns the last slot whose attestation duties the node subscribes to subnets for.
+// This is the start of the next epoch, unless the configured lookahead reaches further.
+func subnetLookaheadEndSlot(currentSlot types.Slot) types.Slot {
+	endEpoch := helpers.SlotToEpoch(currentSlot) + 1
+	endSlot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(endEpoch))
+	if lookaheadSlot := currentSlot + flags.Get().AttestationSubnetLookahead; lookaheadSlot > endSlot {
+		return lookaheadSlot
+	}
+	return endSlot
+}
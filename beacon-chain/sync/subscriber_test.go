@@ -22,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/abool"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -432,3 +433,46 @@ func createPeer(t *testing.T, topics ...string) *p2ptest.TestP2P {
 	}
 	return p
 }
+
+func TestSelectBackboneSubnets(t *testing.T) {
+	subnetCount := params.BeaconNetworkConfig().AttestationSubnetCount
+
+	subnets := selectBackboneSubnets(4)
+	require.Equal(t, 4, len(subnets))
+	seen := make(map[uint64]bool)
+	for _, idx := range subnets {
+		assert.Equal(t, true, idx < subnetCount, "Subnet out of range")
+		assert.Equal(t, false, seen[idx], "Duplicate subnet")
+		seen[idx] = true
+	}
+
+	assert.Equal(t, int(subnetCount), len(selectBackboneSubnets(subnetCount+1)))
+	assert.Equal(t, 0, len(selectBackboneSubnets(0)))
+}
+
+func TestAttesterSubnetIndices_Lookahead(t *testing.T) {
+	defer cache.SubnetIDs.EmptyAllCaches()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	currSlot := slotsPerEpoch + 2
+	cache.SubnetIDs.AddAttesterSubnetID(2*slotsPerEpoch, 3)
+	cache.SubnetIDs.AddAttesterSubnetID(2*slotsPerEpoch+2, 5)
+	s := &Service{}
+
+	// By default the node looks ahead until the start of the next epoch.
+	assert.Equal(t, 2*slotsPerEpoch, subnetLookaheadEndSlot(currSlot))
+	assert.DeepEqual(t, []uint64{3}, s.attesterSubnetIndices(currSlot))
+
+	resetFlags := flags.Get()
+	defer flags.Init(resetFlags)
+	cfg := *resetFlags
+	// The lookahead is capped to an epoch, as the duties are only known until the end of the next epoch.
+	cfg.AttestationSubnetLookahead = slotsPerEpoch
+	flags.Init(&cfg)
+	assert.Equal(t, 2*slotsPerEpoch+2, subnetLookaheadEndSlot(currSlot))
+	assert.DeepEqual(t, []uint64{3, 5}, s.attesterSubnetIndices(currSlot))
+
+	// A lookahead shorter than the rest of the epoch does not shrink it.
+	cfg.AttestationSubnetLookahead = 1
+	flags.Init(&cfg)
+	assert.Equal(t, 2*slotsPerEpoch, subnetLookaheadEndSlot(currSlot))
+}
@@ -60,6 +60,7 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 		traceutil.AnnotateError(span, err)
 		return pubsub.ValidationIgnore
 	}
+	logIfAcceptedByClockTolerance(s.chain.GenesisTime(), m.Message.Aggregate.Data.Slot, "aggregate")
 
 	// Verify this is the first aggregate received from the aggregator with index and slot.
 	if s.hasSeenAggregatorIndexEpoch(m.Message.Aggregate.Data.Target.Epoch, m.Message.AggregatorIndex) {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/golang/snappy"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	types "github.com/prysmaticlabs/eth2-types"
@@ -42,6 +43,17 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 	ctx, span := trace.StartSpan(ctx, "sync.validateBeaconBlockPubSub")
 	defer span.End()
 
+	// Reject oversized blocks before decompressing and decoding them.
+	if size, err := snappy.DecodedLen(msg.Data); err == nil && uint64(size) > maxBlockSSZSize() {
+		log.WithFields(logrus.Fields{
+			"size":    size,
+			"maxSize": maxBlockSSZSize(),
+		}).Debug("Rejected oversized block")
+		oversizedBlocksRejected.WithLabelValues("gossip").Inc()
+		s.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		return pubsub.ValidationReject
+	}
+
 	m, err := s.decodePubsubMessage(msg)
 	if err != nil {
 		log.WithError(err).Debug("Could not decode message")
@@ -104,6 +116,7 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		log.WithError(err).WithField("blockSlot", blk.Block.Slot).Debug("Ignored block")
 		return pubsub.ValidationIgnore
 	}
+	logIfAcceptedByClockTolerance(s.chain.GenesisTime(), blk.Block.Slot, "block")
 
 	// Add metrics for block arrival time subtracts slot start time.
 	genesisTime := uint64(s.chain.GenesisTime().Unix())
@@ -0,0 +1,48 @@
+package sync
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+)
+
+// validationPool bounds the number of gossip messages validated concurrently. Messages beyond the
+// pool size wait for a worker in the order they arrived, as the goroutines blocked on sending to a
+// channel are woken in FIFO order, so the messages of a subnet start validation in arrival order.
+type validationPool struct {
+	workers chan struct{}
+}
+
+// newValidationPool creates a pool of the given number of workers, or of one worker per CPU core
+// if size is not positive.
+func newValidationPool(size int) *validationPool {
+	if size <= 0 {
+		size = runtime.NumCPU()
+	}
+	return &validationPool{workers: make(chan struct{}, size)}
+}
+
+// wrap returns a validator which runs the given validator on a worker of the pool. Messages which
+// do not get a worker before the validation context is done are ignored. A nil pool does not bound
+// the validator.
+func (p *validationPool) wrap(v pubsub.ValidatorEx) pubsub.ValidatorEx {
+	if p == nil {
+		return v
+	}
+	return func(ctx context.Context, pid peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		attestationValidationQueueDepth.Inc()
+		select {
+		case p.workers <- struct{}{}:
+			attestationValidationQueueDepth.Dec()
+		case <-ctx.Done():
+			attestationValidationQueueDepth.Dec()
+			return pubsub.ValidationIgnore
+		}
+		defer func() {
+			<-p.workers
+		}()
+		return v(ctx, pid, msg)
+	}
+}
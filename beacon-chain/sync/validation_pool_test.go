@@ -0,0 +1,61 @@
+package sync
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestValidationPool_DefaultSize(t *testing.T) {
+	assert.Equal(t, runtime.NumCPU(), cap(newValidationPool(0).workers))
+	assert.Equal(t, 3, cap(newValidationPool(3).workers))
+}
+
+func TestValidationPool_BoundsConcurrency(t *testing.T) {
+	p := newValidationPool(1)
+	release := make(chan struct{})
+	started := make(chan int, 3)
+	v := p.wrap(func(_ context.Context, _ peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		started <- int(msg.ReceivedFrom[0])
+		<-release
+		return pubsub.ValidationAccept
+	})
+
+	results := make(chan pubsub.ValidationResult, 3)
+	for i := 0; i < 2; i++ {
+		go func(i int) {
+			results <- v(context.Background(), "", &pubsub.Message{ReceivedFrom: peer.ID([]byte{byte(i)})})
+		}(i)
+		// Wait for the message to be validated or queued before sending the next one.
+		time.Sleep(50 * time.Millisecond)
+	}
+	assert.Equal(t, 0, <-started)
+	select {
+	case <-started:
+		t.Fatal("Second message validated while the only worker was busy")
+	default:
+	}
+
+	// A message which does not get a worker before its context is done is ignored.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, pubsub.ValidationIgnore, v(ctx, "", &pubsub.Message{ReceivedFrom: peer.ID([]byte{2})}))
+
+	close(release)
+	assert.Equal(t, 1, <-started)
+	assert.Equal(t, pubsub.ValidationAccept, <-results)
+	assert.Equal(t, pubsub.ValidationAccept, <-results)
+}
+
+func TestValidationPool_NilPool(t *testing.T) {
+	var p *validationPool
+	v := p.wrap(func(_ context.Context, _ peer.ID, _ *pubsub.Message) pubsub.ValidationResult {
+		return pubsub.ValidationAccept
+	})
+	assert.Equal(t, pubsub.ValidationAccept, v(context.Background(), "", &pubsub.Message{}))
+}
@@ -31,5 +31,20 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "verify",
+			Description: `verifies the integrity of a stopped beacon node database and optionally repairs it`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.VerifyRepairFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.Verify(cliCtx); err != nil {
+					log.Fatalf("Could not verify database: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
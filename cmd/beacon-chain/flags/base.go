@@ -3,6 +3,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli/v2"
 )
@@ -75,6 +77,19 @@ var (
 			"(browser enforced). This flag has no effect if not used with --grpc-gateway-port.",
 		Value: "http://localhost:4200,http://localhost:7500,http://127.0.0.1:4200,http://127.0.0.1:7500,http://0.0.0.0:4200,http://0.0.0.0:7500",
 	}
+	// GRPCGatewayAllowedPaths restricts the gRPC gateway to the matching endpoints.
+	GRPCGatewayAllowedPaths = &cli.StringSliceFlag{
+		Name: "grpc-gateway-allowed-paths",
+		Usage: "Comma separated list of [METHOD] PATH rules of the only endpoints served by the gRPC gateway, " +
+			"other requests are rejected with 403. A path ending with * matches all the paths starting with it, " +
+			"e.g. \"GET /eth/v1/beacon/*\". All endpoints are served when empty.",
+	}
+	// GRPCGatewayDeniedPaths rejects the matching endpoints of the gRPC gateway.
+	GRPCGatewayDeniedPaths = &cli.StringSliceFlag{
+		Name: "grpc-gateway-denied-paths",
+		Usage: "Comma separated list of [METHOD] PATH rules of the endpoints rejected with 403 by the gRPC gateway, " +
+			"e.g. \"/eth/v1alpha1/debug/*\". Denied paths take precedence over the allowed ones.",
+	}
 	// MinSyncPeers specifies the required number of successful peer handshakes in order
 	// to start syncing with external peers.
 	MinSyncPeers = &cli.IntFlag{
@@ -106,6 +121,23 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the DB.",
 		Value: 2048,
 	}
+	// StateRetentionEpochs specifies the number of recent finalized epochs for which saved states are kept in the DB.
+	StateRetentionEpochs = &cli.IntFlag{
+		Name: "state-retention-epochs",
+		Usage: "Prunes the finalized states older than this many epochs that do not lay on an archived point " +
+			"(see --slots-per-archive-point). Pruned states are regenerated by replaying blocks when requested. " +
+			"The genesis, finalized and head states are always kept. 0 disables pruning",
+		Value: 0,
+	}
+	// HotStateCacheSize specifies the max number of hot states kept in memory.
+	HotStateCacheSize = &cli.IntFlag{
+		Name: "hot-state-cache-size",
+		Usage: "The max number of recent unfinalized states kept in memory, besides the head and finalized states " +
+			"which are always kept. A larger cache uses more memory, as each state may take tens to hundreds of " +
+			"megabytes, but saves the CPU cost of replaying blocks to regenerate the states of recent forks, " +
+			"notably during reorg heavy periods",
+		Value: 32,
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
@@ -134,10 +166,238 @@ var (
 		Name:  "enable-debug-rpc-endpoints",
 		Usage: "Enables the debug rpc service, containing utility endpoints such as /eth/v1alpha1/beacon/state.",
 	}
+	// EnableGRPCReflection registers the gRPC server reflection service on the beacon node RPC server.
+	EnableGRPCReflection = &cli.BoolFlag{
+		Name:  "enable-grpc-reflection",
+		Usage: "Registers the gRPC server reflection service so tools such as grpcurl can list the available services and methods. Not recommended for production nodes.",
+	}
 	SubscribeToAllSubnets = &cli.BoolFlag{
 		Name:  "subscribe-all-subnets",
 		Usage: "Subscribe to all possible attestation subnets.",
 	}
+	// BackboneSubnets specifies the number of attestation subnets to stay subscribed to regardless of validator duties.
+	BackboneSubnets = &cli.Uint64Flag{
+		Name: "backbone-subnets",
+		Usage: "The number of attestation subnets the node stays subscribed to for the whole of its lifetime, " +
+			"regardless of validator duties. Useful for non-validating nodes helping to keep the gossip mesh healthy.",
+		Value: 0,
+	}
+	// Eth1DataVoteStrategy defines how the eth1 data vote of proposed blocks is chosen.
+	Eth1DataVoteStrategy = &cli.StringFlag{
+		Name: "eth1-data-vote-strategy",
+		Usage: "Strategy used to choose the eth1 data vote of proposed blocks, one of: local, follow-majority. " +
+			"With follow-majority, the node votes for the eth1 data held by a majority of the votes of the current voting " +
+			"period once it has gathered at least an epoch worth of votes, provided its eth1 node knows the voted block and " +
+			"its deposits, and falls back to the local strategy otherwise. Useful if your eth1 node lags behind. " +
+			"Eth1 data votes are not slashable, but incorrect votes delay deposits processing",
+		Value: "local",
+	}
+	// HistoricalStateCacheSize defines the number of historical states cached for the beacon API.
+	HistoricalStateCacheSize = &cli.IntFlag{
+		Name: "historical-state-cache-size",
+		Usage: "The number of recently queried historical states kept in memory to speed up repeated beacon API " +
+			"state queries by slot. Each state can take tens of megabytes. Set to 0 to disable the cache",
+		Value: 8,
+	}
+	// TrackedValidatorIndices defines the validators whose balance changes and attestation effectiveness are
+	// tracked at each epoch transition.
+	TrackedValidatorIndices = &cli.Int64SliceFlag{
+		Name: "tracked-validator-indices",
+		Usage: "Validator indices for which the node computes the balance change at each epoch transition, broken down " +
+			"into attestation rewards, proposer rewards and penalties, and scores the effectiveness of their attestations. " +
+			"These are exposed as metrics and through the debug RPC endpoints. Epoch transitions processed during " +
+			"initial sync are not tracked",
+	}
+	// MaximumGossipClockDisparity defines the tolerated clock disparity for gossip messages from the future.
+	MaximumGossipClockDisparity = &cli.DurationFlag{
+		Name: "maximum-gossip-clock-disparity",
+		Usage: "The clock disparity tolerated when validating gossip blocks and attestations from a slot which has not " +
+			"started yet according to the local clock. Can be raised up to 2s if the local clock is known to be slightly " +
+			"behind, messages only accepted thanks to the extra tolerance are logged",
+		Value: params.SpecMaximumGossipClockDisparity,
+	}
+	// MaxAggregatedAttestationsInPool defines the cap of the aggregated attestation pool.
+	MaxAggregatedAttestationsInPool = &cli.IntFlag{
+		Name: "max-aggregated-attestations-in-pool",
+		Usage: "The maximum number of aggregated attestations kept in the pool. Attestations of the oldest slots are " +
+			"evicted first, except those needed by a local validator proposing at the current or next slot. " +
+			"Set to 0 for no cap",
+	}
+	// MaxUnaggregatedAttestationsInPool defines the cap of the unaggregated attestation pool.
+	MaxUnaggregatedAttestationsInPool = &cli.IntFlag{
+		Name: "max-unaggregated-attestations-in-pool",
+		Usage: "The maximum number of unaggregated attestations kept in the pool. Attestations of the oldest slots are " +
+			"evicted first, except those needed by a local validator proposing at the current or next slot. " +
+			"Set to 0 for no cap",
+	}
+	// AttestationPoolLowMemory enables the low memory mode of the attestation pool.
+	AttestationPoolLowMemory = &cli.BoolFlag{
+		Name: "attestation-pool-low-memory",
+		Usage: "Keeps a single aggregate per attestation data in the attestation pool. Aggregates which don't overlap " +
+			"are merged on insert and overlapping ones are replaced by the one with the most aggregation bits. " +
+			"Uses less memory at the cost of some CPU and of the attestations dropped from overlapping aggregates",
+	}
+	// GossipSeenCacheTTL defines how long the ids of seen gossip messages are remembered.
+	GossipSeenCacheTTL = &cli.DurationFlag{
+		Name: "p2p-seen-cache-ttl",
+		Usage: "How long the ids of received gossip messages are remembered to drop duplicates, the seen message " +
+			"cache is bounded by this duration so its memory usage grows with it and with the gossip message rate. " +
+			"Nodes with many peers or subnets may enlarge it to avoid processing the same messages again. " +
+			"Set to 0 for the default of 385s",
+	}
+	// GossipHistoryLength defines the number of heartbeats for which full gossip messages are cached.
+	GossipHistoryLength = &cli.IntFlag{
+		Name: "p2p-gossip-history-length",
+		Usage: "The number of gossip heartbeats for which full messages are cached to answer the peers requesting " +
+			"them, each additional heartbeat holds one more interval of messages in memory. Set to 0 for the default",
+	}
+	// GossipMeshDegree defines the target number of peers in the gossip mesh of each topic.
+	GossipMeshDegree = &cli.IntFlag{
+		Name: "p2p-gossip-mesh-degree",
+		Usage: "The target number of peers in the gossip mesh of each topic, which are sent the full messages. " +
+			"It applies to all the topics and must be between the low and high mesh degrees. Set to 0 for the default of 8",
+	}
+	// GossipMeshDegreeLow defines the number of gossip mesh peers below which more peers are grafted.
+	GossipMeshDegreeLow = &cli.IntFlag{
+		Name: "p2p-gossip-mesh-degree-low",
+		Usage: "The number of peers in the gossip mesh of a topic below which more peers are grafted. Raise it " +
+			"on sparsely connected nodes to keep more mesh peers. Set to 0 for the default of 6",
+	}
+	// GossipMeshDegreeHigh defines the number of gossip mesh peers above which peers are pruned.
+	GossipMeshDegreeHigh = &cli.IntFlag{
+		Name: "p2p-gossip-mesh-degree-high",
+		Usage: "The number of peers in the gossip mesh of a topic above which peers are pruned, at most 32 as " +
+			"every mesh peer increases the bandwidth used. Set to 0 for the default of 12",
+	}
+	// P2PGeoIPDatabase defines the path of the local database used to locate the peers.
+	P2PGeoIPDatabase = &cli.StringFlag{
+		Name: "p2p-geoip-db",
+		Usage: "Path to a local IP to ASN database, in the tab separated ip2asn format of iptoasn.com and optionally " +
+			"gzipped, used to report the autonomous system and country diversity of the peers. No external service " +
+			"is queried. Peers are not located if unset",
+	}
+	// P2PGossipOutboundRateLimit defines the cap on the bytes per second of gossip sent to peers.
+	P2PGossipOutboundRateLimit = &cli.Uint64Flag{
+		Name: "p2p-gossip-outbound-rate-limit",
+		Usage: "The maximum number of bytes per second of gossip sent to peers, 0 for no limit. " +
+			"The limit only applies to relayed messages, after validation, so received messages are still " +
+			"imported and the node's own attestations and blocks are sent right away. Relaying less makes " +
+			"the node a less useful mesh member, and peers may score it down and prune it from their meshes.",
+		Value: 0,
+	}
+	// AttestationSubnetLookahead defines how many slots ahead of attestation duties the node looks for subnet peers.
+	AttestationSubnetLookahead = &cli.Uint64Flag{
+		Name: "attestation-subnet-lookahead",
+		Usage: "The number of slots ahead of the attestation duties of connected validators that the node starts " +
+			"subscribing to and searching for peers on their subnets. By default the node only looks ahead until " +
+			"the start of the next epoch. A larger value gives more time to find subnet peers when discovery is slow. " +
+			"The duties are only known until the end of the next epoch, so the value is capped to the slots per epoch",
+		Value: 0,
+	}
+	// AttestationValidationWorkers defines how many subnet attestations are validated concurrently.
+	AttestationValidationWorkers = &cli.IntFlag{
+		Name: "attestation-validation-workers",
+		Usage: "The number of attestations received over gossip subnets that are validated concurrently. " +
+			"Defaults to the number of CPU cores, which suits most machines. Raise it on machines with many cores " +
+			"if the attestation validation queue depth metric keeps growing, and lower it on machines with 2 cores " +
+			"or less to leave room for block processing",
+		Value: 0,
+	}
+	// P2PBootnodeRefreshInterval defines how often the bootnodes are queried again after the initial bootstrap.
+	P2PBootnodeRefreshInterval = &cli.DurationFlag{
+		Name: "p2p-bootnode-refresh-interval",
+		Usage: "How often the bootnodes are queried again after the initial bootstrap, so that the node recovers " +
+			"peer discovery if its routing table emptied while they were offline. Reachability of each bootnode is " +
+			"reported by the p2p_bootnode_reachable metric. Set to 0 to only query them on startup and in the " +
+			"periodic discovery table maintenance",
+	}
+	// P2PDialTimeout defines the timeout for a single outbound peer dial.
+	P2PDialTimeout = &cli.DurationFlag{
+		Name: "p2p-dial-timeout",
+		Usage: "The timeout for a single outbound peer dial. Lowering it wastes less time on unreachable peers, " +
+			"at the risk of failing dials to distant peers. Set to 0 for the default of 10s",
+	}
+	// P2PDialBackoff defines how long a peer is not dialed again after a failed dial.
+	P2PDialBackoff = &cli.DurationFlag{
+		Name: "p2p-dial-backoff",
+		Usage: "How long a peer is not dialed again after a failed dial. The back-off doubles with each consecutive " +
+			"failed dial to the peer, up to the maximum dial back-off, and is cleared by a successful dial. The dial " +
+			"results are reported by the p2p_peer_dials_total metric. Set to 0 for the default of 30s",
+	}
+	// P2PMaxDialBackoff defines the maximum back-off from a peer whose dials keep failing.
+	P2PMaxDialBackoff = &cli.DurationFlag{
+		Name:  "p2p-max-dial-backoff",
+		Usage: "The maximum back-off from a peer whose dials keep failing. Set to 0 for the default of 10m",
+	}
+	// P2PPublishRetries defines how many times a gossip publication is retried after a transient failure.
+	P2PPublishRetries = &cli.IntFlag{
+		Name: "p2p-publish-retries",
+		Usage: "The number of times the publication of a gossip message, such as a block or an attestation, is " +
+			"retried after a transient failure. Retries never go past the start of the next slot. Failures are " +
+			"reported by kind in the p2p_gossip_publish_failures_total metric. Set to 0 to disable retries",
+		Value: 2,
+	}
+	// P2PPublishRetryBackoff defines the wait before the first retry of a failed gossip publication.
+	P2PPublishRetryBackoff = &cli.DurationFlag{
+		Name:  "p2p-publish-retry-backoff",
+		Usage: "The wait before the first retry of a failed gossip publication, doubling with each further retry",
+		Value: 250 * time.Millisecond,
+	}
+	// P2PDisableGossipRelay disables the relay of gossip messages received from other peers.
+	P2PDisableGossipRelay = &cli.BoolFlag{
+		Name: "p2p-disable-gossip-relay",
+		Usage: "Keeps the gossip topic subscriptions and validates the received messages, but stops forwarding the " +
+			"messages of other peers: the node keeps no gossip mesh and doesn't advertise the messages it has seen, " +
+			"so it only receives the messages advertised by its peers. Messages published by this node are still sent " +
+			"to all its peers. This is antisocial towards the network and delays the messages received by this node, " +
+			"only use it on a monitoring node behind well connected nodes which relay gossip",
+	}
+	// P2PGossipScoreThreshold defines the gossipsub score below which gossip is not exchanged with a peer.
+	P2PGossipScoreThreshold = &cli.Float64Flag{
+		Name: "p2p-gossip-score-threshold",
+		Usage: "The gossipsub peer score below which gossip is neither emitted to nor accepted from a peer. " +
+			"Must be negative. Lowering it reduces the protection against misbehaving peers, only do so " +
+			"temporarily during network incidents",
+		Value: -4000,
+	}
+	// P2PPublishScoreThreshold defines the gossipsub score below which published messages are not sent to a peer.
+	P2PPublishScoreThreshold = &cli.Float64Flag{
+		Name: "p2p-publish-score-threshold",
+		Usage: "The gossipsub peer score below which the messages published by this node are not sent to a peer. " +
+			"Must not be higher than the gossip score threshold. Lowering it reduces the protection against " +
+			"misbehaving peers, only do so temporarily during network incidents",
+		Value: -8000,
+	}
+	// P2PGraylistScoreThreshold defines the gossipsub score below which all the messages of a peer are ignored.
+	P2PGraylistScoreThreshold = &cli.Float64Flag{
+		Name: "p2p-graylist-score-threshold",
+		Usage: "The gossipsub peer score below which all the messages of a peer are ignored. Must not be higher " +
+			"than the publish score threshold. Lowering it reduces the protection against misbehaving peers, " +
+			"only do so temporarily during network incidents",
+		Value: -16000,
+	}
+	// MaxBlockSSZSize defines the largest SSZ encoded block accepted from peers.
+	MaxBlockSSZSize = &cli.Uint64Flag{
+		Name: "max-block-ssz-size",
+		Usage: "The largest SSZ encoded size, in bytes, of a block accepted from peers over gossip or RPC. Larger " +
+			"blocks are rejected before being decoded and their sender is penalized. Defaults to the largest " +
+			"block size allowed by the spec when set to 0",
+		Value: 0,
+	}
+	// BlsBatchSize defines the number of signatures verified in a single BLS batch during block processing.
+	BlsBatchSize = &cli.IntFlag{
+		Name: "bls-batch-size",
+		Usage: "The maximum number of signatures verified in a single BLS batch when processing blocks. When a batch " +
+			"fails, its signatures are verified one by one to identify the invalid one. Set to 0 to verify the " +
+			"signatures of a block, or of a batch of blocks during initial sync, in a single batch",
+	}
+	// BlockBatchVerifyWorkers defines the number of workers verifying block signatures during initial sync.
+	BlockBatchVerifyWorkers = &cli.IntFlag{
+		Name: "block-batch-verify-workers",
+		Usage: "The number of workers verifying the signatures of the blocks of a batch during initial sync, " +
+			"while the state transitions of the next blocks of the batch are applied. Set to 0 to verify the " +
+			"signatures of a batch at once after applying its last block",
+	}
 	// HistoricalSlasherNode is a set of beacon node flags required for performing historical detection with a slasher.
 	HistoricalSlasherNode = &cli.BoolFlag{
 		Name:  "historical-slasher-node",
@@ -160,10 +420,53 @@ var (
 			"If such a sync is not possible, the node will treat it a critical and irrecoverable failure",
 		Value: "",
 	}
+	// CheckpointStateURL defines the URL of an SSZ encoded checkpoint state to bootstrap the node from.
+	CheckpointStateURL = &cli.StringFlag{
+		Name: "checkpoint-state-url",
+		Usage: "URL of an SSZ encoded beacon state, at the start of an epoch, to start a node with an empty database from. " +
+			"Requires --checkpoint-block-url and --checkpoint-block-root",
+		Value: "",
+	}
+	// CheckpointBlockURL defines the URL of the SSZ encoded block of the checkpoint state.
+	CheckpointBlockURL = &cli.StringFlag{
+		Name:  "checkpoint-block-url",
+		Usage: "URL of the SSZ encoded signed block referred to by the latest block header of the checkpoint state",
+		Value: "",
+	}
+	// CheckpointBlockRoot defines the trusted block root the checkpoint state must match.
+	CheckpointBlockRoot = &cli.StringFlag{
+		Name: "checkpoint-block-root",
+		Usage: "Hex encoded block root, obtained from a trusted source, that the latest block header of the " +
+			"checkpoint state and the checkpoint block must hash to. The node refuses to start if they do not match",
+		Value: "",
+	}
 	// Eth1HeaderReqLimit defines a flag to set the maximum number of headers that a deposit log query can fetch. If none is set, 1000 will be the limit.
 	Eth1HeaderReqLimit = &cli.Uint64Flag{
 		Name:  "eth1-header-req-limit",
 		Usage: "Sets the maximum number of headers that a deposit log query can fetch.",
 		Value: uint64(1000),
 	}
+	// DepositCacheRetention defines a flag to set how long deposits are kept in the deposit cache once processed.
+	DepositCacheRetention = &cli.Uint64Flag{
+		Name: "deposit-cache-retention",
+		Usage: "Number of eth1 blocks, beyond the eth1 follow distance, for which deposits are kept in the " +
+			"in-memory deposit cache once processed into the finalized state. Older deposits are pruned",
+		Value: uint64(4096),
+	}
+	// HeadEventDebounceInterval defines a flag to set the minimum interval between two new head events.
+	HeadEventDebounceInterval = &cli.DurationFlag{
+		Name: "head-event-debounce-interval",
+		Usage: "Minimum interval between two new head events sent to subscribers, such as the beacon API event " +
+			"stream. The heads updated within an interval are coalesced and only the latest one is sent, which " +
+			"prevents flooding the subscribers during initial sync. Disabled when set to 0",
+		Value: 0,
+	}
+	// AttestationGatheringCutoff defines a flag to set when proposers stop gathering attestations for a block.
+	AttestationGatheringCutoff = &cli.DurationFlag{
+		Name: "attestation-gathering-cutoff",
+		Usage: "Time into the slot of a block being built after which the node stops gathering unaggregated " +
+			"attestations for it, so that a late block request is answered with the aggregates already checked " +
+			"instead of delaying the block further. Disabled when set to 0",
+		Value: 2 * time.Second,
+	}
 )
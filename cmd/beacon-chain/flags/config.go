@@ -1,20 +1,26 @@
 package flags
 
 import (
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli/v2"
 )
 
 // GlobalFlags specifies all the global flags for the
 // beacon node.
 type GlobalFlags struct {
-	HeadSync                   bool
-	DisableSync                bool
-	DisableDiscv5              bool
-	SubscribeToAllSubnets      bool
-	MinimumSyncPeers           int
-	BlockBatchLimit            int
-	BlockBatchLimitBurstFactor int
+	HeadSync                     bool
+	DisableSync                  bool
+	DisableDiscv5                bool
+	SubscribeToAllSubnets        bool
+	BackboneSubnets              uint64
+	AttestationSubnetLookahead   types.Slot
+	AttestationValidationWorkers int
+	MaxBlockSSZSize              uint64
+	MinimumSyncPeers             int
+	BlockBatchLimit              int
+	BlockBatchLimitBurstFactor   int
 }
 
 var globalConfig *GlobalFlags
@@ -48,6 +54,19 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 		log.Warn("Subscribing to All Attestation Subnets")
 		cfg.SubscribeToAllSubnets = true
 	}
+	cfg.BackboneSubnets = ctx.Uint64(BackboneSubnets.Name)
+	if subnetCount := params.BeaconNetworkConfig().AttestationSubnetCount; cfg.BackboneSubnets > subnetCount {
+		log.Warnf("Changing Backbone Subnets to %d", subnetCount)
+		cfg.BackboneSubnets = subnetCount
+	}
+	cfg.AttestationSubnetLookahead = types.Slot(ctx.Uint64(AttestationSubnetLookahead.Name))
+	// The subnets of the duties are only known until the end of the next epoch.
+	if slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch; cfg.AttestationSubnetLookahead > slotsPerEpoch {
+		log.Warnf("Changing Attestation Subnet Lookahead to %d", slotsPerEpoch)
+		cfg.AttestationSubnetLookahead = slotsPerEpoch
+	}
+	cfg.AttestationValidationWorkers = ctx.Int(AttestationValidationWorkers.Name)
+	cfg.MaxBlockSSZSize = ctx.Uint64(MaxBlockSSZSize.Name)
 	cfg.DisableDiscv5 = ctx.Bool(DisableDiscv5.Name)
 	cfg.BlockBatchLimit = ctx.Int(BlockBatchLimit.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
@@ -40,6 +40,8 @@ var appFlags = []cli.Flag{
 	flags.GRPCGatewayHost,
 	flags.GRPCGatewayPort,
 	flags.GPRCGatewayCorsDomain,
+	flags.GRPCGatewayAllowedPaths,
+	flags.GRPCGatewayDeniedPaths,
 	flags.MinSyncPeers,
 	flags.ContractDeploymentBlock,
 	flags.SetGCPercent,
@@ -53,12 +55,51 @@ var appFlags = []cli.Flag{
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.StateRetentionEpochs,
 	flags.EnableDebugRPCEndpoints,
+	flags.EnableGRPCReflection,
 	flags.SubscribeToAllSubnets,
+	flags.BackboneSubnets,
+	flags.Eth1DataVoteStrategy,
+	flags.HistoricalStateCacheSize,
+	flags.TrackedValidatorIndices,
+	flags.MaximumGossipClockDisparity,
+	flags.MaxAggregatedAttestationsInPool,
+	flags.MaxUnaggregatedAttestationsInPool,
+	flags.AttestationPoolLowMemory,
+	flags.GossipSeenCacheTTL,
+	flags.GossipHistoryLength,
+	flags.BlsBatchSize,
+	flags.BlockBatchVerifyWorkers,
+	flags.P2PGeoIPDatabase,
+	flags.P2PGossipOutboundRateLimit,
+	flags.AttestationSubnetLookahead,
+	flags.P2PBootnodeRefreshInterval,
+	flags.P2PDialTimeout,
+	flags.P2PDialBackoff,
+	flags.P2PMaxDialBackoff,
+	flags.P2PPublishRetries,
+	flags.P2PPublishRetryBackoff,
+	flags.P2PDisableGossipRelay,
+	flags.MaxBlockSSZSize,
+	flags.HotStateCacheSize,
+	flags.DepositCacheRetention,
+	flags.AttestationValidationWorkers,
+	flags.HeadEventDebounceInterval,
+	flags.AttestationGatheringCutoff,
+	flags.P2PGossipScoreThreshold,
+	flags.P2PPublishScoreThreshold,
+	flags.P2PGraylistScoreThreshold,
+	flags.GossipMeshDegree,
+	flags.GossipMeshDegreeLow,
+	flags.GossipMeshDegreeHigh,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
 	flags.NetworkID,
 	flags.WeakSubjectivityCheckpt,
+	flags.CheckpointStateURL,
+	flags.CheckpointBlockURL,
+	flags.CheckpointBlockRoot,
 	flags.Eth1HeaderReqLimit,
 	cmd.EnableBackupWebhookFlag,
 	cmd.BackupWebhookOutputDir,
@@ -158,6 +199,8 @@ func main() {
 		default:
 			return fmt.Errorf("unknown log format %s", format)
 		}
+		// Allow the log level of single subsystems to be changed at runtime.
+		logutil.FilterSubsystemLevels(logrus.StandardLogger())
 
 		logFileName := ctx.String(cmd.LogFileName.Name)
 		if logFileName != "" {
@@ -208,7 +251,7 @@ func startNode(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	logrus.SetLevel(level)
+	logutil.SetLevel(level)
 	if level == logrus.TraceLevel {
 		// libp2p specific logging.
 		golog.SetAllLoggers(golog.LevelDebug)
@@ -103,21 +103,62 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GRPCGatewayHost,
 			flags.GRPCGatewayPort,
 			flags.GPRCGatewayCorsDomain,
+			flags.GRPCGatewayAllowedPaths,
+			flags.GRPCGatewayDeniedPaths,
 			flags.HTTPWeb3ProviderFlag,
 			flags.FallbackWeb3ProviderFlag,
 			flags.SetGCPercent,
 			flags.HeadSync,
 			flags.DisableSync,
 			flags.SlotsPerArchivedPoint,
+			flags.StateRetentionEpochs,
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.EnableDebugRPCEndpoints,
+			flags.EnableGRPCReflection,
 			flags.SubscribeToAllSubnets,
+			flags.BackboneSubnets,
+			flags.Eth1DataVoteStrategy,
+			flags.HistoricalStateCacheSize,
+			flags.TrackedValidatorIndices,
+			flags.MaximumGossipClockDisparity,
+			flags.MaxAggregatedAttestationsInPool,
+			flags.MaxUnaggregatedAttestationsInPool,
+			flags.AttestationPoolLowMemory,
+			flags.GossipSeenCacheTTL,
+			flags.GossipHistoryLength,
+			flags.BlsBatchSize,
+			flags.BlockBatchVerifyWorkers,
+			flags.P2PGeoIPDatabase,
+			flags.P2PGossipOutboundRateLimit,
+			flags.AttestationSubnetLookahead,
+			flags.P2PBootnodeRefreshInterval,
+			flags.P2PDialTimeout,
+			flags.P2PDialBackoff,
+			flags.P2PMaxDialBackoff,
+			flags.P2PPublishRetries,
+			flags.P2PPublishRetryBackoff,
+			flags.P2PDisableGossipRelay,
+			flags.MaxBlockSSZSize,
+			flags.HotStateCacheSize,
+			flags.DepositCacheRetention,
+			flags.AttestationValidationWorkers,
+			flags.HeadEventDebounceInterval,
+			flags.AttestationGatheringCutoff,
+			flags.P2PGossipScoreThreshold,
+			flags.P2PPublishScoreThreshold,
+			flags.P2PGraylistScoreThreshold,
+			flags.GossipMeshDegree,
+			flags.GossipMeshDegreeLow,
+			flags.GossipMeshDegreeHigh,
 			flags.HistoricalSlasherNode,
 			flags.ChainID,
 			flags.NetworkID,
 			flags.WeakSubjectivityCheckpt,
+			flags.CheckpointStateURL,
+			flags.CheckpointBlockURL,
+			flags.CheckpointBlockRoot,
 			flags.Eth1HeaderReqLimit,
 		},
 	},
@@ -57,6 +57,13 @@ var (
 		Usage: "Sets the span cache size.",
 		Value: 1500,
 	}
+	// CompressSpansFlag enables compression of the min-max span blobs stored on disk.
+	CompressSpansFlag = &cli.BoolFlag{
+		Name: "compress-spans",
+		Usage: "Compresses min-max span data with snappy before writing it to the slasher database, " +
+			"trading some CPU on reads and writes for a smaller database. Databases with a mix of " +
+			"compressed and uncompressed spans are read transparently.",
+	}
 	// HighestAttCacheSize is a flag that sets the size of highest attestation cache.
 	HighestAttCacheSize = &cli.IntFlag{
 		Name:  "highest-att-cache-size",
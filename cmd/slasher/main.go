@@ -78,6 +78,7 @@ var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
 	flags.EnableHistoricalDetectionFlag,
 	flags.SpanCacheSize,
+	flags.CompressSpansFlag,
 	cmd.AcceptTosFlag,
 	flags.HighestAttCacheSize,
 }
@@ -88,6 +88,7 @@ var appHelpFlagGroups = []flagGroup{
 			flags.BeaconRPCProviderFlag,
 			flags.EnableHistoricalDetectionFlag,
 			flags.SpanCacheSize,
+			flags.CompressSpansFlag,
 			flags.HighestAttCacheSize,
 		},
 	},
@@ -139,6 +139,37 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "import-mnemonic",
+			Description: "imports a range of eth2 validator accounts derived from a mnemonic phrase according to EIP-2334. " +
+				"The mnemonic is never stored, handle it with care",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.MnemonicFileFlag,
+				flags.Mnemonic25thWordFileFlag,
+				flags.SkipMnemonic25thWordCheckFlag,
+				flags.MnemonicStartIndexFlag,
+				flags.NumAccountsFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := accounts.ImportMnemonicAccountsCli(cliCtx); err != nil {
+					log.Fatalf("Could not import accounts from mnemonic: %v", err)
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "voluntary-exit",
 			Description: "Performs a voluntary exit on selected accounts",
@@ -147,6 +178,7 @@ var Commands = &cli.Command{
 				flags.WalletPasswordFileFlag,
 				flags.AccountPasswordFileFlag,
 				flags.VoluntaryExitPublicKeysFlag,
+				flags.VoluntaryExitIndicesFlag,
 				flags.BeaconRPCProviderFlag,
 				cmd.GrpcMaxCallRecvMsgSizeFlag,
 				flags.CertFlag,
@@ -32,6 +32,14 @@ var (
 		Usage: "Beacon node RPC provider endpoint",
 		Value: "127.0.0.1:4000",
 	}
+	// BeaconRPCBroadcastProvidersFlag defines additional beacon node RPC endpoints to broadcast submissions to.
+	BeaconRPCBroadcastProvidersFlag = &cli.StringFlag{
+		Name: "beacon-rpc-broadcast-providers",
+		Usage: "Comma separated list of additional beacon node RPC endpoints that signed blocks, attestations and " +
+			"aggregates are also submitted to. A submission succeeds if at least one beacon node accepts it. " +
+			"Duties and other reads only use --beacon-rpc-provider",
+		Value: "",
+	}
 	// BeaconRPCGatewayProviderFlag defines a beacon node JSON-RPC endpoint.
 	BeaconRPCGatewayProviderFlag = &cli.StringFlag{
 		Name:  "beacon-rpc-gateway-provider",
@@ -79,8 +87,10 @@ var (
 	}
 	// GraffitiFlag defines the graffiti value included in proposed blocks
 	GraffitiFlag = &cli.StringFlag{
-		Name:  "graffiti",
-		Usage: "String to include in proposed blocks",
+		Name: "graffiti",
+		Usage: "String to include in proposed blocks. The {version} and {commit} placeholders are replaced by " +
+			"the version and commit of the validator client, here and in the graffiti file, and the result is " +
+			"truncated to 32 bytes",
 	}
 	// GrpcRetriesFlag defines the number of times to retry a failed gRPC request.
 	GrpcRetriesFlag = &cli.UintFlag{
@@ -184,6 +194,12 @@ var (
 		Usage: "Number of accounts to generate for derived wallets",
 		Value: 1,
 	}
+	// MnemonicStartIndexFlag defines the first account index to derive when importing accounts from a mnemonic.
+	MnemonicStartIndexFlag = &cli.IntFlag{
+		Name:  "mnemonic-start-index",
+		Usage: "First EIP-2334 account index to derive when importing accounts from a mnemonic, along with --num-accounts",
+		Value: 0,
+	}
 	// DeletePublicKeysFlag defines a comma-separated list of hex string public keys
 	// for accounts which a user desires to delete from their wallet.
 	DeletePublicKeysFlag = &cli.StringFlag{
@@ -220,6 +236,14 @@ var (
 			"a voluntary exit",
 		Value: "",
 	}
+	// VoluntaryExitIndicesFlag defines a comma-separated list of validator indices
+	// for accounts on which a user wants to perform a voluntary exit.
+	VoluntaryExitIndicesFlag = &cli.StringFlag{
+		Name: "validator-indices",
+		Usage: "Comma-separated list of validator indices to specify on which validator accounts to perform " +
+			"a voluntary exit",
+		Value: "",
+	}
 	// ExitAllFlag allows stakers to select all validating keys for exit. This will still require the staker
 	// to confirm a prompt for this action given it is a dangerous one.
 	ExitAllFlag = &cli.BoolFlag{
@@ -306,10 +330,26 @@ var (
 		Usage: "Allows users to specify the output directory to export their slashing protection EIP-3076 standard JSON File",
 		Value: "",
 	}
+	// SlashingProtectionHistoryEpochsFlag defines how many epochs of chain history are scanned to
+	// regenerate the slashing protection history.
+	SlashingProtectionHistoryEpochsFlag = &cli.Uint64Flag{
+		Name:  "slashing-protection-history-epochs",
+		Usage: "Number of epochs before the beacon node head scanned for the attestations and blocks of the wallet validators",
+		Value: 256,
+	}
+	// AcceptIncompleteSlashingProtectionFlag acknowledges that a slashing protection history
+	// regenerated from the chain does not capture the messages signed but never included.
+	AcceptIncompleteSlashingProtectionFlag = &cli.BoolFlag{
+		Name: "accept-incomplete-slashing-protection",
+		Usage: "Acknowledge that a slashing protection history regenerated from the chain only contains the attestations " +
+			"and blocks included on chain, and misses anything signed but never included. Only use it if the validators " +
+			"have never run from another machine or database",
+	}
 	// GraffitiFileFlag specifies the file path to load graffiti values.
 	GraffitiFileFlag = &cli.StringFlag{
-		Name:  "graffiti-file",
-		Usage: "The path to a YAML file with graffiti values",
+		Name: "graffiti-file",
+		Usage: "The path to a YAML file with graffiti values. Set cycle: true in the file to use the ordered " +
+			"graffiti round robin. The file is reloaded on SIGHUP",
 	}
 	// EnableDutyCountDown enables more verbose logging for counting down to duty.
 	EnableDutyCountDown = &cli.BoolFlag{
@@ -317,6 +357,88 @@ var (
 		Usage: "Enables more verbose logging for counting down to duty",
 		Value: false,
 	}
+	// DoppelgangerEpochsFlag defines how many epochs to check the liveness of our own validators before performing duties.
+	DoppelgangerEpochsFlag = &cli.Uint64Flag{
+		Name: "doppelganger-protection-epochs",
+		Usage: "Number of epochs, starting from the next one, to check our own validators for liveness on chain before performing duties. " +
+			"Keys seen live elsewhere are not used. Set to 0 to skip this check if you are sure your keys are not in use by another client",
+		Value: 2,
+	}
+	// CheckWithdrawalCredentialsFlag enables the startup check of the withdrawal credentials of the validating keys.
+	CheckWithdrawalCredentialsFlag = &cli.BoolFlag{
+		Name: "check-withdrawal-credentials",
+		Usage: "Warns on startup about deposited validating keys whose withdrawal credentials are BLS (0x00) credentials, " +
+			"or point to an eth1 address missing from --withdrawal-address-allowlist, as they may indicate a compromised " +
+			"setup. The check is advisory only and never prevents performing duties",
+	}
+	// WithdrawalAddressAllowlistFlag defines the eth1 addresses the withdrawal credentials are expected to point to.
+	WithdrawalAddressAllowlistFlag = &cli.StringSliceFlag{
+		Name: "withdrawal-address-allowlist",
+		Usage: "Comma separated list of the 0x prefixed eth1 addresses controlled by the operator, checked against the " +
+			"withdrawal credentials of the validating keys with --check-withdrawal-credentials. When empty, any eth1 address is accepted",
+	}
+	// LateBlockThresholdFlag defines how long after the start of the slot a proposed block is considered late.
+	LateBlockThresholdFlag = &cli.DurationFlag{
+		Name:  "late-block-threshold",
+		Usage: "Time into the slot after which a warning is logged when publishing a proposed block, as it risks not being included",
+		Value: 4 * time.Second,
+	}
+	// MinBeaconNodePeersFlag defines the number of peers the beacon node must be connected to before performing duties.
+	MinBeaconNodePeersFlag = &cli.Uint64Flag{
+		Name: "min-beacon-node-peers",
+		Usage: "Minimum number of peers the beacon node must be connected to before the validator starts performing duties, " +
+			"as a poorly connected node leads to late or missed attestations. Set to 0 to skip this check",
+	}
+	// MinBeaconNodePeersTimeoutFlag defines how long to wait for the minimum number of beacon node peers.
+	MinBeaconNodePeersTimeoutFlag = &cli.DurationFlag{
+		Name:  "min-beacon-node-peers-timeout",
+		Usage: "Time to wait for the beacon node to reach --min-beacon-node-peers, after which duties are performed anyway. Set to 0 to wait indefinitely",
+		Value: 5 * time.Minute,
+	}
+	// SkipDutiesWhenNodeUnsyncedFlag defines whether to skip the duties of the slots when the beacon node is not synced.
+	SkipDutiesWhenNodeUnsyncedFlag = &cli.BoolFlag{
+		Name: "skip-duties-when-node-unsynced",
+		Usage: "Check the beacon node sync status at every slot, and skip attesting, proposing and aggregating while it " +
+			"is syncing or its head lags behind by more than --max-node-head-lag slots, to avoid voting for a stale head. " +
+			"Duties resume automatically once the node is synced",
+	}
+	// MaxNodeHeadLagFlag defines how many slots the beacon node head may lag behind before skipping duties.
+	MaxNodeHeadLagFlag = &cli.Uint64Flag{
+		Name:  "max-node-head-lag",
+		Usage: "Number of slots the beacon node head may lag behind the current slot before duties are skipped, with --skip-duties-when-node-unsynced. Set to 0 to only check the syncing status",
+		Value: 8,
+	}
+	// AggregationSlotOffsetFlag defines how far into the slot aggregate attestations are created.
+	AggregationSlotOffsetFlag = &cli.Float64Flag{
+		Name: "aggregation-slot-offset",
+		Usage: "Fraction of the slot, between 0 and 1, waited before creating aggregate attestations. The default of 2/3 " +
+			"follows the spec. A later offset gathers more attestations on slow networks, but leaves less time for the " +
+			"aggregate to reach the next proposer, increasing the risk of it not being included",
+		Value: 2.0 / 3,
+	}
+	// BlockProductionTimeoutFlag defines how long to wait for the beacon node to produce a block to propose.
+	BlockProductionTimeoutFlag = &cli.DurationFlag{
+		Name: "block-production-timeout",
+		Usage: "Time to wait for the beacon node to produce a block to propose, after which the proposal is skipped " +
+			"so that a slow beacon node does not stall the proposer. Set to 0 to wait until the end of the slot",
+	}
+	// Web3SignerURLFlag defines the URL of a Web3Signer instance to use for remote signing.
+	Web3SignerURLFlag = &cli.StringFlag{
+		Name:  "web3signer-url",
+		Usage: "URL of a Web3Signer instance holding the validating keys, such as http://localhost:9000. Replaces the wallet as the source of keys when set",
+	}
+	// Web3SignerTimeoutFlag defines the timeout of a single request to the Web3Signer.
+	Web3SignerTimeoutFlag = &cli.DurationFlag{
+		Name:  "web3signer-timeout",
+		Usage: "Timeout of a single request to the Web3Signer, failed requests are retried",
+		Value: 2 * time.Second,
+	}
+	// SkipInvalidWalletKeysFlag skips the wallet accounts failing the startup integrity check.
+	SkipInvalidWalletKeysFlag = &cli.BoolFlag{
+		Name: "skip-invalid-wallet-keys",
+		Usage: "Start with the valid accounts of the wallet, logging and skipping the accounts whose keys are " +
+			"malformed or do not match, instead of refusing to start. Accounts cannot be imported or deleted while any are skipped",
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
@@ -48,6 +48,7 @@ func startNode(ctx *cli.Context) error {
 var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
 	flags.BeaconRPCGatewayProviderFlag,
+	flags.BeaconRPCBroadcastProvidersFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
 	flags.DisablePenaltyRewardLogFlag,
@@ -73,6 +74,19 @@ var appFlags = []cli.Flag{
 	flags.EnableWebFlag,
 	flags.GraffitiFileFlag,
 	flags.EnableDutyCountDown,
+	flags.DoppelgangerEpochsFlag,
+	flags.CheckWithdrawalCredentialsFlag,
+	flags.WithdrawalAddressAllowlistFlag,
+	flags.LateBlockThresholdFlag,
+	flags.MinBeaconNodePeersFlag,
+	flags.MinBeaconNodePeersTimeoutFlag,
+	flags.SkipDutiesWhenNodeUnsyncedFlag,
+	flags.MaxNodeHeadLagFlag,
+	flags.AggregationSlotOffsetFlag,
+	flags.BlockProductionTimeoutFlag,
+	flags.Web3SignerURLFlag,
+	flags.Web3SignerTimeoutFlag,
+	flags.SkipInvalidWalletKeysFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.EnableBackupWebhookFlag,
 	cmd.MinimalConfigFlag,
@@ -55,5 +55,39 @@ var Commands = &cli.Command{
 				return slashingprotection.ImportSlashingProtectionCLI(cliCtx)
 			},
 		},
+		{
+			Name: "regenerate",
+			Description: `regenerates your validator slashing protection history from the attestations and blocks ` +
+				`included on chain, as a last resort after losing the validator database. Anything signed but never ` +
+				`included is not captured, which must be acknowledged with --accept-incomplete-slashing-protection`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.AccountPasswordFileFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
+				flags.SlashingProtectionHistoryEpochsFlag,
+				flags.AcceptIncompleteSlashingProtectionFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				return slashingprotection.RegenerateSlashingProtectionCLI(cliCtx)
+			},
+		},
 	},
 }
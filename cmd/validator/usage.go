@@ -86,6 +86,7 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			flags.BeaconRPCProviderFlag,
 			flags.BeaconRPCGatewayProviderFlag,
+			flags.BeaconRPCBroadcastProvidersFlag,
 			flags.CertFlag,
 			flags.EnableWebFlag,
 			flags.DisablePenaltyRewardLogFlag,
@@ -106,6 +107,19 @@ var appHelpFlagGroups = []flagGroup{
 			flags.WalletPasswordFileFlag,
 			flags.GraffitiFileFlag,
 			flags.EnableDutyCountDown,
+			flags.DoppelgangerEpochsFlag,
+			flags.CheckWithdrawalCredentialsFlag,
+			flags.WithdrawalAddressAllowlistFlag,
+			flags.LateBlockThresholdFlag,
+			flags.MinBeaconNodePeersFlag,
+			flags.MinBeaconNodePeersTimeoutFlag,
+			flags.SkipDutiesWhenNodeUnsyncedFlag,
+			flags.MaxNodeHeadLagFlag,
+			flags.AggregationSlotOffsetFlag,
+			flags.BlockProductionTimeoutFlag,
+			flags.Web3SignerURLFlag,
+			flags.Web3SignerTimeoutFlag,
+			flags.SkipInvalidWalletKeysFlag,
 		},
 	},
 	{
@@ -75,6 +75,7 @@ func StartNewValidatorClient(t *testing.T, config *types.E2EConfig, validatorNum
 		fmt.Sprintf("--beacon-rpc-provider=localhost:%d", beaconRPCPort),
 		"--grpc-headers=dummy=value,foo=bar", // Sending random headers shouldn't break anything.
 		"--force-clear-db",
+		"--doppelganger-protection-epochs=0",
 		"--e2e-config",
 		"--accept-terms-of-use",
 		"--verbosity=debug",
@@ -76,30 +76,140 @@ func (m *LogsResponse) GetLogs() []string {
 	return nil
 }
 
+type ValidatorLivenessRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Indices              []uint64 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorLivenessRequest) Reset()         { *m = ValidatorLivenessRequest{} }
+func (m *ValidatorLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLivenessRequest) ProtoMessage()    {}
+func (*ValidatorLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e4b7e98e3e10444, []int{1}
+}
+func (m *ValidatorLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLivenessRequest.Merge(m, src)
+}
+func (m *ValidatorLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLivenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLivenessRequest proto.InternalMessageInfo
+
+func (m *ValidatorLivenessRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorLivenessRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
+type ValidatorLivenessResponse struct {
+	LiveIndices          []uint64 `protobuf:"varint,1,rep,packed,name=live_indices,json=liveIndices,proto3" json:"live_indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorLivenessResponse) Reset()         { *m = ValidatorLivenessResponse{} }
+func (m *ValidatorLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLivenessResponse) ProtoMessage()    {}
+func (*ValidatorLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e4b7e98e3e10444, []int{2}
+}
+func (m *ValidatorLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLivenessResponse.Merge(m, src)
+}
+func (m *ValidatorLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLivenessResponse proto.InternalMessageInfo
+
+func (m *ValidatorLivenessResponse) GetLiveIndices() []uint64 {
+	if m != nil {
+		return m.LiveIndices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LogsResponse)(nil), "ethereum.beacon.rpc.v1.LogsResponse")
+	proto.RegisterType((*ValidatorLivenessRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorLivenessRequest")
+	proto.RegisterType((*ValidatorLivenessResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorLivenessResponse")
 }
 
 func init() { proto.RegisterFile("proto/beacon/rpc/v1/health.proto", fileDescriptor_2e4b7e98e3e10444) }
 
 var fileDescriptor_2e4b7e98e3e10444 = []byte{
-	// 249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x28, 0xca, 0x2f,
-	0xc9, 0xd7, 0x4f, 0x4a, 0x4d, 0x4c, 0xce, 0xcf, 0xd3, 0x2f, 0x2a, 0x48, 0xd6, 0x2f, 0x33, 0xd4,
-	0xcf, 0x48, 0x4d, 0xcc, 0x29, 0xc9, 0xd0, 0x03, 0x4b, 0x09, 0x89, 0xa5, 0x96, 0x64, 0xa4, 0x16,
-	0xa5, 0x96, 0xe6, 0xea, 0x41, 0x14, 0xe9, 0x15, 0x15, 0x24, 0xeb, 0x95, 0x19, 0x4a, 0xc9, 0xa4,
-	0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27, 0xe6, 0xe5, 0xe5, 0x97, 0x24,
-	0x96, 0x64, 0xe6, 0xe7, 0x15, 0x43, 0x74, 0x49, 0x49, 0x43, 0x65, 0xc1, 0xbc, 0xa4, 0xd2, 0x34,
-	0xfd, 0xd4, 0xdc, 0x82, 0x92, 0x4a, 0x88, 0xa4, 0x92, 0x12, 0x17, 0x8f, 0x4f, 0x7e, 0x7a, 0x71,
-	0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x10, 0x17, 0x4b, 0x4e, 0x7e, 0x7a, 0xb1,
-	0x04, 0xa3, 0x02, 0xb3, 0x06, 0x67, 0x10, 0x98, 0x6d, 0xd4, 0xc6, 0xc8, 0xc5, 0xe6, 0x01, 0x76,
-	0x87, 0x50, 0x0d, 0x97, 0x40, 0x70, 0x49, 0x51, 0x6a, 0x62, 0xae, 0x13, 0xd8, 0x01, 0x20, 0xad,
-	0x42, 0x62, 0x7a, 0x10, 0x0b, 0xf4, 0x60, 0x16, 0xe8, 0xb9, 0x82, 0x2c, 0x90, 0x52, 0xd1, 0xc3,
-	0xee, 0x5c, 0x3d, 0x64, 0x0b, 0x95, 0x34, 0x9a, 0x2e, 0x3f, 0x99, 0xcc, 0xa4, 0x24, 0xa4, 0xa0,
-	0x9f, 0x5a, 0x92, 0xa1, 0x5f, 0x66, 0x98, 0x98, 0x53, 0x90, 0x91, 0x08, 0xf3, 0xb7, 0x3e, 0xc8,
-	0x7e, 0xfd, 0x62, 0xb0, 0x8d, 0x06, 0x8c, 0x4e, 0x3c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0x63, 0x12, 0x1b, 0xd8, 0x3e, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xb7, 0x40, 0x67, 0x57, 0x38, 0x01, 0x00, 0x00,
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x95, 0x91, 0xcb, 0x4a, 0x03, 0x31,
+	0x14, 0x86, 0x49, 0xad, 0x55, 0x63, 0x17, 0x12, 0x4a, 0x19, 0x47, 0x91, 0x3a, 0xb8, 0xe8, 0x2a,
+	0x69, 0xeb, 0xde, 0x45, 0x41, 0xbc, 0xd0, 0xd5, 0x08, 0x6e, 0x25, 0x9d, 0x1e, 0x3b, 0x03, 0xd3,
+	0x49, 0x9c, 0xa4, 0x03, 0xa2, 0x2b, 0x17, 0xfa, 0x00, 0xbe, 0x94, 0x4b, 0xc1, 0x17, 0x10, 0xf1,
+	0x41, 0xcc, 0x24, 0x1d, 0x10, 0x6c, 0x17, 0x2e, 0x02, 0x39, 0x97, 0xff, 0x9c, 0x7c, 0x7f, 0x70,
+	0x47, 0xe6, 0x42, 0x0b, 0x36, 0x06, 0x1e, 0x89, 0x8c, 0xe5, 0x32, 0x62, 0x45, 0x9f, 0xc5, 0xc0,
+	0x53, 0x1d, 0x53, 0x5b, 0x22, 0x6d, 0xd0, 0x31, 0xe4, 0x30, 0x9f, 0x51, 0xd7, 0x44, 0x4d, 0x13,
+	0x2d, 0xfa, 0xfe, 0xfe, 0x54, 0x88, 0x69, 0x0a, 0x8c, 0xcb, 0x84, 0xf1, 0x2c, 0x13, 0x9a, 0xeb,
+	0x44, 0x64, 0xca, 0xa9, 0xfc, 0xbd, 0x45, 0xd5, 0x46, 0xe3, 0xf9, 0x2d, 0x83, 0x99, 0xd4, 0xf7,
+	0xae, 0x18, 0x04, 0xb8, 0x39, 0x12, 0x53, 0x15, 0x82, 0x92, 0x46, 0x01, 0x84, 0xe0, 0x7a, 0x6a,
+	0x62, 0x0f, 0x75, 0xd6, 0xba, 0x5b, 0xa1, 0xbd, 0x07, 0x97, 0xd8, 0xbb, 0xe6, 0x69, 0x32, 0xe1,
+	0x5a, 0xe4, 0xa3, 0xa4, 0x80, 0x0c, 0x94, 0x11, 0xdc, 0xcd, 0x41, 0x69, 0xd2, 0xc2, 0xeb, 0x20,
+	0x45, 0x14, 0x1b, 0x01, 0xea, 0xd6, 0x43, 0x17, 0x10, 0x0f, 0x6f, 0x24, 0xd9, 0x24, 0x89, 0x40,
+	0x79, 0x35, 0x33, 0xa8, 0x1e, 0x56, 0x61, 0x70, 0x82, 0x77, 0x97, 0xcc, 0x5a, 0x2c, 0x3f, 0xc4,
+	0xcd, 0xd4, 0xe4, 0x6e, 0x2a, 0x2d, 0xb2, 0xda, 0xed, 0x32, 0x77, 0xe1, 0x52, 0x83, 0x67, 0x84,
+	0x1b, 0xe7, 0xd6, 0x13, 0xf2, 0x88, 0x77, 0xae, 0x74, 0x0e, 0x7c, 0x36, 0xb4, 0x66, 0x94, 0x18,
+	0xa4, 0x4d, 0x1d, 0x2c, 0xad, 0x60, 0xe9, 0x69, 0x09, 0xeb, 0x1f, 0xd1, 0xe5, 0xd6, 0xd1, 0xdf,
+	0xf0, 0x41, 0xf7, 0xe9, 0xe3, 0xfb, 0xb5, 0x16, 0x90, 0x0e, 0x33, 0xdd, 0xc6, 0x7d, 0x9e, 0xca,
+	0x98, 0x57, 0x7f, 0xc0, 0x4a, 0x2f, 0x98, 0xb2, 0x1b, 0x7b, 0x68, 0xf0, 0x82, 0xf0, 0x66, 0x05,
+	0x40, 0x1e, 0x70, 0xeb, 0x0c, 0xf4, 0x1f, 0x30, 0xd2, 0x5b, 0xb5, 0x76, 0x95, 0x9f, 0x7e, 0xff,
+	0x1f, 0x0a, 0xf7, 0xea, 0x61, 0xf3, 0xed, 0xeb, 0x00, 0xbd, 0x9b, 0xf3, 0x69, 0xce, 0xb8, 0x61,
+	0xc9, 0x8f, 0x7f, 0x00, 0xe4, 0x72, 0x7b, 0x55, 0x4e, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -209,6 +319,78 @@ var _Health_serviceDesc = grpc.ServiceDesc{
 	Metadata: "proto/beacon/rpc/v1/health.proto",
 }
 
+// LivenessClient is the client API for Liveness service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LivenessClient interface {
+	GetValidatorLiveness(ctx context.Context, in *ValidatorLivenessRequest, opts ...grpc.CallOption) (*ValidatorLivenessResponse, error)
+}
+
+type livenessClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLivenessClient(cc *grpc.ClientConn) LivenessClient {
+	return &livenessClient{cc}
+}
+
+func (c *livenessClient) GetValidatorLiveness(ctx context.Context, in *ValidatorLivenessRequest, opts ...grpc.CallOption) (*ValidatorLivenessResponse, error) {
+	out := new(ValidatorLivenessResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Liveness/GetValidatorLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LivenessServer is the server API for Liveness service.
+type LivenessServer interface {
+	GetValidatorLiveness(context.Context, *ValidatorLivenessRequest) (*ValidatorLivenessResponse, error)
+}
+
+// UnimplementedLivenessServer can be embedded to have forward compatible implementations.
+type UnimplementedLivenessServer struct {
+}
+
+func (*UnimplementedLivenessServer) GetValidatorLiveness(ctx context.Context, req *ValidatorLivenessRequest) (*ValidatorLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorLiveness not implemented")
+}
+
+func RegisterLivenessServer(s *grpc.Server, srv LivenessServer) {
+	s.RegisterService(&_Liveness_serviceDesc, srv)
+}
+
+func _Liveness_GetValidatorLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LivenessServer).GetValidatorLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Liveness/GetValidatorLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LivenessServer).GetValidatorLiveness(ctx, req.(*ValidatorLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Liveness_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Liveness",
+	HandlerType: (*LivenessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetValidatorLiveness",
+			Handler:    _Liveness_GetValidatorLiveness_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/health.proto",
+}
+
 func (m *LogsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -245,6 +427,101 @@ func (m *LogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Indices) > 0 {
+		dAtA2 := make([]byte, len(m.Indices)*10)
+		var j1 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintHealth(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintHealth(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.LiveIndices) > 0 {
+		dAtA2 := make([]byte, len(m.LiveIndices)*10)
+		var j1 int
+		for _, num := range m.LiveIndices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintHealth(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHealth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHealth(v)
 	base := offset
@@ -274,6 +551,47 @@ func (m *LogsResponse) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovHealth(uint64(m.Epoch))
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovHealth(uint64(e))
+		}
+		n += 1 + sovHealth(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiveIndices) > 0 {
+		l = 0
+		for _, e := range m.LiveIndices {
+			l += sovHealth(uint64(e))
+		}
+		n += 1 + sovHealth(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovHealth(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -363,6 +681,279 @@ func (m *LogsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorLivenessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLivenessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHealth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHealth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHealth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthHealth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthHealth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHealth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHealth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLivenessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHealth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLivenessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHealth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LiveIndices = append(m.LiveIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHealth
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthHealth
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthHealth
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LiveIndices) == 0 {
+					m.LiveIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHealth
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LiveIndices = append(m.LiveIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveIndices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHealth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHealth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipHealth(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -232,3 +232,20 @@ func V1ProposerSlashingToV1Alpha1(v1Slashing *ethpb.ProposerSlashing) *ethpb_alp
 		Header_2: V1SignedHeaderToV1Alpha1(v1Slashing.Header_2),
 	}
 }
+
+// V1Alpha1ValidatorToV1 converts a v1alpha1 Validator to v1.
+func V1Alpha1ValidatorToV1(v1Alpha1Validator *ethpb_alpha.Validator) *ethpb.Validator {
+	if v1Alpha1Validator == nil {
+		return &ethpb.Validator{}
+	}
+	return &ethpb.Validator{
+		Pubkey:                     v1Alpha1Validator.PublicKey,
+		WithdrawalCredentials:      v1Alpha1Validator.WithdrawalCredentials,
+		EffectiveBalance:           v1Alpha1Validator.EffectiveBalance,
+		Slashed:                    v1Alpha1Validator.Slashed,
+		ActivationEligibilityEpoch: v1Alpha1Validator.ActivationEligibilityEpoch,
+		ActivationEpoch:            v1Alpha1Validator.ActivationEpoch,
+		ExitEpoch:                  v1Alpha1Validator.ExitEpoch,
+		WithdrawableEpoch:          v1Alpha1Validator.WithdrawableEpoch,
+	}
+}
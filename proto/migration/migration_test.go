@@ -266,3 +266,26 @@ func Test_V1AttToV1Alpha1(t *testing.T) {
 	require.NoError(t, err)
 	assert.DeepEqual(t, v1Root, alphaRoot)
 }
+
+func Test_V1Alpha1ValidatorToV1(t *testing.T) {
+	alphaValidator := &ethpb_alpha.Validator{
+		PublicKey:                  bytesutil.PadTo([]byte("publickey"), 48),
+		WithdrawalCredentials:      bytesutil.PadTo([]byte("withdrawalcredentials"), 32),
+		EffectiveBalance:           32000000000,
+		Slashed:                    true,
+		ActivationEligibilityEpoch: 1,
+		ActivationEpoch:            2,
+		ExitEpoch:                  3,
+		WithdrawableEpoch:          4,
+	}
+
+	v1Validator := V1Alpha1ValidatorToV1(alphaValidator)
+	assert.DeepEqual(t, alphaValidator.PublicKey, v1Validator.Pubkey)
+	assert.DeepEqual(t, alphaValidator.WithdrawalCredentials, v1Validator.WithdrawalCredentials)
+	assert.Equal(t, alphaValidator.EffectiveBalance, v1Validator.EffectiveBalance)
+	assert.Equal(t, alphaValidator.Slashed, v1Validator.Slashed)
+	assert.Equal(t, alphaValidator.ActivationEligibilityEpoch, v1Validator.ActivationEligibilityEpoch)
+	assert.Equal(t, alphaValidator.ActivationEpoch, v1Validator.ActivationEpoch)
+	assert.Equal(t, alphaValidator.ExitEpoch, v1Validator.ExitEpoch)
+	assert.Equal(t, alphaValidator.WithdrawableEpoch, v1Validator.WithdrawableEpoch)
+}
@@ -1444,6 +1444,321 @@ func (m *BackupAccountsResponse) GetZipFile() []byte {
 	return nil
 }
 
+type MaintenanceStatusResponse struct {
+	Quiesced             bool     `protobuf:"varint,1,opt,name=quiesced,proto3" json:"quiesced,omitempty"`
+	InFlightDuties       uint64   `protobuf:"varint,2,opt,name=in_flight_duties,json=inFlightDuties,proto3" json:"in_flight_duties,omitempty"`
+	SafeToShutdown       bool     `protobuf:"varint,3,opt,name=safe_to_shutdown,json=safeToShutdown,proto3" json:"safe_to_shutdown,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaintenanceStatusResponse) Reset()         { *m = MaintenanceStatusResponse{} }
+func (m *MaintenanceStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceStatusResponse) ProtoMessage()    {}
+func (*MaintenanceStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{23}
+}
+func (m *MaintenanceStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaintenanceStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceStatusResponse.Merge(m, src)
+}
+func (m *MaintenanceStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceStatusResponse proto.InternalMessageInfo
+
+func (m *MaintenanceStatusResponse) GetQuiesced() bool {
+	if m != nil {
+		return m.Quiesced
+	}
+	return false
+}
+
+func (m *MaintenanceStatusResponse) GetInFlightDuties() uint64 {
+	if m != nil {
+		return m.InFlightDuties
+	}
+	return 0
+}
+
+func (m *MaintenanceStatusResponse) GetSafeToShutdown() bool {
+	if m != nil {
+		return m.SafeToShutdown
+	}
+	return false
+}
+
+type SwitchBeaconNodeRequest struct {
+	Endpoint             string   `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SwitchBeaconNodeRequest) Reset()         { *m = SwitchBeaconNodeRequest{} }
+func (m *SwitchBeaconNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*SwitchBeaconNodeRequest) ProtoMessage()    {}
+func (*SwitchBeaconNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{24}
+}
+func (m *SwitchBeaconNodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwitchBeaconNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwitchBeaconNodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwitchBeaconNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwitchBeaconNodeRequest.Merge(m, src)
+}
+func (m *SwitchBeaconNodeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwitchBeaconNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwitchBeaconNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwitchBeaconNodeRequest proto.InternalMessageInfo
+
+func (m *SwitchBeaconNodeRequest) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+type SwitchBeaconNodeResponse struct {
+	PreviousEndpoint     string   `protobuf:"bytes,1,opt,name=previous_endpoint,json=previousEndpoint,proto3" json:"previous_endpoint,omitempty"`
+	Endpoint             string   `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SwitchBeaconNodeResponse) Reset()         { *m = SwitchBeaconNodeResponse{} }
+func (m *SwitchBeaconNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*SwitchBeaconNodeResponse) ProtoMessage()    {}
+func (*SwitchBeaconNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{25}
+}
+func (m *SwitchBeaconNodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwitchBeaconNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwitchBeaconNodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwitchBeaconNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwitchBeaconNodeResponse.Merge(m, src)
+}
+func (m *SwitchBeaconNodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwitchBeaconNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwitchBeaconNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwitchBeaconNodeResponse proto.InternalMessageInfo
+
+func (m *SwitchBeaconNodeResponse) GetPreviousEndpoint() string {
+	if m != nil {
+		return m.PreviousEndpoint
+	}
+	return ""
+}
+
+func (m *SwitchBeaconNodeResponse) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+type NextEpochDutiesResponse struct {
+	Epoch                uint64           `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Duties               []*NextEpochDuty `protobuf:"bytes,2,rep,name=duties,proto3" json:"duties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *NextEpochDutiesResponse) Reset()         { *m = NextEpochDutiesResponse{} }
+func (m *NextEpochDutiesResponse) String() string { return proto.CompactTextString(m) }
+func (*NextEpochDutiesResponse) ProtoMessage()    {}
+func (*NextEpochDutiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{26}
+}
+func (m *NextEpochDutiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NextEpochDutiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NextEpochDutiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NextEpochDutiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextEpochDutiesResponse.Merge(m, src)
+}
+func (m *NextEpochDutiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NextEpochDutiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextEpochDutiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextEpochDutiesResponse proto.InternalMessageInfo
+
+func (m *NextEpochDutiesResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *NextEpochDutiesResponse) GetDuties() []*NextEpochDuty {
+	if m != nil {
+		return m.Duties
+	}
+	return nil
+}
+
+type NextEpochDuty struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	ValidatorIndex       uint64   `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	ProposerSlots        []uint64 `protobuf:"varint,3,rep,packed,name=proposer_slots,json=proposerSlots,proto3" json:"proposer_slots,omitempty"`
+	AttesterSlot         uint64   `protobuf:"varint,4,opt,name=attester_slot,json=attesterSlot,proto3" json:"attester_slot,omitempty"`
+	CommitteeIndex       uint64   `protobuf:"varint,5,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	IsAggregator         bool     `protobuf:"varint,6,opt,name=is_aggregator,json=isAggregator,proto3" json:"is_aggregator,omitempty"`
+	AggregatorKnown      bool     `protobuf:"varint,7,opt,name=aggregator_known,json=aggregatorKnown,proto3" json:"aggregator_known,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NextEpochDuty) Reset()         { *m = NextEpochDuty{} }
+func (m *NextEpochDuty) String() string { return proto.CompactTextString(m) }
+func (*NextEpochDuty) ProtoMessage()    {}
+func (*NextEpochDuty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{27}
+}
+func (m *NextEpochDuty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NextEpochDuty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NextEpochDuty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NextEpochDuty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextEpochDuty.Merge(m, src)
+}
+func (m *NextEpochDuty) XXX_Size() int {
+	return m.Size()
+}
+func (m *NextEpochDuty) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextEpochDuty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextEpochDuty proto.InternalMessageInfo
+
+func (m *NextEpochDuty) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *NextEpochDuty) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *NextEpochDuty) GetProposerSlots() []uint64 {
+	if m != nil {
+		return m.ProposerSlots
+	}
+	return nil
+}
+
+func (m *NextEpochDuty) GetAttesterSlot() uint64 {
+	if m != nil {
+		return m.AttesterSlot
+	}
+	return 0
+}
+
+func (m *NextEpochDuty) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *NextEpochDuty) GetIsAggregator() bool {
+	if m != nil {
+		return m.IsAggregator
+	}
+	return false
+}
+
+func (m *NextEpochDuty) GetAggregatorKnown() bool {
+	if m != nil {
+		return m.AggregatorKnown
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("ethereum.validator.accounts.v2.KeymanagerKind", KeymanagerKind_name, KeymanagerKind_value)
 	proto.RegisterType((*CreateWalletRequest)(nil), "ethereum.validator.accounts.v2.CreateWalletRequest")
@@ -1469,6 +1784,11 @@ func init() {
 	proto.RegisterType((*BeaconStatusResponse)(nil), "ethereum.validator.accounts.v2.BeaconStatusResponse")
 	proto.RegisterType((*BackupAccountsRequest)(nil), "ethereum.validator.accounts.v2.BackupAccountsRequest")
 	proto.RegisterType((*BackupAccountsResponse)(nil), "ethereum.validator.accounts.v2.BackupAccountsResponse")
+	proto.RegisterType((*MaintenanceStatusResponse)(nil), "ethereum.validator.accounts.v2.MaintenanceStatusResponse")
+	proto.RegisterType((*SwitchBeaconNodeRequest)(nil), "ethereum.validator.accounts.v2.SwitchBeaconNodeRequest")
+	proto.RegisterType((*SwitchBeaconNodeResponse)(nil), "ethereum.validator.accounts.v2.SwitchBeaconNodeResponse")
+	proto.RegisterType((*NextEpochDutiesResponse)(nil), "ethereum.validator.accounts.v2.NextEpochDutiesResponse")
+	proto.RegisterType((*NextEpochDuty)(nil), "ethereum.validator.accounts.v2.NextEpochDuty")
 }
 
 func init() {
@@ -1476,140 +1796,163 @@ func init() {
 }
 
 var fileDescriptor_8a5153635bfe042e = []byte{
-	// 2114 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0x1c, 0x49,
-	0xf5, 0xff, 0xb6, 0xed, 0x4c, 0xc6, 0x6f, 0x26, 0xb6, 0x53, 0x76, 0x9c, 0xc9, 0xc4, 0xb1, 0x9d,
-	0xca, 0x0f, 0x3b, 0x4e, 0xbe, 0x33, 0xc9, 0x84, 0x4d, 0xa2, 0x5c, 0x50, 0x3c, 0x99, 0x4d, 0x22,
-	0xe7, 0x87, 0xe9, 0x64, 0x13, 0x71, 0xd9, 0x56, 0xb9, 0xbb, 0x32, 0x53, 0xf2, 0x4c, 0x77, 0x6f,
-	0x77, 0xcd, 0x24, 0x0e, 0x17, 0x58, 0x56, 0x42, 0x20, 0x71, 0x80, 0x3d, 0x20, 0x24, 0x84, 0x04,
-	0x37, 0x24, 0x2e, 0x48, 0x48, 0xfb, 0x2f, 0xc0, 0x0d, 0x89, 0x2b, 0x12, 0x28, 0xe2, 0x02, 0xfc,
-	0x13, 0xa8, 0xaa, 0xab, 0xfa, 0xc7, 0x64, 0x7a, 0xdb, 0x16, 0xe2, 0xc2, 0xad, 0xeb, 0xfd, 0xfc,
-	0xd4, 0xab, 0x57, 0xaf, 0xde, 0x6b, 0xb8, 0xe2, 0x07, 0x1e, 0xf7, 0x9a, 0x23, 0xd2, 0x67, 0x0e,
-	0xe1, 0x5e, 0xd0, 0x24, 0xb6, 0xed, 0x0d, 0x5d, 0x1e, 0x36, 0x47, 0xad, 0xe6, 0x1b, 0xba, 0x67,
-	0x11, 0x9f, 0x35, 0xa4, 0x0c, 0x5a, 0xa5, 0xbc, 0x47, 0x03, 0x3a, 0x1c, 0x34, 0x62, 0xe9, 0x86,
-	0x96, 0x6e, 0x8c, 0x5a, 0xf5, 0xf5, 0xc8, 0xd4, 0x1e, 0x25, 0xb6, 0xe7, 0x36, 0x03, 0xdf, 0x6e,
-	0x8e, 0x6e, 0x34, 0x7b, 0x94, 0xf4, 0x79, 0x2f, 0xb2, 0x50, 0x5f, 0xa3, 0xbc, 0xd7, 0x1c, 0xdd,
-	0x20, 0x7d, 0xbf, 0x47, 0x6e, 0x28, 0x41, 0xcb, 0xee, 0x11, 0xe6, 0x2a, 0x81, 0xd3, 0x19, 0x01,
-	0xd7, 0x73, 0xa8, 0x62, 0xac, 0x74, 0x3d, 0xaf, 0xdb, 0xa7, 0x4d, 0xe2, 0xb3, 0x26, 0x71, 0x5d,
-	0x8f, 0x13, 0xce, 0x3c, 0x37, 0x54, 0xdc, 0xb3, 0x8a, 0x2b, 0x57, 0x7b, 0xc3, 0xd7, 0x4d, 0x3a,
-	0xf0, 0xf9, 0x41, 0xc4, 0xc4, 0xff, 0x9a, 0x82, 0xc5, 0x76, 0x40, 0x09, 0xa7, 0xaf, 0x48, 0xbf,
-	0x4f, 0xb9, 0x49, 0x3f, 0x1b, 0xd2, 0x90, 0xa3, 0xa7, 0x00, 0xfb, 0xf4, 0x60, 0x40, 0x5c, 0xd2,
-	0xa5, 0x41, 0xcd, 0x58, 0x37, 0x36, 0xe7, 0x5a, 0x8d, 0xc6, 0xd7, 0xef, 0xb1, 0xb1, 0x13, 0x6b,
-	0xec, 0x30, 0xd7, 0x31, 0x53, 0x16, 0xd0, 0x06, 0xcc, 0xbf, 0x91, 0x0e, 0x2c, 0x9f, 0x84, 0xe1,
-	0x1b, 0x2f, 0x70, 0x6a, 0x53, 0xeb, 0xc6, 0xe6, 0xac, 0x39, 0x17, 0x91, 0x77, 0x15, 0x15, 0xd5,
-	0xa1, 0x3c, 0x70, 0xe9, 0xc0, 0x73, 0x99, 0x5d, 0x9b, 0x96, 0x12, 0xf1, 0x1a, 0x9d, 0x87, 0xaa,
-	0x3b, 0x1c, 0x58, 0xda, 0x65, 0x6d, 0x66, 0xdd, 0xd8, 0x9c, 0x31, 0x2b, 0xee, 0x70, 0x70, 0x4f,
-	0x91, 0xd0, 0x1a, 0x54, 0x02, 0x3a, 0xf0, 0x38, 0xb5, 0x88, 0xe3, 0x04, 0xb5, 0x63, 0xd2, 0x02,
-	0x44, 0xa4, 0x7b, 0x8e, 0x13, 0xa0, 0xcb, 0x30, 0xaf, 0x04, 0xec, 0x40, 0x80, 0xe1, 0xbd, 0x5a,
-	0x49, 0x0a, 0x9d, 0x88, 0xc8, 0xed, 0x80, 0xef, 0x12, 0xde, 0x4b, 0xc9, 0xed, 0xd3, 0x83, 0x48,
-	0xee, 0x78, 0x5a, 0x6e, 0x87, 0x1e, 0x48, 0xb9, 0xab, 0x80, 0xb4, 0x3d, 0x92, 0x98, 0x2c, 0x4b,
-	0x51, 0x65, 0xa1, 0x4d, 0x94, 0x51, 0xfc, 0x29, 0x2c, 0x65, 0x83, 0x1d, 0xfa, 0x9e, 0x1b, 0x52,
-	0xf4, 0x31, 0x94, 0xa2, 0x30, 0xc8, 0x48, 0x57, 0x8a, 0x23, 0x9d, 0xd5, 0x37, 0x95, 0x36, 0xfe,
-	0xca, 0x80, 0xd3, 0x1d, 0x87, 0xf1, 0x88, 0xdd, 0xf6, 0xdc, 0xd7, 0xac, 0xab, 0x4f, 0x74, 0x2c,
-	0x32, 0xc6, 0x61, 0x22, 0x33, 0x75, 0xc8, 0xc8, 0x4c, 0x1f, 0x3e, 0x32, 0x33, 0x93, 0x23, 0x73,
-	0x0b, 0x6a, 0x0f, 0xa8, 0x4b, 0x03, 0xc2, 0xe9, 0x13, 0x75, 0xdc, 0x71, 0x74, 0xd2, 0x29, 0x61,
-	0x64, 0x53, 0x02, 0xff, 0xc8, 0x80, 0xb9, 0xb1, 0x60, 0xae, 0x41, 0x25, 0x4e, 0x35, 0xde, 0xd3,
-	0x1b, 0xd5, 0x69, 0xc6, 0x7b, 0xe8, 0x15, 0xcc, 0x27, 0x99, 0x69, 0xed, 0x33, 0x37, 0xca, 0xc5,
-	0xa3, 0x27, 0xf8, 0xdc, 0x7e, 0x66, 0x8d, 0x7f, 0x6a, 0xc0, 0xe2, 0x63, 0x16, 0x72, 0x9d, 0x8d,
-	0x3a, 0xf4, 0xff, 0x0f, 0x8b, 0x5d, 0xca, 0x2d, 0x87, 0xfa, 0x5e, 0xc8, 0xb8, 0xc5, 0xdf, 0x5a,
-	0x0e, 0xe1, 0x44, 0x22, 0x2b, 0x9b, 0x0b, 0x5d, 0xca, 0xef, 0x47, 0x9c, 0x17, 0x6f, 0xef, 0x13,
-	0x4e, 0xd0, 0x59, 0x98, 0xf5, 0x49, 0x97, 0x5a, 0x21, 0x7b, 0x47, 0x25, 0xb2, 0x63, 0x66, 0x59,
-	0x10, 0x9e, 0xb3, 0x77, 0x14, 0x9d, 0x03, 0x90, 0x4c, 0xee, 0xed, 0x53, 0x57, 0x05, 0x5e, 0x8a,
-	0xbf, 0x10, 0x04, 0xb4, 0x00, 0xd3, 0xa4, 0xdf, 0x97, 0x51, 0x2e, 0x9b, 0xe2, 0x13, 0xff, 0xda,
-	0x80, 0xa5, 0x2c, 0x28, 0x15, 0xa7, 0x36, 0x94, 0xe3, 0x9b, 0x64, 0xac, 0x4f, 0x6f, 0x56, 0x5a,
-	0x1b, 0x45, 0xfb, 0x57, 0x36, 0xcc, 0x58, 0x51, 0x24, 0x83, 0x4b, 0xdf, 0x8a, 0x50, 0xc7, 0x98,
-	0x54, 0xd2, 0x08, 0xf2, 0x6e, 0x8c, 0xeb, 0x1c, 0x00, 0xf7, 0x38, 0xe9, 0x47, 0x9b, 0x9a, 0x96,
-	0x9b, 0x9a, 0x95, 0x14, 0xb1, 0x2b, 0xfc, 0x3b, 0x03, 0x8e, 0x2b, 0xe3, 0xa8, 0x05, 0xa7, 0x94,
-	0x77, 0xe6, 0x76, 0x2d, 0x7f, 0xb8, 0xd7, 0x67, 0xb6, 0x48, 0x35, 0x19, 0xaf, 0xaa, 0xb9, 0x98,
-	0x30, 0x77, 0x25, 0x6f, 0x87, 0x1e, 0x88, 0xca, 0xa0, 0x20, 0x59, 0x2e, 0x19, 0x50, 0x85, 0xa1,
-	0xa2, 0x68, 0x4f, 0xc9, 0x80, 0x0a, 0xa4, 0xe3, 0x07, 0x30, 0x2d, 0x0d, 0x9e, 0x70, 0x32, 0xd1,
-	0xdf, 0x10, 0x72, 0x01, 0x1b, 0xc9, 0x1a, 0x9a, 0xce, 0xd9, 0xb9, 0x84, 0x2c, 0x53, 0x76, 0x07,
-	0xe6, 0x74, 0x3c, 0x92, 0x2b, 0x96, 0xc0, 0x8d, 0x82, 0x5a, 0x35, 0xc1, 0xd7, 0x28, 0x43, 0x54,
-	0x83, 0xe3, 0xcc, 0x75, 0x98, 0x4d, 0xc3, 0xda, 0xd4, 0xfa, 0xf4, 0xe6, 0x8c, 0xa9, 0x97, 0xf8,
-	0x53, 0xa8, 0xdc, 0x1b, 0xf2, 0x9e, 0xb6, 0x54, 0x87, 0x72, 0x5c, 0x27, 0x55, 0xca, 0xeb, 0x35,
-	0xba, 0x09, 0xa7, 0xf4, 0xb7, 0x65, 0x8b, 0x2b, 0x1e, 0x0c, 0x24, 0x28, 0xb5, 0xe9, 0x25, 0xcd,
-	0x6c, 0xa7, 0x78, 0xf8, 0x19, 0x54, 0x23, 0xfb, 0xea, 0xf0, 0x97, 0xe0, 0x58, 0x74, 0x5a, 0x91,
-	0xf5, 0x68, 0x81, 0xae, 0xc0, 0x82, 0xfc, 0xb0, 0xe8, 0x5b, 0x9f, 0x05, 0x89, 0xd5, 0x19, 0x73,
-	0x5e, 0xd2, 0x3b, 0x31, 0x19, 0xff, 0xd5, 0x80, 0xe5, 0xa7, 0x9e, 0x43, 0xdb, 0x9e, 0xeb, 0x52,
-	0x5b, 0x90, 0x62, 0xdb, 0xd7, 0x61, 0x49, 0xbd, 0x5e, 0xe2, 0x8d, 0xb2, 0xa8, 0xeb, 0xf8, 0x1e,
-	0x73, 0xb9, 0x72, 0x85, 0x22, 0x9e, 0xd0, 0xed, 0x28, 0x0e, 0x5a, 0x81, 0x59, 0x3b, 0xb2, 0x43,
-	0xa3, 0xbb, 0x58, 0x36, 0x13, 0x82, 0x88, 0x5a, 0x78, 0xe0, 0xda, 0xcc, 0xed, 0xca, 0x13, 0x2b,
-	0x9b, 0x7a, 0x29, 0x8e, 0xbd, 0x4b, 0x5d, 0x1a, 0xb2, 0xd0, 0xe2, 0x6c, 0x40, 0xf5, 0x83, 0xa0,
-	0x68, 0x2f, 0xd8, 0x80, 0xa2, 0x3b, 0x50, 0xd3, 0xc7, 0x6e, 0x7b, 0x2e, 0x0f, 0x88, 0xcd, 0x65,
-	0x01, 0xa4, 0x61, 0x28, 0x5f, 0x87, 0xaa, 0xb9, 0xac, 0xf8, 0x6d, 0xc5, 0xbe, 0x17, 0x71, 0xf1,
-	0x77, 0xc5, 0xc5, 0xf1, 0xba, 0xa1, 0x46, 0x19, 0xef, 0xef, 0x16, 0x9c, 0x8e, 0xaf, 0x87, 0xd5,
-	0xf7, 0xba, 0xe1, 0xf8, 0x16, 0x4f, 0xc5, 0xec, 0xb4, 0x7e, 0x2a, 0x2e, 0x59, 0xa5, 0xa9, 0x74,
-	0x5c, 0xd2, 0x1a, 0xf8, 0x01, 0xcc, 0xbf, 0xa4, 0x41, 0x98, 0x0e, 0xee, 0x32, 0x94, 0x22, 0x41,
-	0xe5, 0x4b, 0xad, 0x44, 0x08, 0x63, 0xaf, 0xca, 0x62, 0x42, 0xc0, 0x5f, 0x1a, 0x70, 0xaa, 0xdd,
-	0x23, 0x6e, 0x97, 0xea, 0x87, 0x56, 0x67, 0xda, 0x15, 0x58, 0xb0, 0x87, 0x41, 0x40, 0xdd, 0xd4,
-	0xcb, 0x1c, 0x59, 0x9e, 0x57, 0xf4, 0xf4, 0xd3, 0x3c, 0xf6, 0x78, 0x1f, 0x22, 0x29, 0xa7, 0xbf,
-	0x26, 0x29, 0xef, 0xc0, 0xc9, 0x87, 0x24, 0x1c, 0x2b, 0xdf, 0x17, 0xe0, 0x84, 0x2a, 0xdf, 0xf4,
-	0x2d, 0x0b, 0x65, 0x6d, 0x12, 0x67, 0x5e, 0x8d, 0x88, 0x1d, 0x49, 0xc3, 0x23, 0x58, 0x7e, 0x34,
-	0xf0, 0xbd, 0x80, 0x8b, 0x6b, 0xc5, 0xbd, 0x80, 0xa6, 0x6a, 0x2d, 0xda, 0xd7, 0x34, 0x8b, 0x49,
-	0x19, 0xea, 0xc8, 0xab, 0x38, 0x6b, 0x9e, 0x8c, 0x39, 0x8f, 0x14, 0x23, 0x2b, 0x3e, 0xb6, 0xbb,
-	0x44, 0x5c, 0x87, 0x00, 0xef, 0xc0, 0xe9, 0x0f, 0xfc, 0x26, 0x59, 0xaf, 0xdd, 0x59, 0x1f, 0x56,
-	0x01, 0xa4, 0x79, 0x71, 0xcd, 0x0a, 0xf1, 0x2b, 0x40, 0x0f, 0x49, 0xf8, 0x49, 0x48, 0x9d, 0x57,
-	0x74, 0x2f, 0xb6, 0x83, 0xe1, 0x44, 0x8f, 0x84, 0x56, 0xc8, 0xba, 0x2e, 0x75, 0xac, 0xa1, 0xaf,
-	0xf6, 0x5f, 0xe9, 0x91, 0xf0, 0xb9, 0xa4, 0x7d, 0xe2, 0x8b, 0x6a, 0x2a, 0x64, 0x54, 0xcf, 0xa0,
-	0x2e, 0x4c, 0x4f, 0x87, 0x12, 0x63, 0xa8, 0x8a, 0x34, 0x8a, 0x4d, 0x22, 0x98, 0x11, 0x19, 0xa7,
-	0xa2, 0x20, 0xbf, 0xf1, 0x2f, 0xa7, 0x60, 0x69, 0x5b, 0xa6, 0xce, 0x73, 0x4e, 0xf8, 0x30, 0xfc,
-	0x1f, 0xbb, 0xbd, 0xe8, 0x9b, 0x00, 0xb2, 0x77, 0xb6, 0x7a, 0x94, 0x38, 0xb2, 0xc5, 0xab, 0xb4,
-	0xd6, 0x93, 0xf7, 0x8d, 0xf2, 0x5e, 0x43, 0xb7, 0xd2, 0x8d, 0xb6, 0x10, 0x7c, 0x48, 0x89, 0x63,
-	0xce, 0xda, 0xfa, 0x13, 0x13, 0x38, 0xb5, 0x4d, 0xec, 0xfd, 0xa1, 0x3f, 0xfe, 0x9a, 0x17, 0x56,
-	0xf9, 0x0d, 0x98, 0xdf, 0x93, 0x9a, 0x1f, 0xf4, 0xba, 0x11, 0x39, 0xce, 0xa6, 0x9b, 0xb0, 0x3c,
-	0xee, 0x42, 0x1d, 0xc2, 0x19, 0x28, 0xbf, 0x63, 0xbe, 0xf5, 0x9a, 0xf5, 0xa9, 0x7a, 0xf6, 0x8e,
-	0xbf, 0x63, 0xfe, 0xc7, 0xac, 0x4f, 0xb7, 0x6e, 0xc3, 0x5c, 0xb6, 0x0d, 0x41, 0x15, 0x38, 0x7e,
-	0xbf, 0x63, 0x3e, 0x7a, 0xd9, 0xb9, 0xbf, 0xf0, 0x7f, 0xa8, 0x0a, 0xe5, 0x47, 0x4f, 0x76, 0x9f,
-	0x99, 0x2f, 0x3a, 0xf7, 0x17, 0x0c, 0x04, 0x50, 0x32, 0x3b, 0x4f, 0x9e, 0xbd, 0xe8, 0x2c, 0x4c,
-	0xb5, 0xfe, 0x31, 0x03, 0xa5, 0x28, 0x41, 0xd0, 0xaf, 0x0c, 0xa8, 0xa6, 0x1b, 0x51, 0x74, 0xb3,
-	0xe8, 0xe5, 0x9f, 0x30, 0x23, 0xd4, 0xbf, 0x71, 0x34, 0xa5, 0x68, 0x6b, 0xf8, 0xf2, 0xe7, 0x7f,
-	0xfe, 0xfb, 0x97, 0x53, 0xeb, 0xf8, 0xac, 0x98, 0xa1, 0x92, 0xc9, 0x2a, 0xca, 0xe5, 0xa6, 0x2d,
-	0x55, 0xee, 0x1a, 0x5b, 0x88, 0x43, 0x35, 0xdd, 0xc6, 0xa2, 0xe5, 0x46, 0x34, 0xc7, 0x34, 0xf4,
-	0x1c, 0xd3, 0xe8, 0x88, 0x39, 0xa6, 0x7e, 0xc4, 0x5e, 0x19, 0xaf, 0x48, 0xff, 0xcb, 0x68, 0x69,
-	0x92, 0x7f, 0xf4, 0x63, 0x03, 0x16, 0xc6, 0x1b, 0xd1, 0x5c, 0xd7, 0x77, 0x8a, 0x5c, 0xe7, 0xb5,
-	0xb4, 0x78, 0x43, 0x82, 0x38, 0x8f, 0xd6, 0xb2, 0x20, 0x74, 0x5b, 0xdb, 0xec, 0x2a, 0x45, 0xf4,
-	0x7b, 0x03, 0xe6, 0xc7, 0x2a, 0x0e, 0xba, 0x55, 0xe4, 0x76, 0x72, 0x69, 0xac, 0xdf, 0x3e, 0xb2,
-	0x9e, 0x42, 0x7b, 0x5d, 0xa2, 0xdd, 0xc2, 0x97, 0x26, 0x1e, 0x59, 0x5c, 0x25, 0x9b, 0x51, 0x8d,
-	0xbb, 0x6b, 0x6c, 0xb5, 0xfe, 0x32, 0x0d, 0xe5, 0x78, 0x26, 0xfb, 0xb9, 0x01, 0xd5, 0x74, 0x07,
-	0x5a, 0x9c, 0x6d, 0x13, 0x9a, 0xe8, 0xe2, 0x6c, 0x9b, 0xd4, 0xe4, 0xe2, 0x55, 0x09, 0xbd, 0x86,
-	0x96, 0xb3, 0xd0, 0xe3, 0xfe, 0xf5, 0x37, 0x06, 0xcc, 0x65, 0xef, 0x20, 0xfa, 0xa8, 0xc8, 0xd1,
-	0xc4, 0xb2, 0x50, 0xbf, 0x75, 0x54, 0x35, 0x85, 0x70, 0x53, 0x22, 0xc4, 0xf8, 0xdc, 0x64, 0x84,
-	0xcd, 0xa8, 0x66, 0x88, 0x1b, 0xf1, 0x03, 0x03, 0xe6, 0xb2, 0x8f, 0x78, 0x31, 0xd6, 0x89, 0x8f,
-	0x7e, 0x3d, 0x27, 0xa1, 0xf3, 0xee, 0xa6, 0x2e, 0x63, 0x4d, 0xea, 0x30, 0x79, 0xbc, 0xdf, 0x2b,
-	0x43, 0x29, 0x7a, 0x3c, 0xd0, 0x17, 0x06, 0xcc, 0x3f, 0xa0, 0x3c, 0xfd, 0x94, 0xe4, 0xde, 0x97,
-	0xc2, 0x23, 0x9c, 0xf4, 0x20, 0xe1, 0x0b, 0x12, 0xd4, 0x39, 0x34, 0x06, 0x4a, 0xfd, 0x49, 0x09,
-	0x23, 0x97, 0x5f, 0x19, 0x70, 0xe6, 0x01, 0xe5, 0x2f, 0x35, 0x7b, 0x97, 0x04, 0x9c, 0xd9, 0xcc,
-	0x97, 0x8d, 0x06, 0xba, 0x9d, 0x53, 0xf8, 0x73, 0x35, 0x74, 0xa0, 0x3e, 0xca, 0x51, 0xcc, 0xd3,
-	0x52, 0x90, 0xb7, 0x24, 0xe4, 0x8b, 0x08, 0x4f, 0x84, 0xec, 0x67, 0xb0, 0xfd, 0xd6, 0x80, 0xd3,
-	0x19, 0x1c, 0x34, 0x78, 0xed, 0x05, 0x03, 0xe2, 0xda, 0x14, 0xb5, 0x0a, 0xdd, 0x27, 0xc2, 0x1a,
-	0xf2, 0xcd, 0x23, 0xe9, 0x64, 0x93, 0x10, 0xad, 0x4f, 0x06, 0x9c, 0x82, 0xf4, 0x43, 0x03, 0x4e,
-	0xa4, 0xe1, 0x86, 0xe8, 0x5a, 0x8e, 0x43, 0x71, 0x1f, 0x13, 0x31, 0x0d, 0xef, 0x7c, 0x11, 0xbc,
-	0x30, 0xaf, 0x38, 0x2a, 0x30, 0xa3, 0xc4, 0xf3, 0x2f, 0x0c, 0x58, 0x4a, 0x63, 0xd9, 0x26, 0x7d,
-	0x81, 0x31, 0x53, 0x60, 0xf2, 0x21, 0x69, 0x69, 0x8d, 0x6c, 0xb3, 0x08, 0x99, 0x56, 0xc0, 0x97,
-	0x24, 0xc0, 0x35, 0x74, 0x6e, 0x22, 0xc0, 0x3d, 0x8d, 0x62, 0x04, 0x27, 0xd3, 0xe8, 0xbe, 0x35,
-	0xa4, 0x43, 0x9a, 0x7b, 0x37, 0x2e, 0x15, 0x79, 0x97, 0xea, 0x18, 0x4b, 0xd7, 0x2b, 0xa8, 0x3e,
-	0xd1, 0xf5, 0x67, 0xd2, 0x85, 0x03, 0xe5, 0x07, 0x94, 0xef, 0x52, 0x1a, 0xe4, 0x5f, 0xc5, 0x95,
-	0x1c, 0x77, 0x52, 0xab, 0xc0, 0x8b, 0x2f, 0x64, 0x5a, 0x7f, 0x3c, 0x06, 0xa5, 0x87, 0xf2, 0xff,
-	0x25, 0xfa, 0x59, 0x94, 0xc2, 0xdb, 0x71, 0x6b, 0x98, 0x0c, 0x85, 0xb9, 0x00, 0x0a, 0xcb, 0xe5,
-	0xe4, 0xe1, 0x12, 0x5f, 0x93, 0xd0, 0x2e, 0xa3, 0x8b, 0x59, 0x68, 0xd1, 0x9f, 0x54, 0xf9, 0x53,
-	0xd4, 0xb2, 0x13, 0xef, 0xd1, 0x73, 0xce, 0xd3, 0x43, 0xd5, 0x7f, 0x50, 0x9e, 0x26, 0x4d, 0x83,
-	0xf8, 0xaa, 0x04, 0x74, 0x09, 0x5d, 0x98, 0x08, 0x48, 0xf4, 0xda, 0x4d, 0x1a, 0xbb, 0xfe, 0x0e,
-	0x80, 0x48, 0x89, 0x68, 0xa6, 0xcb, 0x05, 0xd2, 0x2c, 0x02, 0x32, 0x36, 0x14, 0xe2, 0x8b, 0x12,
-	0xc3, 0x2a, 0x5a, 0x99, 0x88, 0x61, 0xa4, 0xdc, 0x7d, 0xdf, 0x80, 0x85, 0xe7, 0x3c, 0xa0, 0x64,
-	0xb0, 0x1d, 0x8f, 0x9a, 0xb9, 0x18, 0x2e, 0x26, 0x18, 0xa2, 0x63, 0x6f, 0x04, 0xbe, 0xdd, 0x18,
-	0xdd, 0x68, 0xa4, 0x27, 0x0b, 0xdc, 0x94, 0x8e, 0xaf, 0xa0, 0x8d, 0xfc, 0xcd, 0xc7, 0x75, 0x5a,
-	0x38, 0xbe, 0x6e, 0xa0, 0x9f, 0x18, 0xb0, 0x18, 0xa1, 0x78, 0x99, 0x9e, 0x92, 0x73, 0x81, 0x5c,
-	0x3b, 0xcc, 0xa9, 0xc4, 0x80, 0x5a, 0x12, 0xd0, 0x35, 0xb4, 0x95, 0x0f, 0x28, 0xa1, 0x6a, 0x4c,
-	0xad, 0x7f, 0x4e, 0xc3, 0xcc, 0xbd, 0x21, 0xef, 0x89, 0xf3, 0x49, 0x46, 0xb2, 0x5c, 0x48, 0xad,
-	0x22, 0x48, 0x1f, 0x8e, 0x75, 0xf8, 0xbc, 0x04, 0x76, 0x16, 0x9d, 0xc9, 0x02, 0x63, 0x2e, 0xe3,
-	0x8c, 0xf4, 0xd9, 0x3b, 0xea, 0xa0, 0xcf, 0x0d, 0x38, 0xf6, 0xd8, 0xeb, 0x32, 0x17, 0x5d, 0x2d,
-	0xfc, 0x11, 0x97, 0xfc, 0x2b, 0x2a, 0x0e, 0x50, 0xfa, 0xc7, 0x8f, 0x6e, 0x88, 0xf0, 0x62, 0x16,
-	0x47, 0x5f, 0xf8, 0x15, 0x4d, 0xc6, 0x17, 0x06, 0x94, 0xc4, 0x9c, 0x39, 0xf4, 0xff, 0x9b, 0x28,
-	0xd6, 0x24, 0x8a, 0x33, 0x78, 0xac, 0x09, 0x0f, 0xa5, 0x63, 0x01, 0xe3, 0xdb, 0x50, 0x7a, 0xec,
-	0x75, 0xbd, 0x21, 0xcf, 0x3d, 0x84, 0xbc, 0x1e, 0x26, 0xc7, 0x74, 0x5f, 0x5a, 0xbb, 0x6b, 0x6c,
-	0x6d, 0x57, 0xff, 0xf0, 0x7e, 0xd5, 0xf8, 0xd3, 0xfb, 0x55, 0xe3, 0x6f, 0xef, 0x57, 0x8d, 0xbd,
-	0x92, 0x54, 0xbf, 0xf9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1c, 0xac, 0xc3, 0xc9, 0xeb, 0x19,
-	0x00, 0x00,
+	// 2490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdd, 0x59, 0xcd, 0x6f, 0x1c, 0x4b,
+	0x11, 0x67, 0xbc, 0xf6, 0x7a, 0x5d, 0x5e, 0x7f, 0xa4, 0xed, 0xd8, 0x1b, 0xc7, 0x49, 0x9c, 0xce,
+	0xf7, 0xc7, 0xdb, 0xcd, 0xdb, 0x90, 0x0f, 0xb8, 0xa0, 0xd8, 0xde, 0x7c, 0x28, 0x24, 0xf1, 0x5b,
+	0xfb, 0x25, 0xe2, 0xc2, 0x68, 0x3c, 0xdb, 0xde, 0x1d, 0x79, 0x77, 0x66, 0x32, 0x33, 0xbb, 0x71,
+	0xc2, 0x05, 0x3d, 0x90, 0x80, 0x87, 0x10, 0x82, 0x1c, 0x10, 0xd2, 0xd3, 0x93, 0xe0, 0x86, 0xc4,
+	0x05, 0x09, 0xe9, 0xfd, 0x0b, 0x70, 0x43, 0xe2, 0x8a, 0x04, 0x42, 0x5c, 0x80, 0x33, 0x77, 0xaa,
+	0x3f, 0xe6, 0x6b, 0xbd, 0xf3, 0x26, 0x16, 0xca, 0x85, 0xc3, 0x4a, 0xd3, 0xd5, 0xd5, 0x55, 0xbf,
+	0xae, 0xae, 0xae, 0xaa, 0xae, 0x85, 0x2b, 0xae, 0xe7, 0x04, 0x4e, 0x6d, 0x60, 0x74, 0xad, 0x96,
+	0x11, 0x38, 0x5e, 0xcd, 0x30, 0x4d, 0xa7, 0x6f, 0x07, 0x7e, 0x6d, 0x50, 0xaf, 0xbd, 0x62, 0xbb,
+	0xba, 0xe1, 0x5a, 0x55, 0xc1, 0x43, 0x4e, 0xb3, 0xa0, 0xc3, 0x3c, 0xd6, 0xef, 0x55, 0x23, 0xee,
+	0x6a, 0xc8, 0x5d, 0x1d, 0xd4, 0x57, 0xd6, 0xa4, 0xa8, 0x5d, 0x66, 0x98, 0x8e, 0x5d, 0xf3, 0x5c,
+	0xb3, 0x36, 0xf8, 0xb0, 0xd6, 0x61, 0x46, 0x37, 0xe8, 0x48, 0x09, 0x2b, 0x67, 0x50, 0x02, 0x12,
+	0x8d, 0xae, 0xdb, 0x31, 0x3e, 0x54, 0x8c, 0xba, 0xd9, 0x31, 0x2c, 0x5b, 0x31, 0x2c, 0xa7, 0x18,
+	0x6c, 0xa7, 0xc5, 0xd4, 0xc4, 0x6a, 0xdb, 0x71, 0xda, 0x5d, 0x56, 0x43, 0x34, 0x35, 0xc3, 0xb6,
+	0x9d, 0xc0, 0x08, 0x2c, 0xc7, 0xf6, 0xd5, 0xec, 0x49, 0x35, 0x2b, 0x46, 0xbb, 0xfd, 0xbd, 0x1a,
+	0xeb, 0xb9, 0xc1, 0x6b, 0x39, 0x49, 0xff, 0x3d, 0x06, 0x0b, 0x1b, 0x1e, 0x33, 0x02, 0xf6, 0xc2,
+	0xe8, 0x76, 0x59, 0xd0, 0x64, 0x2f, 0xfb, 0xcc, 0x0f, 0xc8, 0x53, 0x80, 0x7d, 0xf6, 0xba, 0x67,
+	0xd8, 0x46, 0x9b, 0x79, 0x15, 0x6d, 0x4d, 0xbb, 0x3c, 0x5b, 0xaf, 0x56, 0xbf, 0x7c, 0x8f, 0xd5,
+	0xc7, 0xd1, 0x8a, 0xc7, 0x96, 0xdd, 0x6a, 0x26, 0x24, 0x90, 0x4b, 0x30, 0xf7, 0x4a, 0x28, 0xd0,
+	0x5d, 0xc3, 0xf7, 0x5f, 0x39, 0x5e, 0xab, 0x32, 0x86, 0x42, 0xa7, 0x9a, 0xb3, 0x92, 0xbc, 0xa5,
+	0xa8, 0x64, 0x05, 0x4a, 0x3d, 0x9b, 0xf5, 0x1c, 0xdb, 0x32, 0x2b, 0x05, 0xc1, 0x11, 0x8d, 0xc9,
+	0x59, 0x28, 0xdb, 0xfd, 0x9e, 0x1e, 0xaa, 0xac, 0x8c, 0xe3, 0xfc, 0x78, 0x73, 0x1a, 0x69, 0xf7,
+	0x14, 0x89, 0x9c, 0x81, 0x69, 0x0f, 0xb9, 0x03, 0xa6, 0x1b, 0xad, 0x96, 0x57, 0x99, 0x10, 0x12,
+	0x40, 0x92, 0xee, 0x21, 0x85, 0x5c, 0x84, 0x39, 0xc5, 0x60, 0x7a, 0x1c, 0x4c, 0xd0, 0xa9, 0x14,
+	0x05, 0xd3, 0x8c, 0x24, 0x6f, 0x78, 0x88, 0x25, 0xe8, 0x24, 0xf8, 0x70, 0x17, 0x92, 0x6f, 0x32,
+	0xc9, 0x87, 0x7b, 0x15, 0x7c, 0xd7, 0x80, 0x84, 0xf2, 0x8c, 0x58, 0x64, 0x49, 0xb0, 0x2a, 0x09,
+	0x1b, 0x86, 0x12, 0x4a, 0xbf, 0x0d, 0x8b, 0x69, 0x63, 0xfb, 0x2e, 0x9e, 0x13, 0x23, 0xf7, 0xa1,
+	0x28, 0xcd, 0x20, 0x2c, 0x3d, 0x9d, 0x6f, 0xe9, 0xf4, 0xfa, 0xa6, 0x5a, 0x4d, 0xbf, 0xd0, 0x60,
+	0xb9, 0xd1, 0xb2, 0x02, 0x39, 0xbd, 0xe1, 0xd8, 0x7b, 0x56, 0x3b, 0x3c, 0xd1, 0x21, 0xcb, 0x68,
+	0xef, 0x62, 0x99, 0xb1, 0x77, 0xb4, 0x4c, 0xe1, 0xdd, 0x2d, 0x33, 0x3e, 0xda, 0x32, 0xb7, 0xa1,
+	0xf2, 0x80, 0xd9, 0xcc, 0x43, 0xdb, 0x3c, 0x51, 0xc7, 0x1d, 0x59, 0x27, 0xe9, 0x12, 0x5a, 0xda,
+	0x25, 0xe8, 0xa7, 0x1a, 0xcc, 0x0e, 0x19, 0x13, 0x37, 0x1a, 0xb9, 0x1a, 0x2a, 0x54, 0x1b, 0x0d,
+	0xdd, 0x0c, 0x81, 0xbd, 0x80, 0xb9, 0xd8, 0x33, 0xf5, 0x7d, 0x74, 0x55, 0xb1, 0xd1, 0xa3, 0x3b,
+	0xf8, 0xec, 0x7e, 0x6a, 0x4c, 0x7f, 0xae, 0xc1, 0xc2, 0x37, 0x2d, 0x3f, 0x08, 0xbd, 0x31, 0x34,
+	0xfd, 0x07, 0xb0, 0xd0, 0x46, 0x38, 0x2d, 0xe6, 0x3a, 0xbe, 0x15, 0xe8, 0xc1, 0x81, 0x8e, 0xa2,
+	0x0d, 0x81, 0xac, 0xd4, 0x9c, 0xc7, 0xa9, 0x4d, 0x39, 0xb3, 0x73, 0xb0, 0x89, 0x74, 0x72, 0x12,
+	0xa6, 0x5c, 0x94, 0xa9, 0xfb, 0xd6, 0x1b, 0x26, 0x90, 0x4d, 0x34, 0x4b, 0x9c, 0xb0, 0x8d, 0x63,
+	0x72, 0x0a, 0x40, 0x4c, 0x06, 0xce, 0x3e, 0xb3, 0x95, 0xe1, 0x05, 0xfb, 0x0e, 0x27, 0x90, 0x79,
+	0x28, 0xe0, 0x46, 0x85, 0x95, 0x4b, 0x4d, 0xfe, 0x49, 0x7f, 0xad, 0xc1, 0x62, 0x1a, 0x94, 0xb2,
+	0xd3, 0x06, 0x94, 0xa2, 0x9b, 0xa4, 0xad, 0x15, 0xd0, 0xed, 0x2e, 0xe5, 0xed, 0x5f, 0xc9, 0x68,
+	0x46, 0x0b, 0xb9, 0x33, 0xd8, 0xec, 0x80, 0x9b, 0x3a, 0xc2, 0xa4, 0x9c, 0x86, 0x93, 0xb7, 0x22,
+	0x5c, 0x08, 0x3b, 0xc0, 0xb8, 0xd4, 0x95, 0x9b, 0x2a, 0x88, 0x4d, 0x4d, 0x09, 0x0a, 0xdf, 0x15,
+	0xfd, 0x9d, 0x06, 0x93, 0x4a, 0x38, 0xa9, 0xc3, 0x71, 0xa5, 0xdd, 0xb2, 0xdb, 0xba, 0xdb, 0xdf,
+	0xed, 0x5a, 0x26, 0x77, 0x35, 0x61, 0xaf, 0x72, 0x73, 0x21, 0x9e, 0xdc, 0x12, 0x73, 0x78, 0x28,
+	0x3c, 0x32, 0x28, 0x48, 0xba, 0x6d, 0xf4, 0x98, 0xc2, 0x30, 0xad, 0x68, 0x4f, 0x91, 0xc4, 0x91,
+	0x0e, 0x1f, 0x40, 0x41, 0x08, 0x9c, 0x69, 0xa5, 0xac, 0x7f, 0x89, 0xf3, 0x79, 0xd6, 0x40, 0xc4,
+	0xd0, 0xa4, 0xcf, 0xce, 0xc6, 0x64, 0xe1, 0xb2, 0x8f, 0x61, 0x36, 0xb4, 0x47, 0x7c, 0xc5, 0x62,
+	0xb8, 0xd2, 0xa8, 0xe5, 0x26, 0xb8, 0x21, 0x4a, 0x9f, 0x54, 0x60, 0x12, 0xfd, 0xc4, 0x32, 0x99,
+	0x8f, 0x08, 0x0b, 0x18, 0xbb, 0xc2, 0x21, 0x46, 0x86, 0xe9, 0x7b, 0xfd, 0xa0, 0x13, 0x4a, 0x42,
+	0x97, 0x8f, 0xe2, 0xa4, 0x72, 0xf9, 0x70, 0x4c, 0x6e, 0xc2, 0xf1, 0xf0, 0x5b, 0x37, 0xf9, 0x15,
+	0xf7, 0x7a, 0x02, 0x94, 0xda, 0xf4, 0x62, 0x38, 0xb9, 0x91, 0x98, 0xa3, 0xcf, 0xa0, 0x2c, 0xe5,
+	0xab, 0xc3, 0x5f, 0x84, 0x09, 0x79, 0x5a, 0x52, 0xba, 0x1c, 0x90, 0x2b, 0x30, 0x2f, 0x3e, 0x74,
+	0x76, 0xe0, 0x5a, 0x5e, 0x2c, 0x75, 0xbc, 0x39, 0x27, 0xe8, 0x8d, 0x88, 0x4c, 0xff, 0xaa, 0xc1,
+	0xd2, 0x53, 0x4c, 0x41, 0xa8, 0xc5, 0x66, 0x26, 0x27, 0x45, 0xb2, 0x6f, 0xc0, 0xa2, 0xca, 0x5e,
+	0x3c, 0x47, 0xe9, 0xcc, 0x6e, 0xb9, 0x8e, 0x65, 0x07, 0x4a, 0x15, 0x91, 0x73, 0x7c, 0x6d, 0x43,
+	0xcd, 0x90, 0x55, 0x98, 0x32, 0xa5, 0x1c, 0x26, 0xef, 0x62, 0xa9, 0x19, 0x13, 0xb8, 0xd5, 0xfc,
+	0xd7, 0xb6, 0x89, 0x07, 0x2e, 0x4e, 0xac, 0xd4, 0x0c, 0x87, 0xfc, 0xd8, 0xdb, 0x18, 0x35, 0x7c,
+	0xcb, 0xd7, 0x03, 0x0b, 0x8f, 0x5d, 0x25, 0x04, 0x45, 0xdb, 0x41, 0x12, 0xb9, 0x0b, 0x95, 0xf0,
+	0xd8, 0x51, 0x62, 0xe0, 0x19, 0x66, 0x20, 0x02, 0x20, 0xf3, 0x7d, 0x91, 0x1d, 0xca, 0xcd, 0x25,
+	0x35, 0xbf, 0xa1, 0xa6, 0xef, 0xc9, 0x59, 0xfa, 0x5d, 0x7e, 0x71, 0x9c, 0xb6, 0x1f, 0xa2, 0x8c,
+	0xf6, 0x77, 0x1b, 0x96, 0xa3, 0xeb, 0xa1, 0x77, 0x91, 0x63, 0x78, 0x8b, 0xc7, 0xa3, 0xe9, 0xe4,
+	0xfa, 0x84, 0x5d, 0xd2, 0x8b, 0xc6, 0x92, 0x76, 0x49, 0xae, 0xa0, 0x0f, 0x60, 0xee, 0x39, 0xf3,
+	0xfc, 0xa4, 0x71, 0x97, 0xa0, 0x28, 0x19, 0x95, 0x2e, 0x35, 0xe2, 0x26, 0x8c, 0xb4, 0x2a, 0x89,
+	0x31, 0x81, 0xbe, 0xd5, 0xe0, 0xf8, 0x46, 0xc7, 0xb0, 0xdb, 0x2c, 0x4c, 0xb4, 0xa1, 0xa7, 0xe1,
+	0x91, 0x9b, 0x7d, 0xcf, 0x63, 0x76, 0x22, 0x33, 0x4b, 0xc9, 0x73, 0x8a, 0x9e, 0x4c, 0xcd, 0x43,
+	0xc9, 0xfb, 0x1d, 0x9c, 0xb2, 0xf0, 0x25, 0x4e, 0x79, 0x17, 0x8e, 0x3d, 0x34, 0xfc, 0xa1, 0xf0,
+	0x7d, 0x0e, 0x66, 0x54, 0xf8, 0x66, 0x07, 0x18, 0xb6, 0x7c, 0x15, 0x26, 0xcb, 0x92, 0xd8, 0x10,
+	0x34, 0x3a, 0x80, 0xa5, 0x47, 0x3d, 0xd7, 0xf1, 0x02, 0x7e, 0xad, 0x70, 0x83, 0x2c, 0x11, 0x6b,
+	0xc9, 0x7e, 0x48, 0xd3, 0x2d, 0xc1, 0xc3, 0x5a, 0xe2, 0x2a, 0x4e, 0x35, 0x8f, 0x45, 0x33, 0x8f,
+	0xd4, 0x44, 0x9a, 0x7d, 0x68, 0x77, 0x31, 0x7b, 0x68, 0x02, 0xbc, 0xf3, 0xcb, 0x87, 0xf4, 0xc6,
+	0x5e, 0x1f, 0xaa, 0xd3, 0x0f, 0x47, 0x01, 0x12, 0xce, 0x45, 0x31, 0xcb, 0xa7, 0x2f, 0x80, 0xe0,
+	0xf6, 0x3f, 0xf6, 0x59, 0xeb, 0x05, 0xdb, 0x8d, 0xe4, 0x50, 0x98, 0xe9, 0x18, 0x3e, 0xc6, 0xc9,
+	0xb6, 0x8d, 0x92, 0xfa, 0xae, 0xda, 0xff, 0x34, 0x12, 0xb7, 0x05, 0xed, 0x63, 0x97, 0x47, 0x53,
+	0xce, 0xa3, 0x6a, 0x06, 0x75, 0x61, 0x3a, 0xa1, 0x29, 0x29, 0x85, 0x32, 0x77, 0xa3, 0x48, 0x24,
+	0x81, 0x71, 0xee, 0x71, 0xca, 0x0a, 0xe2, 0x9b, 0x7e, 0x3e, 0x06, 0x8b, 0xeb, 0xc2, 0x75, 0xb6,
+	0xb1, 0x5c, 0xec, 0xfb, 0xff, 0x67, 0xb7, 0x97, 0x7c, 0x03, 0x40, 0xd4, 0xce, 0x3a, 0xd6, 0xd8,
+	0x2d, 0x51, 0xe2, 0x4d, 0xd7, 0xd7, 0xe2, 0xfc, 0x86, 0x1f, 0xd5, 0xb0, 0x94, 0xae, 0x6e, 0x70,
+	0xc6, 0x87, 0xc8, 0x87, 0xb8, 0xc3, 0x4f, 0x6a, 0xc0, 0xf1, 0x75, 0xc3, 0xdc, 0xef, 0xbb, 0xc3,
+	0xd9, 0x3c, 0x37, 0xca, 0x63, 0x06, 0xd9, 0x15, 0x2b, 0x0f, 0xd5, 0xba, 0x92, 0x1c, 0x79, 0xd3,
+	0x4d, 0x58, 0x1a, 0x56, 0xa1, 0x0e, 0xe1, 0x04, 0x94, 0xde, 0x58, 0xae, 0xbe, 0x67, 0x75, 0x99,
+	0x4a, 0x7b, 0x93, 0x38, 0xbe, 0x8f, 0x43, 0xfa, 0x63, 0x0d, 0x4e, 0x3c, 0x41, 0x90, 0x01, 0xb3,
+	0x0d, 0xdb, 0x64, 0x43, 0xa7, 0x87, 0x77, 0xf4, 0x65, 0xdf, 0x62, 0xbe, 0xc9, 0x5a, 0xca, 0x71,
+	0xa2, 0x31, 0xb9, 0x0c, 0xf3, 0x68, 0x8f, 0xbd, 0xae, 0xd5, 0xee, 0x60, 0x31, 0xd2, 0x0f, 0x2c,
+	0x91, 0x86, 0xb8, 0xcd, 0x67, 0x2d, 0xfb, 0xbe, 0x20, 0x6f, 0x0a, 0x2a, 0xe7, 0xf4, 0x8d, 0x3d,
+	0x9e, 0xd0, 0x75, 0xbf, 0xd3, 0x0f, 0x5a, 0xce, 0x2b, 0x5b, 0x1d, 0xde, 0x2c, 0xa7, 0xef, 0x38,
+	0xdb, 0x8a, 0x4a, 0x6f, 0xc1, 0xf2, 0xf6, 0x2b, 0x2b, 0x30, 0x3b, 0xeb, 0x91, 0x5f, 0x24, 0x72,
+	0xd8, 0x90, 0xf3, 0x44, 0x63, 0x6a, 0x42, 0xe5, 0xf0, 0x32, 0xb5, 0x85, 0x6b, 0x70, 0xcc, 0xf5,
+	0xd8, 0xc0, 0x72, 0xfa, 0x87, 0x02, 0xeb, 0x7c, 0x38, 0x11, 0xf9, 0x5e, 0x52, 0xc9, 0xd8, 0x90,
+	0x92, 0x01, 0x2c, 0x3f, 0xc5, 0x22, 0xa4, 0xe1, 0x3a, 0x66, 0x47, 0x6e, 0x2c, 0x99, 0xfe, 0x18,
+	0x27, 0x0b, 0xb9, 0xe3, 0x4d, 0x39, 0x20, 0x0d, 0x28, 0x46, 0x66, 0xe1, 0xf5, 0xd0, 0x07, 0x79,
+	0xf5, 0x50, 0x52, 0xfc, 0xeb, 0xa6, 0x5a, 0x4c, 0x3f, 0x1b, 0x83, 0x99, 0xd4, 0x8c, 0x28, 0xda,
+	0x86, 0xeb, 0x98, 0xa9, 0xc8, 0x63, 0xb8, 0xc3, 0xc4, 0x09, 0x05, 0x2b, 0x02, 0x76, 0x10, 0x9e,
+	0x4b, 0x44, 0x7e, 0xc4, 0xa9, 0xe4, 0x02, 0xcc, 0xe2, 0xb3, 0x0d, 0xfd, 0x1d, 0xeb, 0x56, 0xbf,
+	0xeb, 0x60, 0x70, 0x2c, 0x88, 0x32, 0x62, 0x26, 0xa4, 0x6e, 0x73, 0x22, 0x0f, 0xa1, 0x46, 0x10,
+	0xe0, 0x19, 0x28, 0x36, 0x75, 0xb3, 0xca, 0x21, 0x91, 0x73, 0x71, 0xa5, 0xa6, 0xd3, 0xeb, 0x59,
+	0x48, 0x63, 0x4a, 0xe9, 0x84, 0x54, 0x1a, 0x91, 0xa5, 0x52, 0x94, 0x86, 0x37, 0xd4, 0x68, 0xb7,
+	0x3d, 0xd6, 0x16, 0xd9, 0xa5, 0x28, 0x03, 0xb2, 0xe5, 0xdf, 0x8b, 0x68, 0x3c, 0x8d, 0xc4, 0x1c,
+	0xfa, 0xbe, 0xcd, 0x3d, 0x66, 0x52, 0xf0, 0xcd, 0xc5, 0xf4, 0xc7, 0x9c, 0x7c, 0xf5, 0x0e, 0xcc,
+	0xa6, 0xeb, 0x68, 0x32, 0x0d, 0x93, 0x9b, 0x8d, 0xe6, 0xa3, 0xe7, 0x8d, 0xcd, 0xf9, 0xaf, 0x90,
+	0x32, 0x94, 0x1e, 0x3d, 0xd9, 0x7a, 0xd6, 0xdc, 0xc1, 0x91, 0x46, 0x00, 0x8a, 0xcd, 0xc6, 0x93,
+	0x67, 0x3b, 0x8d, 0xf9, 0xb1, 0xfa, 0x3f, 0xc7, 0xa1, 0x28, 0x23, 0x1c, 0xf9, 0x95, 0x06, 0xe5,
+	0xe4, 0x4b, 0x8a, 0xdc, 0xcc, 0x3b, 0xaa, 0x11, 0x8f, 0xdc, 0x95, 0xaf, 0x1e, 0x6d, 0x91, 0xf4,
+	0x1d, 0x7a, 0xf1, 0x93, 0x3f, 0xff, 0xe3, 0xed, 0xd8, 0x1a, 0x3d, 0xc9, 0x9b, 0x00, 0x71, 0x6b,
+	0x40, 0x06, 0xe3, 0x9a, 0x29, 0x96, 0x7c, 0x5d, 0xbb, 0x4a, 0x02, 0x28, 0x27, 0xdf, 0x61, 0x64,
+	0xa9, 0x2a, 0x1f, 0xe2, 0xd5, 0xf0, 0x21, 0x5e, 0x6d, 0xf0, 0x87, 0xf8, 0xca, 0x11, 0x1f, 0x7b,
+	0x74, 0x55, 0xe8, 0x5f, 0x22, 0x8b, 0xa3, 0xf4, 0x93, 0x9f, 0x68, 0x30, 0x3f, 0xfc, 0x92, 0xca,
+	0x54, 0x7d, 0x37, 0x4f, 0x75, 0xd6, 0x9b, 0x8c, 0x5e, 0x12, 0x20, 0xce, 0x92, 0x33, 0x69, 0x10,
+	0xe1, 0xbb, 0xac, 0xd6, 0x56, 0x0b, 0xc9, 0xef, 0x35, 0x98, 0x1b, 0x4a, 0x99, 0xe4, 0x76, 0x9e,
+	0xda, 0xd1, 0xb9, 0x7d, 0xe5, 0xce, 0x91, 0xd7, 0x29, 0xb4, 0x37, 0x04, 0xda, 0xab, 0xf4, 0xc2,
+	0xc8, 0x23, 0x8b, 0xd2, 0x7c, 0x4d, 0x26, 0x69, 0x3c, 0xbc, 0xfa, 0x5f, 0x0a, 0x50, 0x8a, 0x9a,
+	0x0a, 0xbf, 0x44, 0x6f, 0x4b, 0x3e, 0xa1, 0xf2, 0xbd, 0x6d, 0xc4, 0x2b, 0x30, 0xdf, 0xdb, 0x46,
+	0xbd, 0xd2, 0xe8, 0x69, 0x01, 0xbd, 0x42, 0x96, 0xd2, 0xd0, 0xa3, 0x07, 0xd8, 0x6f, 0xf0, 0x01,
+	0x9c, 0x4e, 0x22, 0xe4, 0x56, 0x9e, 0xa2, 0x91, 0x79, 0x6d, 0xe5, 0xf6, 0x51, 0x97, 0x29, 0x84,
+	0x97, 0x05, 0x42, 0x4a, 0x4f, 0x8d, 0x46, 0x58, 0x93, 0x49, 0x8f, 0xdf, 0x88, 0x1f, 0x20, 0xd6,
+	0x74, 0x15, 0x9a, 0x8f, 0x75, 0x64, 0xd5, 0xba, 0x92, 0xe1, 0xd0, 0x59, 0x77, 0x33, 0xcc, 0xc3,
+	0x35, 0xd6, 0xb2, 0xc4, 0xf1, 0xbe, 0x9d, 0x82, 0xa2, 0x4c, 0x3d, 0xe4, 0xfb, 0xe8, 0xa0, 0x0f,
+	0x58, 0x90, 0xac, 0x85, 0x32, 0xef, 0x4b, 0xee, 0x11, 0x8e, 0xaa, 0xa8, 0xe8, 0x39, 0x01, 0xea,
+	0x14, 0x19, 0x02, 0xa5, 0x5a, 0x81, 0xbe, 0x54, 0xf9, 0x05, 0xa6, 0x75, 0x84, 0xf1, 0x3c, 0x9c,
+	0xde, 0x32, 0xbc, 0xc0, 0x32, 0x2d, 0x57, 0x54, 0xca, 0xe4, 0x4e, 0x46, 0xe5, 0x92, 0xb9, 0x22,
+	0x34, 0xd4, 0xad, 0x8c, 0x85, 0x59, 0xab, 0x14, 0xe4, 0xab, 0x02, 0xf2, 0x79, 0x42, 0x47, 0x42,
+	0x76, 0x53, 0xd8, 0x7e, 0xab, 0xc1, 0x72, 0x0a, 0x07, 0xf3, 0xf6, 0x1c, 0x2c, 0xf1, 0xb1, 0x38,
+	0x21, 0xf5, 0x5c, 0xf5, 0x31, 0x73, 0x08, 0xf9, 0xe6, 0x91, 0xd6, 0xa4, 0x9d, 0x90, 0xac, 0x8d,
+	0x06, 0x9c, 0x80, 0xf4, 0x23, 0x0d, 0x66, 0x92, 0x70, 0x7d, 0x72, 0x3d, 0x43, 0x21, 0xbf, 0x8f,
+	0x31, 0x5b, 0x08, 0xef, 0x6c, 0x1e, 0x3c, 0x3f, 0x2b, 0x38, 0x2a, 0x30, 0x83, 0x58, 0xf3, 0x67,
+	0xf8, 0xc4, 0x4c, 0x62, 0x59, 0x37, 0xba, 0x1c, 0x63, 0x2a, 0xc0, 0x64, 0x43, 0x0a, 0xb9, 0x43,
+	0x64, 0x97, 0xf3, 0x90, 0x85, 0x0b, 0xe8, 0x05, 0x01, 0xf0, 0x0c, 0x39, 0x35, 0x12, 0xe0, 0x6e,
+	0x88, 0x62, 0x00, 0xc7, 0x92, 0xe8, 0x3e, 0xea, 0xb3, 0x3e, 0xcb, 0xbc, 0x1b, 0x17, 0xf2, 0xb4,
+	0x8b, 0xe5, 0x94, 0x0a, 0xd5, 0xab, 0x64, 0x65, 0xa4, 0xea, 0x97, 0x42, 0x45, 0x0b, 0x4a, 0xa8,
+	0x77, 0x8b, 0xe1, 0xd3, 0x37, 0x53, 0xdd, 0x6a, 0x86, 0x3a, 0xb1, 0x2a, 0x47, 0x8b, 0x2b, 0x24,
+	0xff, 0x54, 0x03, 0x82, 0x6a, 0x86, 0x4a, 0xc4, 0x4c, 0x85, 0x77, 0x8e, 0x52, 0x0c, 0x26, 0x6a,
+	0xcd, 0x1c, 0xd7, 0x94, 0x35, 0x63, 0x8d, 0x77, 0xcb, 0xea, 0x7f, 0x9c, 0x80, 0xe2, 0x43, 0xf1,
+	0x97, 0x00, 0xf9, 0x85, 0xbc, 0x54, 0x71, 0x79, 0x1c, 0xf7, 0x59, 0x32, 0x11, 0xe6, 0x06, 0xf0,
+	0xd1, 0xfd, 0x1a, 0x7a, 0x5d, 0x00, 0xbc, 0x48, 0xce, 0xa7, 0x01, 0xca, 0x3f, 0x27, 0xc4, 0xff,
+	0x0c, 0xba, 0x19, 0x6b, 0x97, 0x05, 0x46, 0x90, 0xec, 0x53, 0xfc, 0x0f, 0x01, 0x73, 0x54, 0x83,
+	0x85, 0x5e, 0x13, 0x80, 0x2e, 0x90, 0x73, 0x23, 0x01, 0xf1, 0xe7, 0x6b, 0x8d, 0x45, 0xaa, 0xbf,
+	0x03, 0xc0, 0x9d, 0x54, 0xb6, 0x49, 0x32, 0x81, 0xd4, 0xf2, 0x80, 0x0c, 0xf5, 0x59, 0xe8, 0x79,
+	0x81, 0xe1, 0x34, 0x59, 0x1d, 0x89, 0x61, 0xa0, 0xd4, 0x7d, 0x0f, 0x8d, 0xb1, 0x1d, 0x60, 0xc9,
+	0xd7, 0x5b, 0x8f, 0xba, 0x37, 0x99, 0x18, 0xce, 0xc7, 0x18, 0xe4, 0xe1, 0x57, 0x3d, 0xd7, 0x44,
+	0xcf, 0xad, 0x26, 0x1f, 0xeb, 0xb4, 0x26, 0x14, 0x5f, 0x21, 0x97, 0xb2, 0x37, 0x1f, 0x65, 0x0e,
+	0xae, 0xf8, 0x86, 0x46, 0x7e, 0xa6, 0xc1, 0x82, 0x44, 0xf1, 0x3c, 0xd9, 0x78, 0xca, 0x04, 0x72,
+	0xfd, 0x5d, 0x4e, 0x25, 0x02, 0x54, 0x17, 0x80, 0xae, 0x93, 0xab, 0xd9, 0x80, 0x62, 0x6a, 0x88,
+	0xa9, 0xfe, 0xaf, 0x02, 0x8c, 0xf3, 0x8e, 0x23, 0x3f, 0x9f, 0xb8, 0xcb, 0x91, 0x09, 0xa9, 0x9e,
+	0x07, 0xe9, 0x70, 0xa7, 0x84, 0x9e, 0x15, 0xc0, 0x4e, 0x92, 0x13, 0x69, 0x60, 0x96, 0x6d, 0x05,
+	0x16, 0x8e, 0xde, 0xe0, 0x93, 0xf7, 0x13, 0x0d, 0x26, 0x70, 0x33, 0x96, 0x4d, 0xae, 0xe5, 0xf6,
+	0xb6, 0xe3, 0xf6, 0x6b, 0xbe, 0x81, 0x92, 0xbd, 0xd4, 0xb0, 0x44, 0xa3, 0x0b, 0x69, 0x1c, 0x5d,
+	0xae, 0x97, 0x97, 0x3d, 0x58, 0x61, 0x14, 0x79, 0xeb, 0xa6, 0xef, 0xbe, 0x4f, 0x14, 0x67, 0x04,
+	0x8a, 0x13, 0x74, 0xe8, 0x59, 0xe0, 0x0b, 0xc5, 0x1c, 0xc6, 0xb7, 0xa0, 0x88, 0xa6, 0x70, 0xfa,
+	0x41, 0xe6, 0x21, 0x64, 0x55, 0x55, 0x19, 0xa2, 0xbb, 0x42, 0x1a, 0x2f, 0xa7, 0xfe, 0x33, 0x0e,
+	0xd3, 0x89, 0x9e, 0x04, 0xf9, 0x54, 0x83, 0xc9, 0x8f, 0x64, 0xdb, 0x21, 0x53, 0xd9, 0xd7, 0xf2,
+	0x76, 0x97, 0xd9, 0xe4, 0x08, 0x03, 0x16, 0x3d, 0x3b, 0xf4, 0xf8, 0x88, 0x17, 0xd4, 0x54, 0xd3,
+	0x83, 0xef, 0xfb, 0x87, 0x68, 0x7e, 0x5c, 0xda, 0xef, 0xbd, 0x17, 0x2c, 0x2a, 0x56, 0xd1, 0xb5,
+	0x6c, 0x2c, 0x9e, 0x50, 0xce, 0xa1, 0xbc, 0x95, 0xf9, 0xfe, 0x90, 0xb4, 0xf7, 0x01, 0x2c, 0x23,
+	0xed, 0x24, 0x81, 0xc5, 0xa5, 0xe7, 0xfc, 0x70, 0x37, 0x86, 0xe4, 0xa6, 0xbb, 0x8c, 0xb6, 0x4f,
+	0xfe, 0x9b, 0x32, 0xab, 0xf1, 0x93, 0xf5, 0x4a, 0x4b, 0x22, 0x4e, 0x74, 0x26, 0xd1, 0x9e, 0xeb,
+	0xe5, 0x3f, 0xfc, 0xfd, 0xb4, 0xf6, 0x27, 0xfc, 0xfd, 0x0d, 0x7f, 0xbb, 0x45, 0x61, 0xbc, 0x9b,
+	0xff, 0x05, 0x74, 0x64, 0x5d, 0x37, 0xb6, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1955,6 +2298,7 @@ type BeaconClient interface {
 	GetValidatorBalances(ctx context.Context, in *v1alpha1.ListValidatorBalancesRequest, opts ...grpc.CallOption) (*v1alpha1.ValidatorBalances, error)
 	GetValidatorQueue(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.ValidatorQueue, error)
 	GetPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.Peers, error)
+	GetNextEpochDuties(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*NextEpochDutiesResponse, error)
 }
 
 type beaconClient struct {
@@ -2028,6 +2372,15 @@ func (c *beaconClient) GetPeers(ctx context.Context, in *types.Empty, opts ...gr
 	return out, nil
 }
 
+func (c *beaconClient) GetNextEpochDuties(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*NextEpochDutiesResponse, error) {
+	out := new(NextEpochDutiesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Beacon/GetNextEpochDuties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BeaconServer is the server API for Beacon service.
 type BeaconServer interface {
 	GetBeaconStatus(context.Context, *types.Empty) (*BeaconStatusResponse, error)
@@ -2037,6 +2390,7 @@ type BeaconServer interface {
 	GetValidatorBalances(context.Context, *v1alpha1.ListValidatorBalancesRequest) (*v1alpha1.ValidatorBalances, error)
 	GetValidatorQueue(context.Context, *types.Empty) (*v1alpha1.ValidatorQueue, error)
 	GetPeers(context.Context, *types.Empty) (*v1alpha1.Peers, error)
+	GetNextEpochDuties(context.Context, *types.Empty) (*NextEpochDutiesResponse, error)
 }
 
 // UnimplementedBeaconServer can be embedded to have forward compatible implementations.
@@ -2064,6 +2418,9 @@ func (*UnimplementedBeaconServer) GetValidatorQueue(ctx context.Context, req *ty
 func (*UnimplementedBeaconServer) GetPeers(ctx context.Context, req *types.Empty) (*v1alpha1.Peers, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPeers not implemented")
 }
+func (*UnimplementedBeaconServer) GetNextEpochDuties(ctx context.Context, req *types.Empty) (*NextEpochDutiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextEpochDuties not implemented")
+}
 
 func RegisterBeaconServer(s *grpc.Server, srv BeaconServer) {
 	s.RegisterService(&_Beacon_serviceDesc, srv)
@@ -2195,6 +2552,24 @@ func _Beacon_GetPeers_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Beacon_GetNextEpochDuties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServer).GetNextEpochDuties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Beacon/GetNextEpochDuties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServer).GetNextEpochDuties(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Beacon_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.validator.accounts.v2.Beacon",
 	HandlerType: (*BeaconServer)(nil),
@@ -2227,6 +2602,10 @@ var _Beacon_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPeers",
 			Handler:    _Beacon_GetPeers_Handler,
 		},
+		{
+			MethodName: "GetNextEpochDuties",
+			Handler:    _Beacon_GetNextEpochDuties_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/validator/accounts/v2/web_api.proto",
@@ -2683,39 +3062,219 @@ var _Auth_serviceDesc = grpc.ServiceDesc{
 	Metadata: "proto/validator/accounts/v2/web_api.proto",
 }
 
-func (m *CreateWalletRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// MaintenanceClient is the client API for Maintenance service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MaintenanceClient interface {
+	Quiesce(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*MaintenanceStatusResponse, error)
+	Resume(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*MaintenanceStatusResponse, error)
+	GetMaintenanceStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*MaintenanceStatusResponse, error)
+	SwitchBeaconNode(ctx context.Context, in *SwitchBeaconNodeRequest, opts ...grpc.CallOption) (*SwitchBeaconNodeResponse, error)
+}
+
+type maintenanceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMaintenanceClient(cc *grpc.ClientConn) MaintenanceClient {
+	return &maintenanceClient{cc}
+}
+
+func (c *maintenanceClient) Quiesce(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*MaintenanceStatusResponse, error) {
+	out := new(MaintenanceStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Maintenance/Quiesce", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CreateWalletRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *maintenanceClient) Resume(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*MaintenanceStatusResponse, error) {
+	out := new(MaintenanceStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Maintenance/Resume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *CreateWalletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.RemoteCaCrtPath) > 0 {
-		i -= len(m.RemoteCaCrtPath)
-		copy(dAtA[i:], m.RemoteCaCrtPath)
-		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCaCrtPath)))
-		i--
-		dAtA[i] = 0x42
+func (c *maintenanceClient) GetMaintenanceStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*MaintenanceStatusResponse, error) {
+	out := new(MaintenanceStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Maintenance/GetMaintenanceStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RemoteKeyPath) > 0 {
-		i -= len(m.RemoteKeyPath)
+	return out, nil
+}
+
+func (c *maintenanceClient) SwitchBeaconNode(ctx context.Context, in *SwitchBeaconNodeRequest, opts ...grpc.CallOption) (*SwitchBeaconNodeResponse, error) {
+	out := new(SwitchBeaconNodeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Maintenance/SwitchBeaconNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MaintenanceServer is the server API for Maintenance service.
+type MaintenanceServer interface {
+	Quiesce(context.Context, *types.Empty) (*MaintenanceStatusResponse, error)
+	Resume(context.Context, *types.Empty) (*MaintenanceStatusResponse, error)
+	GetMaintenanceStatus(context.Context, *types.Empty) (*MaintenanceStatusResponse, error)
+	SwitchBeaconNode(context.Context, *SwitchBeaconNodeRequest) (*SwitchBeaconNodeResponse, error)
+}
+
+// UnimplementedMaintenanceServer can be embedded to have forward compatible implementations.
+type UnimplementedMaintenanceServer struct {
+}
+
+func (*UnimplementedMaintenanceServer) Quiesce(ctx context.Context, req *types.Empty) (*MaintenanceStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Quiesce not implemented")
+}
+func (*UnimplementedMaintenanceServer) Resume(ctx context.Context, req *types.Empty) (*MaintenanceStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
+}
+func (*UnimplementedMaintenanceServer) GetMaintenanceStatus(ctx context.Context, req *types.Empty) (*MaintenanceStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenanceStatus not implemented")
+}
+func (*UnimplementedMaintenanceServer) SwitchBeaconNode(ctx context.Context, req *SwitchBeaconNodeRequest) (*SwitchBeaconNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchBeaconNode not implemented")
+}
+
+func RegisterMaintenanceServer(s *grpc.Server, srv MaintenanceServer) {
+	s.RegisterService(&_Maintenance_serviceDesc, srv)
+}
+
+func _Maintenance_Quiesce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServer).Quiesce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Maintenance/Quiesce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServer).Quiesce(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Maintenance_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Maintenance/Resume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServer).Resume(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Maintenance_GetMaintenanceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServer).GetMaintenanceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Maintenance/GetMaintenanceStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServer).GetMaintenanceStatus(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Maintenance_SwitchBeaconNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchBeaconNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServer).SwitchBeaconNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Maintenance/SwitchBeaconNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServer).SwitchBeaconNode(ctx, req.(*SwitchBeaconNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Maintenance_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Maintenance",
+	HandlerType: (*MaintenanceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Quiesce",
+			Handler:    _Maintenance_Quiesce_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _Maintenance_Resume_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceStatus",
+			Handler:    _Maintenance_GetMaintenanceStatus_Handler,
+		},
+		{
+			MethodName: "SwitchBeaconNode",
+			Handler:    _Maintenance_SwitchBeaconNode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/validator/accounts/v2/web_api.proto",
+}
+
+func (m *CreateWalletRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateWalletRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateWalletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RemoteCaCrtPath) > 0 {
+		i -= len(m.RemoteCaCrtPath)
+		copy(dAtA[i:], m.RemoteCaCrtPath)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteCaCrtPath)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RemoteKeyPath) > 0 {
+		i -= len(m.RemoteKeyPath)
 		copy(dAtA[i:], m.RemoteKeyPath)
 		i = encodeVarintWebApi(dAtA, i, uint64(len(m.RemoteKeyPath)))
 		i--
@@ -3780,90 +4339,350 @@ func (m *BackupAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintWebApi(dAtA []byte, offset int, v uint64) int {
-	offset -= sovWebApi(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MaintenanceStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CreateWalletRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MaintenanceStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Keymanager != 0 {
-		n += 1 + sovWebApi(uint64(m.Keymanager))
-	}
-	l = len(m.WalletPassword)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.Mnemonic)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	if m.NumAccounts != 0 {
-		n += 1 + sovWebApi(uint64(m.NumAccounts))
-	}
-	l = len(m.RemoteAddr)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
-	}
-	l = len(m.RemoteCrtPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.RemoteKeyPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.SafeToShutdown {
+		i--
+		if m.SafeToShutdown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.RemoteCaCrtPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.InFlightDuties != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.InFlightDuties))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Quiesced {
+		i--
+		if m.Quiesced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateWalletResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SwitchBeaconNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SwitchBeaconNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwitchBeaconNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Wallet != nil {
-		l = m.Wallet.Size()
-		n += 1 + l + sovWebApi(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EditWalletConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SwitchBeaconNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SwitchBeaconNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwitchBeaconNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RemoteAddr)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.RemoteCrtPath)
-	if l > 0 {
-		n += 1 + l + sovWebApi(uint64(l))
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.RemoteKeyPath)
+	if len(m.PreviousEndpoint) > 0 {
+		i -= len(m.PreviousEndpoint)
+		copy(dAtA[i:], m.PreviousEndpoint)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PreviousEndpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NextEpochDutiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NextEpochDutiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NextEpochDutiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Duties) > 0 {
+		for iNdEx := len(m.Duties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Duties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWebApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NextEpochDuty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NextEpochDuty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NextEpochDuty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.AggregatorKnown {
+		i--
+		if m.AggregatorKnown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IsAggregator {
+		i--
+		if m.IsAggregator {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CommitteeIndex != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.CommitteeIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AttesterSlot != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.AttesterSlot))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerSlots) > 0 {
+		dAtA2 := make([]byte, len(m.ProposerSlots)*10)
+		var j1 int
+		for _, num := range m.ProposerSlots {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintWebApi(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ValidatorIndex != 0 {
+		i = encodeVarintWebApi(dAtA, i, uint64(m.ValidatorIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintWebApi(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWebApi(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWebApi(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreateWalletRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Keymanager != 0 {
+		n += 1 + sovWebApi(uint64(m.Keymanager))
+	}
+	l = len(m.WalletPassword)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.Mnemonic)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.NumAccounts != 0 {
+		n += 1 + sovWebApi(uint64(m.NumAccounts))
+	}
+	l = len(m.RemoteAddr)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteCrtPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteKeyPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteCaCrtPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateWalletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Wallet != nil {
+		l = m.Wallet.Size()
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EditWalletConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RemoteAddr)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteCrtPath)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.RemoteKeyPath)
 	if l > 0 {
 		n += 1 + l + sovWebApi(uint64(l))
 	}
@@ -4309,54 +5128,170 @@ func (m *BackupAccountsResponse) Size() (n int) {
 	return n
 }
 
-func sovWebApi(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MaintenanceStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quiesced {
+		n += 2
+	}
+	if m.InFlightDuties != 0 {
+		n += 1 + sovWebApi(uint64(m.InFlightDuties))
+	}
+	if m.SafeToShutdown {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func sozWebApi(x uint64) (n int) {
-	return sovWebApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SwitchBeaconNodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowWebApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateWalletRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateWalletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keymanager", wireType)
-			}
-			m.Keymanager = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWebApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
+
+func (m *SwitchBeaconNodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousEndpoint)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NextEpochDutiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovWebApi(uint64(m.Epoch))
+	}
+	if len(m.Duties) > 0 {
+		for _, e := range m.Duties {
+			l = e.Size()
+			n += 1 + l + sovWebApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NextEpochDuty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovWebApi(uint64(l))
+	}
+	if m.ValidatorIndex != 0 {
+		n += 1 + sovWebApi(uint64(m.ValidatorIndex))
+	}
+	if len(m.ProposerSlots) > 0 {
+		l = 0
+		for _, e := range m.ProposerSlots {
+			l += sovWebApi(uint64(e))
+		}
+		n += 1 + sovWebApi(uint64(l)) + l
+	}
+	if m.AttesterSlot != 0 {
+		n += 1 + sovWebApi(uint64(m.AttesterSlot))
+	}
+	if m.CommitteeIndex != 0 {
+		n += 1 + sovWebApi(uint64(m.CommitteeIndex))
+	}
+	if m.IsAggregator {
+		n += 2
+	}
+	if m.AggregatorKnown {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovWebApi(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWebApi(x uint64) (n int) {
+	return sovWebApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreateWalletRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateWalletRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateWalletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keymanager", wireType)
+			}
+			m.Keymanager = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
 				iNdEx++
 				m.Keymanager |= KeymanagerKind(b&0x7F) << shift
 				if b < 0x80 {
@@ -7292,6 +8227,676 @@ func (m *BackupAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MaintenanceStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quiesced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Quiesced = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightDuties", wireType)
+			}
+			m.InFlightDuties = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InFlightDuties |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SafeToShutdown", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SafeToShutdown = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwitchBeaconNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwitchBeaconNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwitchBeaconNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwitchBeaconNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwitchBeaconNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwitchBeaconNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEndpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousEndpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NextEpochDutiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextEpochDutiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextEpochDutiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duties = append(m.Duties, &NextEpochDuty{})
+			if err := m.Duties[len(m.Duties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NextEpochDuty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextEpochDuty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextEpochDuty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorIndex", wireType)
+			}
+			m.ValidatorIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWebApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProposerSlots = append(m.ProposerSlots, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWebApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthWebApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthWebApi
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ProposerSlots) == 0 {
+					m.ProposerSlots = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWebApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProposerSlots = append(m.ProposerSlots, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlots", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlot", wireType)
+			}
+			m.AttesterSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttesterSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitteeIndex", wireType)
+			}
+			m.CommitteeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitteeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAggregator", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAggregator = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatorKnown", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AggregatorKnown = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWebApi(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,5 +1,7 @@
 package bls
 
+import "github.com/pkg/errors"
+
 // SignatureSet refers to the defined set of
 // signatures and its respective public keys and
 // messages required to verify it.
@@ -30,3 +32,44 @@ func (s *SignatureSet) Join(set *SignatureSet) *SignatureSet {
 func (s *SignatureSet) Verify() (bool, error) {
 	return VerifyMultipleSignatures(s.Signatures, s.Messages, s.PublicKeys)
 }
+
+// Split divides the signature set into consecutive sets holding at most the given
+// number of signatures. The set itself is returned when the size is not positive.
+func (s *SignatureSet) Split(size int) []*SignatureSet {
+	if size <= 0 || len(s.Signatures) <= size {
+		return []*SignatureSet{s}
+	}
+	sets := make([]*SignatureSet, 0, (len(s.Signatures)+size-1)/size)
+	for i := 0; i < len(s.Signatures); i += size {
+		end := i + size
+		if end > len(s.Signatures) {
+			end = len(s.Signatures)
+		}
+		sets = append(sets, &SignatureSet{
+			Signatures: s.Signatures[i:end],
+			PublicKeys: s.PublicKeys[i:end],
+			Messages:   s.Messages[i:end],
+		})
+	}
+	return sets
+}
+
+// FindInvalid verifies the signatures of the set one by one, and returns the index of
+// the first signature which fails to verify, or -1 if all of them are valid. This is
+// used to identify the culprit once the batch verification of the set has failed.
+func (s *SignatureSet) FindInvalid() (int, error) {
+	if len(s.Signatures) != len(s.PublicKeys) || len(s.Signatures) != len(s.Messages) {
+		return -1, errors.Errorf("provided signatures, pubkeys and messages have differing lengths. S: %d, P: %d,M %d",
+			len(s.Signatures), len(s.PublicKeys), len(s.Messages))
+	}
+	for i, rawSig := range s.Signatures {
+		sig, err := SignatureFromBytes(rawSig)
+		if err != nil {
+			return i, nil
+		}
+		if !sig.Verify(s.PublicKeys[i], s.Messages[i][:]) {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
@@ -0,0 +1,82 @@
+package bls
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func signedSet(t testing.TB, n int) *SignatureSet {
+	set := NewSet()
+	for i := 0; i < n; i++ {
+		priv, err := RandKey()
+		require.NoError(t, err)
+		msg := [32]byte{byte(i), byte(i >> 8)}
+		set.Signatures = append(set.Signatures, priv.Sign(msg[:]).Marshal())
+		set.PublicKeys = append(set.PublicKeys, priv.PublicKey())
+		set.Messages = append(set.Messages, msg)
+	}
+	return set
+}
+
+func TestSignatureSet_Split(t *testing.T) {
+	set := signedSet(t, 5)
+
+	assert.Equal(t, 1, len(set.Split(0)))
+	assert.Equal(t, 1, len(set.Split(5)))
+	sets := set.Split(2)
+	require.Equal(t, 3, len(sets))
+	assert.Equal(t, 2, len(sets[0].Signatures))
+	assert.Equal(t, 2, len(sets[1].Signatures))
+	assert.Equal(t, 1, len(sets[2].Signatures))
+	assert.DeepEqual(t, set.Messages[4], sets[2].Messages[0])
+	for _, s := range sets {
+		valid, err := s.Verify()
+		require.NoError(t, err)
+		assert.Equal(t, true, valid)
+	}
+}
+
+func TestSignatureSet_FindInvalid(t *testing.T) {
+	set := signedSet(t, 4)
+	idx, err := set.FindInvalid()
+	require.NoError(t, err)
+	assert.Equal(t, -1, idx)
+
+	// Replace the message of the third signature.
+	set.Messages[2] = [32]byte{'b', 'a', 'd'}
+	valid, err := set.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, false, valid)
+	idx, err = set.FindInvalid()
+	require.NoError(t, err)
+	assert.Equal(t, 2, idx)
+}
+
+// A block with 128 attestations, verified one by one and in batches of various sizes.
+func BenchmarkSignatureSet_Verify(b *testing.B) {
+	set := signedSet(b, 128)
+
+	b.Run("individually", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j, rawSig := range set.Signatures {
+				sig, err := SignatureFromBytes(rawSig)
+				require.NoError(b, err)
+				require.Equal(b, true, sig.Verify(set.PublicKeys[j], set.Messages[j][:]))
+			}
+		}
+	})
+	for _, size := range []int{16, 32, 64, 128} {
+		b.Run(fmt.Sprintf("batches of %d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, batch := range set.Split(size) {
+					valid, err := batch.Verify()
+					require.NoError(b, err)
+					require.Equal(b, true, valid)
+				}
+			}
+		})
+	}
+}
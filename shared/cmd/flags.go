@@ -89,7 +89,7 @@ var (
 	// StaticPeers specifies a set of peers to connect to explicitly.
 	StaticPeers = &cli.StringSliceFlag{
 		Name:  "peer",
-		Usage: "Connect with this peer. This flag may be used multiple times.",
+		Usage: "Connect with this peer. Static peers are trusted and are never disconnected to stay within the max peer limit. This flag may be used multiple times.",
 	}
 	// BootstrapNode tells the beacon node which bootstrap node to connect to
 	BootstrapNode = &cli.StringSliceFlag{
@@ -231,6 +231,11 @@ var (
 		Usage: "Target directory of the restored database",
 		Value: DefaultDataDir(),
 	}
+	// VerifyRepairFlag prunes the dangling entries found when verifying the database.
+	VerifyRepairFlag = &cli.BoolFlag{
+		Name:  "repair",
+		Usage: "Prunes the unreachable blocks, states and state summaries found when verifying the database. Finalized data is never deleted",
+	}
 	// BoltMMapInitialSizeFlag specifies the initial size in bytes of boltdb's mmap syscall.
 	BoltMMapInitialSizeFlag = &cli.IntFlag{
 		Name:  "bolt-mmap-initial-size",
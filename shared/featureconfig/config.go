@@ -47,8 +47,10 @@ type Flags struct {
 	EnableLargerGossipHistory          bool // EnableLargerGossipHistory increases the gossip history we store in our caches.
 	WriteWalletPasswordOnWebOnboarding bool // WriteWalletPasswordOnWebOnboarding writes the password to disk after Prysm web signup.
 	DisableAttestingHistoryDBCache     bool // DisableAttestingHistoryDBCache for the validator client increases disk reads/writes.
+	DisableSelectionProofCache         bool // DisableSelectionProofCache makes the validator client sign selection proofs every time they are needed.
 	UpdateHeadTimely                   bool // UpdateHeadTimely updates head right after state transition.
 	ProposerAttsSelectionUsingMaxCover bool // ProposerAttsSelectionUsingMaxCover enables max-cover algorithm when selecting attestations for proposing.
+	EnableAdaptiveBlockBatching        bool // EnableAdaptiveBlockBatching adapts the initial sync blocks by range batch size to peer responsiveness.
 
 	// Logging related toggles.
 	DisableGRPCConnectionLogs bool // Disables logging when a new grpc client has connected.
@@ -169,6 +171,10 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		log.WithField(disableBlst.Name, disableBlst.Usage).Warn(enabledFeatureFlag)
 		cfg.EnableBlst = false
 	}
+	if ctx.Bool(enableAdaptiveBlockBatching.Name) {
+		log.WithField(enableAdaptiveBlockBatching.Name, enableAdaptiveBlockBatching.Usage).Warn(enabledFeatureFlag)
+		cfg.EnableAdaptiveBlockBatching = true
+	}
 	if ctx.Bool(enableLargerGossipHistory.Name) {
 		log.WithField(enableLargerGossipHistory.Name, enableLargerGossipHistory.Usage).Warn(enabledFeatureFlag)
 		cfg.EnableLargerGossipHistory = true
@@ -224,6 +230,10 @@ func ConfigureValidator(ctx *cli.Context) {
 		log.WithField(disableAttestingHistoryDBCache.Name, disableAttestingHistoryDBCache.Usage).Warn(enabledFeatureFlag)
 		cfg.DisableAttestingHistoryDBCache = true
 	}
+	if ctx.Bool(disableSelectionProofCache.Name) {
+		log.WithField(disableSelectionProofCache.Name, disableSelectionProofCache.Usage).Warn(enabledFeatureFlag)
+		cfg.DisableSelectionProofCache = true
+	}
 	cfg.EnableBlst = true
 	if ctx.Bool(disableBlst.Name) {
 		log.WithField(disableBlst.Name, disableBlst.Usage).Warn(enabledFeatureFlag)
@@ -73,6 +73,11 @@ var (
 		Name:  "use-check-point-cache",
 		Usage: "Enables check point info caching",
 	}
+	enableAdaptiveBlockBatching = &cli.BoolFlag{
+		Name: "enable-adaptive-block-batching",
+		Usage: "Enables initial sync to grow the number of blocks requested per batch while peers respond quickly, " +
+			"and shrink it on timeouts or invalid responses, holding off requests while fetched blocks wait to be processed",
+	}
 	enableLargerGossipHistory = &cli.BoolFlag{
 		Name:  "enable-larger-gossip-history",
 		Usage: "Enables the node to store a larger amount of gossip messages in its cache.",
@@ -87,6 +92,11 @@ var (
 		Usage: "(Danger): Disables the cache for attesting history in the validator DB, greatly increasing " +
 			"disk reads and writes as well as increasing time required for attestations to be produced",
 	}
+	disableSelectionProofCache = &cli.BoolFlag{
+		Name: "disable-selection-proof-cache",
+		Usage: "Disables the cache of aggregation selection proofs in the validator client, signing them again " +
+			"every time the aggregator duties are computed",
+	}
 	dynamicKeyReloadDebounceInterval = &cli.DurationFlag{
 		Name: "dynamic-key-reload-debounce-interval",
 		Usage: "(Advanced): Specifies the time duration the validator waits to reload new keys if they have " +
@@ -129,6 +139,7 @@ var ValidatorFlags = append(deprecatedFlags, []cli.Flag{
 	writeWalletPasswordOnWebOnboarding,
 	enableExternalSlasherProtectionFlag,
 	disableAttestingHistoryDBCache,
+	disableSelectionProofCache,
 	ToledoTestnet,
 	PyrmontTestnet,
 	Mainnet,
@@ -161,6 +172,7 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	Mainnet,
 	disableBlst,
 	enablePeerScorer,
+	enableAdaptiveBlockBatching,
 	enableLargerGossipHistory,
 	checkPtInfoCache,
 	disableBroadcastSlashingFlag,
@@ -175,4 +187,5 @@ var E2EBeaconChainFlags = []string{
 	"--attestation-aggregation-strategy=opt_max_cover",
 	"--dev",
 	"--use-check-point-cache",
+	"--enable-adaptive-block-batching",
 }
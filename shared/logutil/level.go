@@ -0,0 +1,142 @@
+package logutil
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// prefixField is the field set by the loggers of the subsystems, such as "p2p" or "sync".
+const prefixField = "prefix"
+
+var (
+	levelsLock      sync.RWMutex
+	processLevel    = logrus.InfoLevel
+	subsystemLevels = make(map[string]logrus.Level)
+)
+
+// SubsystemLevel is the log level set for a single subsystem.
+type SubsystemLevel struct {
+	Subsystem string
+	Level     logrus.Level
+}
+
+// ParseLevel parses a logrus level name, such as "debug", rejecting unknown names.
+func ParseLevel(name string) (logrus.Level, error) {
+	level, err := logrus.ParseLevel(name)
+	if err != nil {
+		return 0, errors.Errorf("unknown log level %q", name)
+	}
+	return level, nil
+}
+
+// SetLevel sets the log level of the whole process. Any level previously set for a
+// single subsystem is cleared.
+func SetLevel(level logrus.Level) {
+	levelsLock.Lock()
+	defer levelsLock.Unlock()
+	processLevel = level
+	subsystemLevels = make(map[string]logrus.Level)
+	logrus.SetLevel(level)
+}
+
+// SetSubsystemLevel sets the log level of a single subsystem, as identified by the prefix of its
+// logger, leaving the level of the rest of the process unchanged. The entries of the subsystem are
+// only filtered by level if the standard logger is set up with FilterSubsystemLevels.
+func SetSubsystemLevel(subsystem string, level logrus.Level) {
+	levelsLock.Lock()
+	defer levelsLock.Unlock()
+	subsystemLevels[subsystem] = level
+	// The logger drops entries before they reach the hooks and the formatter, so it has to let
+	// the entries of the most verbose subsystem through.
+	maxLevel := processLevel
+	for _, l := range subsystemLevels {
+		if l > maxLevel {
+			maxLevel = l
+		}
+	}
+	logrus.SetLevel(maxLevel)
+}
+
+// Levels returns the log level of the whole process and the levels set for single subsystems,
+// sorted by subsystem.
+func Levels() (logrus.Level, []SubsystemLevel) {
+	levelsLock.RLock()
+	defer levelsLock.RUnlock()
+	subsystems := make([]SubsystemLevel, 0, len(subsystemLevels))
+	for s, l := range subsystemLevels {
+		subsystems = append(subsystems, SubsystemLevel{Subsystem: s, Level: l})
+	}
+	sort.Slice(subsystems, func(i, j int) bool {
+		return subsystems[i].Subsystem < subsystems[j].Subsystem
+	})
+	return processLevel, subsystems
+}
+
+// enabled returns whether an entry of the given level and subsystem should be written.
+func enabled(subsystem string, level logrus.Level) bool {
+	levelsLock.RLock()
+	defer levelsLock.RUnlock()
+	maxLevel, ok := subsystemLevels[subsystem]
+	if !ok {
+		maxLevel = processLevel
+	}
+	return level <= maxLevel
+}
+
+type subsystemLevelFormatter struct {
+	logrus.Formatter
+}
+
+// Format an entry with the wrapped formatter, or return nothing if the entry is filtered out.
+func (f *subsystemLevelFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	if !entryEnabled(entry) {
+		return nil, nil
+	}
+	return f.Formatter.Format(entry)
+}
+
+type subsystemLevelHook struct {
+	logrus.Hook
+}
+
+// Fire the wrapped hook, unless the entry is filtered out.
+func (h *subsystemLevelHook) Fire(entry *logrus.Entry) error {
+	if !entryEnabled(entry) {
+		return nil
+	}
+	return h.Hook.Fire(entry)
+}
+
+// FilterSubsystemLevels makes the logger skip the entries above the log level of their subsystem,
+// as set by SetSubsystemLevel, both in its output and in the hooks registered so far. Hooks added
+// afterwards should be registered with AddHook.
+func FilterSubsystemLevels(logger *logrus.Logger) {
+	if _, ok := logger.Formatter.(*subsystemLevelFormatter); !ok {
+		logger.SetFormatter(&subsystemLevelFormatter{Formatter: logger.Formatter})
+	}
+	hooks := make(logrus.LevelHooks)
+	for level, levelHooks := range logger.Hooks {
+		for _, hook := range levelHooks {
+			if _, ok := hook.(*subsystemLevelHook); !ok {
+				hook = &subsystemLevelHook{Hook: hook}
+			}
+			hooks[level] = append(hooks[level], hook)
+		}
+	}
+	logger.ReplaceHooks(hooks)
+}
+
+// AddHook adds a hook to the standard logger which skips the entries above the log level of
+// their subsystem.
+func AddHook(hook logrus.Hook) {
+	logrus.AddHook(&subsystemLevelHook{Hook: hook})
+}
+
+// entryEnabled returns whether the entry passes the log level of its subsystem.
+func entryEnabled(entry *logrus.Entry) bool {
+	subsystem, _ := entry.Data[prefixField].(string)
+	return enabled(subsystem, entry.Level)
+}
@@ -0,0 +1,76 @@
+package logutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+)
+
+func TestParseLevel(t *testing.T) {
+	level, err := ParseLevel("debug")
+	require.NoError(t, err)
+	assert.Equal(t, logrus.DebugLevel, level)
+	_, err = ParseLevel("verbose")
+	assert.ErrorContains(t, "unknown log level \"verbose\"", err)
+}
+
+func TestSubsystemLevels(t *testing.T) {
+	logger := logrus.StandardLogger()
+	formatter, out, level := logger.Formatter, logger.Out, logger.GetLevel()
+	hooks := logger.ReplaceHooks(make(logrus.LevelHooks))
+	defer func() {
+		SetLevel(level)
+		logger.SetFormatter(formatter)
+		logger.SetOutput(out)
+		logger.ReplaceHooks(hooks)
+	}()
+	buf := new(bytes.Buffer)
+	logger.SetOutput(buf)
+	logger.SetFormatter(&logrus.TextFormatter{DisableTimestamp: true})
+	// One hook is registered before the logger is set up, the other one afterwards.
+	earlyHook, lateHook := &messagesHook{}, &messagesHook{}
+	logger.AddHook(earlyHook)
+	FilterSubsystemLevels(logger)
+	AddHook(lateHook)
+
+	SetLevel(logrus.InfoLevel)
+	SetSubsystemLevel("p2p", logrus.DebugLevel)
+	assert.Equal(t, logrus.DebugLevel, logger.GetLevel())
+	processLevel, subsystems := Levels()
+	assert.Equal(t, logrus.InfoLevel, processLevel)
+	assert.DeepEqual(t, []SubsystemLevel{{Subsystem: "p2p", Level: logrus.DebugLevel}}, subsystems)
+
+	logrus.WithField("prefix", "p2p").Debug("p2p debug")
+	logrus.WithField("prefix", "sync").Debug("sync debug")
+	logrus.Debug("process debug")
+	logrus.WithField("prefix", "sync").Info("sync info")
+	assert.Equal(t, true, bytes.Contains(buf.Bytes(), []byte("p2p debug")))
+	assert.Equal(t, false, bytes.Contains(buf.Bytes(), []byte("sync debug")))
+	assert.Equal(t, false, bytes.Contains(buf.Bytes(), []byte("process debug")))
+	assert.Equal(t, true, bytes.Contains(buf.Bytes(), []byte("sync info")))
+	wanted := []string{"p2p debug", "sync info"}
+	assert.DeepEqual(t, wanted, earlyHook.messages)
+	assert.DeepEqual(t, wanted, lateHook.messages)
+
+	SetLevel(logrus.WarnLevel)
+	processLevel, subsystems = Levels()
+	assert.Equal(t, logrus.WarnLevel, processLevel)
+	assert.Equal(t, 0, len(subsystems))
+	assert.Equal(t, logrus.WarnLevel, logger.GetLevel())
+}
+
+type messagesHook struct {
+	messages []string
+}
+
+func (h *messagesHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *messagesHook) Fire(entry *logrus.Entry) error {
+	h.messages = append(h.messages, entry.Message)
+	return nil
+}
@@ -25,7 +25,7 @@ var mainnetNetworkConfig = &NetworkConfig{
 	MaxRequestBlocks:                1 << 10, // 1024
 	TtfbTimeout:                     5 * time.Second,
 	RespTimeout:                     10 * time.Second,
-	MaximumGossipClockDisparity:     500 * time.Millisecond,
+	MaximumGossipClockDisparity:     SpecMaximumGossipClockDisparity,
 	MessageDomainInvalidSnappy:      [4]byte{00, 00, 00, 00},
 	MessageDomainValidSnappy:        [4]byte{01, 00, 00, 00},
 	ETH2Key:                         "eth2",
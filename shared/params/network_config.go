@@ -31,6 +31,14 @@ type NetworkConfig struct {
 	BootstrapNodes          []string // BootstrapNodes are the addresses of the bootnodes.
 }
 
+// SpecMaximumGossipClockDisparity is the maximum clock disparity between honest nodes
+// defined by the networking specification.
+const SpecMaximumGossipClockDisparity = 500 * time.Millisecond
+
+// MaxGossipClockDisparityTolerance is the highest clock disparity an operator can configure. Larger
+// values would let the node accept and propagate messages which honest peers consider early.
+const MaxGossipClockDisparityTolerance = 2 * time.Second
+
 var networkConfig = mainnetNetworkConfig
 
 // BeaconNetworkConfig returns the current network config for
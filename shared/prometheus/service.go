@@ -37,12 +37,25 @@ type Handler struct {
 // NewService sets up a new instance for a given address host:port.
 // An empty host will match with any IP so an address like ":2121" is perfectly acceptable.
 func NewService(addr string, svcRegistry *shared.ServiceRegistry, additionalHandlers ...Handler) *Service {
+	return newService(addr, svcRegistry, false /* openMetrics */, additionalHandlers)
+}
+
+// NewServiceWithExemplars sets up a service like NewService, whose /metrics route also serves the
+// OpenMetrics format to the scrapers requesting it. This exposes the exemplars, such as trace IDs,
+// attached to histogram observations. Scrapers which do not request OpenMetrics still get the plain
+// Prometheus format.
+func NewServiceWithExemplars(addr string, svcRegistry *shared.ServiceRegistry, additionalHandlers ...Handler) *Service {
+	return newService(addr, svcRegistry, true /* openMetrics */, additionalHandlers)
+}
+
+func newService(addr string, svcRegistry *shared.ServiceRegistry, openMetrics bool, additionalHandlers []Handler) *Service {
 	s := &Service{svcRegistry: svcRegistry}
 
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{
 		MaxRequestsInFlight: 5,
 		Timeout:             30 * time.Second,
+		EnableOpenMetrics:   openMetrics,
 	}))
 	mux.HandleFunc("/healthz", s.healthzHandler)
 	mux.HandleFunc("/goroutinez", s.goroutinezHandler)
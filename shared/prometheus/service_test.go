@@ -172,3 +172,17 @@ func TestContentNegotiation(t *testing.T) {
 		}
 	})
 }
+
+func TestMetrics_OpenMetrics(t *testing.T) {
+	req, err := http.NewRequest("GET", "/metrics", nil /* body */)
+	require.NoError(t, err)
+	req.Header.Add("Accept", "application/openmetrics-text; version=0.0.1,text/plain;version=0.0.4;q=0.5")
+
+	rr := httptest.NewRecorder()
+	NewService("", nil).server.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, true, strings.HasPrefix(rr.Header().Get("Content-Type"), "text/plain"))
+
+	rr = httptest.NewRecorder()
+	NewServiceWithExemplars("", nil).server.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, true, strings.HasPrefix(rr.Header().Get("Content-Type"), "application/openmetrics-text"))
+}
@@ -0,0 +1,26 @@
+package traceutil
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opencensus.io/trace"
+)
+
+// traceIDLabel is the exemplar label holding the trace ID, as expected by tracing backends.
+const traceIDLabel = "trace_id"
+
+// ObserveWithTraceID records a value in a histogram. If the span of the context is sampled, its
+// trace ID is attached to the observation as an exemplar, linking the metric to the trace. Exemplars
+// are only exposed when metrics are scraped in the OpenMetrics format.
+func ObserveWithTraceID(ctx context.Context, o prometheus.Observer, value float64) {
+	if span := trace.FromContext(ctx); span != nil {
+		if sc := span.SpanContext(); sc.IsSampled() {
+			if eo, ok := o.(prometheus.ExemplarObserver); ok {
+				eo.ObserveWithExemplar(value, prometheus.Labels{traceIDLabel: sc.TraceID.String()})
+				return
+			}
+		}
+	}
+	o.Observe(value)
+}
@@ -0,0 +1,43 @@
+package traceutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"go.opencensus.io/trace"
+)
+
+type fakeObserver struct {
+	values    []float64
+	exemplars []prometheus.Labels
+}
+
+func (o *fakeObserver) Observe(v float64) {
+	o.values = append(o.values, v)
+	o.exemplars = append(o.exemplars, nil)
+}
+
+func (o *fakeObserver) ObserveWithExemplar(v float64, l prometheus.Labels) {
+	o.values = append(o.values, v)
+	o.exemplars = append(o.exemplars, l)
+}
+
+func TestObserveWithTraceID(t *testing.T) {
+	o := &fakeObserver{}
+	ObserveWithTraceID(context.Background(), o, 1)
+	ctx, unsampled := trace.StartSpan(context.Background(), "test", trace.WithSampler(trace.NeverSample()))
+	ObserveWithTraceID(ctx, o, 2)
+	unsampled.End()
+	ctx, sampled := trace.StartSpan(context.Background(), "test", trace.WithSampler(trace.AlwaysSample()))
+	ObserveWithTraceID(ctx, o, 3)
+	sampled.End()
+
+	assert.DeepEqual(t, []float64{1, 2, 3}, o.values)
+	assert.DeepEqual(t, []prometheus.Labels{
+		nil,
+		nil,
+		{traceIDLabel: sampled.SpanContext().TraceID.String()},
+	}, o.exemplars)
+}
@@ -10,6 +10,8 @@ import (
 	ptypes "github.com/gogo/protobuf/types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	slashertypes "github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
@@ -140,7 +142,10 @@ func (s *Service) ReceiveAttestations(ctx context.Context) {
 		if res == nil {
 			continue
 		}
-		s.receivedAttestationsBuffer <- res
+		s.receivedAttestationsBuffer <- &slashertypes.IndexedAttestationWrapper{
+			IndexedAttestation: res,
+			ReceivedAt:         timeutils.Now(),
+		}
 	}
 }
 
@@ -148,7 +153,7 @@ func (s *Service) collectReceivedAttestations(ctx context.Context) {
 	ctx, span := trace.StartSpan(ctx, "beaconclient.collectReceivedAttestations")
 	defer span.End()
 
-	var atts []*ethpb.IndexedAttestation
+	var atts []*slashertypes.IndexedAttestationWrapper
 	halfSlot := slotutil.DivideSlotBy(2 /* 1/2 slot duration */)
 	ticker := time.NewTicker(halfSlot)
 	defer ticker.Stop()
@@ -157,28 +162,32 @@ func (s *Service) collectReceivedAttestations(ctx context.Context) {
 		case <-ticker.C:
 			if len(atts) > 0 {
 				s.collectedAttestationsBuffer <- atts
-				atts = []*ethpb.IndexedAttestation{}
+				atts = []*slashertypes.IndexedAttestationWrapper{}
 			}
 		case att := <-s.receivedAttestationsBuffer:
 			atts = append(atts, att)
 		case collectedAtts := <-s.collectedAttestationsBuffer:
-			if err := s.slasherDB.SaveIndexedAttestations(ctx, collectedAtts); err != nil {
+			indexedAtts := make([]*ethpb.IndexedAttestation, len(collectedAtts))
+			for i, wrapper := range collectedAtts {
+				indexedAtts[i] = wrapper.IndexedAttestation
+			}
+			if err := s.slasherDB.SaveIndexedAttestations(ctx, indexedAtts); err != nil {
 				log.WithError(err).Error("Could not save indexed attestation")
 				continue
 			}
 			log.WithFields(logrus.Fields{
-				"amountSaved": len(collectedAtts),
-				"slot":        collectedAtts[0].Data.Slot,
+				"amountSaved": len(indexedAtts),
+				"slot":        indexedAtts[0].Data.Slot,
 			}).Info("Attestations saved to slasher DB")
-			slasherNumAttestationsReceived.Add(float64(len(collectedAtts)))
+			slasherNumAttestationsReceived.Add(float64(len(indexedAtts)))
 
 			// After saving, we send the received attestation over the attestation feed.
-			for _, att := range collectedAtts {
+			for _, wrapper := range collectedAtts {
 				log.WithFields(logrus.Fields{
-					"slot":    att.Data.Slot,
-					"indices": att.AttestingIndices,
+					"slot":    wrapper.IndexedAttestation.Data.Slot,
+					"indices": wrapper.IndexedAttestation.AttestingIndices,
 				}).Debug("Sending attestation to detection service")
-				s.attestationFeed.Send(att)
+				s.attestationFeed.Send(wrapper)
 			}
 		case <-ctx.Done():
 			return
@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
 )
 
 func TestService_ReceiveBlocks(t *testing.T) {
@@ -48,8 +49,8 @@ func TestService_ReceiveAttestations(t *testing.T) {
 	bs := Service{
 		beaconClient:                client,
 		blockFeed:                   new(event.Feed),
-		receivedAttestationsBuffer:  make(chan *ethpb.IndexedAttestation, 1),
-		collectedAttestationsBuffer: make(chan []*ethpb.IndexedAttestation, 1),
+		receivedAttestationsBuffer:  make(chan *slashertypes.IndexedAttestationWrapper, 1),
+		collectedAttestationsBuffer: make(chan []*slashertypes.IndexedAttestationWrapper, 1),
 	}
 	stream := mock.NewMockBeaconChain_StreamIndexedAttestationsClient(ctrl)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -82,8 +83,8 @@ func TestService_ReceiveAttestations_Batched(t *testing.T) {
 		blockFeed:                   new(event.Feed),
 		slasherDB:                   testDB.SetupSlasherDB(t, false),
 		attestationFeed:             new(event.Feed),
-		receivedAttestationsBuffer:  make(chan *ethpb.IndexedAttestation, 1),
-		collectedAttestationsBuffer: make(chan []*ethpb.IndexedAttestation, 1),
+		receivedAttestationsBuffer:  make(chan *slashertypes.IndexedAttestationWrapper, 1),
+		collectedAttestationsBuffer: make(chan []*slashertypes.IndexedAttestationWrapper, 1),
 	}
 	stream := mock.NewMockBeaconChain_StreamIndexedAttestationsClient(ctrl)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -112,11 +113,11 @@ func TestService_ReceiveAttestations_Batched(t *testing.T) {
 	})
 
 	go bs.ReceiveAttestations(ctx)
-	bs.receivedAttestationsBuffer <- att
+	bs.receivedAttestationsBuffer <- &slashertypes.IndexedAttestationWrapper{IndexedAttestation: att}
 	att.Data.Target.Root = []byte("test root 2")
-	bs.receivedAttestationsBuffer <- att
+	bs.receivedAttestationsBuffer <- &slashertypes.IndexedAttestationWrapper{IndexedAttestation: att}
 	att.Data.Target.Root = []byte("test root 3")
-	bs.receivedAttestationsBuffer <- att
+	bs.receivedAttestationsBuffer <- &slashertypes.IndexedAttestationWrapper{IndexedAttestation: att}
 	atts := <-bs.collectedAttestationsBuffer
 	require.Equal(t, 3, len(atts), "Unexpected number of attestations batched")
 }
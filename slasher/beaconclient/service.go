@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/slasher/cache"
 	"github.com/prysmaticlabs/prysm/slasher/db"
+	slashertypes "github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -54,8 +55,8 @@ type Service struct {
 	attesterSlashingsChan       chan *ethpb.AttesterSlashing
 	attesterSlashingsFeed       *event.Feed
 	proposerSlashingsFeed       *event.Feed
-	receivedAttestationsBuffer  chan *ethpb.IndexedAttestation
-	collectedAttestationsBuffer chan []*ethpb.IndexedAttestation
+	receivedAttestationsBuffer  chan *slashertypes.IndexedAttestationWrapper
+	collectedAttestationsBuffer chan []*slashertypes.IndexedAttestationWrapper
 	publicKeyCache              *cache.PublicKeyCache
 	genesisValidatorRoot        []byte
 	beaconDialOptions           []grpc.DialOption
@@ -94,8 +95,8 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		attesterSlashingsChan:       make(chan *ethpb.AttesterSlashing, 1),
 		attesterSlashingsFeed:       cfg.AttesterSlashingsFeed,
 		proposerSlashingsFeed:       cfg.ProposerSlashingsFeed,
-		receivedAttestationsBuffer:  make(chan *ethpb.IndexedAttestation, 1),
-		collectedAttestationsBuffer: make(chan []*ethpb.IndexedAttestation, 1),
+		receivedAttestationsBuffer:  make(chan *slashertypes.IndexedAttestationWrapper, 1),
+		collectedAttestationsBuffer: make(chan []*slashertypes.IndexedAttestationWrapper, 1),
 		publicKeyCache:              publicKeyCache,
 		beaconClient:                cfg.BeaconClient,
 		nodeClient:                  cfg.NodeClient,
@@ -109,7 +110,8 @@ func (s *Service) BlockFeed() *event.Feed {
 }
 
 // AttestationFeed returns a feed other services in slasher can subscribe to
-// attestations received via the beacon node through gRPC.
+// attestations received via the beacon node through gRPC. Attestations are sent
+// wrapped with the time they were received.
 func (s *Service) AttestationFeed() *event.Feed {
 	return s.attestationFeed
 }
@@ -27,5 +27,21 @@ var DatabaseCommands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "compact",
+			Description: `compacts the slasher database into a fresh file to reclaim disk space freed by pruning.
+The slasher must be stopped while compacting, and the data directory needs enough free space to hold
+a full copy of the live database. Downtime grows with the size of the database.`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := compact(cliCtx); err != nil {
+					log.Fatalf("Could not compact database: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
@@ -0,0 +1,34 @@
+package db
+
+import (
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/slasher/db/kv"
+	"github.com/urfave/cli/v2"
+)
+
+func compact(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	dbDir := path.Join(dataDir, kv.SlasherDbDirName)
+	if !fileutil.FileExists(path.Join(dbDir, kv.DatabaseFileName)) {
+		return errors.Errorf("no slasher database found in %s", dbDir)
+	}
+	// Opening the store fails if a running slasher holds the database lock.
+	store, err := kv.NewKVStore(dbDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not open slasher database")
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			log.WithError(err).Error("Could not close slasher database")
+		}
+	}()
+	if err := store.Compact(cliCtx.Context); err != nil {
+		return err
+	}
+	log.Info("Compaction completed successfully")
+	return nil
+}
@@ -103,6 +103,7 @@ type Database interface {
 	FullAccessDatabase
 	DatabasePath() string
 	ClearDB() error
+	Compact(ctx context.Context) error
 }
 
 // EpochSpansStore represents a data access layer for marshaling and unmarshaling validator spans for each validator per epoch.
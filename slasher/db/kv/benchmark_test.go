@@ -97,3 +97,49 @@ func BenchmarkStore_SetValidatorSpan(b *testing.B) {
 		require.NoError(b, err, "Read validator span map failed")
 	}
 }
+
+func BenchmarkStore_SaveEpochSpans_Compressed(b *testing.B) {
+	ctx := context.Background()
+	db := setupDB(b)
+	db.compressSpans = true
+	es := sparseEpochStore(b)
+	b.ReportMetric(float64(len(es.Bytes()))/float64(len(compressSpans(es.Bytes()))), "compression_ratio")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err := db.SaveEpochSpans(ctx, types.Epoch(i%54000), es, false)
+		require.NoError(b, err, "Save validator span map failed")
+	}
+}
+
+func BenchmarkStore_EpochSpans_Compressed(b *testing.B) {
+	ctx := context.Background()
+	db := setupDB(b)
+	db.compressSpans = true
+	es := sparseEpochStore(b)
+	for i := 0; i < 200; i++ {
+		err := db.SaveEpochSpans(ctx, types.Epoch(i), es, false)
+		require.NoError(b, err, "Save validator span map failed")
+	}
+	b.Log(db.db.Info())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := db.EpochSpans(ctx, types.Epoch(i%200), false)
+		require.NoError(b, err, "Read validator span map failed")
+	}
+}
+
+// sparseEpochStore returns an epoch store in which only one in every hundred
+// validators has attested, mirroring the sparse spans seen in practice.
+func sparseEpochStore(b *testing.B) *slashertypes.EpochStore {
+	sigBytes := [2]byte{}
+	es := &slashertypes.EpochStore{}
+	es, err := es.SetValidatorSpan(benchmarkValidator, slashertypes.Span{MinSpan: 1, MaxSpan: 2, SigBytes: sigBytes, HasAttested: true})
+	require.NoError(b, err)
+	for i := 0; i < benchmarkValidator; i += 100 {
+		es, err = es.SetValidatorSpan(uint64(i), slashertypes.Span{MinSpan: 1, MaxSpan: 2, SigBytes: sigBytes, HasAttested: true})
+		require.NoError(b, err)
+	}
+	return es
+}
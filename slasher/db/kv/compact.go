@@ -0,0 +1,247 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// compactTxMaxSize bounds the amount of data written to the compacted database
+// in a single transaction, keeping memory usage flat for large databases.
+const compactTxMaxSize = 64 * 1024 * 1024
+
+// Compact rewrites the database into a fresh file and atomically swaps it in place
+// of the current database file. BoltDB reuses pages freed by pruning but never returns
+// them to the filesystem, so this is the only way to reclaim that disk space.
+//
+// Compaction must only be run while the slasher is stopped, as the database is closed
+// and reopened during the swap. Expect downtime proportional to the amount of live data,
+// and make sure the data directory has enough free space to hold a second copy of it.
+// The compacted copy is integrity checked before it replaces the original file, so a
+// failed compaction leaves the existing database untouched.
+func (s *Store) Compact(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.Compact")
+	defer span.End()
+
+	dbPath := path.Join(s.databasePath, DatabaseFileName)
+	compactedPath := dbPath + ".compact"
+	if err := os.RemoveAll(compactedPath); err != nil {
+		return errors.Wrap(err, "could not remove stale compacted database")
+	}
+	initialSize, err := fileSize(dbPath)
+	if err != nil {
+		return err
+	}
+
+	log.WithField("path", compactedPath).Info("Compacting slasher database")
+	compactedDB, err := bolt.Open(
+		compactedPath,
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout},
+	)
+	if err != nil {
+		return err
+	}
+	if err := compactInto(ctx, compactedDB, s.db); err != nil {
+		closeAndRemove(compactedDB, compactedPath)
+		return errors.Wrap(err, "could not copy database contents")
+	}
+	if err := verifyCompaction(compactedDB, s.db); err != nil {
+		closeAndRemove(compactedDB, compactedPath)
+		return errors.Wrap(err, "compacted database failed integrity check")
+	}
+	if err := compactedDB.Close(); err != nil {
+		return err
+	}
+
+	// Swap in the compacted database file. The rename is atomic so the database
+	// file is never left in a partially written state.
+	if err := s.db.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(compactedPath, dbPath); err != nil {
+		return errors.Wrap(err, "could not replace database with compacted copy")
+	}
+	s.db, err = bolt.Open(
+		dbPath,
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout},
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not reopen compacted database")
+	}
+
+	compactedSize, err := fileSize(dbPath)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"initialSize":   initialSize,
+		"compactedSize": compactedSize,
+	}).Info("Slasher database compacted")
+	return nil
+}
+
+// walkFunc is called for every bucket and key in a database. Keys of nested
+// buckets are passed with a nil value.
+type walkFunc func(keyPath [][]byte, k, v []byte, seq uint64) error
+
+// compactInto copies all buckets and keys from src into dst, committing the
+// destination transaction every compactTxMaxSize bytes.
+func compactInto(ctx context.Context, dst, src *bolt.DB) error {
+	var size int64
+	tx, err := dst.Begin(true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Rolling back an already committed transaction is a no-op.
+		_ = tx.Rollback()
+	}()
+
+	if err := walk(src, func(keyPath [][]byte, k, v []byte, seq uint64) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		sz := int64(len(k) + len(v))
+		if size+sz > compactTxMaxSize {
+			if err := tx.Commit(); err != nil {
+				return err
+			}
+			tx, err = dst.Begin(true)
+			if err != nil {
+				return err
+			}
+			size = 0
+		}
+		size += sz
+
+		if len(keyPath) == 0 {
+			b, err := tx.CreateBucket(k)
+			if err != nil {
+				return err
+			}
+			return b.SetSequence(seq)
+		}
+		b := tx.Bucket(keyPath[0])
+		for _, key := range keyPath[1:] {
+			b = b.Bucket(key)
+		}
+		if v == nil {
+			nb, err := b.CreateBucket(k)
+			if err != nil {
+				return err
+			}
+			return nb.SetSequence(seq)
+		}
+		return b.Put(k, v)
+	}); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func walk(db *bolt.DB, fn walkFunc) error {
+	return db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			return walkBucket(b, nil, name, nil, b.Sequence(), fn)
+		})
+	})
+}
+
+func walkBucket(b *bolt.Bucket, keyPath [][]byte, k, v []byte, seq uint64, fn walkFunc) error {
+	if err := fn(keyPath, k, v, seq); err != nil {
+		return err
+	}
+	// Only buckets have children to walk.
+	if v != nil {
+		return nil
+	}
+	keyPath = append(keyPath, k)
+	return b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			nested := b.Bucket(k)
+			return walkBucket(nested, keyPath, k, nil, nested.Sequence(), fn)
+		}
+		return walkBucket(b, keyPath, k, v, b.Sequence(), fn)
+	})
+}
+
+// verifyCompaction runs the BoltDB consistency check on the compacted database
+// and ensures it holds every bucket and key of the original with the same value,
+// and no other entries.
+func verifyCompaction(compacted, original *bolt.DB) error {
+	return compacted.View(func(compactedTx *bolt.Tx) error {
+		for err := range compactedTx.Check() {
+			if err != nil {
+				return err
+			}
+		}
+		// The walk visits a bucket before its keys, so the buckets of the key path
+		// are known to exist in the compacted database.
+		originalEntries := 0
+		if err := walk(original, func(keyPath [][]byte, k, v []byte, _ uint64) error {
+			originalEntries++
+			if len(keyPath) == 0 {
+				if compactedTx.Bucket(k) == nil {
+					return fmt.Errorf("bucket %s missing from compacted database", k)
+				}
+				return nil
+			}
+			b := compactedTx.Bucket(keyPath[0])
+			for _, key := range keyPath[1:] {
+				b = b.Bucket(key)
+			}
+			if v == nil {
+				if b.Bucket(k) == nil {
+					return fmt.Errorf("bucket %s missing from compacted database", k)
+				}
+				return nil
+			}
+			if !bytes.Equal(v, b.Get(k)) {
+				return fmt.Errorf("key %#x of bucket %s differs after compaction", k, keyPath[len(keyPath)-1])
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		compactedEntries := 0
+		if err := compactedTx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			return walkBucket(b, nil, name, nil, b.Sequence(), func([][]byte, []byte, []byte, uint64) error {
+				compactedEntries++
+				return nil
+			})
+		}); err != nil {
+			return err
+		}
+		if compactedEntries != originalEntries {
+			return fmt.Errorf("compacted database has %d entries, expected %d", compactedEntries, originalEntries)
+		}
+		return nil
+	})
+}
+
+func closeAndRemove(db *bolt.DB, dbPath string) {
+	if err := db.Close(); err != nil {
+		log.WithError(err).Error("Could not close compacted database")
+	}
+	if err := os.RemoveAll(dbPath); err != nil {
+		log.WithError(err).Error("Could not remove compacted database")
+	}
+}
+
+func fileSize(filePath string) (int64, error) {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
@@ -0,0 +1,125 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	slashertypes "github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_Compact(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	es := &slashertypes.EpochStore{}
+	es, err := es.SetValidatorSpan(20000, slashertypes.Span{MinSpan: 1, MaxSpan: 2, HasAttested: true})
+	require.NoError(t, err)
+	for i := types.Epoch(0); i < 50; i++ {
+		require.NoError(t, db.SaveEpochSpans(ctx, i, es, false))
+	}
+	pubKey := []byte("hello")
+	require.NoError(t, db.SavePubKey(ctx, 1, pubKey))
+
+	// Prune most of the spans, which leaves free pages behind in the database file.
+	require.NoError(t, db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(validatorsMinMaxSpanBucketNew)
+		for i := uint64(1); i < 50; i++ {
+			if err := b.Delete(bytesutil.Bytes8(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	dbPath := path.Join(db.DatabasePath(), DatabaseFileName)
+	sizeBefore, err := fileSize(dbPath)
+	require.NoError(t, err)
+
+	require.NoError(t, db.Compact(ctx))
+
+	sizeAfter, err := fileSize(dbPath)
+	require.NoError(t, err)
+	assert.Equal(t, true, sizeAfter < sizeBefore, "Expected compacted database to be smaller")
+	_, err = os.Stat(dbPath + ".compact")
+	assert.Equal(t, true, os.IsNotExist(err), "Expected temporary compacted file to be removed")
+
+	// The store must remain usable after the swap.
+	received, err := db.ValidatorPubKey(ctx, 1)
+	require.NoError(t, err)
+	require.DeepEqual(t, pubKey, received)
+	spans, err := db.EpochSpans(ctx, 0, false)
+	require.NoError(t, err)
+	require.DeepEqual(t, es.Bytes(), spans.Bytes())
+	require.NoError(t, db.SaveEpochSpans(ctx, 51, es, false))
+}
+
+func TestVerifyCompaction(t *testing.T) {
+	ctx := context.Background()
+	original, err := bolt.Open(path.Join(t.TempDir(), "original.db"), params.BeaconIoConfig().ReadWritePermissions, nil)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, original.Close())
+	}()
+	require.NoError(t, original.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucket([]byte("bucket"))
+		if err != nil {
+			return err
+		}
+		for i := uint64(1); i <= 3; i++ {
+			if err := b.Put(bytesutil.Bytes8(i), []byte{byte(i)}); err != nil {
+				return err
+			}
+		}
+		nested, err := b.CreateBucket([]byte("nested"))
+		if err != nil {
+			return err
+		}
+		return nested.Put([]byte("key"), []byte("value"))
+	}))
+
+	compact := func(t *testing.T, name string) *bolt.DB {
+		compacted, err := bolt.Open(path.Join(t.TempDir(), name), params.BeaconIoConfig().ReadWritePermissions, nil)
+		require.NoError(t, err)
+		require.NoError(t, compactInto(ctx, compacted, original))
+		require.NoError(t, verifyCompaction(compacted, original))
+		return compacted
+	}
+
+	t.Run("missing entry", func(t *testing.T) {
+		compacted := compact(t, "missing.db")
+		defer func() {
+			require.NoError(t, compacted.Close())
+		}()
+		require.NoError(t, compacted.Update(func(tx *bolt.Tx) error {
+			return tx.Bucket([]byte("bucket")).Delete(bytesutil.Bytes8(2))
+		}))
+		assert.ErrorContains(t, "differs after compaction", verifyCompaction(compacted, original))
+	})
+	t.Run("changed nested entry", func(t *testing.T) {
+		compacted := compact(t, "changed.db")
+		defer func() {
+			require.NoError(t, compacted.Close())
+		}()
+		require.NoError(t, compacted.Update(func(tx *bolt.Tx) error {
+			return tx.Bucket([]byte("bucket")).Bucket([]byte("nested")).Put([]byte("key"), []byte("other"))
+		}))
+		assert.ErrorContains(t, "differs after compaction", verifyCompaction(compacted, original))
+	})
+	t.Run("extra entry", func(t *testing.T) {
+		compacted := compact(t, "extra.db")
+		defer func() {
+			require.NoError(t, compacted.Close())
+		}()
+		require.NoError(t, compacted.Update(func(tx *bolt.Tx) error {
+			return tx.Bucket([]byte("bucket")).Put(bytesutil.Bytes8(4), []byte{4})
+		}))
+		assert.ErrorContains(t, "has 7 entries, expected 6", verifyCompaction(compacted, original))
+	})
+}
@@ -28,6 +28,7 @@ const (
 type Store struct {
 	highestAttCacheEnabled  bool
 	spanCacheEnabled        bool
+	compressSpans           bool
 	highestAttestationCache *cache.HighestAttestationCache
 	flatSpanCache           *cache.EpochFlatSpansCache
 	db                      *bolt.DB
@@ -39,6 +40,8 @@ type Config struct {
 	// SpanCacheSize determines the span map cache size.
 	SpanCacheSize               int
 	HighestAttestationCacheSize int
+	// CompressSpans enables snappy compression of min-max span blobs written to disk.
+	CompressSpans bool
 }
 
 // Close closes the underlying boltdb database.
@@ -112,7 +115,7 @@ func NewKVStore(dirPath string, cfg *Config) (*Store, error) {
 		}
 		return nil, err
 	}
-	kv := &Store{db: boltDB, databasePath: dirPath}
+	kv := &Store{db: boltDB, databasePath: dirPath, compressSpans: cfg.CompressSpans}
 	kv.EnableSpanCache(true)
 	kv.EnableHighestAttestationCache(true)
 	flatSpanCache, err := cache.NewEpochFlatSpansCache(cfg.SpanCacheSize, persistFlatSpanMapsOnEviction(kv))
@@ -0,0 +1,57 @@
+package kv
+
+import (
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+)
+
+// spansCompressedTag marks a span blob as snappy compressed. Raw span blobs
+// are always a multiple of the spanner encoded length, so compressed blobs are
+// laid out as [snappy payload][zero padding][padding length][tag] with the padding
+// chosen such that the total length is one more than a multiple of the spanner
+// encoded length. This lets a database hold both formats side by side.
+const spansCompressedTag = byte(0x01)
+
+// encodeSpans returns the bytes to persist for a span blob, compressing them
+// if span compression is enabled for the store.
+func (s *Store) encodeSpans(spans []byte) []byte {
+	if !s.compressSpans {
+		return spans
+	}
+	return compressSpans(spans)
+}
+
+func compressSpans(spans []byte) []byte {
+	encodedLength := slashertypes.SpannerEncodedLength
+	payload := snappy.Encode(nil, spans)
+	// Two trailing bytes hold the padding length and the format tag.
+	total := uint64(len(payload)) + 2
+	padding := (encodedLength + 1 - total%encodedLength) % encodedLength
+	enc := make([]byte, 0, total+padding)
+	enc = append(enc, payload...)
+	enc = append(enc, make([]byte, padding)...)
+	return append(enc, byte(padding), spansCompressedTag)
+}
+
+// decodeSpans returns the raw span bytes from a persisted span blob,
+// transparently decompressing it if it was stored in compressed form.
+func decodeSpans(enc []byte) ([]byte, error) {
+	encodedLength := slashertypes.SpannerEncodedLength
+	encLen := uint64(len(enc))
+	if encLen%encodedLength == 0 {
+		return enc, nil
+	}
+	if encLen%encodedLength != 1 || encLen < 2 || enc[encLen-1] != spansCompressedTag {
+		return nil, slashertypes.ErrWrongSize
+	}
+	padding := uint64(enc[encLen-2])
+	if padding+2 > encLen {
+		return nil, errors.New("invalid padding in compressed span blob")
+	}
+	spans, err := snappy.Decode(nil, enc[:encLen-2-padding])
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress span blob")
+	}
+	return spans, nil
+}
@@ -0,0 +1,54 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	slashertypes "github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+)
+
+func TestCompressSpans_RoundTrip(t *testing.T) {
+	for _, numValidators := range []uint64{0, 1, 2, 3, 100, 1000} {
+		spans := make([]byte, numValidators*slashertypes.SpannerEncodedLength)
+		for i := uint64(0); i < numValidators; i += 7 {
+			spans[i*slashertypes.SpannerEncodedLength] = byte(i)
+		}
+		enc := compressSpans(spans)
+		require.Equal(t, uint64(1), uint64(len(enc))%slashertypes.SpannerEncodedLength)
+		dec, err := decodeSpans(enc)
+		require.NoError(t, err)
+		require.DeepEqual(t, spans, dec)
+	}
+}
+
+func TestDecodeSpans_RejectsUnknownFormat(t *testing.T) {
+	_, err := decodeSpans([]byte{0, 0, 0, 0})
+	require.ErrorContains(t, slashertypes.ErrWrongSize.Error(), err)
+	_, err = decodeSpans([]byte{0, 0, 0, 0, 0, 0, 0, 0})
+	require.ErrorContains(t, slashertypes.ErrWrongSize.Error(), err)
+}
+
+func TestStore_EpochSpans_MixedCompression(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	es := &slashertypes.EpochStore{}
+	es, err := es.SetValidatorSpan(10, slashertypes.Span{MinSpan: 3, MaxSpan: 4, HasAttested: true})
+	require.NoError(t, err)
+
+	require.NoError(t, db.SaveEpochSpans(ctx, 1, es, false))
+	db.compressSpans = true
+	require.NoError(t, db.SaveEpochSpans(ctx, 2, es, false))
+
+	// Reads must not depend on the current setting, only on the stored format.
+	db.compressSpans = false
+	for _, epoch := range []types.Epoch{1, 2} {
+		got, err := db.EpochSpans(ctx, epoch, false)
+		require.NoError(t, err)
+		require.DeepEqual(t, es.Bytes(), got.Bytes())
+		span, err := got.GetValidatorSpan(10)
+		require.NoError(t, err)
+		require.Equal(t, uint16(3), span.MinSpan)
+	}
+}
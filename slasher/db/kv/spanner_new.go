@@ -53,7 +53,7 @@ func persistFlatSpanMapsOnEviction(db *Store) func(key interface{}, value interf
 			}
 
 			bucket := tx.Bucket(validatorsMinMaxSpanBucketNew)
-			if err := bucket.Put(bytesutil.Bytes8(epoch), epochStore.Bytes()); err != nil {
+			if err := bucket.Put(bytesutil.Bytes8(epoch), db.encodeSpans(epochStore.Bytes())); err != nil {
 				return err
 			}
 			epochSpansCacheEvictions.Inc()
@@ -93,7 +93,11 @@ func (s *Store) EpochSpans(_ context.Context, epoch types.Epoch, fromCache bool)
 	if copiedSpans == nil {
 		copiedSpans = []byte{}
 	}
-	return slashertypes.NewEpochStore(copiedSpans)
+	spans, err := decodeSpans(copiedSpans)
+	if err != nil {
+		return &slashertypes.EpochStore{}, err
+	}
+	return slashertypes.NewEpochStore(spans)
 }
 
 // SaveEpochSpans accepts a epoch and span byte array and writes it to disk.
@@ -118,7 +122,7 @@ func (s *Store) SaveEpochSpans(ctx context.Context, epoch types.Epoch, es *slash
 		if err != nil {
 			return err
 		}
-		return b.Put(bytesutil.Bytes8(uint64(epoch)), es.Bytes())
+		return b.Put(bytesutil.Bytes8(uint64(epoch)), s.encodeSpans(es.Bytes()))
 	})
 }
 
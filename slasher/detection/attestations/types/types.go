@@ -4,8 +4,10 @@ package types
 
 import (
 	"errors"
+	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 )
 
@@ -47,6 +49,13 @@ func (r *DetectionResult) Marshal() []byte {
 	return resultBytes
 }
 
+// IndexedAttestationWrapper wraps an indexed attestation with the wall-clock
+// time at which slasher received it, used to measure detection latency.
+type IndexedAttestationWrapper struct {
+	IndexedAttestation *ethpb.IndexedAttestation
+	ReceivedAt         time.Time
+}
+
 // Span defines the structure used for detecting surround and double votes.
 type Span struct {
 	MinSpan     uint16
@@ -3,6 +3,8 @@ package detection
 import (
 	"bytes"
 	"context"
+	"math"
+	"sort"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -77,6 +79,7 @@ func (s *Service) DetectAttesterSlashings(
 			return nil, err
 		}
 	}
+	sortAttesterSlashings(slashingList)
 	return slashingList, nil
 }
 
@@ -196,6 +199,31 @@ func (s *Service) mapResultsToAtts(ctx context.Context, results []*types.Detecti
 	return resultsToAtts, nil
 }
 
+// sortAttesterSlashings orders slashings by the lowest slashable validator index and then
+// by target epoch, so slashings found in a single batch are always emitted in the same order.
+func sortAttesterSlashings(slashings []*ethpb.AttesterSlashing) {
+	sort.SliceStable(slashings, func(i, j int) bool {
+		idxI, idxJ := slashableValidatorIndex(slashings[i]), slashableValidatorIndex(slashings[j])
+		if idxI != idxJ {
+			return idxI < idxJ
+		}
+		return slashings[i].Attestation_1.Data.Target.Epoch < slashings[j].Attestation_1.Data.Target.Epoch
+	})
+}
+
+// slashableValidatorIndex returns the lowest validator index attesting in both
+// attestations of the slashing.
+func slashableValidatorIndex(slashing *ethpb.AttesterSlashing) uint64 {
+	indices := sliceutil.IntersectionUint64(slashing.Attestation_1.AttestingIndices, slashing.Attestation_2.AttestingIndices)
+	lowest := uint64(math.MaxUint64)
+	for _, idx := range indices {
+		if idx < lowest {
+			lowest = idx
+		}
+	}
+	return lowest
+}
+
 func resultHash(result *types.DetectionResult) [32]byte {
 	resultBytes := append(bytesutil.Bytes8(uint64(result.SlashableEpoch)), result.SigBytes[:]...)
 	return hashutil.Hash(resultBytes)
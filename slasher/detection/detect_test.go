@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	eth2types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
@@ -187,6 +188,7 @@ func TestDetect_detectAttesterSlashings_Surround(t *testing.T) {
 			attsl, err := db.AttesterSlashings(ctx, status.Active)
 			require.NoError(t, err)
 			require.Equal(t, tt.slashingsFound, len(attsl), "Didnt save slashing to db")
+			assertAttesterSlashingsOrdered(t, slashings)
 			for _, ss := range slashings {
 				slashingAtt1 := ss.Attestation_1
 				slashingAtt2 := ss.Attestation_2
@@ -338,6 +340,7 @@ func TestDetect_detectAttesterSlashings_Double(t *testing.T) {
 			savedSlashings, err := db.AttesterSlashings(ctx, status.Active)
 			require.NoError(t, err)
 			require.Equal(t, tt.slashingsFound, len(savedSlashings), "Did not save slashing to db")
+			assertAttesterSlashingsOrdered(t, slashings)
 
 			for _, ss := range slashings {
 				slashingAtt1 := ss.Attestation_1
@@ -354,6 +357,48 @@ func TestDetect_detectAttesterSlashings_Double(t *testing.T) {
 	}
 }
 
+func TestDetect_sortAttesterSlashings(t *testing.T) {
+	slashing := func(indices []uint64, target eth2types.Epoch) *ethpb.AttesterSlashing {
+		return &ethpb.AttesterSlashing{
+			Attestation_1: &ethpb.IndexedAttestation{
+				AttestingIndices: indices,
+				Data:             &ethpb.AttestationData{Target: &ethpb.Checkpoint{Epoch: target}},
+			},
+			Attestation_2: &ethpb.IndexedAttestation{
+				AttestingIndices: indices,
+				Data:             &ethpb.AttestationData{Target: &ethpb.Checkpoint{Epoch: target}},
+			},
+		}
+	}
+	slashings := []*ethpb.AttesterSlashing{
+		slashing([]uint64{9}, 3),
+		slashing([]uint64{4, 8}, 7),
+		slashing([]uint64{9, 12}, 1),
+		slashing([]uint64{4}, 2),
+		slashing([]uint64{1, 20}, 5),
+	}
+	sortAttesterSlashings(slashings)
+	wanted := []struct {
+		index  uint64
+		target eth2types.Epoch
+	}{{1, 5}, {4, 2}, {4, 7}, {9, 1}, {9, 3}}
+	for i, want := range wanted {
+		assert.Equal(t, want.index, slashableValidatorIndex(slashings[i]))
+		assert.Equal(t, want.target, slashings[i].Attestation_1.Data.Target.Epoch)
+	}
+}
+
+func assertAttesterSlashingsOrdered(t *testing.T, slashings []*ethpb.AttesterSlashing) {
+	for i := 1; i < len(slashings); i++ {
+		prevIdx, currIdx := slashableValidatorIndex(slashings[i-1]), slashableValidatorIndex(slashings[i])
+		require.Equal(t, true, prevIdx <= currIdx, "Slashings not ordered by validator index")
+		if prevIdx == currIdx {
+			prevEpoch, currEpoch := slashings[i-1].Attestation_1.Data.Target.Epoch, slashings[i].Attestation_1.Data.Target.Epoch
+			require.Equal(t, true, prevEpoch <= currEpoch, "Slashings not ordered by target epoch")
+		}
+	}
+}
+
 func TestDetect_updateHighestAttestation(t *testing.T) {
 	tests := []struct {
 		name         string
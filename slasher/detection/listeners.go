@@ -11,6 +11,9 @@ import (
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/blockutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -51,19 +54,21 @@ func (s *Service) detectIncomingBlocks(ctx context.Context, ch chan *ethpb.Signe
 // attestation objects from a notifier interface. Upon receiving
 // an attestation from the feed, we run surround vote and double vote
 // detection on the attestation.
-func (s *Service) detectIncomingAttestations(ctx context.Context, ch chan *ethpb.IndexedAttestation) {
+func (s *Service) detectIncomingAttestations(ctx context.Context, ch chan *types.IndexedAttestationWrapper) {
 	ctx, span := trace.StartSpan(ctx, "detection.detectIncomingAttestations")
 	defer span.End()
 	sub := s.notifier.AttestationFeed().Subscribe(ch)
 	defer sub.Unsubscribe()
 	for {
 		select {
-		case indexedAtt := <-ch:
+		case wrapper := <-ch:
+			indexedAtt := wrapper.IndexedAttestation
 			slashings, err := s.DetectAttesterSlashings(ctx, indexedAtt)
 			if err != nil {
 				log.WithError(err).Error("Could not detect attester slashings")
 				continue
 			}
+			recordDetectionLatency(wrapper, len(slashings))
 			if len(slashings) < 1 {
 				if err := s.minMaxSpanDetector.UpdateSpans(ctx, indexedAtt); err != nil {
 					log.WithError(err).Error("Could not update spans")
@@ -83,3 +88,22 @@ func (s *Service) detectIncomingAttestations(ctx context.Context, ch chan *ethpb
 		}
 	}
 }
+
+// recordDetectionLatency observes the time elapsed from the receipt of an attestation
+// to the completion of slashing detection on it, whether or not slashings were found.
+// Attestations without a receive timestamp are not counted towards the histogram. The
+// historical attestations replayed on startup are detected without going through the
+// attestation feed, so they are never counted either.
+func recordDetectionLatency(wrapper *types.IndexedAttestationWrapper, numSlashings int) {
+	if wrapper.ReceivedAt.IsZero() {
+		return
+	}
+	elapsed := timeutils.Since(wrapper.ReceivedAt)
+	attestationDetectionLatency.Observe(float64(elapsed.Milliseconds()))
+	if numSlashings > 0 {
+		log.WithFields(logrus.Fields{
+			"numSlashings": numSlashings,
+			"latency":      elapsed,
+		}).Info("Detected attester slashings from received attestation")
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	testDB "github.com/prysmaticlabs/prysm/slasher/db/testing"
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations"
+	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection/proposals"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -77,14 +79,29 @@ func TestService_DetectIncomingAttestations(t *testing.T) {
 		},
 	}
 	exitRoutine := make(chan bool)
-	attsChan := make(chan *ethpb.IndexedAttestation)
+	attsChan := make(chan *types.IndexedAttestationWrapper)
 	ctx, cancel := context.WithCancel(context.Background())
 	go func(tt *testing.T) {
 		ds.detectIncomingAttestations(ctx, attsChan)
 		<-exitRoutine
 	}(t)
-	attsChan <- att
+	attsChan <- &types.IndexedAttestationWrapper{IndexedAttestation: att, ReceivedAt: time.Now()}
 	cancel()
 	exitRoutine <- true
 	require.LogsContain(t, hook, "Context canceled")
 }
+
+func TestRecordDetectionLatency_SkipsUntimed(t *testing.T) {
+	hook := logTest.NewGlobal()
+	att := &ethpb.IndexedAttestation{Data: &ethpb.AttestationData{Slot: 1}}
+	recordDetectionLatency(&types.IndexedAttestationWrapper{
+		IndexedAttestation: att,
+	}, 1)
+	require.LogsDoNotContain(t, hook, "Detected attester slashings")
+
+	recordDetectionLatency(&types.IndexedAttestationWrapper{
+		IndexedAttestation: att,
+		ReceivedAt:         time.Now(),
+	}, 1)
+	require.LogsContain(t, hook, "Detected attester slashings")
+}
@@ -18,4 +18,11 @@ var (
 		Name: "surrounded_votes_detected_total",
 		Help: "The # of surrounded slashable events detected",
 	})
+	attestationDetectionLatency = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "slasher_attestation_detection_latency_milliseconds",
+			Help:    "Time from receiving a live attestation to completing slashing detection on it in milliseconds, observed whether or not a slashing is found",
+			Buckets: []float64{100, 250, 500, 1000, 2000, 4000, 8000, 16000},
+		},
+	)
 )
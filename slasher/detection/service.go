@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/slasher/db"
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations"
 	"github.com/prysmaticlabs/prysm/slasher/detection/attestations/iface"
+	slashertypes "github.com/prysmaticlabs/prysm/slasher/detection/attestations/types"
 	"github.com/prysmaticlabs/prysm/slasher/detection/proposals"
 	proposerIface "github.com/prysmaticlabs/prysm/slasher/detection/proposals/iface"
 	"github.com/sirupsen/logrus"
@@ -52,7 +53,7 @@ type Service struct {
 	cancel                context.CancelFunc
 	slasherDB             db.Database
 	blocksChan            chan *ethpb.SignedBeaconBlock
-	attsChan              chan *ethpb.IndexedAttestation
+	attsChan              chan *slashertypes.IndexedAttestationWrapper
 	notifier              beaconclient.Notifier
 	chainFetcher          beaconclient.ChainFetcher
 	beaconClient          *beaconclient.Service
@@ -86,7 +87,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		slasherDB:             cfg.SlasherDB,
 		beaconClient:          cfg.BeaconClient,
 		blocksChan:            make(chan *ethpb.SignedBeaconBlock, 1),
-		attsChan:              make(chan *ethpb.IndexedAttestation, 1),
+		attsChan:              make(chan *slashertypes.IndexedAttestationWrapper, 1),
 		attesterSlashingsFeed: cfg.AttesterSlashingsFeed,
 		proposerSlashingsFeed: cfg.ProposerSlashingsFeed,
 		minMaxSpanDetector:    attestations.NewSpanDetector(cfg.SlasherDB),
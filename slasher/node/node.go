@@ -185,7 +185,11 @@ func (n *SlasherNode) startDB() error {
 	dbPath := path.Join(baseDir, kv.SlasherDbDirName)
 	spanCacheSize := n.cliCtx.Int(flags.SpanCacheSize.Name)
 	highestAttCacheSize := n.cliCtx.Int(flags.HighestAttCacheSize.Name)
-	cfg := &kv.Config{SpanCacheSize: spanCacheSize, HighestAttestationCacheSize: highestAttCacheSize}
+	cfg := &kv.Config{
+		SpanCacheSize:               spanCacheSize,
+		HighestAttestationCacheSize: highestAttCacheSize,
+		CompressSpans:               n.cliCtx.Bool(flags.CompressSpansFlag.Name),
+	}
 	log.Infof("Span cache size has been set to: %d", spanCacheSize)
 	d, err := db.NewDB(dbPath, cfg)
 	if err != nil {
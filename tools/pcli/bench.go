@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+)
+
+// transitionTimings is the time spent in each phase of the benchmarked state transitions.
+type transitionTimings struct {
+	blocks        int
+	slots         int
+	epochs        int
+	slotTime      time.Duration
+	blockTime     time.Duration
+	stateRootTime time.Duration
+}
+
+func (t *transitionTimings) total() time.Duration {
+	return t.slotTime + t.blockTime + t.stateRootTime
+}
+
+// report logs the throughput of the benchmarked state transitions and the time spent in each phase.
+func (t *transitionTimings) report() {
+	total := t.total()
+	if total == 0 {
+		log.Info("No state transition benchmarked")
+		return
+	}
+	log.WithFields(log.Fields{
+		"blocks":          t.blocks,
+		"slots":           t.slots,
+		"epochs":          t.epochs,
+		"duration":        total,
+		"blocksPerSecond": fmt.Sprintf("%.2f", float64(t.blocks)/total.Seconds()),
+	}).Info("Finished benchmarking state transitions")
+	phases := []struct {
+		name  string
+		time  time.Duration
+		count int
+	}{
+		{"slot processing, including the epoch processing", t.slotTime, t.slots},
+		{"block processing", t.blockTime, t.blocks},
+		{"state root", t.stateRootTime, t.blocks},
+	}
+	for _, p := range phases {
+		var average time.Duration
+		if p.count > 0 {
+			average = p.time / time.Duration(p.count)
+		}
+		log.WithFields(log.Fields{
+			"duration": p.time,
+			"share":    fmt.Sprintf("%.1f%%", 100*p.time.Seconds()/total.Seconds()),
+			"count":    p.count,
+			"average":  average,
+		}).Infof("Time spent in %s", p.name)
+	}
+}
+
+// benchmarkTransitions applies the blocks to the state with the functions of the beacon node state
+// transition, timing each of its phases: the slot processing up to the block including the epoch
+// processing, the block processing including its signature verification, and the post state root
+// computation. The skip slot cache is disabled, so that every run measures the full work.
+func benchmarkTransitions(
+	ctx context.Context, st iface.BeaconState, blks []*ethpb.SignedBeaconBlock,
+) (iface.BeaconState, *transitionTimings, error) {
+	state.SkipSlotCache.Disable()
+	defer state.SkipSlotCache.Enable()
+	timings := &transitionTimings{}
+	var err error
+	for _, blk := range blks {
+		if st.Slot() >= blk.Block.Slot {
+			return nil, nil, fmt.Errorf("block slot %d is not after state slot %d", blk.Block.Slot, st.Slot())
+		}
+		timings.slots += int(blk.Block.Slot - st.Slot())
+		timings.epochs += int(helpers.SlotToEpoch(blk.Block.Slot) - helpers.SlotToEpoch(st.Slot()))
+		start := time.Now()
+		st, err = state.ProcessSlots(ctx, st, blk.Block.Slot)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not process slots up to slot %d", blk.Block.Slot)
+		}
+		timings.slotTime += time.Since(start)
+
+		start = time.Now()
+		st, err = state.ProcessBlock(ctx, st, blk)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not process block in slot %d", blk.Block.Slot)
+		}
+		timings.blockTime += time.Since(start)
+
+		start = time.Now()
+		root, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		timings.stateRootTime += time.Since(start)
+		if !bytes.Equal(root[:], blk.Block.StateRoot) {
+			return nil, nil, fmt.Errorf("state root of block in slot %d does not match, wanted %#x, computed %#x",
+				blk.Block.Slot, blk.Block.StateRoot, root)
+		}
+		timings.blocks++
+	}
+	return st, timings, nil
+}
+
+// blocksFromDir reads the SSZ encoded signed blocks of the directory, ordered by slot.
+func blocksFromDir(dir string) ([]*ethpb.SignedBeaconBlock, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var blks []*ethpb.SignedBeaconBlock
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		blk := &ethpb.SignedBeaconBlock{}
+		if err := dataFetcher(filepath.Join(dir, f.Name()), blk); err != nil {
+			return nil, errors.Wrapf(err, "could not read block file %s", f.Name())
+		}
+		blks = append(blks, blk)
+	}
+	sort.Slice(blks, func(i, j int) bool {
+		return blks[i].Block.Slot < blks[j].Block.Slot
+	})
+	return blks, nil
+}
+
+// transitionInputsFromDB reads the chain of blocks in the slot range from the beacon node database
+// of the data directory, along with the post state of the parent of its first block. The chain is
+// the one of the block with the highest slot in the range.
+func transitionInputsFromDB(
+	ctx context.Context, dataDir string, startSlot, endSlot types.Slot,
+) (iface.BeaconState, []*ethpb.SignedBeaconBlock, error) {
+	dbDir := path.Join(dataDir, kv.BeaconNodeDbDirName)
+	if !fileutil.FileExists(path.Join(dbDir, kv.DatabaseFileName)) {
+		return nil, nil, errors.Errorf("no database found in %s", dbDir)
+	}
+	store, err := kv.NewKVStore(ctx, dbDir, &kv.Config{})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	blks, roots, err := store.Blocks(ctx, filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(endSlot))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not read blocks")
+	}
+	chain := blockChain(blks, roots)
+	if len(chain) == 0 {
+		return nil, nil, errors.Errorf("no block found between slots %d and %d", startSlot, endSlot)
+	}
+	parentRoot := bytesutil.ToBytes32(chain[0].Block.ParentRoot)
+	preState, err := stategen.New(store).StateByRoot(ctx, parentRoot)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not get state of block %#x", parentRoot)
+	}
+	if preState == nil {
+		return nil, nil, errors.Errorf("no state found for block %#x", parentRoot)
+	}
+	return preState, chain, nil
+}
+
+// blockChain returns the chain of the block with the highest slot, from its oldest ancestor among
+// the given blocks. The genesis block is left out, as it is not applied through a state transition.
+func blockChain(blks []*ethpb.SignedBeaconBlock, roots [][32]byte) []*ethpb.SignedBeaconBlock {
+	byRoot := make(map[[32]byte]*ethpb.SignedBeaconBlock, len(blks))
+	var head *ethpb.SignedBeaconBlock
+	for i, blk := range blks {
+		if blk.Block.Slot == 0 {
+			continue
+		}
+		byRoot[roots[i]] = blk
+		if head == nil || blk.Block.Slot > head.Block.Slot {
+			head = blk
+		}
+	}
+	var chain []*ethpb.SignedBeaconBlock
+	for blk := head; blk != nil; blk = byRoot[bytesutil.ToBytes32(blk.Block.ParentRoot)] {
+		chain = append(chain, blk)
+	}
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return chain
+}
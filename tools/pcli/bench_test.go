@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestBenchmarkTransitions(t *testing.T) {
+	ctx := context.Background()
+	preState, keys := testutil.DeterministicGenesisState(t, 64)
+	st := preState.Copy()
+	var blks []*ethpb.SignedBeaconBlock
+	// The last block crosses an epoch boundary.
+	for _, slot := range []types.Slot{1, 2, params.BeaconConfig().SlotsPerEpoch + 1} {
+		blk, err := testutil.GenerateFullBlock(st, keys, testutil.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		st, err = state.ExecuteStateTransition(ctx, st, blk)
+		require.NoError(t, err)
+		blks = append(blks, blk)
+	}
+
+	postState, timings, err := benchmarkTransitions(ctx, preState.Copy(), blks)
+	require.NoError(t, err)
+	assert.Equal(t, 3, timings.blocks)
+	assert.Equal(t, int(params.BeaconConfig().SlotsPerEpoch+1), timings.slots)
+	assert.Equal(t, 1, timings.epochs)
+	wantRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	gotRoot, err := postState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, gotRoot)
+
+	// The signatures of the blocks are verified.
+	blks[1].Signature = blks[0].Signature
+	_, _, err = benchmarkTransitions(ctx, preState.Copy(), blks)
+	assert.ErrorContains(t, "could not process block in slot 2", err)
+}
+
+func TestBlocksFromDir(t *testing.T) {
+	dir := t.TempDir()
+	for i, slot := range []types.Slot{3, 1, 2} {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		enc, err := blk.MarshalSSZ()
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("block_%d.ssz", i)), enc, 0600))
+	}
+	blks, err := blocksFromDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(blks))
+	for i, blk := range blks {
+		assert.Equal(t, types.Slot(i+1), blk.Block.Slot)
+	}
+}
+
+func TestBlockChain(t *testing.T) {
+	newBlock := func(slot types.Slot, parent [32]byte) (*ethpb.SignedBeaconBlock, [32]byte) {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = parent[:]
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		return blk, root
+	}
+	genesis, genesisRoot := newBlock(0, [32]byte{})
+	a, aRoot := newBlock(1, genesisRoot)
+	b, bRoot := newBlock(2, aRoot)
+	fork, forkRoot := newBlock(2, [32]byte{'f'})
+	c, cRoot := newBlock(3, bRoot)
+
+	chain := blockChain(
+		[]*ethpb.SignedBeaconBlock{genesis, a, b, fork, c},
+		[][32]byte{genesisRoot, aRoot, bRoot, forkRoot, cRoot},
+	)
+	assert.DeepEqual(t, []*ethpb.SignedBeaconBlock{a, b, c}, chain)
+	assert.Equal(t, 0, len(blockChain(nil, nil)))
+}
@@ -11,9 +11,11 @@ import (
 
 	fssz "github.com/ferranbt/fastssz"
 	"github.com/kr/pretty"
+	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	iface "github.com/prysmaticlabs/prysm/beacon-chain/state/interface"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/sszutil"
 	"github.com/prysmaticlabs/prysm/shared/version"
@@ -29,6 +31,12 @@ func main() {
 	var expectedPostStatePath string
 	var sszPath string
 	var sszType string
+	var stateAPath string
+	var stateBPath string
+	var blocksDir string
+	var dataDir string
+	var startSlot uint64
+	var endSlot uint64
 
 	customFormatter := new(prefixed.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
@@ -199,6 +207,119 @@ func main() {
 				return nil
 			},
 		},
+		{
+			Name:     "state-diff",
+			Category: "state-transition",
+			Usage:    "Subcommand to diff the fields of two beacon states",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        "state-a-path",
+					Usage:       "Path to first state file(ssz)",
+					Required:    true,
+					Destination: &stateAPath,
+				},
+				&cli.StringFlag{
+					Name:        "state-b-path",
+					Usage:       "Path to second state file(ssz)",
+					Required:    true,
+					Destination: &stateBPath,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				stateA := &pb.BeaconState{}
+				if err := dataFetcher(stateAPath, stateA); err != nil {
+					log.Fatal(err)
+				}
+				stateB := &pb.BeaconState{}
+				if err := dataFetcher(stateBPath, stateB); err != nil {
+					log.Fatal(err)
+				}
+				diffs := diffStates(stateA, stateB)
+				if len(diffs) == 0 {
+					log.Info("States are identical")
+					return nil
+				}
+				log.Infof("Found %d differing fields", len(diffs))
+				for _, d := range diffs {
+					fmt.Println(d)
+				}
+				return nil
+			},
+		},
+		{
+			Name:     "benchmark-transition",
+			Category: "state-transition",
+			Usage: "Subcommand to time the state transitions of a sequence of blocks, read either from SSZ " +
+				"files or from a beacon node database",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        "pre-state-path",
+					Usage:       "Path to pre state file(ssz), the state the first block applies to",
+					Destination: &preStatePath,
+				},
+				&cli.StringFlag{
+					Name:        "blocks-dir",
+					Usage:       "Path to a directory of signed block files(ssz), applied in slot order",
+					Destination: &blocksDir,
+				},
+				&cli.StringFlag{
+					Name:        "datadir",
+					Usage:       "Data directory of a stopped beacon node to read the blocks and pre state from",
+					Destination: &dataDir,
+				},
+				&cli.Uint64Flag{
+					Name:        "start-slot",
+					Usage:       "First slot of the blocks read from the database",
+					Destination: &startSlot,
+				},
+				&cli.Uint64Flag{
+					Name:        "end-slot",
+					Usage:       "Last slot of the blocks read from the database",
+					Destination: &endSlot,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				var preState iface.BeaconState
+				var blks []*ethpb.SignedBeaconBlock
+				switch {
+				case dataDir != "":
+					if endSlot < startSlot {
+						log.Fatalf("End slot %d is before start slot %d", endSlot, startSlot)
+					}
+					var err error
+					preState, blks, err = transitionInputsFromDB(c.Context, dataDir, types.Slot(startSlot), types.Slot(endSlot))
+					if err != nil {
+						log.Fatal(err)
+					}
+				case preStatePath != "" && blocksDir != "":
+					st := &pb.BeaconState{}
+					if err := dataFetcher(preStatePath, st); err != nil {
+						log.Fatal(err)
+					}
+					var err error
+					preState, err = stateTrie.InitializeFromProto(st)
+					if err != nil {
+						log.Fatal(err)
+					}
+					blks, err = blocksFromDir(blocksDir)
+					if err != nil {
+						log.Fatal(err)
+					}
+				default:
+					log.Fatal("Provide either a pre state path and a blocks directory, or a data directory")
+				}
+				log.WithFields(log.Fields{
+					"preStateSlot": preState.Slot(),
+					"blocks":       len(blks),
+				}).Info("Benchmarking state transitions")
+				_, timings, err := benchmarkTransitions(c.Context, preState, blks)
+				if err != nil {
+					log.Fatal(err)
+				}
+				timings.report()
+				return nil
+			},
+		},
 	}
 	if err := app.Run(os.Args); err != nil {
 		log.Error(err.Error())
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/sszutil"
+)
+
+// maxListedIndices is the number of differing indices printed for a list
+// field before the remainder is summarized as a count.
+const maxListedIndices = 10
+
+// fieldDiff describes a single differing field between two beacon states.
+type fieldDiff struct {
+	path   string
+	detail string
+}
+
+func (d *fieldDiff) String() string {
+	return fmt.Sprintf("%s: %s", d.path, d.detail)
+}
+
+// validatorFields lists the comparable fields of a validator record, so that
+// registry differences are reported per field rather than per validator.
+var validatorFields = []struct {
+	name  string
+	equal func(a, b *ethpb.Validator) bool
+}{
+	{"public_key", func(a, b *ethpb.Validator) bool { return bytes.Equal(a.PublicKey, b.PublicKey) }},
+	{"withdrawal_credentials", func(a, b *ethpb.Validator) bool {
+		return bytes.Equal(a.WithdrawalCredentials, b.WithdrawalCredentials)
+	}},
+	{"effective_balance", func(a, b *ethpb.Validator) bool { return a.EffectiveBalance == b.EffectiveBalance }},
+	{"slashed", func(a, b *ethpb.Validator) bool { return a.Slashed == b.Slashed }},
+	{"activation_eligibility_epoch", func(a, b *ethpb.Validator) bool {
+		return a.ActivationEligibilityEpoch == b.ActivationEligibilityEpoch
+	}},
+	{"activation_epoch", func(a, b *ethpb.Validator) bool { return a.ActivationEpoch == b.ActivationEpoch }},
+	{"exit_epoch", func(a, b *ethpb.Validator) bool { return a.ExitEpoch == b.ExitEpoch }},
+	{"withdrawable_epoch", func(a, b *ethpb.Validator) bool { return a.WithdrawableEpoch == b.WithdrawableEpoch }},
+}
+
+// diffStates returns the fields which differ between the two provided beacon
+// states. List fields are compared element by element and summarized by the
+// number of changed entries, which keeps the output readable for large
+// validator registries.
+func diffStates(a, b *pb.BeaconState) []*fieldDiff {
+	var diffs []*fieldDiff
+	add := func(path, format string, args ...interface{}) {
+		diffs = append(diffs, &fieldDiff{path: path, detail: fmt.Sprintf(format, args...)})
+	}
+	scalar := func(path string, x, y interface{}) {
+		if x != y {
+			add(path, "%v != %v", x, y)
+		}
+	}
+	message := func(path string, x, y interface{}) {
+		if !sszutil.DeepEqual(x, y) {
+			add(path, "%v != %v", x, y)
+		}
+	}
+	roots := func(path string, x, y [][]byte) {
+		if d := diffList(path, len(x), len(y), func(i int) bool { return bytes.Equal(x[i], y[i]) }); d != nil {
+			diffs = append(diffs, d)
+		}
+	}
+	uints := func(path string, x, y []uint64) {
+		if d := diffList(path, len(x), len(y), func(i int) bool { return x[i] == y[i] }); d != nil {
+			diffs = append(diffs, d)
+		}
+	}
+
+	scalar("genesis_time", a.GenesisTime, b.GenesisTime)
+	if !bytes.Equal(a.GenesisValidatorsRoot, b.GenesisValidatorsRoot) {
+		add("genesis_validators_root", "%#x != %#x", a.GenesisValidatorsRoot, b.GenesisValidatorsRoot)
+	}
+	scalar("slot", a.Slot, b.Slot)
+	message("fork", a.Fork, b.Fork)
+	message("latest_block_header", a.LatestBlockHeader, b.LatestBlockHeader)
+	roots("block_roots", a.BlockRoots, b.BlockRoots)
+	roots("state_roots", a.StateRoots, b.StateRoots)
+	roots("historical_roots", a.HistoricalRoots, b.HistoricalRoots)
+	message("eth1_data", a.Eth1Data, b.Eth1Data)
+	if d := diffList("eth1_data_votes", len(a.Eth1DataVotes), len(b.Eth1DataVotes), func(i int) bool {
+		return sszutil.DeepEqual(a.Eth1DataVotes[i], b.Eth1DataVotes[i])
+	}); d != nil {
+		diffs = append(diffs, d)
+	}
+	scalar("eth1_deposit_index", a.Eth1DepositIndex, b.Eth1DepositIndex)
+	diffs = append(diffs, diffValidators(a.Validators, b.Validators)...)
+	uints("balances", a.Balances, b.Balances)
+	roots("randao_mixes", a.RandaoMixes, b.RandaoMixes)
+	uints("slashings", a.Slashings, b.Slashings)
+	if d := diffList("previous_epoch_attestations", len(a.PreviousEpochAttestations), len(b.PreviousEpochAttestations), func(i int) bool {
+		return sszutil.DeepEqual(a.PreviousEpochAttestations[i], b.PreviousEpochAttestations[i])
+	}); d != nil {
+		diffs = append(diffs, d)
+	}
+	if d := diffList("current_epoch_attestations", len(a.CurrentEpochAttestations), len(b.CurrentEpochAttestations), func(i int) bool {
+		return sszutil.DeepEqual(a.CurrentEpochAttestations[i], b.CurrentEpochAttestations[i])
+	}); d != nil {
+		diffs = append(diffs, d)
+	}
+	if !bytes.Equal(a.JustificationBits, b.JustificationBits) {
+		add("justification_bits", "%#x != %#x", []byte(a.JustificationBits), []byte(b.JustificationBits))
+	}
+	message("previous_justified_checkpoint", a.PreviousJustifiedCheckpoint, b.PreviousJustifiedCheckpoint)
+	message("current_justified_checkpoint", a.CurrentJustifiedCheckpoint, b.CurrentJustifiedCheckpoint)
+	message("finalized_checkpoint", a.FinalizedCheckpoint, b.FinalizedCheckpoint)
+	return diffs
+}
+
+// diffValidators compares the two registries field by field, reporting the
+// changed validator indices for each validator field.
+func diffValidators(a, b []*ethpb.Validator) []*fieldDiff {
+	var diffs []*fieldDiff
+	if len(a) != len(b) {
+		diffs = append(diffs, &fieldDiff{path: "validators", detail: fmt.Sprintf("length %d != %d", len(a), len(b))})
+	}
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	for _, f := range validatorFields {
+		if d := diffList("validators[*]."+f.name, n, n, func(i int) bool {
+			if a[i] == nil || b[i] == nil {
+				return a[i] == b[i]
+			}
+			return f.equal(a[i], b[i])
+		}); d != nil {
+			diffs = append(diffs, d)
+		}
+	}
+	return diffs
+}
+
+// diffList compares two lists of the given lengths using the provided element
+// comparison and summarizes the differing indices. It returns nil if the lists
+// are equal.
+func diffList(path string, lenA, lenB int, equal func(i int) bool) *fieldDiff {
+	n := lenA
+	if lenB < n {
+		n = lenB
+	}
+	var changed []int
+	for i := 0; i < n; i++ {
+		if !equal(i) {
+			changed = append(changed, i)
+		}
+	}
+	if lenA == lenB && len(changed) == 0 {
+		return nil
+	}
+	var details []string
+	if lenA != lenB {
+		details = append(details, fmt.Sprintf("length %d != %d", lenA, lenB))
+	}
+	if len(changed) > 0 {
+		listed := changed
+		if len(listed) > maxListedIndices {
+			listed = listed[:maxListedIndices]
+		}
+		indices := make([]string, len(listed))
+		for i, idx := range listed {
+			indices[i] = fmt.Sprintf("%d", idx)
+		}
+		summary := fmt.Sprintf("%d of %d entries changed (indices %s", len(changed), n, strings.Join(indices, ", "))
+		if len(changed) > len(listed) {
+			summary += fmt.Sprintf(", and %d more", len(changed)-len(listed))
+		}
+		details = append(details, summary+")")
+	}
+	return &fieldDiff{path: path, detail: strings.Join(details, ", ")}
+}
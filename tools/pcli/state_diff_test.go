@@ -0,0 +1,69 @@
+package main
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestDiffStates_Equal(t *testing.T) {
+	st := &pb.BeaconState{
+		Slot:       5,
+		Balances:   []uint64{1, 2, 3},
+		Validators: []*ethpb.Validator{{EffectiveBalance: 1}},
+	}
+	assert.Equal(t, 0, len(diffStates(st, st)))
+}
+
+func TestDiffStates_ReportsFieldPaths(t *testing.T) {
+	a := &pb.BeaconState{
+		Slot:                5,
+		FinalizedCheckpoint: &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+		Validators: []*ethpb.Validator{
+			{EffectiveBalance: 32, ExitEpoch: 10},
+			{EffectiveBalance: 32, ExitEpoch: 10},
+		},
+		Balances: []uint64{32, 32},
+	}
+	b := &pb.BeaconState{
+		Slot:                6,
+		FinalizedCheckpoint: &ethpb.Checkpoint{Epoch: 2, Root: make([]byte, 32)},
+		Validators: []*ethpb.Validator{
+			{EffectiveBalance: 31, ExitEpoch: 10},
+			{EffectiveBalance: 32, ExitEpoch: 10},
+			{EffectiveBalance: 32, ExitEpoch: 10},
+		},
+		Balances: []uint64{32, 31, 32},
+	}
+	diffs := diffStates(a, b)
+	paths := make(map[string]string)
+	for _, d := range diffs {
+		paths[d.path] = d.detail
+	}
+	require.Equal(t, 5, len(diffs), "Unexpected diffs: %v", diffs)
+	assert.Equal(t, "5 != 6", paths["slot"])
+	assert.Equal(t, "length 2 != 3", paths["validators"])
+	assert.Equal(t, "1 of 2 entries changed (indices 0)", paths["validators[*].effective_balance"])
+	assert.Equal(t, "length 2 != 3, 1 of 2 entries changed (indices 1)", paths["balances"])
+	_, ok := paths["finalized_checkpoint"]
+	assert.Equal(t, true, ok)
+	_, ok = paths["current_justified_checkpoint"]
+	assert.Equal(t, false, ok)
+}
+
+func TestDiffList_SummarizesLargeLists(t *testing.T) {
+	a := make([]uint64, 1000)
+	b := make([]uint64, 1000)
+	for i := range b {
+		if i%2 == 0 {
+			b[i] = 1
+		}
+	}
+	d := diffList("balances", len(a), len(b), func(i int) bool { return a[i] == b[i] })
+	require.NotNil(t, d)
+	assert.Equal(t, "500 of 1000 entries changed (indices 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, and 490 more)", d.detail)
+	assert.Equal(t, (*fieldDiff)(nil), diffList("balances", len(a), len(a), func(int) bool { return true }))
+}
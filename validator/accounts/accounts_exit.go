@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
@@ -42,19 +44,26 @@ func ExitAccountsCli(cliCtx *cli.Context, r io.Reader) error {
 		return err
 	}
 
-	rawPubKeys, trimmedPubKeys, err := interact(cliCtx, r, validatingPublicKeys)
+	validatorClient, nodeClient, err := prepareClients(cliCtx)
 	if err != nil {
 		return err
 	}
-	// User decided to cancel the voluntary exit.
-	if rawPubKeys == nil && trimmedPubKeys == nil {
-		return nil
+
+	if cliCtx.IsSet(flags.VoluntaryExitIndicesFlag.Name) {
+		validatingPublicKeys, err = filterPublicKeysByIndices(cliCtx, *validatorClient, validatingPublicKeys)
+		if err != nil {
+			return err
+		}
 	}
 
-	validatorClient, nodeClient, err := prepareClients(cliCtx)
+	rawPubKeys, trimmedPubKeys, err := interact(cliCtx, r, validatingPublicKeys)
 	if err != nil {
 		return err
 	}
+	// User decided to cancel the voluntary exit.
+	if rawPubKeys == nil && trimmedPubKeys == nil {
+		return nil
+	}
 
 	cfg := performExitCfg{
 		*validatorClient,
@@ -95,12 +104,49 @@ func prepareWallet(cliCtx *cli.Context) (validatingPublicKeys [][48]byte, km key
 	return validatingPublicKeys, km, nil
 }
 
+// filterPublicKeysByIndices returns the validating public keys of the wallet
+// matching the validator indices provided by the user.
+func filterPublicKeysByIndices(
+	cliCtx *cli.Context,
+	validatorClient ethpb.BeaconNodeValidatorClient,
+	validatingPublicKeys [][48]byte,
+) ([][48]byte, error) {
+	var indices []int64
+	for _, str := range strings.Split(cliCtx.String(flags.VoluntaryExitIndicesFlag.Name), ",") {
+		idx, err := strconv.ParseInt(strings.TrimSpace(str), 10, 64)
+		if err != nil || idx < 0 {
+			return nil, fmt.Errorf("could not parse %q as a validator index", str)
+		}
+		indices = append(indices, idx)
+	}
+	resp, err := validatorClient.MultipleValidatorStatus(cliCtx.Context, &ethpb.MultipleValidatorStatusRequest{Indices: indices})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not request validator public keys")
+	}
+	inWallet := make(map[[48]byte]bool, len(validatingPublicKeys))
+	for _, pk := range validatingPublicKeys {
+		inWallet[pk] = true
+	}
+	filtered := make([][48]byte, 0, len(resp.PublicKeys))
+	for i, pk := range resp.PublicKeys {
+		pubKey := bytesutil.ToBytes48(pk)
+		if !inWallet[pubKey] {
+			return nil, fmt.Errorf("validator %d is not in the wallet", resp.Indices[i])
+		}
+		filtered = append(filtered, pubKey)
+	}
+	if len(filtered) != len(indices) {
+		return nil, errors.New("could not find the public keys of all the validator indices")
+	}
+	return filtered, nil
+}
+
 func interact(
 	cliCtx *cli.Context,
 	r io.Reader,
 	validatingPublicKeys [][48]byte,
 ) (rawPubKeys [][]byte, formattedPubKeys []string, err error) {
-	if !cliCtx.IsSet(flags.ExitAllFlag.Name) {
+	if !cliCtx.IsSet(flags.ExitAllFlag.Name) && !cliCtx.IsSet(flags.VoluntaryExitIndicesFlag.Name) {
 		// Allow the user to interactively select the accounts to exit or optionally
 		// provide them via cli flags as a string of comma-separated, hex strings.
 		filteredPubKeys, err := filterPublicKeysFromUserInput(
@@ -204,9 +250,38 @@ func prepareClients(cliCtx *cli.Context) (*ethpb.BeaconNodeValidatorClient, *eth
 	return &validatorClient, &nodeClient, nil
 }
 
+// performExit submits a voluntary exit for each of the active validators. Submissions are spread
+// so that no more exits are sent in a slot than can be included in a block.
 func performExit(cliCtx *cli.Context, cfg performExitCfg) (rawExitedKeys [][]byte, formattedExitedKeys []string, err error) {
+	statusResp, err := cfg.validatorClient.MultipleValidatorStatus(
+		cliCtx.Context, &ethpb.MultipleValidatorStatusRequest{PublicKeys: cfg.rawPubKeys},
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not request validator statuses")
+	}
+	statuses := make(map[[48]byte]ethpb.ValidatorStatus, len(statusResp.PublicKeys))
+	for i, pk := range statusResp.PublicKeys {
+		if i < len(statusResp.Statuses) {
+			statuses[bytesutil.ToBytes48(pk)] = statusResp.Statuses[i].Status
+		}
+	}
+
 	var rawNotExitedKeys [][]byte
+	var submitted uint64
 	for i, key := range cfg.rawPubKeys {
+		if s := statuses[bytesutil.ToBytes48(key)]; s != ethpb.ValidatorStatus_ACTIVE {
+			rawNotExitedKeys = append(rawNotExitedKeys, key)
+			log.Warningf("Could not perform voluntary exit for account %s: validator is %s, not active", cfg.formattedPubKeys[i], s)
+			continue
+		}
+		if submitted != 0 && submitted%params.BeaconConfig().MaxVoluntaryExits == 0 {
+			select {
+			case <-cliCtx.Context.Done():
+				return nil, nil, cliCtx.Context.Err()
+			case <-time.After(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second):
+			}
+		}
+		submitted++
 		if err := client.ProposeExit(cliCtx.Context, cfg.validatorClient, cfg.nodeClient, cfg.keymanager.Sign, key); err != nil {
 			rawNotExitedKeys = append(rawNotExitedKeys, key)
 
@@ -217,7 +292,9 @@ func performExit(cliCtx *cli.Context, cfg performExitCfg) (rawExitedKeys [][]byt
 			} else {
 				log.WithError(err).Errorf("voluntary exit failed for account %s", cfg.formattedPubKeys[i])
 			}
+			continue
 		}
+		log.Infof("Submitted voluntary exit for account %s", cfg.formattedPubKeys[i])
 	}
 
 	rawExitedKeys = make([][]byte, 0)
@@ -2,6 +2,8 @@ package accounts
 
 import (
 	"bytes"
+	"context"
+	"flag"
 	"os"
 	"path/filepath"
 	"sort"
@@ -10,7 +12,10 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
+	eth2types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -18,6 +23,8 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
 	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -48,6 +55,10 @@ func TestExitAccountsCli_OK(t *testing.T) {
 		ProposeExit(gomock.Any(), gomock.AssignableToTypeOf(&ethpb.SignedVoluntaryExit{})).
 		Return(&ethpb.ProposeExitResponse{}, nil)
 
+	mockValidatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), gomock.Any()).
+		DoAndReturn(statusesResponse(ethpb.ValidatorStatus_ACTIVE))
+
 	walletDir, _, passwordFilePath := setupWalletAndPasswordsDir(t)
 	// Write a directory where we will import keys from.
 	keysDir := filepath.Join(t.TempDir(), "keysDir")
@@ -141,6 +152,10 @@ func TestExitAccountsCli_OK_AllPublicKeys(t *testing.T) {
 		Times(2).
 		Return(&ethpb.ProposeExitResponse{}, nil)
 
+	mockValidatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), gomock.Any()).
+		DoAndReturn(statusesResponse(ethpb.ValidatorStatus_ACTIVE))
+
 	walletDir, _, passwordFilePath := setupWalletAndPasswordsDir(t)
 	// Write a directory where we will import keys from.
 	keysDir := filepath.Join(t.TempDir(), "keysDir")
@@ -208,6 +223,80 @@ func TestExitAccountsCli_OK_AllPublicKeys(t *testing.T) {
 	require.DeepEqual(t, wantedFormatted, formattedExitedKeys)
 }
 
+func TestPerformExit_SkipsInactiveValidators(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockValidatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	mockValidatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), gomock.Any()).
+		DoAndReturn(statusesResponse(ethpb.ValidatorStatus_EXITING))
+
+	hook := test.NewGlobal()
+	cliCtx := cli.NewContext(&cli.App{}, flag.NewFlagSet("test", 0), nil)
+	cfg := performExitCfg{
+		validatorClient:  mockValidatorClient,
+		rawPubKeys:       [][]byte{bytesutil.PadTo([]byte{'a'}, 48)},
+		formattedPubKeys: []string{"0x61"},
+	}
+	rawExitedKeys, formattedExitedKeys, err := performExit(cliCtx, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(rawExitedKeys))
+	assert.Equal(t, 0, len(formattedExitedKeys))
+	assert.LogsContain(t, hook, "validator is EXITING, not active")
+}
+
+func TestFilterPublicKeysByIndices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockValidatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	walletKeys := [][48]byte{{'a'}, {'b'}, {'c'}}
+	mockValidatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), &ethpb.MultipleValidatorStatusRequest{Indices: []int64{4, 2}}).
+		Return(&ethpb.MultipleValidatorStatusResponse{
+			PublicKeys: [][]byte{walletKeys[2][:], walletKeys[0][:]},
+			Indices:    []eth2types.ValidatorIndex{4, 2},
+		}, nil)
+	mockValidatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), &ethpb.MultipleValidatorStatusRequest{Indices: []int64{7}}).
+		Return(&ethpb.MultipleValidatorStatusResponse{
+			PublicKeys: [][]byte{bytesutil.PadTo([]byte{'d'}, 48)},
+			Indices:    []eth2types.ValidatorIndex{7},
+		}, nil)
+
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.VoluntaryExitIndicesFlag.Name, "", "")
+	require.NoError(t, set.Set(flags.VoluntaryExitIndicesFlag.Name, "4, 2"))
+	cliCtx := cli.NewContext(&cli.App{}, set, nil)
+	filtered, err := filterPublicKeysByIndices(cliCtx, mockValidatorClient, walletKeys)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{walletKeys[2], walletKeys[0]}, filtered)
+
+	require.NoError(t, set.Set(flags.VoluntaryExitIndicesFlag.Name, "7"))
+	_, err = filterPublicKeysByIndices(cliCtx, mockValidatorClient, walletKeys)
+	assert.ErrorContains(t, "validator 7 is not in the wallet", err)
+
+	require.NoError(t, set.Set(flags.VoluntaryExitIndicesFlag.Name, "foo"))
+	_, err = filterPublicKeysByIndices(cliCtx, mockValidatorClient, walletKeys)
+	assert.ErrorContains(t, "could not parse", err)
+}
+
+// statusesResponse returns a mock response giving the same status to every requested public key.
+func statusesResponse(s ethpb.ValidatorStatus) func(
+	context.Context, *ethpb.MultipleValidatorStatusRequest, ...grpc.CallOption,
+) (*ethpb.MultipleValidatorStatusResponse, error) {
+	return func(
+		_ context.Context, req *ethpb.MultipleValidatorStatusRequest, _ ...grpc.CallOption,
+	) (*ethpb.MultipleValidatorStatusResponse, error) {
+		resp := &ethpb.MultipleValidatorStatusResponse{PublicKeys: req.PublicKeys}
+		for range req.PublicKeys {
+			resp.Statuses = append(resp.Statuses, &ethpb.ValidatorStatusResponse{Status: s})
+		}
+		return resp, nil
+	}
+}
+
 func TestPrepareWallet_EmptyWalletReturnsError(t *testing.T) {
 	imported.ResetCaches()
 	walletDir, _, passwordFilePath := setupWalletAndPasswordsDir(t)
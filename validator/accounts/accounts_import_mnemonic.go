@@ -0,0 +1,151 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/urfave/cli/v2"
+)
+
+const mnemonicImportWarning = "Importing accounts from a mnemonic phrase. Anyone who sees this phrase " +
+	"controls all keys derived from it, including withdrawal keys. Only run this on a trusted, offline " +
+	"machine, and never store the phrase on disk next to the wallet"
+
+// keypairImporter is implemented by the keymanagers which can write raw keypairs into the wallet.
+type keypairImporter interface {
+	ImportKeypairs(ctx context.Context, privKeys, pubKeys [][]byte) error
+}
+
+// ImportMnemonicAccountsConfig defines values to run the import mnemonic accounts function.
+type ImportMnemonicAccountsConfig struct {
+	Keymanager       keymanager.IKeymanager
+	Mnemonic         []byte
+	Mnemonic25thWord string
+	StartIndex       int
+	NumAccounts      int
+}
+
+// ImportMnemonicAccountsCli derives a range of EIP-2334 validator keys from a mnemonic phrase and
+// imports them into the Prysm validator wallet. This uses the CLI to extract values necessary
+// to run the function.
+func ImportMnemonicAccountsCli(cliCtx *cli.Context) error {
+	log.Warn(mnemonicImportWarning)
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	km, err := w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	if err != nil {
+		return errors.Wrap(err, ErrCouldNotInitializeKeymanager)
+	}
+	if _, ok := km.(keypairImporter); !ok {
+		return fmt.Errorf(errKeymanagerNotSupported, w.KeymanagerKind())
+	}
+	mnemonicPassphrase, err := inputMnemonicImportPassphrase(cliCtx)
+	if err != nil {
+		return errors.Wrap(err, "could not get mnemonic passphrase")
+	}
+	mnemonic, err := inputMnemonicBytes(cliCtx)
+	if err != nil {
+		return errors.Wrap(err, "could not get mnemonic phrase")
+	}
+	cfg := &ImportMnemonicAccountsConfig{
+		Keymanager:       km,
+		Mnemonic:         mnemonic,
+		Mnemonic25thWord: mnemonicPassphrase,
+		StartIndex:       cliCtx.Int(flags.MnemonicStartIndexFlag.Name),
+		NumAccounts:      cliCtx.Int(flags.NumAccountsFlag.Name),
+	}
+	pubKeys, err := ImportMnemonicAccounts(cliCtx.Context, cfg)
+	if err != nil {
+		return err
+	}
+	fmt.Printf(
+		"Successfully imported %s accounts with indices %d through %d, view all of them by running `accounts list`\n",
+		au.BrightMagenta(strconv.Itoa(len(pubKeys))), cfg.StartIndex, cfg.StartIndex+cfg.NumAccounts-1,
+	)
+	return nil
+}
+
+// ImportMnemonicAccounts derives the validator keys with account indices StartIndex through
+// StartIndex+NumAccounts-1 from a mnemonic phrase and imports them into the wallet, returning
+// their public keys. The mnemonic is never persisted and is zeroed in memory once used.
+func ImportMnemonicAccounts(ctx context.Context, cfg *ImportMnemonicAccountsConfig) ([][]byte, error) {
+	km, ok := cfg.Keymanager.(keypairImporter)
+	if !ok {
+		for i := range cfg.Mnemonic {
+			cfg.Mnemonic[i] = 0
+		}
+		return nil, errors.New("keymanager cannot import accounts from a mnemonic")
+	}
+	privKeys, pubKeys, err := derived.DeriveValidatingKeys(
+		cfg.Mnemonic, cfg.Mnemonic25thWord, cfg.StartIndex, cfg.NumAccounts,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not derive keys from mnemonic")
+	}
+	if err := km.ImportKeypairs(ctx, privKeys, pubKeys); err != nil {
+		return nil, errors.Wrap(err, "could not import derived keys into wallet")
+	}
+	return pubKeys, nil
+}
+
+// Reads the mnemonic phrase as bytes from a file or from a prompt which does not echo it, so
+// that it can be zeroed once used.
+func inputMnemonicBytes(cliCtx *cli.Context) ([]byte, error) {
+	if cliCtx.IsSet(flags.MnemonicFileFlag.Name) {
+		mnemonicFilePath, err := fileutil.ExpandPath(cliCtx.String(flags.MnemonicFileFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.ReadFile(mnemonicFilePath)
+	}
+	fmt.Printf("%s: ", au.Bold("Enter the english seed phrase to import accounts from"))
+	mnemonic, err := promptutil.PasswordReader(os.Stdin)
+	fmt.Println("")
+	return mnemonic, err
+}
+
+func inputMnemonicImportPassphrase(cliCtx *cli.Context) (string, error) {
+	if !cliCtx.IsSet(flags.Mnemonic25thWordFileFlag.Name) {
+		if cliCtx.IsSet(flags.SkipMnemonic25thWordCheckFlag.Name) {
+			return "", nil
+		}
+		resp, err := promptutil.ValidatePrompt(
+			os.Stdin, mnemonicPassphraseYesNoText, promptutil.ValidateYesOrNo,
+		)
+		if err != nil {
+			return "", errors.Wrap(err, "could not validate choice")
+		}
+		if !strings.EqualFold(resp, "y") {
+			return "", nil
+		}
+	}
+	return promptutil.InputPassword(
+		cliCtx,
+		flags.Mnemonic25thWordFileFlag,
+		mnemonicPassphrasePromptText,
+		"Confirm mnemonic passphrase",
+		false, /* Should confirm password */
+		func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return errors.New("input cannot be empty")
+			}
+			return nil
+		},
+	)
+}
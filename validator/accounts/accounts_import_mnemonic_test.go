@@ -0,0 +1,54 @@
+package accounts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+)
+
+func TestImportMnemonicAccounts(t *testing.T) {
+	imported.ResetCaches()
+	ctx := context.Background()
+	w, err := CreateWalletWithKeymanager(ctx, &CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      t.TempDir(),
+			KeymanagerKind: keymanager.Imported,
+			WalletPassword: password,
+		},
+	})
+	require.NoError(t, err)
+	km, err := imported.NewKeymanager(ctx, &imported.SetupConfig{Wallet: w})
+	require.NoError(t, err)
+
+	phrase := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	mnemonic := []byte(phrase)
+	pubKeys, err := ImportMnemonicAccounts(ctx, &ImportMnemonicAccountsConfig{
+		Keymanager:  km,
+		Mnemonic:    mnemonic,
+		StartIndex:  5,
+		NumAccounts: 2,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]byte, len(mnemonic)), mnemonic, "Expected mnemonic to be zeroed")
+
+	_, wantPubKeys, err := derived.DeriveValidatingKeys([]byte(phrase), "", 5, 2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantPubKeys, pubKeys)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keys))
+	importedKeys := make(map[[48]byte]bool)
+	for _, key := range keys {
+		importedKeys[key] = true
+	}
+	for _, key := range wantPubKeys {
+		assert.Equal(t, true, importedKeys[bytesutil.ToBytes48(key)])
+	}
+}
@@ -9,6 +9,9 @@ import (
 // InitKeymanagerConfig defines configuration options for initializing a keymanager.
 type InitKeymanagerConfig struct {
 	ListenForChanges bool
+	// SkipInvalidKeys makes the keymanager log and skip the accounts failing its
+	// integrity check instead of refusing to start.
+	SkipInvalidKeys bool
 }
 
 // Wallet defines a struct which has capabilities and knowledge of how
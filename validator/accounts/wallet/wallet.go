@@ -262,6 +262,7 @@ func (w *Wallet) InitializeKeymanager(ctx context.Context, cfg iface.InitKeymana
 		km, err = imported.NewKeymanager(ctx, &imported.SetupConfig{
 			Wallet:           w,
 			ListenForChanges: cfg.ListenForChanges,
+			SkipInvalidKeys:  cfg.SkipInvalidKeys,
 		})
 		if err != nil {
 			return nil, errors.Wrap(err, "could not initialize imported keymanager")
@@ -270,6 +271,7 @@ func (w *Wallet) InitializeKeymanager(ctx context.Context, cfg iface.InitKeymana
 		km, err = derived.NewKeymanager(ctx, &derived.SetupConfig{
 			Wallet:           w,
 			ListenForChanges: cfg.ListenForChanges,
+			SkipInvalidKeys:  cfg.SkipInvalidKeys,
 		})
 		if err != nil {
 			return nil, errors.Wrap(err, "could not initialize derived keymanager")
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
@@ -24,6 +25,10 @@ import (
 // an aggregator. If yes, then beacon node will broadcast aggregated signature and
 // proof on the validator's behalf.
 func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot, pubKey [48]byte) {
+	if !v.startDuty(slot, "aggregate") {
+		return
+	}
+	defer v.maintenance.done()
 	ctx, span := trace.StartSpan(ctx, "validator.SubmitAggregateAndProof")
 	defer span.End()
 
@@ -61,7 +66,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 	// As specified in spec, an aggregator should wait until two thirds of the way through slot
 	// to broadcast the best aggregate to the global aggregate channel.
 	// https://github.com/ethereum/eth2.0-specs/blob/v0.9.3/specs/validator/0_beacon-chain-validator.md#broadcast-aggregate
-	v.waitToSlotTwoThirds(ctx, slot)
+	v.waitToAggregationTime(ctx, slot)
 
 	res, err := v.validatorClient.SubmitAggregateSelectionProof(ctx, &ethpb.AggregateSelectionRequest{
 		Slot:           slot,
@@ -115,9 +120,32 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot types.Slot
 
 }
 
+// selectionProofKey identifies the selection proof of a validator for a slot.
+type selectionProofKey struct {
+	pubKey [48]byte
+	slot   types.Slot
+}
+
+// cachedSelectionProof returns the selection proof of a validator for a slot if it was already signed.
+func (v *validator) cachedSelectionProof(pubKey [48]byte, slot types.Slot) ([]byte, bool) {
+	v.selectionProofCacheLock.Lock()
+	defer v.selectionProofCacheLock.Unlock()
+	proof, ok := v.selectionProofCache[selectionProofKey{pubKey: pubKey, slot: slot}]
+	return proof, ok
+}
+
 // This implements selection logic outlined in:
 // https://github.com/ethereum/eth2.0-specs/blob/v0.9.3/specs/validator/0_beacon-chain-validator.md#aggregation-selection
+// Selection proofs are cached, as the aggregator duties are computed several times for the same slot.
 func (v *validator) signSlot(ctx context.Context, pubKey [48]byte, slot types.Slot) ([]byte, error) {
+	useCache := !featureconfig.Get().DisableSelectionProofCache
+	key := selectionProofKey{pubKey: pubKey, slot: slot}
+	if useCache {
+		if proof, ok := v.cachedSelectionProof(pubKey, slot); ok {
+			return proof, nil
+		}
+	}
+
 	domain, err := v.domainData(ctx, helpers.SlotToEpoch(slot), params.BeaconConfig().DomainSelectionProof[:])
 	if err != nil {
 		return nil, err
@@ -136,22 +164,42 @@ func (v *validator) signSlot(ctx context.Context, pubKey [48]byte, slot types.Sl
 		Object:          &validatorpb.SignRequest_Slot{Slot: slot},
 	})
 	if err != nil {
+		recordSigningFailure(signOpAggregate, signingFailureCause(err))
 		return nil, err
 	}
+	proof := sig.Marshal()
 
-	return sig.Marshal(), nil
+	if useCache {
+		v.selectionProofCacheLock.Lock()
+		if v.selectionProofCache == nil {
+			v.selectionProofCache = make(map[selectionProofKey][]byte)
+		}
+		v.selectionProofCache[key] = proof
+		v.selectionProofCacheLock.Unlock()
+	}
+	return proof, nil
+}
+
+// pruneSelectionProofCache removes the selection proofs of the slots before the given epoch.
+func (v *validator) pruneSelectionProofCache(epoch types.Epoch) {
+	v.selectionProofCacheLock.Lock()
+	defer v.selectionProofCacheLock.Unlock()
+
+	for key := range v.selectionProofCache {
+		if helpers.SlotToEpoch(key.slot) < epoch {
+			delete(v.selectionProofCache, key)
+		}
+	}
 }
 
-// waitToSlotTwoThirds waits until two third through the current slot period
-// such that any attestations from this slot have time to reach the beacon node
-// before creating the aggregated attestation.
-func (v *validator) waitToSlotTwoThirds(ctx context.Context, slot types.Slot) {
-	ctx, span := trace.StartSpan(ctx, "validator.waitToSlotTwoThirds")
+// waitToAggregationTime waits until the configured offset through the current slot
+// period, two third by default, such that any attestations from this slot have time
+// to reach the beacon node before creating the aggregated attestation.
+func (v *validator) waitToAggregationTime(ctx context.Context, slot types.Slot) {
+	ctx, span := trace.StartSpan(ctx, "validator.waitToAggregationTime")
 	defer span.End()
 
-	oneThird := slotutil.DivideSlotBy(3 /* one third of slot duration */)
-	twoThird := oneThird + oneThird
-	delay := twoThird
+	delay := v.aggregationDelay()
 
 	startTime := slotutil.SlotStartTime(v.genesisTime, slot)
 	finalTime := startTime.Add(delay)
@@ -170,6 +218,18 @@ func (v *validator) waitToSlotTwoThirds(ctx context.Context, slot types.Slot) {
 	}
 }
 
+// aggregationDelay returns the time into the slot at which the aggregate is created.
+// A later offset gathers more attestations, at the risk of the aggregate reaching the
+// next proposer too late to be included.
+func (v *validator) aggregationDelay() time.Duration {
+	if v.aggregationOffset == 0 {
+		oneThird := slotutil.DivideSlotBy(3 /* one third of slot duration */)
+		return oneThird + oneThird
+	}
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	return time.Duration(float64(slotDuration) * v.aggregationOffset).Truncate(time.Millisecond)
+}
+
 // This returns the signature of validator signing over aggregate and
 // proof object.
 func (v *validator) aggregateAndProofSig(ctx context.Context, pubKey [48]byte, agg *ethpb.AggregateAttestationAndProof) ([]byte, error) {
@@ -189,6 +249,7 @@ func (v *validator) aggregateAndProofSig(ctx context.Context, pubKey [48]byte, a
 		Object:          &validatorpb.SignRequest_AggregateAttestationAndProof{AggregateAttestationAndProof: agg},
 	})
 	if err != nil {
+		recordSigningFailure(signOpAggregate, signingFailureCause(err))
 		return nil, err
 	}
 
@@ -10,6 +10,8 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -125,7 +127,7 @@ func TestWaitForSlotTwoThird_WaitCorrectly(t *testing.T) {
 	timeToSleep := oneThird + oneThird
 
 	twoThirdTime := currentTime.Add(timeToSleep)
-	validator.waitToSlotTwoThirds(context.Background(), numOfSlots)
+	validator.waitToAggregationTime(context.Background(), numOfSlots)
 	currentTime = timeutils.Now()
 	assert.Equal(t, twoThirdTime.Unix(), currentTime.Unix())
 }
@@ -140,11 +142,27 @@ func TestWaitForSlotTwoThird_DoneContext_ReturnsImmediately(t *testing.T) {
 	expectedTime := timeutils.Now()
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
-	validator.waitToSlotTwoThirds(ctx, numOfSlots)
+	validator.waitToAggregationTime(ctx, numOfSlots)
 	currentTime = timeutils.Now()
 	assert.Equal(t, expectedTime.Unix(), currentTime.Unix())
 }
 
+func TestWaitToAggregationTime_ConfiguredOffset(t *testing.T) {
+	validator, _, _, finish := setup(t)
+	defer finish()
+	validator.aggregationOffset = 0.75
+	currentTime := timeutils.Now()
+	numOfSlots := types.Slot(4)
+	validator.genesisTime = uint64(currentTime.Unix()) - uint64(numOfSlots.Mul(params.BeaconConfig().SecondsPerSlot))
+	timeToSleep := slotutil.DivideSlotBy(4) * 3
+
+	assert.Equal(t, timeToSleep, validator.aggregationDelay())
+	aggregationTime := currentTime.Add(timeToSleep)
+	validator.waitToAggregationTime(context.Background(), numOfSlots)
+	currentTime = timeutils.Now()
+	assert.Equal(t, aggregationTime.Unix(), currentTime.Unix())
+}
+
 func TestAggregateAndProofSignature_CanSignValidSignature(t *testing.T) {
 	validator, m, validatorKey, finish := setup(t)
 	defer finish()
@@ -168,3 +186,70 @@ func TestAggregateAndProofSignature_CanSignValidSignature(t *testing.T) {
 	_, err = bls.SignatureFromBytes(sig)
 	require.NoError(t, err)
 }
+
+func TestSignSlot_CachesSelectionProof(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	// Domain data is only requested when the selection proof is not cached.
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Times(2).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+
+	slot := params.BeaconConfig().SlotsPerEpoch + 1
+	first, err := validator.signSlot(context.Background(), pubKey, slot)
+	require.NoError(t, err)
+	second, err := validator.signSlot(context.Background(), pubKey, slot)
+	require.NoError(t, err)
+	assert.DeepEqual(t, first, second)
+
+	validator.pruneSelectionProofCache(1)
+	assert.Equal(t, 1, len(validator.selectionProofCache), "Proof of the current epoch was pruned")
+	validator.pruneSelectionProofCache(2)
+	assert.Equal(t, 0, len(validator.selectionProofCache), "Proof of a past epoch was not pruned")
+
+	_, err = validator.signSlot(context.Background(), pubKey, slot)
+	require.NoError(t, err)
+}
+
+func BenchmarkSignSlot_1000Validators(b *testing.B) {
+	ctrl := gomock.NewController(b)
+	defer ctrl.Finish()
+	validatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).AnyTimes().Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+
+	km := &mockKeymanager{keysMap: make(map[[48]byte]bls.SecretKey)}
+	pubKeys := make([][48]byte, 1000)
+	for i := range pubKeys {
+		key, err := bls.RandKey()
+		require.NoError(b, err)
+		copy(pubKeys[i][:], key.PublicKey().Marshal())
+		km.keysMap[pubKeys[i]] = key
+	}
+
+	for _, disableCache := range []bool{false, true} {
+		name := "cached"
+		if disableCache {
+			name = "uncached"
+		}
+		b.Run(name, func(b *testing.B) {
+			resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{DisableSelectionProofCache: disableCache})
+			defer resetCfg()
+			v := &validator{keyManager: km, validatorClient: validatorClient}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for _, pubKey := range pubKeys {
+					if _, err := v.signSlot(context.Background(), pubKey, 1); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
@@ -30,6 +30,10 @@ import (
 // information in order to sign the block and include information about the validator's
 // participation in voting on the block.
 func (v *validator) SubmitAttestation(ctx context.Context, slot types.Slot, pubKey [48]byte) {
+	if !v.startDuty(slot, "attestation") {
+		return
+	}
+	defer v.maintenance.done()
 	ctx, span := trace.StartSpan(ctx, "validator.SubmitAttestation")
 	defer span.End()
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
@@ -206,6 +210,7 @@ func (v *validator) signAtt(ctx context.Context, pubKey [48]byte, data *ethpb.At
 		Object:          &validatorpb.SignRequest_AttestationData{AttestationData: data},
 	})
 	if err != nil {
+		recordSigningFailure(signOpAttestation, signingFailureCause(err))
 		return nil, [32]byte{}, err
 	}
 
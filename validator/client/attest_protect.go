@@ -35,6 +35,7 @@ func (v *validator) slashableAttestationCheck(
 		return err
 	}
 	if exists && indexedAtt.Data.Source.Epoch < lowestSourceEpoch {
+		recordSigningFailure(signOpAttestation, signFailureSlashingProtection)
 		return fmt.Errorf(
 			"could not sign attestation lower than lowest source epoch in db, %d < %d",
 			indexedAtt.Data.Source.Epoch,
@@ -54,6 +55,7 @@ func (v *validator) slashableAttestationCheck(
 		return err
 	}
 	if signingRootsDiffer && exists && indexedAtt.Data.Target.Epoch <= lowestTargetEpoch {
+		recordSigningFailure(signOpAttestation, signFailureSlashingProtection)
 		return fmt.Errorf(
 			"could not sign attestation lower than or equal to lowest target epoch in db, %d <= %d",
 			indexedAtt.Data.Target.Epoch,
@@ -66,6 +68,7 @@ func (v *validator) slashableAttestationCheck(
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		recordSigningFailure(signOpAttestation, signFailureSlashingProtection)
 		switch slashingKind {
 		case kv.DoubleVote:
 			log.Warn("Attestation is slashable as it is a double vote")
@@ -83,6 +86,7 @@ func (v *validator) slashableAttestationCheck(
 
 	if featureconfig.Get().SlasherProtection && v.protector != nil {
 		if !v.protector.CommitAttestation(ctx, indexedAtt) {
+			recordSigningFailure(signOpAttestation, signFailureSlashingProtection)
 			if v.emitAccountMetrics {
 				ValidatorAttestFailVecSlasher.WithLabelValues(fmtKey).Inc()
 			}
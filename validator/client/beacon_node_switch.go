@@ -0,0 +1,101 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+// beaconNodeCheckTimeout bounds the time spent connecting to each new beacon node endpoint before
+// switching to it.
+const beaconNodeCheckTimeout = 10 * time.Second
+
+var (
+	// ErrInvalidBeaconNodeEndpoint is returned when switching to an empty beacon node endpoint.
+	ErrInvalidBeaconNodeEndpoint = errors.New("invalid beacon node endpoint")
+	// ErrBeaconNodeUnreachable is returned when a new beacon node endpoint cannot be reached.
+	ErrBeaconNodeUnreachable = errors.New("beacon node is unreachable")
+	// ErrBeaconNodeGenesisMismatch is returned when a new beacon node follows another chain than the current one.
+	ErrBeaconNodeGenesisMismatch = errors.New("beacon node has a different genesis")
+)
+
+// SwitchBeaconNode points the validator client at new beacon node endpoints, given as a comma
+// separated list, without restarting it. Each new endpoint must be reachable and follow the same
+// chain as the current beacon node. Requests in flight to the current beacon node are allowed to
+// complete, while new requests and the blocks stream go to the new endpoints. It returns the
+// previous endpoint and the new one, stripped of whitespace.
+func (v *ValidatorService) SwitchBeaconNode(ctx context.Context, endpoint string) (string, string, error) {
+	val := v.runningValidator()
+	if val == nil || v.endpointResolver == nil {
+		return "", "", ErrValidatorNotStarted
+	}
+	var endpoints []string
+	for _, e := range strings.Split(endpoint, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			endpoints = append(endpoints, e)
+		}
+	}
+	if len(endpoints) == 0 {
+		return "", "", ErrInvalidBeaconNodeEndpoint
+	}
+	endpoint = strings.Join(endpoints, ",")
+
+	v.endpointLock.Lock()
+	defer v.endpointLock.Unlock()
+	ctx = grpcutils.AppendHeaders(ctx, v.grpcHeaders)
+	current, err := v.GenesisInfo(ctx)
+	if err != nil {
+		log.WithError(err).Warn("Could not get genesis from the current beacon node, not checking the new one follows the same chain")
+		current = nil
+	}
+	for _, e := range endpoints {
+		if err := v.checkBeaconNode(ctx, e, current); err != nil {
+			return "", "", err
+		}
+	}
+
+	previous := v.endpoint
+	log.WithFields(logrus.Fields{
+		"previous": previous,
+		"endpoint": endpoint,
+	}).Info("Switching beacon node, requests in flight to the previous beacon node are allowed to complete")
+	if !v.endpointResolver.updateEndpoints(endpoint) {
+		return "", "", errors.Wrapf(ErrInvalidBeaconNodeEndpoint, "endpoint %s cannot be switched at runtime", previous)
+	}
+	v.endpoint = endpoint
+	val.RestartBlockStream()
+	log.WithField("endpoint", endpoint).Info("Switched beacon node")
+	return previous, endpoint, nil
+}
+
+// checkBeaconNode connects to a beacon node endpoint and checks that it has the given genesis, if any.
+func (v *ValidatorService) checkBeaconNode(ctx context.Context, endpoint string, genesis *ethpb.Genesis) error {
+	ctx, cancel := context.WithTimeout(ctx, beaconNodeCheckTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, endpoint, append(append([]grpc.DialOption{}, v.dialOpts...), grpc.WithBlock())...)
+	if err != nil {
+		return errors.Wrapf(ErrBeaconNodeUnreachable, "could not dial %s: %v", endpoint, err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Debug("Could not close connection to new beacon node")
+		}
+	}()
+	got, err := ethpb.NewNodeClient(conn).GetGenesis(ctx, &ptypes.Empty{})
+	if err != nil {
+		return errors.Wrapf(ErrBeaconNodeUnreachable, "could not get genesis from %s: %v", endpoint, err)
+	}
+	if genesis != nil && (!bytes.Equal(got.GenesisValidatorsRoot, genesis.GenesisValidatorsRoot) ||
+		!bytes.Equal(got.DepositContractAddress, genesis.DepositContractAddress)) {
+		return errors.Wrapf(ErrBeaconNodeGenesisMismatch, "endpoint %s", endpoint)
+	}
+	return nil
+}
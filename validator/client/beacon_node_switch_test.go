@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/resolver"
+)
+
+type fakeResolverConn struct {
+	resolver.ClientConn
+	states []resolver.State
+}
+
+func (c *fakeResolverConn) UpdateState(s resolver.State) {
+	c.states = append(c.states, s)
+}
+
+func TestMultipleEndpointsGrpcResolver_UpdateEndpoints(t *testing.T) {
+	b := &multipleEndpointsGrpcResolverBuilder{}
+	assert.Equal(t, false, b.updateEndpoints("127.0.0.1:5000"))
+
+	cc := &fakeResolverConn{}
+	r, err := b.Build(resolver.Target{Endpoint: "127.0.0.1:4000"}, cc, resolver.BuildOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(cc.states))
+	assert.DeepEqual(t, []resolver.Address{{Addr: "127.0.0.1:4000", ServerName: "127.0.0.1:4000"}}, cc.states[0].Addresses)
+
+	assert.Equal(t, true, b.updateEndpoints("127.0.0.1:5000,127.0.0.1:5001"))
+	require.Equal(t, 2, len(cc.states))
+	assert.DeepEqual(t, []resolver.Address{
+		{Addr: "127.0.0.1:5000", ServerName: "127.0.0.1:5000"},
+		{Addr: "127.0.0.1:5001", ServerName: "127.0.0.1:5001"},
+	}, cc.states[1].Addresses)
+
+	r.Close()
+	assert.Equal(t, false, b.updateEndpoints("127.0.0.1:6000"))
+}
+
+func TestValidatorService_SwitchBeaconNode_InvalidRequest(t *testing.T) {
+	v := &ValidatorService{}
+	_, _, err := v.SwitchBeaconNode(context.Background(), "127.0.0.1:4000")
+	assert.ErrorContains(t, ErrValidatorNotStarted.Error(), err)
+
+	v = &ValidatorService{
+		validator:        &FakeValidator{},
+		endpointResolver: &multipleEndpointsGrpcResolverBuilder{},
+	}
+	_, _, err = v.SwitchBeaconNode(context.Background(), " , ")
+	assert.ErrorContains(t, ErrInvalidBeaconNodeEndpoint.Error(), err)
+}
+
+func TestValidator_RestartBlockStream(t *testing.T) {
+	v := &validator{}
+	// Restarting before the stream is opened does nothing.
+	v.RestartBlockStream()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	v.cancelBlockStream = cancel
+	v.RestartBlockStream()
+	assert.ErrorContains(t, context.Canceled.Error(), ctx.Err())
+}
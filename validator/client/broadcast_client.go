@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+// broadcastValidatorClient submits signed blocks, attestations and aggregates to the primary
+// beacon node and to every broadcast beacon node. A submission succeeds as soon as one of the
+// beacon nodes accepts it, while the others complete in the background. All other calls, such as duties and state reads, only go to the
+// primary beacon node, which fails over between the endpoints of --beacon-rpc-provider.
+type broadcastValidatorClient struct {
+	ethpb.BeaconNodeValidatorClient
+	primaryEndpoint    string
+	broadcastEndpoints []string
+	broadcastClients   []ethpb.BeaconNodeValidatorClient
+}
+
+type submissionResult struct {
+	index int
+	resp  interface{}
+	err   error
+}
+
+// detachedContext carries the values of a context, such as the gRPC headers, without its
+// cancellation, so that submissions still in flight are not aborted once the caller returns.
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+func newBroadcastValidatorClient(
+	primary ethpb.BeaconNodeValidatorClient,
+	primaryEndpoint string,
+	broadcastEndpoints []string,
+	broadcastClients []ethpb.BeaconNodeValidatorClient,
+) *broadcastValidatorClient {
+	return &broadcastValidatorClient{
+		BeaconNodeValidatorClient: primary,
+		primaryEndpoint:           primaryEndpoint,
+		broadcastEndpoints:        broadcastEndpoints,
+		broadcastClients:          broadcastClients,
+	}
+}
+
+// ProposeBlock submits the signed block to all beacon nodes.
+func (c *broadcastValidatorClient) ProposeBlock(
+	ctx context.Context, in *ethpb.SignedBeaconBlock, opts ...grpc.CallOption,
+) (*ethpb.ProposeResponse, error) {
+	resp, err := c.broadcast(ctx, "ProposeBlock", func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (interface{}, error) {
+		return client.ProposeBlock(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*ethpb.ProposeResponse), nil
+}
+
+// ProposeAttestation submits the signed attestation to all beacon nodes.
+func (c *broadcastValidatorClient) ProposeAttestation(
+	ctx context.Context, in *ethpb.Attestation, opts ...grpc.CallOption,
+) (*ethpb.AttestResponse, error) {
+	resp, err := c.broadcast(ctx, "ProposeAttestation", func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (interface{}, error) {
+		return client.ProposeAttestation(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*ethpb.AttestResponse), nil
+}
+
+// SubmitSignedAggregateSelectionProof submits the signed aggregate to all beacon nodes.
+func (c *broadcastValidatorClient) SubmitSignedAggregateSelectionProof(
+	ctx context.Context, in *ethpb.SignedAggregateSubmitRequest, opts ...grpc.CallOption,
+) (*ethpb.SignedAggregateSubmitResponse, error) {
+	resp, err := c.broadcast(ctx, "SubmitSignedAggregateSelectionProof", func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (interface{}, error) {
+		return client.SubmitSignedAggregateSelectionProof(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*ethpb.SignedAggregateSubmitResponse), nil
+}
+
+// broadcast runs the submission against all beacon nodes concurrently and returns the response of
+// the first beacon node which accepts it. The other submissions keep running in the background
+// until the deadline of the caller, or for at most one slot if the caller has none, and their
+// results are only logged.
+func (c *broadcastValidatorClient) broadcast(
+	ctx context.Context,
+	method string,
+	submit func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (interface{}, error),
+) (interface{}, error) {
+	clients := append([]ethpb.BeaconNodeValidatorClient{c.BeaconNodeValidatorClient}, c.broadcastClients...)
+	endpoints := append([]string{c.primaryEndpoint}, c.broadcastEndpoints...)
+
+	var submitCtx context.Context
+	var cancel context.CancelFunc
+	if deadline, ok := ctx.Deadline(); ok {
+		submitCtx, cancel = context.WithDeadline(detachedContext{ctx}, deadline)
+	} else {
+		slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+		submitCtx, cancel = context.WithTimeout(detachedContext{ctx}, slotDuration)
+	}
+	results := make(chan submissionResult, len(clients))
+	for i, client := range clients {
+		go func(i int, client ethpb.BeaconNodeValidatorClient) {
+			resp, err := submit(submitCtx, client)
+			results <- submissionResult{index: i, resp: resp, err: err}
+		}(i, client)
+	}
+
+	var errs []string
+	logResult := func(res submissionResult) {
+		logFields := logrus.Fields{
+			"method":   method,
+			"endpoint": endpoints[res.index],
+			"primary":  res.index == 0,
+		}
+		if res.err != nil {
+			log.WithFields(logFields).WithError(res.err).Debug("Beacon node rejected submission")
+			errs = append(errs, fmt.Sprintf("%s: %v", endpoints[res.index], res.err))
+			return
+		}
+		log.WithFields(logFields).Debug("Beacon node accepted submission")
+	}
+	for received := 1; received <= len(clients); received++ {
+		res := <-results
+		logResult(res)
+		if res.err != nil {
+			continue
+		}
+		go func(remaining int) {
+			defer cancel()
+			for ; remaining > 0; remaining-- {
+				logResult(<-results)
+			}
+			if len(errs) > 0 {
+				log.WithField("method", method).WithField("errors", errs).Warn("Some beacon nodes rejected submission")
+			}
+		}(len(clients) - received)
+		return res.resp, nil
+	}
+	cancel()
+	return nil, errors.Errorf("no beacon node accepted %s: %v", method, errs)
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+func TestBroadcastValidatorClient_ReturnsFirstAccepted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	primary := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	secondary := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	c := newBroadcastValidatorClient(primary, "primary", []string{"secondary"}, []ethpb.BeaconNodeValidatorClient{secondary})
+
+	release := make(chan struct{})
+	done := make(chan error, 1)
+	primary.EXPECT().ProposeAttestation(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *ethpb.Attestation, _ ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+			<-release
+			done <- ctx.Err()
+			return &ethpb.AttestResponse{AttestationDataRoot: []byte{'a'}}, nil
+		})
+	secondary.EXPECT().ProposeAttestation(gomock.Any(), gomock.Any()).Return(&ethpb.AttestResponse{AttestationDataRoot: []byte{'b'}}, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	resp, err := c.ProposeAttestation(ctx, &ethpb.Attestation{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{'b'}, resp.AttestationDataRoot)
+
+	// The slower submission completes in the background, even once the caller is done.
+	cancel()
+	close(release)
+	require.NoError(t, <-done)
+}
+
+func TestBroadcastValidatorClient_BackgroundSubmissionTimesOut(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.SecondsPerSlot = 1
+	params.OverrideBeaconConfig(cfg)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	primary := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	secondary := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	c := newBroadcastValidatorClient(primary, "primary", []string{"secondary"}, []ethpb.BeaconNodeValidatorClient{secondary})
+
+	done := make(chan error, 1)
+	primary.EXPECT().ProposeBlock(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *ethpb.SignedBeaconBlock, _ ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+			<-ctx.Done()
+			done <- ctx.Err()
+			return nil, ctx.Err()
+		})
+	secondary.EXPECT().ProposeBlock(gomock.Any(), gomock.Any()).Return(&ethpb.ProposeResponse{BlockRoot: []byte{'b'}}, nil)
+	resp, err := c.ProposeBlock(context.Background(), testutil.NewBeaconBlock())
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{'b'}, resp.BlockRoot)
+
+	// A caller without a deadline still bounds the hanging submission to one slot.
+	select {
+	case err := <-done:
+		assert.Equal(t, context.DeadlineExceeded, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Background submission did not time out")
+	}
+}
+
+func TestBroadcastValidatorClient_SucceedsIfOneAccepts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	primary := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	secondary := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	c := newBroadcastValidatorClient(primary, "primary", []string{"secondary"}, []ethpb.BeaconNodeValidatorClient{secondary})
+
+	primary.EXPECT().ProposeBlock(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+	secondary.EXPECT().ProposeBlock(gomock.Any(), gomock.Any()).Return(&ethpb.ProposeResponse{BlockRoot: []byte{'b'}}, nil)
+	resp, err := c.ProposeBlock(context.Background(), testutil.NewBeaconBlock())
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{'b'}, resp.BlockRoot)
+}
+
+func TestBroadcastValidatorClient_FailsIfNoneAccepts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	primary := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	secondary := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	c := newBroadcastValidatorClient(primary, "primary", []string{"secondary"}, []ethpb.BeaconNodeValidatorClient{secondary})
+
+	primary.EXPECT().SubmitSignedAggregateSelectionProof(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+	secondary.EXPECT().SubmitSignedAggregateSelectionProof(gomock.Any(), gomock.Any()).Return(nil, errors.New("invalid"))
+	_, err := c.SubmitSignedAggregateSelectionProof(context.Background(), &ethpb.SignedAggregateSubmitRequest{})
+	assert.ErrorContains(t, "no beacon node accepted SubmitSignedAggregateSelectionProof", err)
+}
+
+func TestBroadcastValidatorClient_ReadsOnlyFromPrimary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	primary := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	secondary := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	c := newBroadcastValidatorClient(primary, "primary", []string{"secondary"}, []ethpb.BeaconNodeValidatorClient{secondary})
+
+	primary.EXPECT().GetDuties(gomock.Any(), gomock.Any()).Return(&ethpb.DutiesResponse{}, nil)
+	_, err := c.GetDuties(context.Background(), &ethpb.DutiesRequest{})
+	require.NoError(t, err)
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+)
+
+// CheckDoppelgangers queries the liveness of the validating keys on chain for the configured
+// number of epochs before the validator starts performing duties. Validating keys which are seen
+// live during that window are most likely in use by another validator client, so they are
+// excluded from duties for the lifetime of the process to avoid getting slashed.
+func (v *validator) CheckDoppelgangers(ctx context.Context) error {
+	if v.doppelgangerEpochs == 0 {
+		return nil
+	}
+	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating keys")
+	}
+	if len(validatingKeys) == 0 {
+		return nil
+	}
+	req := &ethpb.MultipleValidatorStatusRequest{PublicKeys: bytesutil.FromBytes48Array(validatingKeys)}
+	resp, err := v.validatorClient.MultipleValidatorStatus(ctx, req)
+	if err != nil {
+		return err
+	}
+	if len(resp.Statuses) != len(validatingKeys) || len(resp.Indices) != len(validatingKeys) {
+		return errors.New("number of status responses did not match number of requested keys")
+	}
+	// Only validators which are able to attest can give a liveness signal.
+	pubKeysByIndex := make(map[uint64][48]byte)
+	for i, status := range resp.Statuses {
+		if status.Status == ethpb.ValidatorStatus_ACTIVE || status.Status == ethpb.ValidatorStatus_EXITING ||
+			status.Status == ethpb.ValidatorStatus_SLASHING {
+			pubKeysByIndex[uint64(resp.Indices[i])] = validatingKeys[i]
+		}
+	}
+	if len(pubKeysByIndex) == 0 {
+		return nil
+	}
+
+	// The attestations of the current epoch may be from this validator client before it was
+	// restarted, so only the epochs after it are checked.
+	startEpoch := slotutil.EpochsSinceGenesis(time.Unix(int64(v.genesisTime), 0)) + 1
+	log.WithFields(logrus.Fields{
+		"epochs":     v.doppelgangerEpochs,
+		"startEpoch": startEpoch,
+		"validators": len(pubKeysByIndex),
+	}).Info("Checking the liveness of our validators before performing duties (doppelganger protection)")
+	doppelgangers, err := v.watchForDoppelgangers(ctx, pubKeysByIndex, startEpoch)
+
+	v.slashableKeysLock.Lock()
+	defer v.slashableKeysLock.Unlock()
+	if v.doppelgangerPublicKeys == nil {
+		v.doppelgangerPublicKeys = make(map[[48]byte]bool)
+	}
+	for pubKey := range doppelgangers {
+		v.doppelgangerPublicKeys[pubKey] = true
+		log.WithField(
+			"publicKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+		).Error("DOPPELGANGER DETECTED: this validator key is attesting elsewhere on the network, " +
+			"it will not perform any duties. Make sure it is only used by a single validator client and restart")
+	}
+	return err
+}
+
+// watchForDoppelgangers returns the public keys of the given validator indices which are live in
+// any of the configured number of epochs from the start epoch. The attestations of an epoch can be
+// included until the end of the next epoch, so the liveness of each watched epoch is queried at the
+// start of both epochs after it.
+func (v *validator) watchForDoppelgangers(
+	ctx context.Context, pubKeysByIndex map[uint64][48]byte, startEpoch types.Epoch,
+) (map[[48]byte]bool, error) {
+	indices := make([]uint64, 0, len(pubKeysByIndex))
+	for index := range pubKeysByIndex {
+		indices = append(indices, index)
+	}
+	doppelgangers := make(map[[48]byte]bool)
+	lastEpoch := startEpoch + v.doppelgangerEpochs - 1
+	for epoch := startEpoch + 1; epoch <= lastEpoch+2; epoch++ {
+		startSlot, err := helpers.StartSlot(epoch)
+		if err != nil {
+			return doppelgangers, err
+		}
+		timer := time.NewTimer(time.Until(slotutil.SlotStartTime(v.genesisTime, startSlot)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return doppelgangers, ctx.Err()
+		case <-timer.C:
+		}
+		for _, checked := range []types.Epoch{epoch - 2, epoch - 1} {
+			// The epochs outside of the watched ones are skipped, as is epoch - 2 wrapping around below zero.
+			if checked < startEpoch || checked > lastEpoch {
+				continue
+			}
+			resp, err := v.livenessClient.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{
+				Epoch:   uint64(checked),
+				Indices: indices,
+			})
+			if err != nil {
+				return doppelgangers, errors.Wrap(errConnectionIssue, errors.Wrap(err, "could not get validator liveness").Error())
+			}
+			for _, index := range resp.LiveIndices {
+				if pubKey, ok := pubKeysByIndex[index]; ok {
+					doppelgangers[pubKey] = true
+				}
+			}
+		}
+	}
+	return doppelgangers, nil
+}
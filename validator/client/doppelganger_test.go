@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type fakeLivenessClient struct {
+	pbrpc.LivenessClient
+	liveEpochs      map[uint64]bool
+	lateLiveEpochs  map[uint64]bool
+	requestedEpochs []uint64
+}
+
+func (c *fakeLivenessClient) GetValidatorLiveness(
+	_ context.Context, req *pbrpc.ValidatorLivenessRequest, _ ...grpc.CallOption,
+) (*pbrpc.ValidatorLivenessResponse, error) {
+	// The attestations of the late live epochs are only included by the second request.
+	requestedBefore := false
+	for _, epoch := range c.requestedEpochs {
+		requestedBefore = requestedBefore || epoch == req.Epoch
+	}
+	c.requestedEpochs = append(c.requestedEpochs, req.Epoch)
+	if !c.liveEpochs[req.Epoch] && !(c.lateLiveEpochs[req.Epoch] && requestedBefore) {
+		return &pbrpc.ValidatorLivenessResponse{}, nil
+	}
+	return &pbrpc.ValidatorLivenessResponse{LiveIndices: req.Indices}, nil
+}
+
+func TestCheckDoppelgangers_Disabled(t *testing.T) {
+	v, _, _, finish := setup(t)
+	defer finish()
+	require.NoError(t, v.CheckDoppelgangers(context.Background()))
+}
+
+func TestCheckDoppelgangers(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.SlotsPerEpoch = 1
+	cfg.SecondsPerSlot = 1
+	params.OverrideBeaconConfig(cfg)
+
+	tests := []struct {
+		name             string
+		liveEpochs       func(startEpoch uint64) map[uint64]bool
+		lateLiveEpochs   func(startEpoch uint64) map[uint64]bool
+		wantDoppelganger bool
+	}{
+		{
+			name: "live after restart",
+			liveEpochs: func(startEpoch uint64) map[uint64]bool {
+				return map[uint64]bool{startEpoch: true}
+			},
+			wantDoppelganger: true,
+		},
+		{
+			// The attestations of the process before the restart do not count.
+			name: "live before restart",
+			liveEpochs: func(startEpoch uint64) map[uint64]bool {
+				return map[uint64]bool{startEpoch - 1: true}
+			},
+			wantDoppelganger: false,
+		},
+		{
+			// The attestations of the last watched epoch are only included in the next epoch.
+			name: "live in last epoch, included late",
+			liveEpochs: func(startEpoch uint64) map[uint64]bool {
+				return map[uint64]bool{}
+			},
+			lateLiveEpochs: func(startEpoch uint64) map[uint64]bool {
+				return map[uint64]bool{startEpoch + 1: true}
+			},
+			wantDoppelganger: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, m, validatorKey, finish := setup(t)
+			defer finish()
+			pubKey := [48]byte{}
+			copy(pubKey[:], validatorKey.PublicKey().Marshal())
+			v.doppelgangerEpochs = 2
+			v.genesisTime = uint64(time.Now().Unix()) - 3
+			startEpoch := uint64(slotutil.EpochsSinceGenesis(time.Unix(int64(v.genesisTime), 0))) + 1
+			client := &fakeLivenessClient{liveEpochs: tt.liveEpochs(startEpoch)}
+			if tt.lateLiveEpochs != nil {
+				client.lateLiveEpochs = tt.lateLiveEpochs(startEpoch)
+			}
+			v.livenessClient = client
+			m.validatorClient.EXPECT().MultipleValidatorStatus(
+				gomock.Any(), // ctx
+				gomock.Any(), // request
+			).Return(&ethpb.MultipleValidatorStatusResponse{
+				Statuses: []*ethpb.ValidatorStatusResponse{{Status: ethpb.ValidatorStatus_ACTIVE}},
+				Indices:  []types.ValidatorIndex{7},
+			}, nil /*err*/)
+
+			require.NoError(t, v.CheckDoppelgangers(context.Background()))
+			assert.Equal(t, tt.wantDoppelganger, v.doppelgangerPublicKeys[pubKey])
+			for _, epoch := range client.requestedEpochs {
+				assert.Equal(t, true, epoch >= startEpoch, "Requested liveness of epoch %d before the start epoch %d", epoch, startEpoch)
+				assert.Equal(t, true, epoch < startEpoch+2, "Requested liveness of epoch %d after the watched epochs", epoch)
+			}
+			assert.Equal(t, true, len(client.requestedEpochs) > 0)
+		})
+	}
+}
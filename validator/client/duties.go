@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+// ErrDutiesNotReady is returned when the duties for the next epoch
+// have not yet been fetched from the beacon node.
+var ErrDutiesNotReady = errors.New("duties for the next epoch are not yet known")
+
+// NextEpochDuty describes the assignments of a managed validator key for the next epoch.
+type NextEpochDuty struct {
+	PublicKey      []byte
+	ValidatorIndex types.ValidatorIndex
+	ProposerSlots  []types.Slot
+	AttesterSlot   types.Slot
+	CommitteeIndex types.CommitteeIndex
+	// IsAggregator is only meaningful when AggregatorKnown is set, that is when the selection
+	// proof of the attester slot has already been signed by the validator client.
+	IsAggregator    bool
+	AggregatorKnown bool
+}
+
+// NextEpochDuties returns the assignments of the managed validator keys for the next epoch
+// from the duties the client already maintains. ErrDutiesNotReady is returned if those
+// are not known yet, such as right after startup or around an epoch boundary. Reading the
+// duties never signs anything: the aggregator status is taken from the selection proofs
+// cached when subscribing to the attestation subnets, and reported as unknown otherwise.
+func (v *validator) NextEpochDuties(_ context.Context) (types.Epoch, []*NextEpochDuty, error) {
+	nextEpoch := helpers.SlotToEpoch(slotutil.SlotsSinceGenesis(time.Unix(int64(v.genesisTime), 0))) + 1
+
+	v.dutiesLock.RLock()
+	duties := v.duties
+	v.dutiesLock.RUnlock()
+	if duties == nil || len(duties.NextEpochDuties) == 0 {
+		return nextEpoch, nil, ErrDutiesNotReady
+	}
+
+	nextEpochDuties := make([]*NextEpochDuty, 0, len(duties.NextEpochDuties))
+	for _, duty := range duties.NextEpochDuties {
+		// The duties are only refreshed at the start of an epoch, so they may still
+		// describe the current epoch right after the boundary was crossed.
+		if helpers.SlotToEpoch(duty.AttesterSlot) != nextEpoch {
+			return nextEpoch, nil, ErrDutiesNotReady
+		}
+		d := &NextEpochDuty{
+			PublicKey:      duty.PublicKey,
+			ValidatorIndex: duty.ValidatorIndex,
+			ProposerSlots:  duty.ProposerSlots,
+			AttesterSlot:   duty.AttesterSlot,
+			CommitteeIndex: duty.CommitteeIndex,
+		}
+		if proof, ok := v.cachedSelectionProof(bytesutil.ToBytes48(duty.PublicKey), duty.AttesterSlot); ok {
+			d.IsAggregator = isAggregatorProof(duty.Committee, proof)
+			d.AggregatorKnown = true
+		}
+		nextEpochDuties = append(nextEpochDuties, d)
+	}
+	return nextEpoch, nextEpochDuties, nil
+}
+
+// NextEpochDuties returns the assignments of the managed validator keys for the next epoch.
+func (v *ValidatorService) NextEpochDuties(ctx context.Context) (types.Epoch, []*NextEpochDuty, error) {
+	val := v.runningValidator()
+	if val == nil {
+		return 0, nil, ErrValidatorNotStarted
+	}
+	return val.NextEpochDuties(ctx)
+}
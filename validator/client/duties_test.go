@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+func TestNextEpochDuties_NotReady(t *testing.T) {
+	v, _, validatorKey, finish := setup(t)
+	defer finish()
+	v.genesisTime = uint64(timeutils.Now().Unix())
+
+	_, _, err := v.NextEpochDuties(context.Background())
+	require.ErrorContains(t, ErrDutiesNotReady.Error(), err)
+
+	// Duties which still describe the current epoch are not ready either.
+	v.duties = &ethpb.DutiesResponse{
+		NextEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{PublicKey: validatorKey.PublicKey().Marshal(), AttesterSlot: 1},
+		},
+	}
+	_, _, err = v.NextEpochDuties(context.Background())
+	require.ErrorContains(t, ErrDutiesNotReady.Error(), err)
+}
+
+func TestNextEpochDuties_OK(t *testing.T) {
+	v, _, validatorKey, finish := setup(t)
+	defer finish()
+	v.genesisTime = uint64(timeutils.Now().Unix())
+
+	attesterSlot := params.BeaconConfig().SlotsPerEpoch + 1
+	v.duties = &ethpb.DutiesResponse{
+		NextEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{
+				PublicKey:      validatorKey.PublicKey().Marshal(),
+				ValidatorIndex: 3,
+				AttesterSlot:   attesterSlot,
+				CommitteeIndex: 2,
+				Committee:      []types.ValidatorIndex{3},
+			},
+		},
+	}
+
+	// No selection proof is signed when reading the duties, so the aggregator status is unknown.
+	epoch, duties, err := v.NextEpochDuties(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(1), epoch)
+	require.Equal(t, 1, len(duties))
+	assert.Equal(t, types.ValidatorIndex(3), duties[0].ValidatorIndex)
+	assert.Equal(t, attesterSlot, duties[0].AttesterSlot)
+	assert.Equal(t, types.CommitteeIndex(2), duties[0].CommitteeIndex)
+	assert.Equal(t, false, duties[0].AggregatorKnown)
+	assert.Equal(t, false, duties[0].IsAggregator)
+
+	// Once the selection proof is cached, a single member committee selects its member as aggregator.
+	key := selectionProofKey{pubKey: bytesutil.ToBytes48(validatorKey.PublicKey().Marshal()), slot: attesterSlot}
+	v.selectionProofCache = map[selectionProofKey][]byte{key: make([]byte, 96)}
+	_, duties, err = v.NextEpochDuties(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(duties))
+	assert.Equal(t, true, duties[0].AggregatorKnown)
+	assert.Equal(t, true, duties[0].IsAggregator)
+}
+
+func TestValidatorService_NextEpochDuties_NotStarted(t *testing.T) {
+	s := &ValidatorService{}
+	_, _, err := s.NextEpochDuties(context.Background())
+	require.ErrorContains(t, ErrValidatorNotStarted.Error(), err)
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrValidatorNotStarted is returned when the validator has not been started by the validator service yet.
+var ErrValidatorNotStarted = errors.New("validator is not started")
+
+// dutyGate blocks new duties while the validator client is quiesced for maintenance and keeps
+// count of the duties in flight, so an operator can tell when it is safe to shut down.
+type dutyGate struct {
+	lock     sync.Mutex
+	quiesced bool
+	inFlight uint64
+	drained  chan struct{}
+}
+
+// start registers a new duty as in flight, returning false if the validator client is quiesced.
+func (g *dutyGate) start() bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if g.quiesced {
+		return false
+	}
+	g.inFlight++
+	return true
+}
+
+// done marks a duty registered with start as completed.
+func (g *dutyGate) done() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.inFlight--
+	if g.inFlight == 0 && g.drained != nil {
+		close(g.drained)
+		g.drained = nil
+	}
+}
+
+// quiesce blocks new duties and waits until the duties in flight complete or the context is done.
+func (g *dutyGate) quiesce(ctx context.Context) error {
+	g.lock.Lock()
+	g.quiesced = true
+	if g.inFlight == 0 {
+		g.lock.Unlock()
+		return nil
+	}
+	if g.drained == nil {
+		g.drained = make(chan struct{})
+	}
+	drained := g.drained
+	g.lock.Unlock()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// resume allows new duties to start again.
+func (g *dutyGate) resume() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.quiesced = false
+}
+
+// status returns whether new duties are blocked and how many duties are in flight.
+func (g *dutyGate) status() (bool, uint64) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return g.quiesced, g.inFlight
+}
+
+// startDuty registers a duty of the slot as in flight, returning false if the validator client is
+// quiesced for maintenance. The caller must call v.maintenance.done() once the duty completes.
+func (v *validator) startDuty(slot types.Slot, duty string) bool {
+	if v.maintenance.start() {
+		return true
+	}
+	log.WithFields(logrus.Fields{
+		"slot": slot,
+		"duty": duty,
+	}).Info("Validator client is quiesced for maintenance, skipping duty")
+	return false
+}
+
+// Quiesce stops the validator from starting new duties and waits for the duties in flight to complete.
+// Once it returns without error, no signing is in progress and the validator client can be safely shut down.
+func (v *validator) Quiesce(ctx context.Context) error {
+	log.Info("Quiescing validator client for maintenance")
+	if err := v.maintenance.quiesce(ctx); err != nil {
+		return err
+	}
+	log.Info("Validator client is quiesced, it is safe to shut down")
+	return nil
+}
+
+// Resume lets the validator perform its duties again after Quiesce.
+func (v *validator) Resume() {
+	v.maintenance.resume()
+	log.Info("Resumed validator duties")
+}
+
+// MaintenanceStatus returns whether the validator is quiesced and the number of duties still in flight.
+func (v *validator) MaintenanceStatus() (bool, uint64) {
+	return v.maintenance.status()
+}
+
+// Quiesce stops the validator from starting new duties and waits for the duties in flight to complete.
+func (v *ValidatorService) Quiesce(ctx context.Context) error {
+	val := v.runningValidator()
+	if val == nil {
+		return ErrValidatorNotStarted
+	}
+	return val.Quiesce(ctx)
+}
+
+// Resume lets the validator perform its duties again after Quiesce.
+func (v *ValidatorService) Resume() error {
+	val := v.runningValidator()
+	if val == nil {
+		return ErrValidatorNotStarted
+	}
+	val.Resume()
+	return nil
+}
+
+// MaintenanceStatus returns whether the validator is quiesced and the number of duties still in flight.
+func (v *ValidatorService) MaintenanceStatus() (bool, uint64, error) {
+	val := v.runningValidator()
+	if val == nil {
+		return false, 0, ErrValidatorNotStarted
+	}
+	quiesced, inFlight := val.MaintenanceStatus()
+	return quiesced, inFlight, nil
+}
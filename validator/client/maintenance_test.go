@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestDutyGate_QuiesceWaitsForDutiesInFlight(t *testing.T) {
+	g := &dutyGate{}
+	require.Equal(t, true, g.start())
+	require.Equal(t, true, g.start())
+
+	quiesced := make(chan error, 1)
+	go func() {
+		quiesced <- g.quiesce(context.Background())
+	}()
+	g.done()
+	select {
+	case <-quiesced:
+		t.Fatal("Quiesce returned while a duty was in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+	assert.Equal(t, false, g.start(), "Expected new duties to be blocked")
+
+	g.done()
+	require.NoError(t, <-quiesced)
+	isQuiesced, inFlight := g.status()
+	assert.Equal(t, true, isQuiesced)
+	assert.Equal(t, uint64(0), inFlight)
+
+	g.resume()
+	assert.Equal(t, true, g.start())
+	isQuiesced, inFlight = g.status()
+	assert.Equal(t, false, isQuiesced)
+	assert.Equal(t, uint64(1), inFlight)
+}
+
+func TestDutyGate_QuiesceContextCanceled(t *testing.T) {
+	g := &dutyGate{}
+	require.Equal(t, true, g.start())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorContains(t, context.DeadlineExceeded.Error(), g.quiesce(ctx))
+
+	// A later quiesce returns once the duty completes.
+	g.done()
+	require.NoError(t, g.quiesce(context.Background()))
+}
+
+func TestSubmitAttestation_SkippedWhenQuiesced(t *testing.T) {
+	hook := logTest.NewGlobal()
+	v := &validator{}
+	require.NoError(t, v.Quiesce(context.Background()))
+	v.SubmitAttestation(context.Background(), 1, [48]byte{})
+	require.LogsContain(t, hook, "Validator client is quiesced for maintenance, skipping duty")
+
+	quiesced, inFlight := v.MaintenanceStatus()
+	assert.Equal(t, true, quiesced)
+	assert.Equal(t, uint64(0), inFlight)
+}
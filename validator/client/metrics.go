@@ -3,6 +3,8 @@ package client
 import (
 	"context"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -11,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"github.com/sirupsen/logrus"
 )
 
@@ -76,6 +79,37 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorBlockRequestFailuresVec used to count the failed block requests to the beacon node by cause.
+	ValidatorBlockRequestFailuresVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "block_request_failures_total",
+			Help:      "Count the failed requests for a block to propose, by cause: timeout or other.",
+		},
+		[]string{
+			"cause",
+		},
+	)
+	// ValidatorProposalDelayHistogram used to keep track of the time from the start of the slot to the publication of proposed blocks.
+	ValidatorProposalDelayHistogram = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "validator",
+			Name:      "proposal_delay_seconds",
+			Help:      "Time from the start of the slot to the publication of proposed blocks.",
+			Buckets:   []float64{0.25, 0.5, 1, 1.5, 2, 3, 4, 6, 8, 12},
+		},
+	)
+	// ValidatorLateProposalsVec used to count the blocks published after the late block threshold.
+	ValidatorLateProposalsVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "late_proposals_total",
+			Help:      "Count the proposed blocks published after the late block threshold.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
 	// ValidatorBalancesGaugeVec used to keep track of validator balances by public key.
 	ValidatorBalancesGaugeVec = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -92,12 +126,21 @@ var (
 		prometheus.GaugeOpts{
 			Namespace: "validator",
 			Name:      "inclusion_distance",
-			Help:      "Inclusion distance of last attestation.",
+			Help:      "Inclusion distance of last attestation. Attestations not included in time count as SLOTS_PER_EPOCH.",
 		},
 		[]string{
 			"pubkey",
 		},
 	)
+	// ValidatorInclusionDistancesHistogram used to keep track of the inclusion distances of all validator attestations.
+	ValidatorInclusionDistancesHistogram = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "validator",
+			Name:      "inclusion_distance_slots",
+			Help:      "Inclusion distance of attestations. Attestations not included in time count as SLOTS_PER_EPOCH.",
+			Buckets:   []float64{1, 2, 3, 4, 6, 8, 12, 16, 24, 32},
+		},
+	)
 	// ValidatorAttestedSlotsGaugeVec used to keep track of validator attested slots by public key.
 	ValidatorAttestedSlotsGaugeVec = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -194,8 +237,106 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorNextAttestationSecondsGaugeVec used to track the time left until the next attestation duty by public key.
+	ValidatorNextAttestationSecondsGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "next_attestation_seconds",
+			Help:      "Seconds until the start of the slot of the next attestation duty, -1 if no attestation duty is known yet.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorNextProposalSecondsGaugeVec used to track the time left until the next proposal duty by public key.
+	ValidatorNextProposalSecondsGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "next_proposal_seconds",
+			Help:      "Seconds until the start of the slot of the next proposal duty, -1 if no proposal duty is known yet.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
 )
 
+// noUpcomingDuty is the value of the duty countdown metrics when no duty is known
+// in the current and next epochs.
+const noUpcomingDuty = -1
+
+// dutyCountdown is the number of seconds left until the next duties of a validator.
+type dutyCountdown struct {
+	attestation float64
+	proposal    float64
+}
+
+// UpdateDutyCountdownMetrics updates the time left until the next attestation and proposal
+// duties of each validator, out of the known duties of the current and next epochs.
+func (v *validator) UpdateDutyCountdownMetrics(slot types.Slot) {
+	if !v.emitAccountMetrics {
+		return
+	}
+	for pubKey, countdown := range v.dutyCountdowns(slot, timeutils.Now()) {
+		ValidatorNextAttestationSecondsGaugeVec.WithLabelValues(pubKey).Set(countdown.attestation)
+		ValidatorNextProposalSecondsGaugeVec.WithLabelValues(pubKey).Set(countdown.proposal)
+	}
+}
+
+// dutyCountdowns returns the time left from now until the next duties after the given slot,
+// by hex encoded public key.
+func (v *validator) dutyCountdowns(slot types.Slot, now time.Time) map[string]*dutyCountdown {
+	v.dutiesLock.RLock()
+	defer v.dutiesLock.RUnlock()
+	if v.duties == nil {
+		return nil
+	}
+	secondsUntil := func(dutySlot types.Slot) float64 {
+		dutyTime, err := helpers.SlotToTime(v.genesisTime, dutySlot)
+		if err != nil {
+			return noUpcomingDuty
+		}
+		return math.Max(dutyTime.Sub(now).Seconds(), 0)
+	}
+	countdowns := make(map[string]*dutyCountdown)
+	for _, duties := range [][]*ethpb.DutiesResponse_Duty{v.duties.CurrentEpochDuties, v.duties.NextEpochDuties} {
+		for _, duty := range duties {
+			if duty == nil {
+				continue
+			}
+			pubKey := fmt.Sprintf("%#x", duty.PublicKey)
+			countdown, ok := countdowns[pubKey]
+			if !ok {
+				countdown = &dutyCountdown{attestation: noUpcomingDuty, proposal: noUpcomingDuty}
+				countdowns[pubKey] = countdown
+			}
+			if duty.Status != ethpb.ValidatorStatus_ACTIVE && duty.Status != ethpb.ValidatorStatus_EXITING {
+				continue
+			}
+			if duty.AttesterSlot > slot {
+				countdown.attestation = earliestDuty(countdown.attestation, secondsUntil(duty.AttesterSlot))
+			}
+			for _, proposerSlot := range duty.ProposerSlots {
+				if proposerSlot > slot {
+					countdown.proposal = earliestDuty(countdown.proposal, secondsUntil(proposerSlot))
+				}
+			}
+		}
+	}
+	return countdowns
+}
+
+// earliestDuty returns the smallest of two countdowns, ignoring unknown duties.
+func earliestDuty(a, b float64) float64 {
+	if a == noUpcomingDuty {
+		return b
+	}
+	if b == noUpcomingDuty {
+		return a
+	}
+	return math.Min(a, b)
+}
+
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
 // responsibilities throughout the beacon chain's lifecycle. It logs absolute accrued rewards
 // and penalties over time, percentage gain/loss, and gives the end user a better idea
@@ -274,7 +415,9 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot types.S
 			}).Info("Previous epoch voting summary")
 			if v.emitAccountMetrics {
 				ValidatorBalancesGaugeVec.WithLabelValues(fmtKey).Set(newBalance)
-				ValidatorInclusionDistancesGaugeVec.WithLabelValues(fmtKey).Set(float64(resp.InclusionDistances[i]))
+				inclusionDistance := float64(attestationInclusionDistance(resp, i))
+				ValidatorInclusionDistancesGaugeVec.WithLabelValues(fmtKey).Set(inclusionDistance)
+				ValidatorInclusionDistancesHistogram.Observe(inclusionDistance)
 				if resp.CorrectlyVotedSource[i] {
 					ValidatorCorrectlyVotedSourceGaugeVec.WithLabelValues(fmtKey).Set(1)
 				} else {
@@ -301,6 +444,17 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot types.S
 	return nil
 }
 
+// attestationInclusionDistance returns the inclusion distance of the previous epoch attestation of
+// the validator at the given index of the performance response. An attestation which was not
+// included within the inclusion window counts as the maximum distance of SLOTS_PER_EPOCH.
+func attestationInclusionDistance(resp *ethpb.ValidatorPerformanceResponse, i int) types.Slot {
+	maxDistance := params.BeaconConfig().SlotsPerEpoch
+	if uint64(resp.InclusionSlots[i]) == ^uint64(0) || resp.InclusionDistances[i] > maxDistance {
+		return maxDistance
+	}
+	return resp.InclusionDistances[i]
+}
+
 // UpdateLogAggregateStats updates and logs the voteStats struct of a validator using the RPC response obtained from LogValidatorGainsAndLosses.
 func (v *validator) UpdateLogAggregateStats(resp *ethpb.ValidatorPerformanceResponse, slot types.Slot) {
 	summary := &v.voteStats
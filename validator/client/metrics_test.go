@@ -1,11 +1,15 @@
 package client
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -89,3 +93,55 @@ func TestUpdateLogAggregateStats(t *testing.T) {
 		"correctlyVotedTargetPct=\"86%\" numberOfEpochs=3 pctChangeCombinedBalance=\"0.20555%\"")
 
 }
+
+func TestAttestationInclusionDistance(t *testing.T) {
+	resp := &ethpb.ValidatorPerformanceResponse{
+		InclusionSlots:     []types.Slot{10, types.Slot(^uint64(0)), 40},
+		InclusionDistances: []types.Slot{3, types.Slot(^uint64(0)), 40},
+	}
+	maxDistance := params.BeaconConfig().SlotsPerEpoch
+	require.Equal(t, types.Slot(3), attestationInclusionDistance(resp, 0))
+	require.Equal(t, maxDistance, attestationInclusionDistance(resp, 1), "Missed attestation should count as the max distance")
+	require.Equal(t, maxDistance, attestationInclusionDistance(resp, 2), "Late attestation should count as the max distance")
+}
+
+func TestDutyCountdowns(t *testing.T) {
+	genesis := time.Unix(1000, 0)
+	secondsPerSlot := float64(params.BeaconConfig().SecondsPerSlot)
+	pubKeys := [][]byte{
+		bytesutil.PadTo([]byte("active"), 48),
+		bytesutil.PadTo([]byte("pending"), 48),
+		bytesutil.PadTo([]byte("proposer"), 48),
+	}
+	v := &validator{
+		genesisTime: uint64(genesis.Unix()),
+		duties: &ethpb.DutiesResponse{
+			CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+				{PublicKey: pubKeys[0], Status: ethpb.ValidatorStatus_ACTIVE, AttesterSlot: 3},
+				{PublicKey: pubKeys[1], Status: ethpb.ValidatorStatus_PENDING},
+				{PublicKey: pubKeys[2], Status: ethpb.ValidatorStatus_ACTIVE, AttesterSlot: 10, ProposerSlots: []types.Slot{12, 6}},
+			},
+			NextEpochDuties: []*ethpb.DutiesResponse_Duty{
+				{PublicKey: pubKeys[0], Status: ethpb.ValidatorStatus_ACTIVE, AttesterSlot: 40},
+				{PublicKey: pubKeys[1], Status: ethpb.ValidatorStatus_PENDING},
+				{PublicKey: pubKeys[2], Status: ethpb.ValidatorStatus_ACTIVE, AttesterSlot: 35},
+			},
+		},
+	}
+	now := genesis.Add(time.Duration(5*secondsPerSlot) * time.Second)
+
+	countdowns := v.dutyCountdowns(5, now)
+	require.Equal(t, 3, len(countdowns))
+	active := countdowns[fmt.Sprintf("%#x", pubKeys[0])]
+	assert.Equal(t, 35*secondsPerSlot, active.attestation, "Next epoch attestation should be used")
+	assert.Equal(t, float64(noUpcomingDuty), active.proposal)
+	pending := countdowns[fmt.Sprintf("%#x", pubKeys[1])]
+	assert.Equal(t, float64(noUpcomingDuty), pending.attestation)
+	assert.Equal(t, float64(noUpcomingDuty), pending.proposal)
+	proposer := countdowns[fmt.Sprintf("%#x", pubKeys[2])]
+	assert.Equal(t, 5*secondsPerSlot, proposer.attestation)
+	assert.Equal(t, 1*secondsPerSlot, proposer.proposal, "Earliest proposal should be used")
+
+	v.duties = nil
+	assert.Equal(t, 0, len(v.dutyCountdowns(5, now)))
+}
@@ -29,6 +29,9 @@ type FakeValidator struct {
 	SlotDeadlineCalled                bool
 	WaitForChainStartCalled           int
 	WaitForSyncCalled                 int
+	WaitForPeersCalled                bool
+	NodeUnsynced                      bool
+	NodeIsSyncedCalled                int
 	WaitForActivationCalled           int
 	CanonicalHeadSlotCalled           int
 	ReceiveBlocksCalled               int
@@ -72,6 +75,18 @@ func (fv *FakeValidator) WaitForChainStart(_ context.Context) error {
 	return nil
 }
 
+// WaitForPeers for mocking.
+func (fv *FakeValidator) WaitForPeers(_ context.Context) error {
+	fv.WaitForPeersCalled = true
+	return nil
+}
+
+// NodeIsSynced for mocking.
+func (fv *FakeValidator) NodeIsSynced(_ context.Context, _ types.Slot) (bool, error) {
+	fv.NodeIsSyncedCalled++
+	return !fv.NodeUnsynced, nil
+}
+
 // WaitForActivation for mocking.
 func (fv *FakeValidator) WaitForActivation(_ context.Context) error {
 	fv.WaitForActivationCalled++
@@ -173,6 +188,9 @@ func (fv *FakeValidator) LogNextDutyTimeLeft(slot types.Slot) error {
 	return nil
 }
 
+// UpdateDutyCountdownMetrics for mocking.
+func (fv *FakeValidator) UpdateDutyCountdownMetrics(_ types.Slot) {}
+
 // UpdateDomainDataCaches for mocking.
 func (fv *FakeValidator) UpdateDomainDataCaches(context.Context, types.Slot) {}
 
@@ -216,3 +234,34 @@ func (fv *FakeValidator) ReceiveBlocks(ctx context.Context, connectionErrorChann
 		connectionErrorChannel <- errConnectionIssue
 	}
 }
+
+// NextEpochDuties for mocking.
+func (fv *FakeValidator) NextEpochDuties(_ context.Context) (types.Epoch, []*NextEpochDuty, error) {
+	return 0, nil, ErrDutiesNotReady
+}
+
+// CheckDoppelgangers for mocking.
+func (fv *FakeValidator) CheckDoppelgangers(_ context.Context) error {
+	return nil
+}
+
+// CheckWithdrawalCredentials for mocking.
+func (fv *FakeValidator) CheckWithdrawalCredentials(_ context.Context) error {
+	return nil
+}
+
+// RestartBlockStream for mocking.
+func (fv *FakeValidator) RestartBlockStream() {}
+
+// Quiesce for mocking.
+func (fv *FakeValidator) Quiesce(_ context.Context) error {
+	return nil
+}
+
+// Resume for mocking.
+func (fv *FakeValidator) Resume() {}
+
+// MaintenanceStatus for mocking.
+func (fv *FakeValidator) MaintenanceStatus() (bool, uint64) {
+	return false, 0
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"strings"
+	"sync"
 
 	"google.golang.org/grpc/resolver"
 )
@@ -12,13 +13,21 @@ import (
 // It can be used with any grpc load balancer (pick_first, round_robin). Default is pick_first.
 // Round robin can be used by adding the following option:
 // grpc.WithDefaultServiceConfig("{\"loadBalancingConfig\":[{\"round_robin\":{}}]}")
-type multipleEndpointsGrpcResolverBuilder struct{}
+// The endpoints of the connections built with a builder can be replaced at runtime with updateEndpoints.
+type multipleEndpointsGrpcResolverBuilder struct {
+	lock      sync.Mutex
+	resolvers []*multipleEndpointsGrpcResolver
+}
 
-func (*multipleEndpointsGrpcResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+func (b *multipleEndpointsGrpcResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
 	r := &multipleEndpointsGrpcResolver{
-		target: target,
-		cc:     cc,
+		target:  target,
+		cc:      cc,
+		builder: b,
 	}
+	b.lock.Lock()
+	b.resolvers = append(b.resolvers, r)
+	b.lock.Unlock()
 	r.start()
 	return r, nil
 }
@@ -27,20 +36,47 @@ func (*multipleEndpointsGrpcResolverBuilder) Scheme() string {
 	return resolver.GetDefaultScheme()
 }
 
+// updateEndpoints replaces the addresses of all the connections built with the builder by the given
+// comma separated endpoints, returning false if no connection was built. The connections close their
+// transports to the previous addresses once the requests in flight complete.
+func (b *multipleEndpointsGrpcResolverBuilder) updateEndpoints(endpoint string) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for _, r := range b.resolvers {
+		r.update(endpoint)
+	}
+	return len(b.resolvers) > 0
+}
+
 type multipleEndpointsGrpcResolver struct {
-	target resolver.Target
-	cc     resolver.ClientConn
+	target  resolver.Target
+	cc      resolver.ClientConn
+	builder *multipleEndpointsGrpcResolverBuilder
 }
 
 func (r *multipleEndpointsGrpcResolver) start() {
-	endpoints := strings.Split(r.target.Endpoint, ",")
+	r.update(r.target.Endpoint)
+}
+
+func (r *multipleEndpointsGrpcResolver) update(endpoint string) {
+	endpoints := strings.Split(endpoint, ",")
 	var addrs []resolver.Address
 	for _, endpoint := range endpoints {
-		addrs = append(addrs, resolver.Address{Addr: endpoint})
+		// The server name is set so that each address is authenticated against its own host.
+		addrs = append(addrs, resolver.Address{Addr: endpoint, ServerName: endpoint})
 	}
 	r.cc.UpdateState(resolver.State{Addresses: addrs})
 }
 
 func (*multipleEndpointsGrpcResolver) ResolveNow(_ resolver.ResolveNowOptions) {}
 
-func (*multipleEndpointsGrpcResolver) Close() {}
+func (r *multipleEndpointsGrpcResolver) Close() {
+	r.builder.lock.Lock()
+	defer r.builder.lock.Unlock()
+	for i, built := range r.builder.resolvers {
+		if built == r {
+			r.builder.resolvers = append(r.builder.resolvers[:i], r.builder.resolvers[i+1:]...)
+			return
+		}
+	}
+}
@@ -17,9 +17,13 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/mputil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type signingFunc func(context.Context, *validatorpb.SignRequest) (bls.Signature, error)
@@ -28,6 +32,12 @@ const domainDataErr = "could not get domain data"
 const signingRootErr = "could not get signing root"
 const signExitErr = "could not sign voluntary exit proposal"
 
+// Causes of block request failures reported by the block request failures metric.
+const (
+	blockRequestFailureTimeout = "timeout"
+	blockRequestFailureOther   = "other"
+)
+
 // ProposeBlock proposes a new beacon block for a given slot. This method collects the
 // previous beacon block, any pending deposits, and ETH1 data from the beacon
 // chain node to construct the new block. The new block is then processed with
@@ -38,6 +48,10 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 		log.Debug("Assigned to genesis slot, skipping proposal")
 		return
 	}
+	if !v.startDuty(slot, "proposal") {
+		return
+	}
+	defer v.maintenance.done()
 	lock := mputil.NewMultilock(string(rune(roleProposer)), string(pubKey[:]))
 	lock.Lock()
 	defer lock.Unlock()
@@ -66,15 +80,20 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 		// to produce the block.
 		log.WithError(err).Warn("Could not get graffiti")
 	}
+	g = graffiti.Expand(g)
 
 	// Request block from beacon node
-	b, err := v.validatorClient.GetBlock(ctx, &ethpb.BlockRequest{
+	b, err := v.requestBlock(ctx, &ethpb.BlockRequest{
 		Slot:         slot,
 		RandaoReveal: randaoReveal,
 		Graffiti:     g,
 	})
 	if err != nil {
-		log.WithField("blockSlot", slot).WithError(err).Error("Failed to request block from beacon node")
+		if blockRequestFailureCause(err) == blockRequestFailureTimeout {
+			log.WithField("blockSlot", slot).WithError(err).Error("Timed out requesting block from beacon node, skipping proposal")
+		} else {
+			log.WithField("blockSlot", slot).WithError(err).Error("Failed to request block from beacon node")
+		}
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -134,6 +153,8 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 		trace.Int64Attribute("numAttestations", int64(len(b.Body.Attestations))),
 	)
 
+	v.recordProposalDelay(slot, fmtKey)
+
 	blkRoot := fmt.Sprintf("%#x", bytesutil.Trunc(blkResp.BlockRoot))
 	log.WithFields(logrus.Fields{
 		"slot":            b.Slot,
@@ -148,6 +169,49 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 	}
 }
 
+// recordProposalDelay tracks how far into the slot a proposed block was published and warns
+// when it was published after the configured late block threshold.
+func (v *validator) recordProposalDelay(slot types.Slot, fmtKey string) {
+	delay := timeutils.Now().Sub(slotutil.SlotStartTime(v.genesisTime, slot))
+	ValidatorProposalDelayHistogram.Observe(delay.Seconds())
+	if v.lateBlockThreshold == 0 || delay <= v.lateBlockThreshold {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"slot":      slot,
+		"delay":     delay,
+		"threshold": v.lateBlockThreshold,
+	}).Warn("Published block late into the slot, it may not be included in the canonical chain")
+	if v.emitAccountMetrics {
+		ValidatorLateProposalsVec.WithLabelValues(fmtKey).Inc()
+	}
+}
+
+// requestBlock requests a block to propose from the beacon node. With a block production timeout
+// configured, the request is abandoned once it elapses rather than when the slot ends, so that a
+// slow beacon node does not stall the proposer.
+func (v *validator) requestBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BeaconBlock, error) {
+	if v.blockTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, v.blockTimeout)
+		defer cancel()
+	}
+	b, err := v.validatorClient.GetBlock(ctx, req)
+	if err != nil {
+		ValidatorBlockRequestFailuresVec.WithLabelValues(blockRequestFailureCause(err)).Inc()
+		return nil, err
+	}
+	return b, nil
+}
+
+// blockRequestFailureCause categorizes an error returned by the beacon node when requesting a block.
+func blockRequestFailureCause(err error) string {
+	if errors.Is(err, context.DeadlineExceeded) || status.Code(err) == codes.DeadlineExceeded {
+		return blockRequestFailureTimeout
+	}
+	return blockRequestFailureOther
+}
+
 // ProposeExit performs a voluntary exit on a validator.
 // The exit is signed by the validator before being sent to the beacon node for broadcasting.
 func ProposeExit(
@@ -213,6 +277,7 @@ func (v *validator) signRandaoReveal(ctx context.Context, pubKey [48]byte, epoch
 		Object:          &validatorpb.SignRequest_Epoch{Epoch: epoch},
 	})
 	if err != nil {
+		recordSigningFailure(signOpBlock, signingFailureCause(err))
 		return nil, err
 	}
 	return randaoReveal.Marshal(), nil
@@ -240,6 +305,7 @@ func (v *validator) signBlock(ctx context.Context, pubKey [48]byte, epoch types.
 		Object:          &validatorpb.SignRequest_Block{Block: b},
 	})
 	if err != nil {
+		recordSigningFailure(signOpBlock, signingFailureCause(err))
 		return nil, nil, errors.Wrap(err, "could not sign block proposal")
 	}
 	return sig.Marshal(), domain, nil
@@ -290,6 +356,21 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [48]byte) ([]byte, e
 		return v.graffiti, nil
 	}
 
+	v.graffitiLock.Lock()
+	defer v.graffitiLock.Unlock()
+
+	// Pick up the graffiti file if it was reloaded since the last proposal.
+	if v.graffitiStore != nil {
+		if g := v.graffitiStore.Graffiti(); v.graffitiStruct == nil || g.Hash != v.graffitiStruct.Hash {
+			orderedIndex, err := v.db.GraffitiOrderedIndex(ctx, g.Hash)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get graffiti ordered index")
+			}
+			v.graffitiStruct = g
+			v.graffitiOrderedIndex = orderedIndex
+		}
+	}
+
 	if v.graffitiStruct == nil {
 		return nil, errors.New("graffitiStruct can't be nil")
 	}
@@ -305,9 +386,15 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [48]byte) ([]byte, e
 	}
 
 	// When specified, a graffiti from the ordered list in the file take third priority.
-	if v.graffitiOrderedIndex < uint64(len(v.graffitiStruct.Ordered)) {
-		graffiti := v.graffitiStruct.Ordered[v.graffitiOrderedIndex]
-		v.graffitiOrderedIndex = v.graffitiOrderedIndex + 1
+	// The list is used once unless it is set to cycle, in which case it is used round robin.
+	ordered := v.graffitiStruct.Ordered
+	if len(ordered) != 0 && (v.graffitiStruct.Cycle || v.graffitiOrderedIndex < uint64(len(ordered))) {
+		i := v.graffitiOrderedIndex % uint64(len(ordered))
+		graffiti := ordered[i]
+		v.graffitiOrderedIndex = i + 1
+		if v.graffitiStruct.Cycle {
+			v.graffitiOrderedIndex = v.graffitiOrderedIndex % uint64(len(ordered))
+		}
 		err := v.db.SaveGraffitiOrderedIndex(ctx, v.graffitiOrderedIndex)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to update graffiti ordered index")
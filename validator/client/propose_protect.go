@@ -40,6 +40,7 @@ func (v *validator) preBlockSignValidations(
 	// we consider that proposal slashable.
 	signingRootIsDifferent := prevSigningRoot == params.BeaconConfig().ZeroHash || prevSigningRoot != signingRoot
 	if proposalAtSlotExists && signingRootIsDifferent {
+		recordSigningFailure(signOpBlock, signFailureSlashingProtection)
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -51,6 +52,7 @@ func (v *validator) preBlockSignValidations(
 	// In the case the slot of the incoming block is equal to the minimum signed proposal, we
 	// then also check the signing root is different.
 	if lowestProposalExists && signingRootIsDifferent && lowestSignedProposalSlot >= block.Slot {
+		recordSigningFailure(signOpBlock, signFailureSlashingProtection)
 		return fmt.Errorf(
 			"could not sign block with slot <= lowest signed slot in db, lowest signed slot: %d >= block slot: %d",
 			lowestSignedProposalSlot,
@@ -64,6 +66,7 @@ func (v *validator) preBlockSignValidations(
 			return errors.Wrap(err, "failed to get block header from block")
 		}
 		if !v.protector.CheckBlockSafety(ctx, blockHdr) {
+			recordSigningFailure(signOpBlock, signFailureSlashingProtection)
 			if v.emitAccountMetrics {
 				ValidatorProposeFailVecSlasher.WithLabelValues(fmtKey).Inc()
 			}
@@ -91,6 +94,7 @@ func (v *validator) postBlockSignUpdate(
 			return err
 		}
 		if !valid {
+			recordSigningFailure(signOpBlock, signFailureSlashingProtection)
 			if v.emitAccountMetrics {
 				ValidatorProposeFailVecSlasher.WithLabelValues(fmtKey).Inc()
 			}
@@ -21,9 +21,12 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	testing2 "github.com/prysmaticlabs/prysm/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type mocks struct {
@@ -149,6 +152,38 @@ func TestProposeBlock_RequestBlockFailed(t *testing.T) {
 	require.LogsContain(t, hook, "Failed to request block from beacon node")
 }
 
+func TestProposeBlock_RequestBlockTimeout(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	validator.blockTimeout = 10 * time.Millisecond
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+
+	m.validatorClient.EXPECT().GetBlock(
+		gomock.Any(), // ctx
+		gomock.Any(), // block request
+	).DoAndReturn(func(ctx context.Context, _ *ethpb.BlockRequest) (*ethpb.BeaconBlock, error) {
+		<-ctx.Done()
+		return nil, status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+	})
+
+	validator.ProposeBlock(context.Background(), 1, pubKey)
+	require.LogsContain(t, hook, "Timed out requesting block from beacon node, skipping proposal")
+}
+
+func TestBlockRequestFailureCause(t *testing.T) {
+	assert.Equal(t, blockRequestFailureTimeout, blockRequestFailureCause(context.DeadlineExceeded))
+	assert.Equal(t, blockRequestFailureTimeout, blockRequestFailureCause(status.Error(codes.DeadlineExceeded, "slow")))
+	assert.Equal(t, blockRequestFailureOther, blockRequestFailureCause(status.Error(codes.Internal, "uh oh")))
+	assert.Equal(t, blockRequestFailureOther, blockRequestFailureCause(errors.New("uh oh")))
+}
+
 func TestProposeBlock_ProposeBlockFailed(t *testing.T) {
 	hook := logTest.NewGlobal()
 	validator, m, validatorKey, finish := setup(t)
@@ -409,6 +444,22 @@ func TestProposeBlock_BroadcastsBlock(t *testing.T) {
 	validator.ProposeBlock(context.Background(), 1, pubKey)
 }
 
+func TestRecordProposalDelay_WarnsOnLateBlock(t *testing.T) {
+	hook := logTest.NewGlobal()
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	v := &validator{
+		genesisTime:        uint64(timeutils.Now().Unix()) - 2*secondsPerSlot,
+		lateBlockThreshold: time.Second,
+	}
+
+	v.recordProposalDelay(2, "")
+	require.LogsDoNotContain(t, hook, "Published block late into the slot")
+
+	v.genesisTime -= 3
+	v.recordProposalDelay(2, "")
+	require.LogsContain(t, hook, "Published block late into the slot")
+}
+
 func TestProposeBlock_BroadcastsBlock_WithGraffiti(t *testing.T) {
 	validator, m, validatorKey, finish := setup(t)
 	defer finish()
@@ -771,3 +822,31 @@ func TestGetGraffitiOrdered_Ok(t *testing.T) {
 		require.DeepEqual(t, want, got)
 	}
 }
+
+func TestGetGraffitiOrdered_Cycle(t *testing.T) {
+	pubKey := [48]byte{'a'}
+	valDB := testing2.SetupDB(t, [][48]byte{pubKey})
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+	}
+	m.validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		Times(5).
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+
+	v := &validator{
+		db:              valDB,
+		validatorClient: m.validatorClient,
+		graffitiStruct: &graffiti.Graffiti{
+			Ordered: []string{"a", "b", "c"},
+			Cycle:   true,
+			Default: "d",
+		},
+	}
+	for _, want := range [][]byte{{'a'}, {'b'}, {'c'}, {'a'}, {'b'}} {
+		got, err := v.getGraffiti(context.Background(), pubKey)
+		require.NoError(t, err)
+		require.DeepEqual(t, want, got)
+	}
+}
@@ -27,6 +27,8 @@ type Validator interface {
 	Done()
 	WaitForChainStart(ctx context.Context) error
 	WaitForSync(ctx context.Context) error
+	WaitForPeers(ctx context.Context) error
+	NodeIsSynced(ctx context.Context, slot types.Slot) (bool, error)
 	WaitForActivation(ctx context.Context) error
 	SlasherReady(ctx context.Context) error
 	CanonicalHeadSlot(ctx context.Context) (types.Slot, error)
@@ -40,11 +42,19 @@ type Validator interface {
 	SubmitAggregateAndProof(ctx context.Context, slot types.Slot, pubKey [48]byte)
 	LogAttestationsSubmitted()
 	LogNextDutyTimeLeft(slot types.Slot) error
+	UpdateDutyCountdownMetrics(slot types.Slot)
 	UpdateDomainDataCaches(ctx context.Context, slot types.Slot)
 	WaitForWalletInitialization(ctx context.Context) error
 	AllValidatorsAreExited(ctx context.Context) (bool, error)
 	GetKeymanager() keymanager.IKeymanager
 	ReceiveBlocks(ctx context.Context, connectionErrorChannel chan<- error)
+	RestartBlockStream()
+	NextEpochDuties(ctx context.Context) (types.Epoch, []*NextEpochDuty, error)
+	CheckDoppelgangers(ctx context.Context) error
+	CheckWithdrawalCredentials(ctx context.Context) error
+	Quiesce(ctx context.Context) error
+	Resume()
+	MaintenanceStatus() (bool, uint64)
 }
 
 // Run the main validator routine. This routine exits if the context is
@@ -101,6 +111,14 @@ func run(ctx context.Context, v Validator) {
 		if err != nil {
 			log.Fatalf("Could not determine if beacon node synced: %v", err)
 		}
+		err = v.WaitForPeers(ctx)
+		if isConnectionError(err) {
+			log.Warnf("Could not determine beacon node peer count: %v", err)
+			continue
+		}
+		if err != nil {
+			log.Fatalf("Could not wait for beacon node peers: %v", err)
+		}
 		err = v.WaitForActivation(ctx)
 		if isConnectionError(err) {
 			log.Warnf("Could not wait for validator activation: %v", err)
@@ -117,9 +135,22 @@ func run(ctx context.Context, v Validator) {
 		if err != nil {
 			log.Fatalf("Could not get current canonical head slot: %v", err)
 		}
+		err = v.CheckDoppelgangers(ctx)
+		if isConnectionError(err) {
+			log.Warnf("Could not complete doppelganger protection: %v", err)
+			continue
+		}
+		if err != nil {
+			log.Fatalf("Could not complete doppelganger protection: %v", err)
+		}
 		break
 	}
 
+	// The withdrawal credentials check is advisory, so its failures do not prevent performing duties.
+	if err := v.CheckWithdrawalCredentials(ctx); err != nil {
+		log.WithError(err).Warn("Could not check withdrawal credentials")
+	}
+
 	connectionErrorChannel := make(chan error, 1)
 	go v.ReceiveBlocks(ctx, connectionErrorChannel)
 	if err := v.UpdateDuties(ctx, headSlot); err != nil {
@@ -172,6 +203,16 @@ func run(ctx context.Context, v Validator) {
 				go v.UpdateDomainDataCaches(ctx, slot+1)
 			}
 
+			synced, err := v.NodeIsSynced(slotCtx, slot)
+			if err != nil {
+				log.WithError(err).Error("Could not check if beacon node is synced, skipping duties of the slot")
+			}
+			if !synced {
+				cancel()
+				span.End()
+				continue
+			}
+
 			var wg sync.WaitGroup
 
 			allRoles, err := v.RolesAt(ctx, slot)
@@ -212,6 +253,7 @@ func run(ctx context.Context, v Validator) {
 				if err := v.LogNextDutyTimeLeft(slot); err != nil {
 					log.WithError(err).Error("Could not report next count down")
 				}
+				v.UpdateDutyCountdownMetrics(slot)
 				span.End()
 			}()
 		}
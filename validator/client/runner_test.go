@@ -53,6 +53,12 @@ func TestRetry_On_ConnectionError(t *testing.T) {
 	assert.Equal(t, retry, v.ReceiveBlocksCalled, "Expected WaitForActivation() to be called")
 }
 
+func TestCancelledContext_WaitsForPeers(t *testing.T) {
+	v := &FakeValidator{Keymanager: &mockKeymanager{accountsChangedFeed: &event.Feed{}}}
+	run(cancelledContext(), v)
+	assert.Equal(t, true, v.WaitForPeersCalled, "Expected WaitForPeers() to be called")
+}
+
 func TestCancelledContext_WaitsForActivation(t *testing.T) {
 	v := &FakeValidator{Keymanager: &mockKeymanager{accountsChangedFeed: &event.Feed{}}}
 	run(cancelledContext(), v)
@@ -190,6 +196,28 @@ func TestBothProposesAndAttests_NextSlot(t *testing.T) {
 	assert.Equal(t, uint64(slot), v.ProposeBlockArg1, "ProposeBlock was called with wrong arg")
 }
 
+func TestSkipsDutiesWhenNodeUnsynced_NextSlot(t *testing.T) {
+	v := &FakeValidator{Keymanager: &mockKeymanager{accountsChangedFeed: &event.Feed{}}}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	slot := types.Slot(55)
+	ticker := make(chan types.Slot)
+	v.NextSlotRet = ticker
+	v.RolesAtRet = []ValidatorRole{roleAttester, roleProposer}
+	v.NodeUnsynced = true
+	go func() {
+		ticker <- slot
+
+		cancel()
+	}()
+	timer := time.NewTimer(200 * time.Millisecond)
+	run(ctx, v)
+	<-timer.C
+	assert.Equal(t, 1, v.NodeIsSyncedCalled, "NodeIsSynced(%d) was not called", slot)
+	assert.Equal(t, false, v.AttestToBlockHeadCalled, "SubmitAttestation(%d) was called", slot)
+	assert.Equal(t, false, v.ProposeBlockCalled, "ProposeBlock(%d) was called", slot)
+}
+
 func TestAllValidatorsAreExited_NextSlot(t *testing.T) {
 	v := &FakeValidator{Keymanager: &mockKeymanager{accountsChangedFeed: &event.Feed{}}}
 	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), allValidatorsAreExitedCtxKey, true))
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/ristretto"
+	"github.com/ethereum/go-ethereum/common"
 	ptypes "github.com/gogo/protobuf/types"
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
@@ -15,7 +17,9 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
@@ -52,6 +56,10 @@ type ValidatorService struct {
 	logValidatorBalances  bool
 	logDutyCountDown      bool
 	conn                  *grpc.ClientConn
+	broadcastConns        []*grpc.ClientConn
+	dialOpts              []grpc.DialOption
+	endpointResolver      *multipleEndpointsGrpcResolverBuilder
+	endpointLock          sync.Mutex
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
 	maxCallRecvMsgSize    int
@@ -61,13 +69,26 @@ type ValidatorService struct {
 	dataDir               string
 	withCert              string
 	endpoint              string
+	broadcastEndpoints    []string
 	validator             Validator
+	validatorLock         sync.RWMutex
 	protector             iface.Protector
 	ctx                   context.Context
 	keyManager            keymanager.IKeymanager
 	grpcHeaders           []string
 	graffiti              []byte
 	graffitiStruct        *graffiti.Graffiti
+	graffitiStore         *graffiti.Store
+	doppelgangerEpochs    types.Epoch
+	checkWithdrawals      bool
+	withdrawalAllowlist   map[common.Address]bool
+	lateBlockThreshold    time.Duration
+	minPeers              uint64
+	minPeersTimeout       time.Duration
+	skipWhenUnsynced      bool
+	maxHeadLag            types.Slot
+	aggregationOffset     float64
+	blockTimeout          time.Duration
 }
 
 // Config for the validator service.
@@ -82,6 +103,7 @@ type Config struct {
 	GrpcMaxCallRecvMsgSizeFlag int
 	Protector                  iface.Protector
 	Endpoint                   string
+	BroadcastEndpoints         []string
 	Validator                  Validator
 	ValDB                      db.Database
 	KeyManager                 keymanager.IKeymanager
@@ -90,16 +112,32 @@ type Config struct {
 	DataDir                    string
 	GrpcHeadersFlag            string
 	GraffitiStruct             *graffiti.Graffiti
+	GraffitiStore              *graffiti.Store
+	DoppelgangerEpochs         types.Epoch
+	CheckWithdrawalCredentials bool
+	WithdrawalAddresses        []common.Address
+	LateBlockThreshold         time.Duration
+	MinPeers                   uint64
+	MinPeersTimeout            time.Duration
+	SkipDutiesWhenUnsynced     bool
+	MaxHeadLag                 types.Slot
+	AggregationSlotOffset      float64
+	BlockProductionTimeout     time.Duration
 }
 
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
 	ctx, cancel := context.WithCancel(ctx)
+	withdrawalAllowlist := make(map[common.Address]bool, len(cfg.WithdrawalAddresses))
+	for _, addr := range cfg.WithdrawalAddresses {
+		withdrawalAllowlist[addr] = true
+	}
 	return &ValidatorService{
 		ctx:                   ctx,
 		cancel:                cancel,
 		endpoint:              cfg.Endpoint,
+		broadcastEndpoints:    cfg.BroadcastEndpoints,
 		withCert:              cfg.CertFlag,
 		dataDir:               cfg.DataDir,
 		graffiti:              []byte(cfg.GraffitiFlag),
@@ -116,7 +154,18 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		walletInitializedFeed: cfg.WalletInitializedFeed,
 		useWeb:                cfg.UseWeb,
 		graffitiStruct:        cfg.GraffitiStruct,
+		graffitiStore:         cfg.GraffitiStore,
 		logDutyCountDown:      cfg.LogDutyCountDown,
+		doppelgangerEpochs:    cfg.DoppelgangerEpochs,
+		checkWithdrawals:      cfg.CheckWithdrawalCredentials,
+		withdrawalAllowlist:   withdrawalAllowlist,
+		lateBlockThreshold:    cfg.LateBlockThreshold,
+		minPeers:              cfg.MinPeers,
+		minPeersTimeout:       cfg.MinPeersTimeout,
+		skipWhenUnsynced:      cfg.SkipDutiesWhenUnsynced,
+		maxHeadLag:            cfg.MaxHeadLag,
+		aggregationOffset:     cfg.AggregationSlotOffset,
+		blockTimeout:          cfg.BlockProductionTimeout,
 	}, nil
 }
 
@@ -135,7 +184,11 @@ func (v *ValidatorService) Start() {
 
 	v.ctx = grpcutils.AppendHeaders(v.ctx, v.grpcHeaders)
 
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
+	// The resolver is listed first so that it takes precedence over the one of the dial options,
+	// which lets the beacon node endpoint be switched at runtime.
+	v.dialOpts = dialOpts
+	v.endpointResolver = &multipleEndpointsGrpcResolverBuilder{}
+	conn, err := grpc.DialContext(v.ctx, v.endpoint, append([]grpc.DialOption{grpc.WithResolvers(v.endpointResolver)}, dialOpts...)...)
 	if err != nil {
 		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
 		return
@@ -145,6 +198,21 @@ func (v *ValidatorService) Start() {
 	}
 
 	v.conn = conn
+	var validatorClient ethpb.BeaconNodeValidatorClient = ethpb.NewBeaconNodeValidatorClient(v.conn)
+	if len(v.broadcastEndpoints) > 0 {
+		broadcastClients := make([]ethpb.BeaconNodeValidatorClient, len(v.broadcastEndpoints))
+		for i, endpoint := range v.broadcastEndpoints {
+			bConn, err := grpc.DialContext(v.ctx, endpoint, dialOpts...)
+			if err != nil {
+				log.Errorf("Could not dial broadcast endpoint: %s, %v", endpoint, err)
+				return
+			}
+			v.broadcastConns = append(v.broadcastConns, bConn)
+			broadcastClients[i] = ethpb.NewBeaconNodeValidatorClient(bConn)
+		}
+		validatorClient = newBroadcastValidatorClient(validatorClient, v.endpoint, v.broadcastEndpoints, broadcastClients)
+		log.WithField("endpoints", v.broadcastEndpoints).Info("Broadcasting signed blocks, attestations and aggregates to additional beacon nodes")
+	}
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1920, // number of keys to track.
 		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
@@ -176,11 +244,12 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
-	v.validator = &validator{
+	val := &validator{
 		db:                             v.db,
-		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
+		validatorClient:                validatorClient,
 		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
 		node:                           ethpb.NewNodeClient(v.conn),
+		livenessClient:                 pbrpc.NewLivenessClient(v.conn),
 		keyManager:                     v.keyManager,
 		graffiti:                       v.graffiti,
 		logValidatorBalances:           v.logValidatorBalances,
@@ -196,18 +265,44 @@ func (v *ValidatorService) Start() {
 		walletInitializedFeed:          v.walletInitializedFeed,
 		blockFeed:                      new(event.Feed),
 		graffitiStruct:                 v.graffitiStruct,
+		graffitiStore:                  v.graffitiStore,
 		graffitiOrderedIndex:           graffitiOrderedIndex,
 		eipImportBlacklistedPublicKeys: slashablePublicKeys,
 		logDutyCountDown:               v.logDutyCountDown,
+		doppelgangerEpochs:             v.doppelgangerEpochs,
+		checkWithdrawals:               v.checkWithdrawals,
+		withdrawalAllowlist:            v.withdrawalAllowlist,
+		lateBlockThreshold:             v.lateBlockThreshold,
+		minPeers:                       v.minPeers,
+		minPeersTimeout:                v.minPeersTimeout,
+		skipWhenUnsynced:               v.skipWhenUnsynced,
+		maxHeadLag:                     v.maxHeadLag,
+		aggregationOffset:              v.aggregationOffset,
+		blockTimeout:                   v.blockTimeout,
 	}
-	go run(v.ctx, v.validator)
+	v.validatorLock.Lock()
+	v.validator = val
+	v.validatorLock.Unlock()
+	go run(v.ctx, val)
 	go v.recheckKeys(v.ctx)
 }
 
+// runningValidator returns the validator started by the service, or nil if it is not started yet.
+func (v *ValidatorService) runningValidator() Validator {
+	v.validatorLock.RLock()
+	defer v.validatorLock.RUnlock()
+	return v.validator
+}
+
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	for _, conn := range v.broadcastConns {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close broadcast connection")
+		}
+	}
 	if v.conn != nil {
 		return v.conn.Close()
 	}
@@ -325,6 +420,26 @@ func (v *ValidatorService) GenesisInfo(ctx context.Context) (*ethpb.Genesis, err
 	return nc.GetGenesis(ctx, &ptypes.Empty{})
 }
 
+// ForkInfo queries the beacon node for the fork of its head state and returns it along with
+// the genesis validators root, which remote signers need to compute signing domains.
+func (v *ValidatorService) ForkInfo(ctx context.Context) (*ethpbv1.Fork, []byte, error) {
+	if v.conn == nil {
+		return nil, nil, errors.New("not connected to a beacon node")
+	}
+	resp, err := ethpbv1.NewBeaconChainClient(v.conn).GetStateFork(ctx, &ethpbv1.StateRequest{StateId: []byte("head")})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get fork of head state")
+	}
+	genesisValidatorsRoot, err := v.db.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get genesis validators root")
+	}
+	if len(genesisValidatorsRoot) == 0 {
+		return nil, nil, errors.New("genesis validators root is not known yet")
+	}
+	return resp.Data, genesisValidatorsRoot, nil
+}
+
 // to accounts changes in the keymanager, then updates those keys'
 // buckets in bolt DB if a bucket for a key does not exist.
 func recheckValidatingKeysBucket(ctx context.Context, valDB db.Database, km keymanager.IKeymanager) {
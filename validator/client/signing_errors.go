@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Signing operations reported by the signing failures metric.
+const (
+	signOpAttestation = "attestation"
+	signOpBlock       = "block"
+	signOpAggregate   = "aggregate"
+)
+
+// Causes of signing failures reported by the signing failures metric.
+const (
+	signFailureTimeout            = "timeout"
+	signFailureRefused            = "refused"
+	signFailureSlashingProtection = "slashing-protection-block"
+	signFailureOther              = "other"
+)
+
+// ValidatorSigningFailuresVec used to count the signing failures by operation and cause.
+var ValidatorSigningFailuresVec = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "validator_signing_failures_total",
+		Help: "Count the signing failures by operation and cause, slashing-protection-block failures " +
+			"include the rejections of the local slashing protection and of the remote signer's one.",
+	},
+	[]string{
+		"operation",
+		"cause",
+	},
+)
+
+// recordSigningFailure counts a signing failure of the given operation.
+func recordSigningFailure(operation, cause string) {
+	ValidatorSigningFailuresVec.WithLabelValues(operation, cause).Inc()
+}
+
+// signingFailureCause categorizes an error returned by the keymanager when signing.
+func signingFailureCause(err error) string {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, web3signer.ErrSigningTimeout),
+		status.Code(err) == codes.DeadlineExceeded:
+		return signFailureTimeout
+	case errors.Is(err, web3signer.ErrSigningDenied):
+		// Web3Signer only denies requests which would violate its slashing protection.
+		return signFailureSlashingProtection
+	case errors.Is(err, remote.ErrSigningDenied), status.Code(err) == codes.PermissionDenied,
+		status.Code(err) == codes.Unauthenticated:
+		return signFailureRefused
+	default:
+		return signFailureOther
+	}
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSigningFailureCause(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "context deadline", err: context.DeadlineExceeded, want: signFailureTimeout},
+		{name: "web3signer timeout", err: web3signer.ErrSigningTimeout, want: signFailureTimeout},
+		{name: "grpc deadline", err: status.Error(codes.DeadlineExceeded, "too slow"), want: signFailureTimeout},
+		{name: "web3signer slashing protection", err: web3signer.ErrSigningDenied, want: signFailureSlashingProtection},
+		{name: "remote denied", err: remote.ErrSigningDenied, want: signFailureRefused},
+		{name: "grpc permission denied", err: status.Error(codes.PermissionDenied, "no"), want: signFailureRefused},
+		{name: "remote failed", err: remote.ErrSigningFailed, want: signFailureOther},
+		{name: "unknown", err: errors.New("bad"), want: signFailureOther},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, signingFailureCause(tt.err))
+		})
+	}
+}
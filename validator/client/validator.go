@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/dgraph-io/ristretto"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
 	lru "github.com/hashicorp/golang-lru"
@@ -22,6 +23,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -69,14 +71,17 @@ type validator struct {
 	domainDataLock                     sync.Mutex
 	attLogsLock                        sync.Mutex
 	aggregatedSlotCommitteeIDCacheLock sync.Mutex
+	selectionProofCacheLock            sync.Mutex
 	prevBalanceLock                    sync.RWMutex
 	slashableKeysLock                  sync.RWMutex
+	dutiesLock                         sync.RWMutex
 	walletInitializedFeed              *event.Feed
 	blockFeed                          *event.Feed
 	genesisTime                        uint64
 	highestValidSlot                   types.Slot
 	domainDataCache                    *ristretto.Cache
 	aggregatedSlotCommitteeIDCache     *lru.Cache
+	selectionProofCache                map[selectionProofKey][]byte
 	ticker                             *slotutil.SlotTicker
 	prevBalance                        map[[48]byte]uint64
 	duties                             *ethpb.DutiesResponse
@@ -85,14 +90,32 @@ type validator struct {
 	node                               ethpb.NodeClient
 	keyManager                         keymanager.IKeymanager
 	beaconClient                       ethpb.BeaconChainClient
+	livenessClient                     pbrpc.LivenessClient
 	validatorClient                    ethpb.BeaconNodeValidatorClient
 	protector                          iface.Protector
 	db                                 vdb.Database
 	graffiti                           []byte
 	voteStats                          voteStats
 	graffitiStruct                     *graffiti.Graffiti
+	graffitiStore                      *graffiti.Store
 	graffitiOrderedIndex               uint64
+	graffitiLock                       sync.Mutex
 	eipImportBlacklistedPublicKeys     map[[48]byte]bool
+	doppelgangerPublicKeys             map[[48]byte]bool
+	doppelgangerEpochs                 types.Epoch
+	checkWithdrawals                   bool
+	withdrawalAllowlist                map[common.Address]bool
+	lateBlockThreshold                 time.Duration
+	minPeers                           uint64
+	minPeersTimeout                    time.Duration
+	skipWhenUnsynced                   bool
+	maxHeadLag                         types.Slot
+	nodeUnsynced                       bool
+	aggregationOffset                  float64
+	blockTimeout                       time.Duration
+	maintenance                        dutyGate
+	blockStreamLock                    sync.Mutex
+	cancelBlockStream                  context.CancelFunc
 }
 
 // Done cleans up the validator.
@@ -217,6 +240,84 @@ func (v *validator) WaitForSync(ctx context.Context) error {
 	}
 }
 
+// WaitForPeers waits until the beacon node is connected to the configured minimum number of peers,
+// as attestations published by a poorly connected node are likely to be late or missed. Once the
+// timeout elapses, it proceeds anyway with a warning.
+func (v *validator) WaitForPeers(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "validator.WaitForPeers")
+	defer span.End()
+
+	if v.minPeers == 0 {
+		return nil
+	}
+	var timeout <-chan time.Time
+	if v.minPeersTimeout > 0 {
+		timeout = time.After(v.minPeersTimeout)
+	}
+	for {
+		peers, err := v.node.ListPeers(ctx, &ptypes.Empty{})
+		if err != nil {
+			return errors.Wrap(errConnectionIssue, errors.Wrap(err, "could not list peers").Error())
+		}
+		fields := logrus.Fields{
+			"peers":    len(peers.Peers),
+			"minPeers": v.minPeers,
+		}
+		if uint64(len(peers.Peers)) >= v.minPeers {
+			log.WithFields(fields).Info("Beacon node is connected to enough peers")
+			return nil
+		}
+		log.WithFields(fields).Info("Waiting for beacon node to connect to enough peers")
+		select {
+		// Poll every half slot.
+		case <-time.After(slotutil.DivideSlotBy(2 /* twice per slot */)):
+		case <-timeout:
+			log.WithFields(fields).Warn("Timed out waiting for beacon node peers, performing duties anyway")
+			return nil
+		case <-ctx.Done():
+			return errors.New("context has been canceled, exiting goroutine")
+		}
+	}
+}
+
+// NodeIsSynced checks, when duties are configured to be skipped while the beacon node is not synced,
+// that the node is not syncing and that its head does not lag behind the slot by more than the
+// configured number of slots. Duties performed on a stale head would vote for the wrong chain.
+func (v *validator) NodeIsSynced(ctx context.Context, slot types.Slot) (bool, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.NodeIsSynced")
+	defer span.End()
+
+	if !v.skipWhenUnsynced {
+		return true, nil
+	}
+	s, err := v.node.GetSyncStatus(ctx, &ptypes.Empty{})
+	if err != nil {
+		return false, errors.Wrap(err, "could not get sync status")
+	}
+	fields := logrus.Fields{
+		"slot":    slot,
+		"syncing": s.Syncing,
+	}
+	synced := !s.Syncing
+	if synced && v.maxHeadLag > 0 {
+		head, err := v.beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+		if err != nil {
+			return false, errors.Wrap(err, "could not get chain head")
+		}
+		fields["headSlot"] = head.HeadSlot
+		fields["maxHeadLag"] = v.maxHeadLag
+		synced = head.HeadSlot+v.maxHeadLag >= slot
+	}
+
+	if !synced {
+		log.WithFields(fields).Warn("Beacon node is not synced, skipping duties of the slot")
+	} else if v.nodeUnsynced {
+		log.WithFields(fields).Info("Beacon node is synced again, resuming duties")
+	}
+	v.nodeUnsynced = !synced
+	return synced, nil
+}
+
 // SlasherReady checks if slasher that was configured as external protection
 // is reachable.
 func (v *validator) SlasherReady(ctx context.Context) error {
@@ -252,7 +353,12 @@ func (v *validator) SlasherReady(ctx context.Context) error {
 // blocks from the beacon node. Upon receiving a block, the service
 // broadcasts it to a feed for other usages to subscribe to.
 func (v *validator) ReceiveBlocks(ctx context.Context, connectionErrorChannel chan<- error) {
-	stream, err := v.beaconClient.StreamBlocks(ctx, &ethpb.StreamBlocksRequest{VerifiedOnly: true})
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	v.blockStreamLock.Lock()
+	v.cancelBlockStream = cancel
+	v.blockStreamLock.Unlock()
+	stream, err := v.beaconClient.StreamBlocks(streamCtx, &ethpb.StreamBlocksRequest{VerifiedOnly: true})
 	if err != nil {
 		log.WithError(err).Error("Failed to retrieve blocks stream, " + errConnectionIssue.Error())
 		connectionErrorChannel <- errors.Wrap(errConnectionIssue, err.Error())
@@ -281,6 +387,16 @@ func (v *validator) ReceiveBlocks(ctx context.Context, connectionErrorChannel ch
 	}
 }
 
+// RestartBlockStream interrupts the blocks stream, which ReceiveBlocks then reports as a connection
+// error so that the stream is opened again, such as against a new beacon node.
+func (v *validator) RestartBlockStream() {
+	v.blockStreamLock.Lock()
+	defer v.blockStreamLock.Unlock()
+	if v.cancelBlockStream != nil {
+		v.cancelBlockStream()
+	}
+}
+
 func (v *validator) checkAndLogValidatorStatus(validatorStatuses []*ethpb.ValidatorActivationResponse_Status) bool {
 	nonexistentIndex := types.ValidatorIndex(^uint64(0))
 	var validatorActivated bool
@@ -372,6 +488,8 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 	ctx, span := trace.StartSpan(ctx, "validator.UpdateAssignments")
 	defer span.End()
 
+	v.pruneSelectionProofCache(helpers.SlotToEpoch(slot))
+
 	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
 	if err != nil {
 		return err
@@ -381,6 +499,9 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 	filteredKeys := make([][48]byte, 0, len(validatingKeys))
 	v.slashableKeysLock.RLock()
 	for _, pubKey := range validatingKeys {
+		if v.doppelgangerPublicKeys[pubKey] {
+			continue
+		}
 		if ok := v.eipImportBlacklistedPublicKeys[pubKey]; !ok {
 			filteredKeys = append(filteredKeys, pubKey)
 		} else {
@@ -400,12 +521,16 @@ func (v *validator) UpdateDuties(ctx context.Context, slot types.Slot) error {
 	// If duties is nil it means we have had no prior duties and just started up.
 	resp, err := v.validatorClient.GetDuties(ctx, req)
 	if err != nil {
+		v.dutiesLock.Lock()
 		v.duties = nil // Clear assignments so we know to retry the request.
+		v.dutiesLock.Unlock()
 		log.Error(err)
 		return err
 	}
 
+	v.dutiesLock.Lock()
 	v.duties = resp
+	v.dutiesLock.Unlock()
 	v.logDuties(slot, v.duties.CurrentEpochDuties)
 
 	// Non-blocking call for beacon node to start subscriptions for aggregators.
@@ -534,19 +659,23 @@ func (v *validator) GetKeymanager() keymanager.IKeymanager {
 // isAggregator checks if a validator is an aggregator of a given slot, it uses the selection algorithm outlined in:
 // https://github.com/ethereum/eth2.0-specs/blob/v0.9.3/specs/validator/0_beacon-chain-validator.md#aggregation-selection
 func (v *validator) isAggregator(ctx context.Context, committee []types.ValidatorIndex, slot types.Slot, pubKey [48]byte) (bool, error) {
-	modulo := uint64(1)
-	if len(committee)/int(params.BeaconConfig().TargetAggregatorsPerCommittee) > 1 {
-		modulo = uint64(len(committee)) / params.BeaconConfig().TargetAggregatorsPerCommittee
-	}
-
 	slotSig, err := v.signSlot(ctx, pubKey, slot)
 	if err != nil {
 		return false, err
 	}
+	return isAggregatorProof(committee, slotSig), nil
+}
+
+// isAggregatorProof checks whether the selection proof of a validator selects it as an aggregator of its committee.
+func isAggregatorProof(committee []types.ValidatorIndex, slotSig []byte) bool {
+	modulo := uint64(1)
+	if len(committee)/int(params.BeaconConfig().TargetAggregatorsPerCommittee) > 1 {
+		modulo = uint64(len(committee)) / params.BeaconConfig().TargetAggregatorsPerCommittee
+	}
 
 	b := hashutil.Hash(slotSig)
 
-	return binary.LittleEndian.Uint64(b[:8])%modulo == 0, nil
+	return binary.LittleEndian.Uint64(b[:8])%modulo == 0
 }
 
 // UpdateDomainDataCaches by making calls for all of the possible domain data. These can change when
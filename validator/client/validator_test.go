@@ -453,6 +453,150 @@ func TestWaitSync_Syncing(t *testing.T) {
 	require.NoError(t, v.WaitForSync(context.Background()))
 }
 
+func TestWaitForPeers_Disabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	n := mock.NewMockNodeClient(ctrl)
+
+	v := validator{
+		node: n,
+	}
+	require.NoError(t, v.WaitForPeers(context.Background()))
+}
+
+func TestNodeIsSynced_Disabled(t *testing.T) {
+	v := validator{}
+	synced, err := v.NodeIsSynced(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, synced)
+}
+
+func TestNodeIsSynced_Syncing(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	n := mock.NewMockNodeClient(ctrl)
+
+	v := validator{
+		node:             n,
+		skipWhenUnsynced: true,
+		maxHeadLag:       4,
+	}
+
+	n.EXPECT().GetSyncStatus(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.SyncStatus{Syncing: true}, nil)
+
+	synced, err := v.NodeIsSynced(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, false, synced)
+	require.LogsContain(t, hook, "Beacon node is not synced, skipping duties of the slot")
+}
+
+func TestNodeIsSynced_HeadLag(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	n := mock.NewMockNodeClient(ctrl)
+	client := mock.NewMockBeaconChainClient(ctrl)
+
+	v := validator{
+		node:             n,
+		beaconClient:     client,
+		skipWhenUnsynced: true,
+		maxHeadLag:       4,
+	}
+
+	n.EXPECT().GetSyncStatus(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.SyncStatus{Syncing: false}, nil).Times(2)
+	client.EXPECT().GetChainHead(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.ChainHead{HeadSlot: 5}, nil)
+	client.EXPECT().GetChainHead(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.ChainHead{HeadSlot: 9}, nil)
+
+	synced, err := v.NodeIsSynced(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, false, synced)
+	require.LogsContain(t, hook, "Beacon node is not synced, skipping duties of the slot")
+	require.LogsContain(t, hook, "headSlot=5")
+
+	synced, err = v.NodeIsSynced(context.Background(), 11)
+	require.NoError(t, err)
+	assert.Equal(t, true, synced)
+	require.LogsContain(t, hook, "Beacon node is synced again, resuming duties")
+}
+
+func TestWaitForPeers_EnoughPeers(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	n := mock.NewMockNodeClient(ctrl)
+
+	v := validator{
+		node:     n,
+		minPeers: 2,
+	}
+
+	n.EXPECT().ListPeers(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.Peers{Peers: []*ethpb.Peer{{}, {}, {}}}, nil)
+
+	require.NoError(t, v.WaitForPeers(context.Background()))
+	require.LogsContain(t, hook, "Beacon node is connected to enough peers")
+	require.LogsContain(t, hook, "peers=3")
+}
+
+func TestWaitForPeers_Timeout(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	n := mock.NewMockNodeClient(ctrl)
+
+	v := validator{
+		node:            n,
+		minPeers:        5,
+		minPeersTimeout: 10 * time.Millisecond,
+	}
+
+	n.EXPECT().ListPeers(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.Peers{Peers: []*ethpb.Peer{{}}}, nil)
+
+	require.NoError(t, v.WaitForPeers(context.Background()))
+	require.LogsContain(t, hook, "Waiting for beacon node to connect to enough peers")
+	require.LogsContain(t, hook, "Timed out waiting for beacon node peers, performing duties anyway")
+}
+
+func TestWaitForPeers_ContextCanceled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	n := mock.NewMockNodeClient(ctrl)
+
+	v := validator{
+		node:     n,
+		minPeers: 5,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	n.EXPECT().ListPeers(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.Peers{}, nil)
+
+	assert.ErrorContains(t, cancelledCtx, v.WaitForPeers(ctx))
+}
+
 func TestUpdateDuties_DoesNothingWhenNotEpochStart_AlreadyExistingAssignments(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
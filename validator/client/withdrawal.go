@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// eth1AddressWithdrawalPrefix is the first byte of withdrawal credentials committing to an eth1
+// address, which takes the last 20 bytes of the credentials.
+const eth1AddressWithdrawalPrefix = byte(1)
+
+// CheckWithdrawalCredentials logs a warning for each validating key whose withdrawal credentials
+// on chain are BLS (0x00) credentials, or point to an eth1 address missing from the configured
+// allowlist, as they may not be controlled by the operator. The check is advisory only: it never
+// prevents the validator from performing its duties.
+func (v *validator) CheckWithdrawalCredentials(ctx context.Context) error {
+	if !v.checkWithdrawals {
+		return nil
+	}
+	validatingKeys, err := v.keyManager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating keys")
+	}
+	if len(validatingKeys) == 0 {
+		return nil
+	}
+	req := &ethpb.ListValidatorsRequest{PublicKeys: bytesutil.FromBytes48Array(validatingKeys)}
+	var checked, flagged int
+	for {
+		resp, err := v.beaconClient.ListValidators(ctx, req)
+		if err != nil {
+			return errors.Wrap(err, "could not list validators")
+		}
+		for _, val := range resp.ValidatorList {
+			checked++
+			warning := withdrawalCredentialsWarning(val.Validator.WithdrawalCredentials, v.withdrawalAllowlist)
+			if warning == "" {
+				continue
+			}
+			flagged++
+			log.WithFields(logrus.Fields{
+				"publicKey":             fmt.Sprintf("%#x", bytesutil.Trunc(val.Validator.PublicKey)),
+				"validatorIndex":        val.Index,
+				"withdrawalCredentials": fmt.Sprintf("%#x", val.Validator.WithdrawalCredentials),
+			}).Warn(warning)
+		}
+		if resp.NextPageToken == "" || len(resp.ValidatorList) == 0 {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	log.WithFields(logrus.Fields{
+		"validators": checked,
+		"flagged":    flagged,
+	}).Info("Checked withdrawal credentials of deposited validators")
+	return nil
+}
+
+// withdrawalCredentialsWarning returns why the withdrawal credentials may not be controlled by
+// the operator, or an empty string if they point to an allowed eth1 address. Any eth1 address is
+// allowed when the allowlist is empty.
+func withdrawalCredentialsWarning(creds []byte, allowlist map[common.Address]bool) string {
+	if len(creds) != 32 {
+		return "Withdrawal credentials are malformed"
+	}
+	switch creds[0] {
+	case params.BeaconConfig().BLSWithdrawalPrefixByte:
+		return "Validator has BLS (0x00) withdrawal credentials, make sure you hold the withdrawal key they commit to"
+	case eth1AddressWithdrawalPrefix:
+		if len(allowlist) == 0 || allowlist[common.BytesToAddress(creds[12:])] {
+			return ""
+		}
+		return "Withdrawal credentials point to an eth1 address which is not in the withdrawal address allowlist"
+	default:
+		return "Withdrawal credentials have an unknown prefix"
+	}
+}
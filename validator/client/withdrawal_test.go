@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+)
+
+type fakeValidatorListClient struct {
+	ethpb.BeaconChainClient
+	pages []*ethpb.Validators
+}
+
+func (c *fakeValidatorListClient) ListValidators(
+	_ context.Context, req *ethpb.ListValidatorsRequest, _ ...grpc.CallOption,
+) (*ethpb.Validators, error) {
+	if req.PageToken == "" {
+		return c.pages[0], nil
+	}
+	return c.pages[1], nil
+}
+
+func eth1WithdrawalCredentials(addr common.Address) []byte {
+	creds := make([]byte, 32)
+	creds[0] = eth1AddressWithdrawalPrefix
+	copy(creds[12:], addr.Bytes())
+	return creds
+}
+
+func TestWithdrawalCredentialsWarning(t *testing.T) {
+	allowed := common.HexToAddress("0x0102030405060708090a0b0c0d0e0f1011121314")
+	other := common.HexToAddress("0xffffffffffffffffffffffffffffffffffffffff")
+	unknownPrefix := make([]byte, 32)
+	unknownPrefix[0] = 0x42
+	tests := []struct {
+		name      string
+		creds     []byte
+		allowlist map[common.Address]bool
+		wantWarn  bool
+	}{
+		{name: "bls credentials", creds: make([]byte, 32), wantWarn: true},
+		{name: "eth1 address without allowlist", creds: eth1WithdrawalCredentials(other), wantWarn: false},
+		{
+			name:      "allowed eth1 address",
+			creds:     eth1WithdrawalCredentials(allowed),
+			allowlist: map[common.Address]bool{allowed: true},
+			wantWarn:  false,
+		},
+		{
+			name:      "eth1 address not in allowlist",
+			creds:     eth1WithdrawalCredentials(other),
+			allowlist: map[common.Address]bool{allowed: true},
+			wantWarn:  true,
+		},
+		{name: "unknown prefix", creds: unknownPrefix, wantWarn: true},
+		{name: "malformed", creds: []byte{1, 2}, wantWarn: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantWarn, withdrawalCredentialsWarning(tt.creds, tt.allowlist) != "")
+		})
+	}
+}
+
+func TestCheckWithdrawalCredentials_Disabled(t *testing.T) {
+	v, _, _, finish := setup(t)
+	defer finish()
+	// The beacon client is not set, so querying it would panic.
+	v.beaconClient = nil
+	require.NoError(t, v.CheckWithdrawalCredentials(context.Background()))
+}
+
+func TestCheckWithdrawalCredentials_WarnsOnUnexpectedCredentials(t *testing.T) {
+	hook := logTest.NewGlobal()
+	v, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := validatorKey.PublicKey().Marshal()
+	allowed := common.HexToAddress("0x0102030405060708090a0b0c0d0e0f1011121314")
+	v.checkWithdrawals = true
+	v.withdrawalAllowlist = map[common.Address]bool{allowed: true}
+	v.beaconClient = &fakeValidatorListClient{
+		pages: []*ethpb.Validators{
+			{
+				ValidatorList: []*ethpb.Validators_ValidatorContainer{
+					{Index: 1, Validator: &ethpb.Validator{PublicKey: pubKey, WithdrawalCredentials: eth1WithdrawalCredentials(allowed)}},
+				},
+				NextPageToken: "1",
+			},
+			{
+				ValidatorList: []*ethpb.Validators_ValidatorContainer{
+					{Index: 2, Validator: &ethpb.Validator{PublicKey: pubKey, WithdrawalCredentials: make([]byte, 32)}},
+				},
+			},
+		},
+	}
+
+	require.NoError(t, v.CheckWithdrawalCredentials(context.Background()))
+	require.LogsContain(t, hook, "Validator has BLS (0x00) withdrawal credentials")
+	require.LogsContain(t, hook, "validatorIndex=2")
+	require.LogsDoNotContain(t, hook, "validatorIndex=1")
+	require.LogsContain(t, hook, "flagged=1")
+	require.LogsContain(t, hook, "validators=2")
+}
@@ -575,3 +575,33 @@ func (s *Store) LowestSignedTargetEpoch(ctx context.Context, publicKey [48]byte)
 	})
 	return lowestSignedTargetEpoch, exists, err
 }
+
+// RaiseLowestSignedEpochs sets the lowest signed source and target epochs for a validator
+// public key to the given epochs wherever they are higher than the stored values. It never
+// lowers either watermark, which allows merging external slashing protection data conservatively.
+func (s *Store) RaiseLowestSignedEpochs(ctx context.Context, publicKey [48]byte, source, target types.Epoch) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.RaiseLowestSignedEpochs")
+	defer span.End()
+
+	return s.update(func(tx *bolt.Tx) error {
+		lowestSourceBucket, err := tx.CreateBucketIfNotExists(lowestSignedSourceBucket)
+		if err != nil {
+			return err
+		}
+		lowestTargetBucket, err := tx.CreateBucketIfNotExists(lowestSignedTargetBucket)
+		if err != nil {
+			return err
+		}
+		lowestSignedSourceBytes := lowestSourceBucket.Get(publicKey[:])
+		if len(lowestSignedSourceBytes) < 8 || bytesutil.BytesToEpochBigEndian(lowestSignedSourceBytes) < source {
+			if err := lowestSourceBucket.Put(publicKey[:], bytesutil.EpochToBytesBigEndian(source)); err != nil {
+				return err
+			}
+		}
+		lowestSignedTargetBytes := lowestTargetBucket.Get(publicKey[:])
+		if len(lowestSignedTargetBytes) < 8 || bytesutil.BytesToEpochBigEndian(lowestSignedTargetBytes) < target {
+			return lowestTargetBucket.Put(publicKey[:], bytesutil.EpochToBytesBigEndian(target))
+		}
+		return nil
+	})
+}
@@ -329,6 +329,25 @@ func TestLowestSignedTargetEpoch_SaveRetrieveReplace(t *testing.T) {
 	require.Equal(t, types.Epoch(199), got)
 }
 
+func TestStore_RaiseLowestSignedEpochs(t *testing.T) {
+	ctx := context.Background()
+	pubkey := [48]byte{3}
+	validatorDB := setupDB(t, [][48]byte{pubkey})
+
+	require.NoError(t, validatorDB.RaiseLowestSignedEpochs(ctx, pubkey, 5, 6))
+	require.NoError(t, validatorDB.RaiseLowestSignedEpochs(ctx, pubkey, 3, 8))
+
+	// Each watermark only ever moves up.
+	source, exists, err := validatorDB.LowestSignedSourceEpoch(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(5), source)
+	target, exists, err := validatorDB.LowestSignedTargetEpoch(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(8), target)
+}
+
 func TestStore_SaveAttestationsForPubKey(t *testing.T) {
 	ctx := context.Background()
 	numValidators := 1
@@ -166,6 +166,23 @@ func (s *Store) LowestSignedProposal(ctx context.Context, publicKey [48]byte) (t
 	return lowestSignedProposalSlot, exists, err
 }
 
+// RaiseLowestSignedProposal sets the lowest signed proposal slot for a validator public key
+// to the given slot if it is higher than the stored value. It never lowers the watermark,
+// which allows merging external slashing protection data conservatively.
+func (s *Store) RaiseLowestSignedProposal(ctx context.Context, publicKey [48]byte, slot types.Slot) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.RaiseLowestSignedProposal")
+	defer span.End()
+
+	return s.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(lowestSignedProposalsBucket)
+		lowestSignedProposalBytes := bucket.Get(publicKey[:])
+		if len(lowestSignedProposalBytes) >= 8 && bytesutil.BytesToSlotBigEndian(lowestSignedProposalBytes) >= slot {
+			return nil
+		}
+		return bucket.Put(publicKey[:], bytesutil.SlotToBytesBigEndian(slot))
+	})
+}
+
 // HighestSignedProposal returns the highest signed proposal slot for a validator public key.
 // If no data exists, a boolean of value false is returned.
 func (s *Store) HighestSignedProposal(ctx context.Context, publicKey [48]byte) (types.Slot, bool, error) {
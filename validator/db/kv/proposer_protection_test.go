@@ -241,6 +241,31 @@ func TestStore_LowestSignedProposal(t *testing.T) {
 	assert.Equal(t, types.Slot(1), slot)
 }
 
+func TestStore_RaiseLowestSignedProposal(t *testing.T) {
+	ctx := context.Background()
+	pubkey := [48]byte{3}
+	validatorDB := setupDB(t, [][48]byte{pubkey})
+
+	// Raising a missing watermark sets it.
+	require.NoError(t, validatorDB.RaiseLowestSignedProposal(ctx, pubkey, 5))
+	slot, exists, err := validatorDB.LowestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, true, exists)
+	assert.Equal(t, types.Slot(5), slot)
+
+	// A lower value does not lower the watermark.
+	require.NoError(t, validatorDB.RaiseLowestSignedProposal(ctx, pubkey, 2))
+	slot, _, err = validatorDB.LowestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(5), slot)
+
+	// A higher value raises it.
+	require.NoError(t, validatorDB.RaiseLowestSignedProposal(ctx, pubkey, 8))
+	slot, _, err = validatorDB.LowestSignedProposal(ctx, pubkey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(8), slot)
+}
+
 func TestStore_HighestSignedProposal(t *testing.T) {
 	ctx := context.Background()
 	pubkey := [48]byte{3}
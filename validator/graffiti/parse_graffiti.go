@@ -1,6 +1,7 @@
 package graffiti
 
 import (
+	"fmt"
 	"io/ioutil"
 
 	types "github.com/prysmaticlabs/eth2-types"
@@ -8,15 +9,20 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
+// maxGraffitiLength is the size of the graffiti field of a beacon block.
+const maxGraffitiLength = 32
+
 type Graffiti struct {
 	Hash     [32]byte
 	Default  string                          `yaml:"default,omitempty"`
 	Ordered  []string                        `yaml:"ordered,omitempty"`
+	Cycle    bool                            `yaml:"cycle,omitempty"`
 	Random   []string                        `yaml:"random,omitempty"`
 	Specific map[types.ValidatorIndex]string `yaml:"specific,omitempty"`
 }
 
 // ParseGraffitiFile parses the graffiti file and returns the graffiti struct.
+// The file is rejected if any graffiti in it without placeholders does not fit in a beacon block.
 func ParseGraffitiFile(f string) (*Graffiti, error) {
 	yamlFile, err := ioutil.ReadFile(f)
 	if err != nil {
@@ -26,6 +32,24 @@ func ParseGraffitiFile(f string) (*Graffiti, error) {
 	if err := yaml.Unmarshal(yamlFile, g); err != nil {
 		return nil, err
 	}
+	if err := g.validate(); err != nil {
+		return nil, err
+	}
 	g.Hash = hashutil.Hash(yamlFile)
 	return g, nil
 }
+
+func (g *Graffiti) validate() error {
+	entries := append([]string{g.Default}, g.Ordered...)
+	entries = append(entries, g.Random...)
+	for _, s := range g.Specific {
+		entries = append(entries, s)
+	}
+	for _, s := range entries {
+		// Graffiti with placeholders are truncated once expanded instead.
+		if len(s) > maxGraffitiLength && !hasPlaceholders([]byte(s)) {
+			return fmt.Errorf("graffiti %q is %d bytes long, the maximum is %d bytes", s, len(s), maxGraffitiLength)
+		}
+	}
+	return nil
+}
@@ -157,3 +157,59 @@ specific:
 	}
 	require.DeepEqual(t, wanted, got)
 }
+
+func TestParseGraffitiFile_Cycle(t *testing.T) {
+	input := []byte(`ordered:
+  - "Mr D was here"
+  - "Mr E was here"
+cycle: true`)
+
+	someFileName := filepath.Join(t.TempDir(), "somefile.txt")
+	require.NoError(t, ioutil.WriteFile(someFileName, input, os.ModePerm))
+
+	got, err := ParseGraffitiFile(someFileName)
+	require.NoError(t, err)
+
+	wanted := &Graffiti{
+		Hash:    hashutil.Hash(input),
+		Ordered: []string{"Mr D was here", "Mr E was here"},
+		Cycle:   true,
+	}
+	require.DeepEqual(t, wanted, got)
+}
+
+func TestParseGraffitiFile_TooLong(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "default", input: `default: "This graffiti is longer than thirty two bytes"`},
+		{name: "ordered", input: "ordered:\n  - \"ok\"\n  - \"This graffiti is longer than thirty two bytes\""},
+		{name: "random", input: "random:\n  - \"This graffiti is longer than thirty two bytes\""},
+		{name: "specific", input: "specific:\n  1: \"This graffiti is longer than thirty two bytes\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			someFileName := filepath.Join(t.TempDir(), "somefile.txt")
+			require.NoError(t, ioutil.WriteFile(someFileName, []byte(tt.input), os.ModePerm))
+			_, err := ParseGraffitiFile(someFileName)
+			require.ErrorContains(t, "the maximum is 32 bytes", err)
+		})
+	}
+}
+
+func TestStore_Reload_KeepsPreviousOnError(t *testing.T) {
+	someFileName := filepath.Join(t.TempDir(), "somefile.txt")
+	require.NoError(t, ioutil.WriteFile(someFileName, []byte(`default: "Mr T was here"`), os.ModePerm))
+	s, err := NewStore(someFileName)
+	require.NoError(t, err)
+	require.Equal(t, "Mr T was here", s.Graffiti().Default)
+
+	require.NoError(t, ioutil.WriteFile(someFileName, []byte(`default: "This graffiti is longer than thirty two bytes"`), os.ModePerm))
+	require.ErrorContains(t, "the maximum is 32 bytes", s.Reload())
+	require.Equal(t, "Mr T was here", s.Graffiti().Default)
+
+	require.NoError(t, ioutil.WriteFile(someFileName, []byte(`default: "Mr R was here"`), os.ModePerm))
+	require.NoError(t, s.Reload())
+	require.Equal(t, "Mr R was here", s.Graffiti().Default)
+}
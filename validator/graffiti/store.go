@@ -0,0 +1,65 @@
+package graffiti
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// Store holds the graffiti loaded from a file, which can be reloaded
+// while the validator client is running.
+type Store struct {
+	path     string
+	lock     sync.RWMutex
+	graffiti *Graffiti
+}
+
+// NewStore loads the graffiti from the given file.
+func NewStore(path string) (*Store, error) {
+	s := &Store{path: path}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Graffiti returns the graffiti from the last successfully loaded file.
+func (s *Store) Graffiti() *Graffiti {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.graffiti
+}
+
+// Reload parses the graffiti file again. The current graffiti
+// is kept if the file is invalid.
+func (s *Store) Reload() error {
+	g, err := ParseGraffitiFile(s.path)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	s.graffiti = g
+	s.lock.Unlock()
+	log.WithField("path", s.path).Info("Loaded graffiti file")
+	return nil
+}
+
+// ReloadOnSignal reloads the graffiti file every time the process
+// receives a SIGHUP, until the context is canceled.
+func (s *Store) ReloadOnSignal(ctx context.Context) {
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGHUP)
+	defer signal.Stop(sigc)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sigc:
+			if err := s.Reload(); err != nil {
+				log.WithError(err).Error("Could not reload graffiti file, keeping the previous one")
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package graffiti
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/sirupsen/logrus"
+)
+
+// Placeholders replaced in the graffiti at proposal time.
+const (
+	// versionPlaceholder is replaced by the version of the validator client, such as v1.3.4.
+	versionPlaceholder = "{version}"
+	// commitPlaceholder is replaced by the first characters of the git commit hash of the validator client.
+	commitPlaceholder = "{commit}"
+)
+
+// shortCommitLength is the number of characters of the commit hash put in a graffiti.
+const shortCommitLength = 8
+
+// hasPlaceholders returns whether the graffiti contains any placeholder.
+func hasPlaceholders(g []byte) bool {
+	return bytes.Contains(g, []byte(versionPlaceholder)) || bytes.Contains(g, []byte(commitPlaceholder))
+}
+
+// Expand replaces the placeholders of a graffiti with the version and commit of the validator client.
+// The result is truncated to the size of the graffiti field of a beacon block, with a warning, if it
+// does not fit. A graffiti without placeholders is returned unchanged.
+func Expand(g []byte) []byte {
+	if !hasPlaceholders(g) {
+		return g
+	}
+	commit := version.GitCommit()
+	if len(commit) > shortCommitLength {
+		commit = commit[:shortCommitLength]
+	}
+	expanded := bytes.ReplaceAll(g, []byte(versionPlaceholder), []byte(version.SemanticVersion()))
+	expanded = bytes.ReplaceAll(expanded, []byte(commitPlaceholder), []byte(commit))
+	if len(expanded) <= maxGraffitiLength {
+		return expanded
+	}
+	truncated := truncate(expanded)
+	log.WithFields(logrus.Fields{
+		"graffiti":  string(expanded),
+		"truncated": string(truncated),
+	}).Warnf("Graffiti is longer than %d bytes once its placeholders are expanded, truncating it", maxGraffitiLength)
+	return truncated
+}
+
+// truncate cuts a graffiti to the size of the graffiti field of a beacon block, without
+// leaving a partial UTF-8 character at the end.
+func truncate(g []byte) []byte {
+	if len(g) <= maxGraffitiLength {
+		return g
+	}
+	truncated := g[:maxGraffitiLength]
+	for len(truncated) > 0 && !utf8.Valid(truncated) {
+		truncated = truncated[:len(truncated)-1]
+	}
+	return truncated
+}
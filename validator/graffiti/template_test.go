@@ -0,0 +1,35 @@
+package graffiti
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestExpand(t *testing.T) {
+	hook := logTest.NewGlobal()
+	commit := version.GitCommit()
+	if len(commit) > shortCommitLength {
+		commit = commit[:shortCommitLength]
+	}
+
+	assert.DeepEqual(t, []byte("Mr T was here"), Expand([]byte("Mr T was here")))
+	assert.DeepEqual(t, []byte{}, Expand([]byte{}))
+	assert.DeepEqual(t, []byte("Prysm "+version.SemanticVersion()+" "+commit), Expand([]byte("Prysm {version} {commit}")))
+	assert.LogsDoNotContain(t, hook, "truncating")
+
+	got := Expand([]byte("{commit} and a very long graffiti which does not fit"))
+	assert.Equal(t, maxGraffitiLength, len(got))
+	assert.DeepEqual(t, []byte(commit + " and a very long graffiti which does not fit")[:maxGraffitiLength], got)
+	assert.LogsContain(t, hook, "truncating")
+}
+
+func TestTruncate(t *testing.T) {
+	fits := []byte("01234567890123456789012345678€")
+	assert.DeepEqual(t, fits, truncate(fits))
+	// The 3 bytes character starting at byte 31 does not fit, and is dropped as a whole.
+	assert.DeepEqual(t, []byte("0123456789012345678901234567890"), truncate([]byte("0123456789012345678901234567890€")))
+	assert.DeepEqual(t, []byte("01234567890123456789012345678901"), truncate([]byte("01234567890123456789012345678901234")))
+}
@@ -28,6 +28,7 @@ const (
 type SetupConfig struct {
 	Wallet           iface.Wallet
 	ListenForChanges bool
+	SkipInvalidKeys  bool
 }
 
 // Keymanager implementation for derived, HD keymanager using EIP-2333 and EIP-2334.
@@ -43,6 +44,7 @@ func NewKeymanager(
 	importedKM, err := imported.NewKeymanager(ctx, &imported.SetupConfig{
 		Wallet:           cfg.Wallet,
 		ListenForChanges: cfg.ListenForChanges,
+		SkipInvalidKeys:  cfg.SkipInvalidKeys,
 	})
 	if err != nil {
 		return nil, err
@@ -77,6 +79,12 @@ func (km *Keymanager) RecoverAccountsFromMnemonic(
 	return km.importedKM.ImportKeypairs(ctx, privKeys, pubKeys)
 }
 
+// ImportKeypairs writes the specified private keys into the wallet along with the
+// accounts derived from its mnemonic.
+func (km *Keymanager) ImportKeypairs(ctx context.Context, privKeys, pubKeys [][]byte) error {
+	return km.importedKM.ImportKeypairs(ctx, privKeys, pubKeys)
+}
+
 // ExtractKeystores retrieves the secret keys for specified public keys
 // in the function input, encrypts them using the specified password,
 // and returns their respective EIP-2335 keystores.
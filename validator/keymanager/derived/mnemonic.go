@@ -1,6 +1,9 @@
 package derived
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
 	"fmt"
 	"os"
 
@@ -8,6 +11,9 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/shared/rand"
 	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/wordlists"
+	util "github.com/wealdtech/go-eth2-util"
+	"golang.org/x/crypto/pbkdf2"
 )
 
 const confirmationText = "Confirm you have written down the recovery words somewhere safe (offline) [y|Y]"
@@ -79,3 +85,94 @@ func seedFromMnemonic(mnemonic, mnemonicPassphrase string) ([]byte, error) {
 	}
 	return bip39.NewSeed(mnemonic, mnemonicPassphrase), nil
 }
+
+// englishWordIndices maps the words of the english BIP-39 wordlist to their index.
+var englishWordIndices = func() map[string]int {
+	m := make(map[string]int, len(wordlists.English))
+	for i, w := range wordlists.English {
+		m[w] = i
+	}
+	return m
+}()
+
+// DeriveValidatingKeys derives the EIP-2334 validating keys with account indices start
+// through start+count-1 from an english mnemonic phrase. The mnemonic is only handled as
+// bytes, which are zeroed along with the derived seed before returning, as a Go string
+// holding the phrase could not be cleared from memory.
+func DeriveValidatingKeys(
+	mnemonic []byte, mnemonicPassphrase string, start, count int,
+) (privKeys, pubKeys [][]byte, err error) {
+	defer zeroBytes(mnemonic)
+	if start < 0 || count <= 0 {
+		return nil, nil, fmt.Errorf("invalid account index range, start %d count %d", start, count)
+	}
+	phrase, err := normalizeMnemonic(mnemonic)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer zeroBytes(phrase)
+	// This is the BIP-39 seed derivation, done on bytes instead of bip39.NewSeed.
+	seed := pbkdf2.Key(phrase, []byte("mnemonic"+mnemonicPassphrase), 2048, 64, sha512.New)
+	defer zeroBytes(seed)
+
+	privKeys = make([][]byte, count)
+	pubKeys = make([][]byte, count)
+	for i := 0; i < count; i++ {
+		privKey, err := util.PrivateKeyFromSeedAndPath(
+			seed, fmt.Sprintf(ValidatingKeyDerivationPathTemplate, start+i),
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		privKeys[i] = privKey.Marshal()
+		pubKeys[i] = privKey.PublicKey().Marshal()
+	}
+	return privKeys, pubKeys, nil
+}
+
+// normalizeMnemonic checks the words and the checksum of an english mnemonic phrase, and
+// returns a copy of its words separated by single spaces as expected for seed derivation.
+func normalizeMnemonic(mnemonic []byte) ([]byte, error) {
+	words := bytes.Fields(mnemonic)
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return nil, bip39.ErrInvalidMnemonic
+	}
+	totalBits := len(words) * 11
+	checksumBits := totalBits / 33
+	entropyBits := totalBits - checksumBits
+	bits := make([]byte, (totalBits+7)/8)
+	defer zeroBytes(bits)
+	for i, w := range words {
+		index, ok := englishWordIndices[string(w)]
+		if !ok {
+			return nil, bip39.ErrInvalidMnemonic
+		}
+		for j := 0; j < 11; j++ {
+			if index&(1<<(10-j)) != 0 {
+				pos := i*11 + j
+				bits[pos/8] |= 1 << (7 - pos%8)
+			}
+		}
+	}
+	checksum := sha256.Sum256(bits[:entropyBits/8])
+	for j := 0; j < checksumBits; j++ {
+		pos := entropyBits + j
+		if (checksum[0]>>(7-j))&1 != (bits[pos/8]>>(7-pos%8))&1 {
+			return nil, bip39.ErrInvalidMnemonic
+		}
+	}
+	phrase := make([]byte, 0, len(mnemonic))
+	for i, w := range words {
+		if i > 0 {
+			phrase = append(phrase, ' ')
+		}
+		phrase = append(phrase, w...)
+	}
+	return phrase, nil
+}
+
+func zeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -1,11 +1,13 @@
 package derived
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/tyler-smith/go-bip39"
+	util "github.com/wealdtech/go-eth2-util"
 )
 
 func TestMnemonic_Generate_CanRecover(t *testing.T) {
@@ -18,3 +20,45 @@ func TestMnemonic_Generate_CanRecover(t *testing.T) {
 	require.NoError(t, err)
 	assert.DeepEqual(t, data, entropy, "Expected to recover original data")
 }
+
+func TestDeriveValidatingKeys_MatchesSeedDerivation(t *testing.T) {
+	generator := &EnglishMnemonicGenerator{}
+	data := make([]byte, 32)
+	copy(data, "hello-world")
+	phrase, err := generator.Generate(data)
+	require.NoError(t, err)
+
+	mnemonic := []byte("  " + phrase + "\n")
+	privKeys, pubKeys, err := DeriveValidatingKeys(mnemonic, "passphrase", 2, 3)
+	require.NoError(t, err)
+	assert.DeepEqual(t, make([]byte, len(mnemonic)), mnemonic, "Expected mnemonic to be zeroed")
+	require.Equal(t, 3, len(privKeys))
+
+	seed := bip39.NewSeed(phrase, "passphrase")
+	for i := 0; i < 3; i++ {
+		privKey, err := util.PrivateKeyFromSeedAndPath(seed, fmt.Sprintf(ValidatingKeyDerivationPathTemplate, 2+i))
+		require.NoError(t, err)
+		assert.DeepEqual(t, privKey.Marshal(), privKeys[i])
+		assert.DeepEqual(t, privKey.PublicKey().Marshal(), pubKeys[i])
+	}
+}
+
+func TestDeriveValidatingKeys_InvalidMnemonic(t *testing.T) {
+	validPhrase := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	_, _, err := DeriveValidatingKeys([]byte(validPhrase), "", 0, 1)
+	require.NoError(t, err)
+
+	for _, phrase := range []string{
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon ability",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon notaword",
+		"abandon abandon abandon",
+		"",
+	} {
+		mnemonic := []byte(phrase)
+		_, _, err := DeriveValidatingKeys(mnemonic, "", 0, 1)
+		assert.ErrorContains(t, bip39.ErrInvalidMnemonic.Error(), err)
+		assert.DeepEqual(t, make([]byte, len(mnemonic)), mnemonic, "Expected mnemonic to be zeroed")
+	}
+	_, _, err = DeriveValidatingKeys([]byte(validPhrase), "", 0, 0)
+	assert.ErrorContains(t, "invalid account index range", err)
+}
@@ -61,6 +61,99 @@ func (km *Keymanager) ImportKeystores(
 	return km.wallet.WriteFileAtPath(ctx, AccountsPath, AccountsKeystoreFileName, encodedAccounts)
 }
 
+// KeystoreImportStatus defines the outcome of importing a single keystore in a batch.
+type KeystoreImportStatus int
+
+const (
+	// KeystoreImported means the keystore was decrypted and added to the wallet.
+	KeystoreImported KeystoreImportStatus = iota
+	// KeystoreDuplicate means the keystore's key already exists in the wallet or earlier in the batch.
+	KeystoreDuplicate
+	// KeystoreFailed means the keystore could not be parsed or decrypted.
+	KeystoreFailed
+)
+
+// String returns a human readable import status.
+func (s KeystoreImportStatus) String() string {
+	switch s {
+	case KeystoreImported:
+		return "imported"
+	case KeystoreDuplicate:
+		return "duplicate"
+	case KeystoreFailed:
+		return "failed"
+	default:
+		return fmt.Sprintf("%d", int(s))
+	}
+}
+
+// KeystoreImportResult reports the outcome of importing one keystore in a batch.
+type KeystoreImportResult struct {
+	PublicKey []byte
+	Status    KeystoreImportStatus
+	Err       error
+}
+
+// BatchImportKeystores imports a list of EIP-2335 keystore JSON files which all share
+// a single password. Unlike ImportKeystores, it never prompts for input, and a keystore
+// that cannot be parsed or decrypted does not abort the import of the rest. Keys already
+// present in the wallet are skipped and reported as duplicates. The returned results are
+// in the same order as the provided keystores.
+func (km *Keymanager) BatchImportKeystores(
+	ctx context.Context,
+	encodedKeystores [][]byte,
+	password string,
+) ([]*KeystoreImportResult, error) {
+	decryptor := keystorev4.New()
+	seen := make(map[string]bool)
+	if km.accountsStore != nil {
+		for _, pubKey := range km.accountsStore.PublicKeys {
+			seen[string(pubKey)] = true
+		}
+	}
+	results := make([]*KeystoreImportResult, len(encodedKeystores))
+	privKeys := make([][]byte, 0, len(encodedKeystores))
+	pubKeys := make([][]byte, 0, len(encodedKeystores))
+	for i, encoded := range encodedKeystores {
+		keystore := &keymanager.Keystore{}
+		if err := json.Unmarshal(encoded, keystore); err != nil {
+			results[i] = &KeystoreImportResult{
+				Status: KeystoreFailed,
+				Err:    errors.Wrap(err, "not a valid EIP-2335 keystore JSON file"),
+			}
+			continue
+		}
+		privKeyBytes, err := decryptor.Decrypt(keystore.Crypto, password)
+		if err != nil {
+			results[i] = &KeystoreImportResult{
+				Status: KeystoreFailed,
+				Err:    errors.Wrap(err, "could not decrypt keystore"),
+			}
+			continue
+		}
+		pubKeyBytes, err := keystorePublicKey(keystore, privKeyBytes)
+		if err != nil {
+			results[i] = &KeystoreImportResult{Status: KeystoreFailed, Err: err}
+			continue
+		}
+		if seen[string(pubKeyBytes)] {
+			results[i] = &KeystoreImportResult{PublicKey: pubKeyBytes, Status: KeystoreDuplicate}
+			continue
+		}
+		seen[string(pubKeyBytes)] = true
+		privKeys = append(privKeys, privKeyBytes)
+		pubKeys = append(pubKeys, pubKeyBytes)
+		results[i] = &KeystoreImportResult{PublicKey: pubKeyBytes, Status: KeystoreImported}
+	}
+	if len(pubKeys) == 0 {
+		return results, nil
+	}
+	if err := km.ImportKeypairs(ctx, privKeys, pubKeys); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // ImportKeypairs directly into the keymanager.
 func (km *Keymanager) ImportKeypairs(ctx context.Context, privKeys, pubKeys [][]byte) error {
 	// Write the accounts to disk into a single keystore.
@@ -102,22 +195,28 @@ func (km *Keymanager) attemptDecryptKeystore(
 	if err != nil && !strings.Contains(err.Error(), "invalid checksum") {
 		return nil, nil, "", errors.Wrap(err, "could not decrypt keystore")
 	}
-	var pubKeyBytes []byte
-	// Attempt to use the pubkey present in the keystore itself as a field. If unavailable,
-	// then utilize the public key directly from the private key.
+	pubKeyBytes, err := keystorePublicKey(keystore, privKeyBytes)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return privKeyBytes, pubKeyBytes, password, nil
+}
+
+// Attempt to use the pubkey present in the keystore itself as a field. If unavailable,
+// then utilize the public key directly from the private key.
+func keystorePublicKey(keystore *keymanager.Keystore, privKeyBytes []byte) ([]byte, error) {
 	if keystore.Pubkey != "" {
-		pubKeyBytes, err = hex.DecodeString(keystore.Pubkey)
-		if err != nil {
-			return nil, nil, "", errors.Wrap(err, "could not decode pubkey from keystore")
-		}
-	} else {
-		privKey, err := bls.SecretKeyFromBytes(privKeyBytes)
+		pubKeyBytes, err := hex.DecodeString(keystore.Pubkey)
 		if err != nil {
-			return nil, nil, "", errors.Wrap(err, "could not initialize private key from bytes")
+			return nil, errors.Wrap(err, "could not decode pubkey from keystore")
 		}
-		pubKeyBytes = privKey.PublicKey().Marshal()
+		return pubKeyBytes, nil
 	}
-	return privKeyBytes, pubKeyBytes, password, nil
+	privKey, err := bls.SecretKeyFromBytes(privKeyBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize private key from bytes")
+	}
+	return privKey.PublicKey().Marshal(), nil
 }
 
 func initializeProgressBar(numItems int, msg string) *progressbar.ProgressBar {
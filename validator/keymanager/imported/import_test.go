@@ -152,3 +152,50 @@ func TestImportedKeymanager_ImportKeystores(t *testing.T) {
 	assert.Equal(t, numAccounts, len(store.PublicKeys))
 	assert.Equal(t, numAccounts, len(store.PrivateKeys))
 }
+
+func TestImportedKeymanager_BatchImportKeystores(t *testing.T) {
+	wallet := &mock.Wallet{
+		Files:          make(map[string]map[string][]byte),
+		WalletPassword: password,
+	}
+	dr := &Keymanager{
+		wallet:        wallet,
+		accountsStore: &accountStore{},
+	}
+	ctx := context.Background()
+
+	existing := createRandomKeystore(t, password)
+	require.NoError(t, dr.ImportKeystores(ctx, []*keymanager.Keystore{existing}, password))
+
+	encode := func(keystore *keymanager.Keystore) []byte {
+		enc, err := json.Marshal(keystore)
+		require.NoError(t, err)
+		return enc
+	}
+	fresh := createRandomKeystore(t, password)
+	encoded := [][]byte{
+		encode(fresh),
+		encode(existing),
+		[]byte("not a keystore"),
+		encode(createRandomKeystore(t, "wrongPassw0rd$1999")),
+		encode(fresh),
+	}
+	results, err := dr.BatchImportKeystores(ctx, encoded, password)
+	require.NoError(t, err)
+	require.Equal(t, len(encoded), len(results))
+	wanted := []KeystoreImportStatus{
+		KeystoreImported,
+		KeystoreDuplicate,
+		KeystoreFailed,
+		KeystoreFailed,
+		KeystoreDuplicate,
+	}
+	for i, want := range wanted {
+		assert.Equal(t, want, results[i].Status, "Unexpected status for keystore %d", i)
+	}
+	assert.Equal(t, fresh.Pubkey, fmt.Sprintf("%x", results[0].PublicKey))
+	assert.NotNil(t, results[2].Err)
+
+	// Only the existing and the freshly imported key should be in the wallet.
+	require.Equal(t, 2, len(dr.accountsStore.PublicKeys))
+}
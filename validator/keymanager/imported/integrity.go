@@ -0,0 +1,133 @@
+package imported
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// invalidAccount describes an account of the accounts store which cannot be used for signing.
+type invalidAccount struct {
+	index     int
+	publicKey []byte
+	reason    string
+	duplicate bool
+}
+
+func (a *invalidAccount) String() string {
+	return fmt.Sprintf("account %d (public key %#x): %s", a.index, bytesutil.Trunc(a.publicKey), a.reason)
+}
+
+// checkAccountsStore verifies that every private key of the store is a valid BLS secret key,
+// that every public key is a well-formed BLS public key matching its private key, and that no
+// public key is listed twice. It returns a store holding only the valid accounts, in their
+// original order, along with every invalid or duplicate account found.
+func checkAccountsStore(store *accountStore) (*accountStore, []*invalidAccount) {
+	valid := &accountStore{
+		PrivateKeys: make([][]byte, 0, len(store.PrivateKeys)),
+		PublicKeys:  make([][]byte, 0, len(store.PublicKeys)),
+	}
+	var invalid []*invalidAccount
+	seen := make(map[[48]byte]int, len(store.PublicKeys))
+	for i, pubKeyBytes := range store.PublicKeys {
+		reason := checkAccount(store.PrivateKeys[i], pubKeyBytes)
+		if reason != "" {
+			invalid = append(invalid, &invalidAccount{index: i, publicKey: pubKeyBytes, reason: reason})
+			continue
+		}
+		if first, ok := seen[bytesutil.ToBytes48(pubKeyBytes)]; ok {
+			invalid = append(invalid, &invalidAccount{
+				index:     i,
+				publicKey: pubKeyBytes,
+				reason:    fmt.Sprintf("duplicate of account %d", first),
+				duplicate: true,
+			})
+			continue
+		}
+		seen[bytesutil.ToBytes48(pubKeyBytes)] = i
+		valid.PrivateKeys = append(valid.PrivateKeys, store.PrivateKeys[i])
+		valid.PublicKeys = append(valid.PublicKeys, pubKeyBytes)
+	}
+	return valid, invalid
+}
+
+// checkAccount returns the reason the account cannot be used, or an empty string if it is valid.
+func checkAccount(privKeyBytes, pubKeyBytes []byte) string {
+	secretKey, err := bls.SecretKeyFromBytes(privKeyBytes)
+	if err != nil {
+		return fmt.Sprintf("invalid private key: %v", err)
+	}
+	if _, err := bls.PublicKeyFromBytes(pubKeyBytes); err != nil {
+		return fmt.Sprintf("malformed public key: %v", err)
+	}
+	if !bytes.Equal(secretKey.PublicKey().Marshal(), pubKeyBytes) {
+		return "public key does not match the private key"
+	}
+	return ""
+}
+
+// verifyAccountsStore checks the integrity of the accounts store and returns the accounts to
+// validate with. Duplicate accounts are logged and dropped, as the remaining copy holds the same
+// key. All invalid accounts are reported in a single error, unless the keymanager is configured
+// to skip them, in which case they are logged and the keymanager refuses to rewrite the accounts
+// store until they are fixed, as doing so would delete them from disk.
+func (km *Keymanager) verifyAccountsStore(store *accountStore) (*accountStore, error) {
+	valid, found := checkAccountsStore(store)
+	if len(found) == 0 {
+		km.skippedInvalidKeys = 0
+		return store, nil
+	}
+	var invalid []*invalidAccount
+	for _, a := range found {
+		if !a.duplicate {
+			invalid = append(invalid, a)
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			"index":     a.index,
+			"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(a.publicKey)),
+		}).Warn("Ignoring duplicate validator account")
+	}
+	if len(invalid) == 0 {
+		km.skippedInvalidKeys = 0
+		return valid, nil
+	}
+	if !km.skipInvalidKeys {
+		problems := make([]string, len(invalid))
+		for i, a := range invalid {
+			problems[i] = a.String()
+		}
+		return nil, errors.Errorf(
+			"found %d invalid accounts in keystore: %s", len(invalid), strings.Join(problems, "; "),
+		)
+	}
+	for _, a := range invalid {
+		log.WithFields(logrus.Fields{
+			"index":     a.index,
+			"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(a.publicKey)),
+			"reason":    a.reason,
+		}).Warn("Skipping invalid validator account")
+	}
+	log.Warnf(
+		"Skipped %d invalid accounts, continuing with the %d valid ones. Accounts cannot be imported or "+
+			"deleted until the invalid accounts are fixed", len(invalid), len(valid.PublicKeys),
+	)
+	km.skippedInvalidKeys = len(invalid)
+	return valid, nil
+}
+
+// checkWritable returns an error if rewriting the accounts store would drop skipped accounts.
+func (km *Keymanager) checkWritable() error {
+	if km.skippedInvalidKeys > 0 {
+		return errors.Errorf(
+			"cannot modify the accounts keystore while %d invalid accounts are skipped, "+
+				"as they would be deleted. Fix or remove them first", km.skippedInvalidKeys,
+		)
+	}
+	return nil
+}
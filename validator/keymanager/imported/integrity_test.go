@@ -0,0 +1,112 @@
+package imported
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func invalidAccountsStore(t *testing.T) *accountStore {
+	store := &accountStore{}
+	keys := make([]bls.SecretKey, 3)
+	for i := range keys {
+		key, err := bls.RandKey()
+		require.NoError(t, err)
+		keys[i] = key
+	}
+	add := func(privKey, pubKey []byte) {
+		store.PrivateKeys = append(store.PrivateKeys, privKey)
+		store.PublicKeys = append(store.PublicKeys, pubKey)
+	}
+	add(keys[0].Marshal(), keys[0].PublicKey().Marshal())
+	// Truncated private key.
+	add(keys[1].Marshal()[:10], keys[1].PublicKey().Marshal())
+	// Malformed public key.
+	add(keys[1].Marshal(), []byte{1, 2, 3})
+	// Public key of another private key.
+	add(keys[1].Marshal(), keys[2].PublicKey().Marshal())
+	add(keys[2].Marshal(), keys[2].PublicKey().Marshal())
+	// Duplicate account.
+	add(keys[0].Marshal(), keys[0].PublicKey().Marshal())
+	return store
+}
+
+func TestCheckAccountsStore(t *testing.T) {
+	store := invalidAccountsStore(t)
+	valid, invalid := checkAccountsStore(store)
+	require.Equal(t, 4, len(invalid))
+	assert.Equal(t, 1, invalid[0].index)
+	assert.Equal(t, true, strings.HasPrefix(invalid[0].reason, "invalid private key"))
+	assert.Equal(t, 2, invalid[1].index)
+	assert.Equal(t, true, strings.HasPrefix(invalid[1].reason, "malformed public key"))
+	assert.Equal(t, 3, invalid[2].index)
+	assert.Equal(t, "public key does not match the private key", invalid[2].reason)
+	assert.Equal(t, 5, invalid[3].index)
+	assert.Equal(t, "duplicate of account 0", invalid[3].reason)
+	assert.Equal(t, true, invalid[3].duplicate)
+
+	require.Equal(t, 2, len(valid.PublicKeys))
+	assert.DeepEqual(t, [][]byte{store.PublicKeys[0], store.PublicKeys[4]}, valid.PublicKeys)
+	assert.DeepEqual(t, [][]byte{store.PrivateKeys[0], store.PrivateKeys[4]}, valid.PrivateKeys)
+}
+
+func TestVerifyAccountsStore_ReportsAllProblems(t *testing.T) {
+	km := &Keymanager{}
+	_, err := km.verifyAccountsStore(invalidAccountsStore(t))
+	require.ErrorContains(t, "found 3 invalid accounts in keystore", err)
+	for _, reason := range []string{"account 1", "account 2", "account 3"} {
+		assert.ErrorContains(t, reason, err)
+	}
+}
+
+func TestVerifyAccountsStore_IgnoresDuplicates(t *testing.T) {
+	hook := logTest.NewGlobal()
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	store := &accountStore{
+		PrivateKeys: [][]byte{key.Marshal(), key.Marshal()},
+		PublicKeys:  [][]byte{key.PublicKey().Marshal(), key.PublicKey().Marshal()},
+	}
+	km := &Keymanager{}
+	verified, err := km.verifyAccountsStore(store)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(verified.PublicKeys))
+	require.LogsContain(t, hook, "Ignoring duplicate validator account")
+	require.NoError(t, km.checkWritable())
+}
+
+func TestVerifyAccountsStore_SkipInvalidKeys(t *testing.T) {
+	hook := logTest.NewGlobal()
+	km := &Keymanager{skipInvalidKeys: true}
+	store, err := km.verifyAccountsStore(invalidAccountsStore(t))
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(store.PublicKeys))
+	assert.Equal(t, 2, len(store.PrivateKeys))
+	require.LogsContain(t, hook, "Skipping invalid validator account")
+	require.LogsContain(t, hook, "Skipped 3 invalid accounts, continuing with the 2 valid ones")
+	require.LogsContain(t, hook, "Ignoring duplicate validator account")
+
+	// Rewriting the accounts store would delete the skipped accounts from disk.
+	_, err = km.CreateAccountsKeystore(context.Background(), nil, nil)
+	assert.ErrorContains(t, "cannot modify the accounts keystore while 3 invalid accounts are skipped", err)
+	err = km.DeleteAccounts(context.Background(), [][]byte{store.PublicKeys[0]})
+	assert.ErrorContains(t, "cannot modify the accounts keystore while 3 invalid accounts are skipped", err)
+}
+
+func TestVerifyAccountsStore_Valid(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	store := &accountStore{
+		PrivateKeys: [][]byte{key.Marshal()},
+		PublicKeys:  [][]byte{key.PublicKey().Marshal()},
+	}
+	km := &Keymanager{}
+	verified, err := km.verifyAccountsStore(store)
+	require.NoError(t, err)
+	assert.Equal(t, store, verified)
+}
@@ -42,6 +42,8 @@ type Keymanager struct {
 	wallet              iface.Wallet
 	accountsStore       *accountStore
 	accountsChangedFeed *event.Feed
+	skipInvalidKeys     bool
+	skippedInvalidKeys  int
 }
 
 // SetupConfig includes configuration values for initializing
@@ -49,6 +51,9 @@ type Keymanager struct {
 type SetupConfig struct {
 	Wallet           iface.Wallet
 	ListenForChanges bool
+	// SkipInvalidKeys drops the accounts which fail the integrity check of the
+	// accounts store, instead of failing to initialize the keymanager.
+	SkipInvalidKeys bool
 }
 
 // Defines a struct containing 1-to-1 corresponding
@@ -81,6 +86,7 @@ func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		wallet:              cfg.Wallet,
 		accountsStore:       &accountStore{},
 		accountsChangedFeed: new(event.Feed),
+		skipInvalidKeys:     cfg.SkipInvalidKeys,
 	}
 
 	if err := k.initializeAccountKeystore(ctx); err != nil {
@@ -159,6 +165,9 @@ func (km *Keymanager) initializeKeysCachesFromKeystore() error {
 
 // DeleteAccounts takes in public keys and removes the accounts entirely. This includes their disk keystore and cached keystore.
 func (km *Keymanager) DeleteAccounts(ctx context.Context, publicKeys [][]byte) error {
+	if err := km.checkWritable(); err != nil {
+		return err
+	}
 	for _, publicKey := range publicKeys {
 		var index int
 		var found bool
@@ -287,6 +296,10 @@ func (km *Keymanager) initializeAccountKeystore(ctx context.Context) error {
 	if len(store.PublicKeys) == 0 {
 		return nil
 	}
+	store, err = km.verifyAccountsStore(store)
+	if err != nil {
+		return err
+	}
 	km.accountsStore = store
 	err = km.initializeKeysCachesFromKeystore()
 	if err != nil {
@@ -300,6 +313,9 @@ func (km *Keymanager) CreateAccountsKeystore(
 	_ context.Context,
 	privateKeys, publicKeys [][]byte,
 ) (*AccountsKeystoreRepresentation, error) {
+	if err := km.checkWritable(); err != nil {
+		return nil, err
+	}
 	encryptor := keystorev4.New()
 	id, err := uuid.NewRandom()
 	if err != nil {
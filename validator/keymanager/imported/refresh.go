@@ -105,6 +105,10 @@ func (km *Keymanager) reloadAccountsFromKeystore(keystore *AccountsKeystoreRepre
 	if len(newAccountsStore.PublicKeys) != len(newAccountsStore.PrivateKeys) {
 		return errors.New("number of public and private keys in keystore do not match")
 	}
+	newAccountsStore, err = km.verifyAccountsStore(newAccountsStore)
+	if err != nil {
+		return err
+	}
 	pubKeys := make([][48]byte, len(km.accountsStore.PublicKeys))
 	for i := 0; i < len(km.accountsStore.PrivateKeys); i++ {
 		privKey, err := bls.SecretKeyFromBytes(km.accountsStore.PrivateKeys[i])
@@ -0,0 +1,125 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	signPath       = "/api/v1/eth2/sign/"
+	// maxResponseSize bounds how much of a Web3Signer response body is read.
+	maxResponseSize = 1 << 20
+)
+
+// errRetryable marks a request failure that is worth retrying, such as a
+// timeout or a server side error.
+var errRetryable = errors.New("retryable web3signer error")
+
+// errTimeout marks a request which timed out, it is retryable.
+var errTimeout = fmt.Errorf("%w: request timed out", errRetryable)
+
+// client is a minimal HTTP client for the Web3Signer eth2 signing API.
+type client struct {
+	baseURL    string
+	httpClient *http.Client
+	maxRetries int
+	retryDelay time.Duration
+}
+
+// publicKeys returns the hex encoded public keys available for signing in the remote signer.
+func (c *client) publicKeys(ctx context.Context) ([]string, error) {
+	var keys []string
+	if err := c.doWithRetries(ctx, http.MethodGet, publicKeysPath, nil, &keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// sign submits a signing request for the given hex encoded public key and
+// returns the hex encoded signature.
+func (c *client) sign(ctx context.Context, pubKey string, req *signRequestJSON) (string, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal sign request")
+	}
+	resp := &signResponseJSON{}
+	if err := c.doWithRetries(ctx, http.MethodPost, signPath+pubKey, body, resp); err != nil {
+		return "", err
+	}
+	return resp.Signature, nil
+}
+
+func (c *client) doWithRetries(ctx context.Context, method, path string, body []byte, result interface{}) error {
+	var err error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			log.WithError(err).WithField("attempt", attempt).Debug("Retrying web3signer request")
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(c.retryDelay):
+			}
+		}
+		err = c.do(ctx, method, path, body, result)
+		if err == nil || !errors.Is(err, errRetryable) {
+			return err
+		}
+	}
+	return err
+}
+
+func (c *client) do(ctx context.Context, method, path string, body []byte, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		var netErr net.Error
+		if ctx.Err() == nil && errors.As(err, &netErr) && netErr.Timeout() {
+			return fmt.Errorf("%w: %v", errTimeout, err)
+		}
+		return errors.Wrap(err, "could not reach web3signer")
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	respBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return errors.Wrap(err, "could not read web3signer response")
+	}
+	switch {
+	case resp.StatusCode == http.StatusOK:
+	case resp.StatusCode == http.StatusPreconditionFailed:
+		// Web3Signer rejects requests that would violate its slashing protection with a 412.
+		return ErrSigningDenied
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return fmt.Errorf("%w: status %d: %s", errRetryable, resp.StatusCode, respBody)
+	default:
+		return fmt.Errorf("web3signer returned status %d: %s", resp.StatusCode, respBody)
+	}
+	// The sign endpoint answers with a bare hex signature unless JSON is negotiated,
+	// so accept both representations.
+	if sig, ok := result.(*signResponseJSON); ok && !bytes.HasPrefix(bytes.TrimSpace(respBody), []byte("{")) {
+		sig.Signature = string(bytes.TrimSpace(respBody))
+		return nil
+	}
+	if err := json.Unmarshal(respBody, result); err != nil {
+		return errors.Wrap(err, "could not decode web3signer response")
+	}
+	return nil
+}
@@ -0,0 +1,341 @@
+// Package web3signer defines a keymanager implementation which forwards signing
+// requests to a remote Web3Signer instance over HTTP, using the standardized
+// eth2 signing JSON API. Validating keys never leave the remote signer.
+package web3signer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+)
+
+const (
+	// DefaultTimeout for a single request to the remote signer.
+	DefaultTimeout = 2 * time.Second
+	// DefaultMaxRetries for a request to the remote signer which timed out or failed server side.
+	DefaultMaxRetries = 3
+	// DefaultRetryDelay between two attempts of the same request.
+	DefaultRetryDelay = 250 * time.Millisecond
+)
+
+var (
+	// ErrSigningFailed defines a failure from the remote signer
+	// when performing a signing operation.
+	ErrSigningFailed = errors.New("signing failed in the remote signer")
+	// ErrSigningDenied defines a signing request which was refused by
+	// the slashing protection of the remote signer.
+	ErrSigningDenied = errors.New("signing request was denied by remote signer")
+	// ErrSigningTimeout defines a signing request to which the remote
+	// signer did not answer in time.
+	ErrSigningTimeout = errors.New("signing request to remote signer timed out")
+)
+
+// ForkInfoProvider returns the fork information to attach to a signing request.
+type ForkInfoProvider func(ctx context.Context) (*ForkInfo, error)
+
+// SetupConfig includes configuration values for initializing
+// a Web3Signer keymanager.
+type SetupConfig struct {
+	// BaseURL of the Web3Signer instance, such as http://localhost:9000.
+	BaseURL string
+	// Timeout for a single request, defaults to DefaultTimeout.
+	Timeout time.Duration
+	// MaxRetries for timed out or server side failed requests, defaults to DefaultMaxRetries.
+	MaxRetries int
+	// RetryDelay between attempts, defaults to DefaultRetryDelay.
+	RetryDelay time.Duration
+	// ForkInfo, if set, is used to attach fork information to every signing request.
+	// Web3Signer needs it to verify the signing domain and apply slashing protection.
+	ForkInfo ForkInfoProvider
+}
+
+// Keymanager implementation using remote signing keys held by a Web3Signer.
+type Keymanager struct {
+	client     *client
+	forkInfo   ForkInfoProvider
+	keysLock   sync.RWMutex
+	publicKeys [][48]byte
+}
+
+// NewKeymanager instantiates a new Web3Signer keymanager and enumerates the
+// public keys available in the remote signer.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.BaseURL == "" {
+		return nil, errors.New("web3signer url is required")
+	}
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	maxRetries := cfg.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultMaxRetries
+	}
+	retryDelay := cfg.RetryDelay
+	if retryDelay == 0 {
+		retryDelay = DefaultRetryDelay
+	}
+	km := &Keymanager{
+		client: &client{
+			baseURL:    strings.TrimSuffix(cfg.BaseURL, "/"),
+			httpClient: &http.Client{Timeout: timeout},
+			maxRetries: maxRetries,
+			retryDelay: retryDelay,
+		},
+		forkInfo: cfg.ForkInfo,
+	}
+	if _, err := km.refreshPublicKeys(ctx); err != nil {
+		return nil, errors.Wrap(err, "could not fetch public keys from web3signer")
+	}
+	return km, nil
+}
+
+// FetchValidatingPublicKeys fetches the list of public keys available in the remote signer.
+func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	keys, err := km.refreshPublicKeys(ctx)
+	if err != nil {
+		// Fall back to the last known keys so a temporarily unreachable
+		// signer does not stop the validator client from performing duties.
+		log.WithError(err).Warn("Could not refresh public keys from web3signer, using cached keys")
+		km.keysLock.RLock()
+		defer km.keysLock.RUnlock()
+		return km.publicKeys, nil
+	}
+	return keys, nil
+}
+
+func (km *Keymanager) refreshPublicKeys(ctx context.Context) ([][48]byte, error) {
+	hexKeys, err := km.client.publicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([][48]byte, len(hexKeys))
+	for i, hexKey := range hexKeys {
+		raw, err := hexutil.Decode(hexKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", hexKey)
+		}
+		if len(raw) != 48 {
+			return nil, fmt.Errorf("public key %s has length %d, expected 48", hexKey, len(raw))
+		}
+		keys[i] = bytesutil.ToBytes48(raw)
+	}
+	km.keysLock.Lock()
+	km.publicKeys = keys
+	km.keysLock.Unlock()
+	return keys, nil
+}
+
+// Sign signs a message by forwarding it to the remote signer.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	body, err := signRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if km.forkInfo != nil {
+		body.ForkInfo, err = km.forkInfo(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get fork info")
+		}
+	}
+	sig, err := km.client.sign(ctx, hexutil.Encode(req.PublicKey), body)
+	if err != nil {
+		if errors.Is(err, ErrSigningDenied) {
+			return nil, err
+		}
+		log.WithError(err).Error("Could not sign with web3signer")
+		if errors.Is(err, errTimeout) || errors.Is(err, context.DeadlineExceeded) {
+			return nil, ErrSigningTimeout
+		}
+		return nil, ErrSigningFailed
+	}
+	rawSig, err := hexutil.Decode(sig)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode signature")
+	}
+	return bls.SignatureFromBytes(rawSig)
+}
+
+// SubscribeAccountChanges is currently NOT IMPLEMENTED for the Web3Signer keymanager.
+// INVOKING THIS FUNCTION HAS NO EFFECT!
+func (km *Keymanager) SubscribeAccountChanges(_ chan [][48]byte) event.Subscription {
+	return event.NewSubscription(func(i <-chan struct{}) error {
+		return nil
+	})
+}
+
+// signRequestBody maps a Prysm sign request to its Web3Signer JSON representation.
+func signRequestBody(req *validatorpb.SignRequest) (*signRequestJSON, error) {
+	body := &signRequestJSON{SigningRoot: hexutil.Encode(req.SigningRoot)}
+	switch obj := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		body.Type = blockSignType
+		body.BeaconBlock = &beaconBlockJSON{
+			Version: "PHASE0",
+			Block:   blockToJSON(obj.Block),
+		}
+	case *validatorpb.SignRequest_AttestationData:
+		body.Type = attestationSignType
+		body.Attestation = attestationDataToJSON(obj.AttestationData)
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		agg := obj.AggregateAttestationAndProof
+		body.Type = aggregateAndProofSignType
+		body.AggregateAndProof = &aggregateAndProofJSON{
+			AggregatorIndex: fmt.Sprintf("%d", agg.AggregatorIndex),
+			Aggregate:       attestationToJSON(agg.Aggregate),
+			SelectionProof:  hexutil.Encode(agg.SelectionProof),
+		}
+	case *validatorpb.SignRequest_Slot:
+		body.Type = aggregationSlotSignType
+		body.AggregationSlot = &aggregationSlotJSON{Slot: fmt.Sprintf("%d", obj.Slot)}
+	case *validatorpb.SignRequest_Epoch:
+		body.Type = randaoRevealSignType
+		body.RandaoReveal = &randaoRevealJSON{Epoch: fmt.Sprintf("%d", obj.Epoch)}
+	case *validatorpb.SignRequest_Exit:
+		body.Type = voluntaryExitSignType
+		body.VoluntaryExit = &voluntaryExitJSON{
+			Epoch:          fmt.Sprintf("%d", obj.Exit.Epoch),
+			ValidatorIndex: fmt.Sprintf("%d", obj.Exit.ValidatorIndex),
+		}
+	default:
+		return nil, fmt.Errorf("unsupported sign request object %T", obj)
+	}
+	return body, nil
+}
+
+func attestationDataToJSON(data *ethpb.AttestationData) *attestationDataJSON {
+	return &attestationDataJSON{
+		Slot:            fmt.Sprintf("%d", data.Slot),
+		Index:           fmt.Sprintf("%d", data.CommitteeIndex),
+		BeaconBlockRoot: hexutil.Encode(data.BeaconBlockRoot),
+		Source: &checkpointJSON{
+			Epoch: fmt.Sprintf("%d", data.Source.Epoch),
+			Root:  hexutil.Encode(data.Source.Root),
+		},
+		Target: &checkpointJSON{
+			Epoch: fmt.Sprintf("%d", data.Target.Epoch),
+			Root:  hexutil.Encode(data.Target.Root),
+		},
+	}
+}
+
+// NewForkInfo returns the fork information of a signing request from the fork and the
+// genesis validators root reported by the beacon node.
+func NewForkInfo(fork *ethpbv1.Fork, genesisValidatorsRoot []byte) *ForkInfo {
+	return &ForkInfo{
+		Fork: &Fork{
+			PreviousVersion: hexutil.Encode(fork.PreviousVersion),
+			CurrentVersion:  hexutil.Encode(fork.CurrentVersion),
+			Epoch:           fmt.Sprintf("%d", fork.Epoch),
+		},
+		GenesisValidatorsRoot: hexutil.Encode(genesisValidatorsRoot),
+	}
+}
+
+func blockToJSON(b *ethpb.BeaconBlock) *blockJSON {
+	body := &blockBodyJSON{
+		RandaoReveal: hexutil.Encode(b.Body.RandaoReveal),
+		Eth1Data: &eth1DataJSON{
+			DepositRoot:  hexutil.Encode(b.Body.Eth1Data.DepositRoot),
+			DepositCount: fmt.Sprintf("%d", b.Body.Eth1Data.DepositCount),
+			BlockHash:    hexutil.Encode(b.Body.Eth1Data.BlockHash),
+		},
+		Graffiti:          hexutil.Encode(b.Body.Graffiti),
+		ProposerSlashings: make([]*proposerSlashingJSON, len(b.Body.ProposerSlashings)),
+		AttesterSlashings: make([]*attesterSlashingJSON, len(b.Body.AttesterSlashings)),
+		Attestations:      make([]*attestationJSON, len(b.Body.Attestations)),
+		Deposits:          make([]*depositJSON, len(b.Body.Deposits)),
+		VoluntaryExits:    make([]*signedVoluntaryExitJSON, len(b.Body.VoluntaryExits)),
+	}
+	for i, s := range b.Body.ProposerSlashings {
+		body.ProposerSlashings[i] = &proposerSlashingJSON{
+			SignedHeader1: signedHeaderToJSON(s.Header_1),
+			SignedHeader2: signedHeaderToJSON(s.Header_2),
+		}
+	}
+	for i, s := range b.Body.AttesterSlashings {
+		body.AttesterSlashings[i] = &attesterSlashingJSON{
+			Attestation1: indexedAttestationToJSON(s.Attestation_1),
+			Attestation2: indexedAttestationToJSON(s.Attestation_2),
+		}
+	}
+	for i, att := range b.Body.Attestations {
+		body.Attestations[i] = attestationToJSON(att)
+	}
+	for i, d := range b.Body.Deposits {
+		proof := make([]string, len(d.Proof))
+		for j, p := range d.Proof {
+			proof[j] = hexutil.Encode(p)
+		}
+		body.Deposits[i] = &depositJSON{
+			Proof: proof,
+			Data: &depositDataJSON{
+				Pubkey:                hexutil.Encode(d.Data.PublicKey),
+				WithdrawalCredentials: hexutil.Encode(d.Data.WithdrawalCredentials),
+				Amount:                fmt.Sprintf("%d", d.Data.Amount),
+				Signature:             hexutil.Encode(d.Data.Signature),
+			},
+		}
+	}
+	for i, e := range b.Body.VoluntaryExits {
+		body.VoluntaryExits[i] = &signedVoluntaryExitJSON{
+			Message: &voluntaryExitJSON{
+				Epoch:          fmt.Sprintf("%d", e.Exit.Epoch),
+				ValidatorIndex: fmt.Sprintf("%d", e.Exit.ValidatorIndex),
+			},
+			Signature: hexutil.Encode(e.Signature),
+		}
+	}
+	return &blockJSON{
+		Slot:          fmt.Sprintf("%d", b.Slot),
+		ProposerIndex: fmt.Sprintf("%d", b.ProposerIndex),
+		ParentRoot:    hexutil.Encode(b.ParentRoot),
+		StateRoot:     hexutil.Encode(b.StateRoot),
+		Body:          body,
+	}
+}
+
+func signedHeaderToJSON(h *ethpb.SignedBeaconBlockHeader) *signedBeaconBlockHeaderJSON {
+	return &signedBeaconBlockHeaderJSON{
+		Message: &beaconBlockHeaderJSON{
+			Slot:          fmt.Sprintf("%d", h.Header.Slot),
+			ProposerIndex: fmt.Sprintf("%d", h.Header.ProposerIndex),
+			ParentRoot:    hexutil.Encode(h.Header.ParentRoot),
+			StateRoot:     hexutil.Encode(h.Header.StateRoot),
+			BodyRoot:      hexutil.Encode(h.Header.BodyRoot),
+		},
+		Signature: hexutil.Encode(h.Signature),
+	}
+}
+
+func indexedAttestationToJSON(att *ethpb.IndexedAttestation) *indexedAttestationJSON {
+	indices := make([]string, len(att.AttestingIndices))
+	for i, index := range att.AttestingIndices {
+		indices[i] = fmt.Sprintf("%d", index)
+	}
+	return &indexedAttestationJSON{
+		AttestingIndices: indices,
+		Data:             attestationDataToJSON(att.Data),
+		Signature:        hexutil.Encode(att.Signature),
+	}
+}
+
+func attestationToJSON(att *ethpb.Attestation) *attestationJSON {
+	return &attestationJSON{
+		AggregationBits: hexutil.Encode(att.AggregationBits),
+		Data:            attestationDataToJSON(att.Data),
+		Signature:       hexutil.Encode(att.Signature),
+	}
+}
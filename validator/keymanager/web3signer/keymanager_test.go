@@ -0,0 +1,218 @@
+package web3signer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethpbv1 "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockSigner struct {
+	secretKey   bls.SecretKey
+	failures    int32
+	deny        bool
+	lastRequest *signRequestJSON
+}
+
+func (m *mockSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	pubKey := hexutil.Encode(m.secretKey.PublicKey().Marshal())
+	if atomic.AddInt32(&m.failures, -1) >= 0 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	switch r.URL.Path {
+	case publicKeysPath:
+		if err := json.NewEncoder(w).Encode([]string{pubKey}); err != nil {
+			panic(err)
+		}
+	case signPath + pubKey:
+		if m.deny {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		req := &signRequestJSON{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m.lastRequest = req
+		root, err := hexutil.Decode(req.SigningRoot)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if _, err := fmt.Fprint(w, hexutil.Encode(m.secretKey.Sign(root).Marshal())); err != nil {
+			panic(err)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func setupSigner(t *testing.T) (*mockSigner, *httptest.Server) {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	signer := &mockSigner{secretKey: secretKey}
+	srv := httptest.NewServer(signer)
+	t.Cleanup(srv.Close)
+	return signer, srv
+}
+
+func TestNewKeymanager_FetchesPublicKeys(t *testing.T) {
+	signer, srv := setupSigner(t)
+	km, err := NewKeymanager(context.Background(), &SetupConfig{BaseURL: srv.URL, RetryDelay: time.Millisecond})
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+	assert.DeepEqual(t, signer.secretKey.PublicKey().Marshal(), keys[0][:])
+}
+
+func TestNewKeymanager_RequiresURL(t *testing.T) {
+	_, err := NewKeymanager(context.Background(), &SetupConfig{})
+	require.ErrorContains(t, "web3signer url is required", err)
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	signer, srv := setupSigner(t)
+	km, err := NewKeymanager(context.Background(), &SetupConfig{BaseURL: srv.URL, RetryDelay: time.Millisecond})
+	require.NoError(t, err)
+
+	root := [32]byte{1, 2, 3}
+	data := &ethpb.AttestationData{
+		Slot:            5,
+		BeaconBlockRoot: make([]byte, 32),
+		Source:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Epoch: 2, Root: make([]byte, 32)},
+	}
+	sig, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   signer.secretKey.PublicKey().Marshal(),
+		SigningRoot: root[:],
+		Object:      &validatorpb.SignRequest_AttestationData{AttestationData: data},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(signer.secretKey.PublicKey(), root[:]))
+	assert.Equal(t, attestationSignType, signer.lastRequest.Type)
+	assert.Equal(t, "2", signer.lastRequest.Attestation.Target.Epoch)
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 3
+	_, err = km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   signer.secretKey.PublicKey().Marshal(),
+		SigningRoot: root[:],
+		Object:      &validatorpb.SignRequest_Block{Block: blk.Block},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, blockSignType, signer.lastRequest.Type)
+	assert.Equal(t, "PHASE0", signer.lastRequest.BeaconBlock.Version)
+	assert.Equal(t, "3", signer.lastRequest.BeaconBlock.Block.Slot)
+	assert.Equal(t, hexutil.Encode(blk.Block.Body.RandaoReveal), signer.lastRequest.BeaconBlock.Block.Body.RandaoReveal)
+}
+
+func TestKeymanager_Sign_ForkInfo(t *testing.T) {
+	signer, srv := setupSigner(t)
+	genesisValidatorsRoot := [32]byte{'a'}
+	fork := &ethpbv1.Fork{
+		PreviousVersion: []byte{0, 0, 0, 1},
+		CurrentVersion:  []byte{0, 0, 0, 2},
+		Epoch:           10,
+	}
+	km, err := NewKeymanager(context.Background(), &SetupConfig{
+		BaseURL:    srv.URL,
+		RetryDelay: time.Millisecond,
+		ForkInfo: func(_ context.Context) (*ForkInfo, error) {
+			return NewForkInfo(fork, genesisValidatorsRoot[:]), nil
+		},
+	})
+	require.NoError(t, err)
+
+	root := [32]byte{1, 2, 3}
+	_, err = km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   signer.secretKey.PublicKey().Marshal(),
+		SigningRoot: root[:],
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 10},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, signer.lastRequest.ForkInfo)
+	assert.Equal(t, "0x00000001", signer.lastRequest.ForkInfo.Fork.PreviousVersion)
+	assert.Equal(t, "0x00000002", signer.lastRequest.ForkInfo.Fork.CurrentVersion)
+	assert.Equal(t, "10", signer.lastRequest.ForkInfo.Fork.Epoch)
+	assert.Equal(t, hexutil.Encode(genesisValidatorsRoot[:]), signer.lastRequest.ForkInfo.GenesisValidatorsRoot)
+}
+
+func TestKeymanager_Sign_RetriesServerErrors(t *testing.T) {
+	signer, srv := setupSigner(t)
+	km, err := NewKeymanager(context.Background(), &SetupConfig{BaseURL: srv.URL, RetryDelay: time.Millisecond})
+	require.NoError(t, err)
+
+	atomic.StoreInt32(&signer.failures, 2)
+	root := [32]byte{4}
+	sig, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   signer.secretKey.PublicKey().Marshal(),
+		SigningRoot: root[:],
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(signer.secretKey.PublicKey(), root[:]))
+
+	// Exhausting all retries surfaces a signing failure.
+	atomic.StoreInt32(&signer.failures, DefaultMaxRetries+1)
+	_, err = km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   signer.secretKey.PublicKey().Marshal(),
+		SigningRoot: root[:],
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	assert.ErrorContains(t, ErrSigningFailed.Error(), err)
+}
+
+func TestKeymanager_Sign_Denied(t *testing.T) {
+	signer, srv := setupSigner(t)
+	km, err := NewKeymanager(context.Background(), &SetupConfig{BaseURL: srv.URL, RetryDelay: time.Millisecond})
+	require.NoError(t, err)
+
+	signer.deny = true
+	_, err = km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   signer.secretKey.PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Slot{Slot: 1},
+	})
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+}
+
+func TestKeymanager_Sign_Timeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == publicKeysPath {
+			_, err := fmt.Fprint(w, "[]")
+			require.NoError(t, err)
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}))
+	t.Cleanup(srv.Close)
+	km, err := NewKeymanager(context.Background(), &SetupConfig{
+		BaseURL:    srv.URL,
+		Timeout:    10 * time.Millisecond,
+		MaxRetries: 1,
+		RetryDelay: time.Millisecond,
+	})
+	require.NoError(t, err)
+	_, err = km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   make([]byte, 48),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Slot{Slot: 1},
+	})
+	assert.ErrorContains(t, ErrSigningTimeout.Error(), err)
+}
@@ -0,0 +1,158 @@
+package web3signer
+
+// Signing request types understood by the Web3Signer eth2 signing API.
+const (
+	blockSignType             = "BLOCK_V2"
+	attestationSignType       = "ATTESTATION"
+	aggregateAndProofSignType = "AGGREGATE_AND_PROOF"
+	aggregationSlotSignType   = "AGGREGATION_SLOT"
+	randaoRevealSignType      = "RANDAO_REVEAL"
+	voluntaryExitSignType     = "VOLUNTARY_EXIT"
+)
+
+// ForkInfo is the fork information Web3Signer uses to compute and verify
+// the signing domain of a request.
+type ForkInfo struct {
+	Fork                  *Fork  `json:"fork"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+}
+
+// Fork in the standard eth2 JSON representation.
+type Fork struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+
+// signRequestJSON is the body of a POST /api/v1/eth2/sign/{identifier} request.
+type signRequestJSON struct {
+	Type              string                 `json:"type"`
+	ForkInfo          *ForkInfo              `json:"fork_info,omitempty"`
+	SigningRoot       string                 `json:"signingRoot"`
+	BeaconBlock       *beaconBlockJSON       `json:"beacon_block,omitempty"`
+	Attestation       *attestationDataJSON   `json:"attestation,omitempty"`
+	AggregateAndProof *aggregateAndProofJSON `json:"aggregate_and_proof,omitempty"`
+	AggregationSlot   *aggregationSlotJSON   `json:"aggregation_slot,omitempty"`
+	RandaoReveal      *randaoRevealJSON      `json:"randao_reveal,omitempty"`
+	VoluntaryExit     *voluntaryExitJSON     `json:"voluntary_exit,omitempty"`
+}
+
+type signResponseJSON struct {
+	Signature string `json:"signature"`
+}
+
+// beaconBlockJSON is the block of a BLOCK_V2 request. Phase 0 blocks are sent in full, the
+// block header is only accepted for later forks.
+type beaconBlockJSON struct {
+	Version string     `json:"version"`
+	Block   *blockJSON `json:"block"`
+}
+
+type blockJSON struct {
+	Slot          string         `json:"slot"`
+	ProposerIndex string         `json:"proposer_index"`
+	ParentRoot    string         `json:"parent_root"`
+	StateRoot     string         `json:"state_root"`
+	Body          *blockBodyJSON `json:"body"`
+}
+
+type blockBodyJSON struct {
+	RandaoReveal      string                     `json:"randao_reveal"`
+	Eth1Data          *eth1DataJSON              `json:"eth1_data"`
+	Graffiti          string                     `json:"graffiti"`
+	ProposerSlashings []*proposerSlashingJSON    `json:"proposer_slashings"`
+	AttesterSlashings []*attesterSlashingJSON    `json:"attester_slashings"`
+	Attestations      []*attestationJSON         `json:"attestations"`
+	Deposits          []*depositJSON             `json:"deposits"`
+	VoluntaryExits    []*signedVoluntaryExitJSON `json:"voluntary_exits"`
+}
+
+type eth1DataJSON struct {
+	DepositRoot  string `json:"deposit_root"`
+	DepositCount string `json:"deposit_count"`
+	BlockHash    string `json:"block_hash"`
+}
+
+type proposerSlashingJSON struct {
+	SignedHeader1 *signedBeaconBlockHeaderJSON `json:"signed_header_1"`
+	SignedHeader2 *signedBeaconBlockHeaderJSON `json:"signed_header_2"`
+}
+
+type signedBeaconBlockHeaderJSON struct {
+	Message   *beaconBlockHeaderJSON `json:"message"`
+	Signature string                 `json:"signature"`
+}
+
+type attesterSlashingJSON struct {
+	Attestation1 *indexedAttestationJSON `json:"attestation_1"`
+	Attestation2 *indexedAttestationJSON `json:"attestation_2"`
+}
+
+type indexedAttestationJSON struct {
+	AttestingIndices []string             `json:"attesting_indices"`
+	Data             *attestationDataJSON `json:"data"`
+	Signature        string               `json:"signature"`
+}
+
+type depositJSON struct {
+	Proof []string         `json:"proof"`
+	Data  *depositDataJSON `json:"data"`
+}
+
+type depositDataJSON struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	Signature             string `json:"signature"`
+}
+
+type signedVoluntaryExitJSON struct {
+	Message   *voluntaryExitJSON `json:"message"`
+	Signature string             `json:"signature"`
+}
+
+type beaconBlockHeaderJSON struct {
+	Slot          string `json:"slot"`
+	ProposerIndex string `json:"proposer_index"`
+	ParentRoot    string `json:"parent_root"`
+	StateRoot     string `json:"state_root"`
+	BodyRoot      string `json:"body_root"`
+}
+
+type checkpointJSON struct {
+	Epoch string `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+type attestationDataJSON struct {
+	Slot            string          `json:"slot"`
+	Index           string          `json:"index"`
+	BeaconBlockRoot string          `json:"beacon_block_root"`
+	Source          *checkpointJSON `json:"source"`
+	Target          *checkpointJSON `json:"target"`
+}
+
+type attestationJSON struct {
+	AggregationBits string               `json:"aggregation_bits"`
+	Data            *attestationDataJSON `json:"data"`
+	Signature       string               `json:"signature"`
+}
+
+type aggregateAndProofJSON struct {
+	AggregatorIndex string           `json:"aggregator_index"`
+	Aggregate       *attestationJSON `json:"aggregate"`
+	SelectionProof  string           `json:"selection_proof"`
+}
+
+type aggregationSlotJSON struct {
+	Slot string `json:"slot"`
+}
+
+type randaoRevealJSON struct {
+	Epoch string `json:"epoch"`
+}
+
+type voluntaryExitJSON struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
@@ -13,7 +13,9 @@ import (
 	"sync"
 	"syscall"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
@@ -34,6 +36,7 @@ import (
 	g "github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/prysmaticlabs/prysm/validator/rpc"
 	"github.com/prysmaticlabs/prysm/validator/rpc/gateway"
 	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection"
@@ -174,6 +177,16 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 		if err != nil {
 			return errors.Wrap(err, "could not generate interop keys")
 		}
+	} else if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
+		keyManager, err = web3signer.NewKeymanager(cliCtx.Context, &web3signer.SetupConfig{
+			BaseURL:  cliCtx.String(flags.Web3SignerURLFlag.Name),
+			Timeout:  cliCtx.Duration(flags.Web3SignerTimeoutFlag.Name),
+			ForkInfo: c.web3SignerForkInfo,
+		})
+		if err != nil {
+			return errors.Wrap(err, "could not initialize web3signer keymanager")
+		}
+		log.WithField("url", cliCtx.String(flags.Web3SignerURLFlag.Name)).Info("Using web3signer for remote signing")
 	} else {
 		// Read the wallet from the specified path.
 		w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
@@ -187,7 +200,10 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 			"wallet":          w.AccountsDir(),
 			"keymanager-kind": w.KeymanagerKind().String(),
 		}).Info("Opened validator wallet")
-		keyManager, err = w.InitializeKeymanager(cliCtx.Context, accountsiface.InitKeymanagerConfig{ListenForChanges: true})
+		keyManager, err = w.InitializeKeymanager(cliCtx.Context, accountsiface.InitKeymanagerConfig{
+			ListenForChanges: true,
+			SkipInvalidKeys:  cliCtx.Bool(flags.SkipInvalidWalletKeysFlag.Name),
+		})
 		if err != nil {
 			return errors.Wrap(err, "could not read keymanager for wallet")
 		}
@@ -283,7 +299,10 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 			"wallet":          w.AccountsDir(),
 			"keymanager-kind": w.KeymanagerKind().String(),
 		}).Info("Opened validator wallet")
-		keyManager, err = w.InitializeKeymanager(cliCtx.Context, accountsiface.InitKeymanagerConfig{ListenForChanges: true})
+		keyManager, err = w.InitializeKeymanager(cliCtx.Context, accountsiface.InitKeymanagerConfig{
+			ListenForChanges: true,
+			SkipInvalidKeys:  cliCtx.Bool(flags.SkipInvalidWalletKeysFlag.Name),
+		})
 		if err != nil {
 			return errors.Wrap(err, "could not read keymanager for wallet")
 		}
@@ -374,10 +393,30 @@ func (c *ValidatorClient) registerPrometheusService(cliCtx *cli.Context) error {
 	return c.services.RegisterService(service)
 }
 
+// web3SignerForkInfo returns the fork information attached to the requests sent to Web3Signer,
+// as reported by the beacon node the validator service is connected to.
+func (c *ValidatorClient) web3SignerForkInfo(ctx context.Context) (*web3signer.ForkInfo, error) {
+	var vs *client.ValidatorService
+	if err := c.services.FetchService(&vs); err != nil {
+		return nil, err
+	}
+	fork, genesisValidatorsRoot, err := vs.ForkInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return web3signer.NewForkInfo(fork, genesisValidatorsRoot), nil
+}
+
 func (c *ValidatorClient) registerValidatorService(
 	keyManager keymanager.IKeymanager,
 ) error {
 	endpoint := c.cliCtx.String(flags.BeaconRPCProviderFlag.Name)
+	var broadcastEndpoints []string
+	for _, e := range strings.Split(c.cliCtx.String(flags.BeaconRPCBroadcastProvidersFlag.Name), ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			broadcastEndpoints = append(broadcastEndpoints, e)
+		}
+	}
 	dataDir := c.cliCtx.String(cmd.DataDirFlag.Name)
 	logValidatorBalances := !c.cliCtx.Bool(flags.DisablePenaltyRewardLogFlag.Name)
 	emitAccountMetrics := !c.cliCtx.Bool(flags.DisableAccountMetricsFlag.Name)
@@ -386,6 +425,17 @@ func (c *ValidatorClient) registerValidatorService(
 	maxCallRecvMsgSize := c.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	grpcRetries := c.cliCtx.Uint(flags.GrpcRetriesFlag.Name)
 	grpcRetryDelay := c.cliCtx.Duration(flags.GrpcRetryDelayFlag.Name)
+	aggregationOffset := c.cliCtx.Float64(flags.AggregationSlotOffsetFlag.Name)
+	if aggregationOffset <= 0 || aggregationOffset >= 1 {
+		return fmt.Errorf("--%s must be between 0 and 1, got %v", flags.AggregationSlotOffsetFlag.Name, aggregationOffset)
+	}
+	var withdrawalAddresses []common.Address
+	for _, addr := range c.cliCtx.StringSlice(flags.WithdrawalAddressAllowlistFlag.Name) {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("--%s must only contain eth1 addresses, got %q", flags.WithdrawalAddressAllowlistFlag.Name, addr)
+		}
+		withdrawalAddresses = append(withdrawalAddresses, common.HexToAddress(addr))
+	}
 	var sp *slashingprotection.Service
 	var protector iface.Protector
 	if err := c.services.FetchService(&sp); err == nil {
@@ -393,17 +443,20 @@ func (c *ValidatorClient) registerValidatorService(
 	}
 
 	gStruct := &g.Graffiti{}
+	var gStore *g.Store
 	var err error
 	if c.cliCtx.IsSet(flags.GraffitiFileFlag.Name) {
-		n := c.cliCtx.String(flags.GraffitiFileFlag.Name)
-		gStruct, err = g.ParseGraffitiFile(n)
+		gStore, err = g.NewStore(c.cliCtx.String(flags.GraffitiFileFlag.Name))
 		if err != nil {
-			log.WithError(err).Warn("Could not parse graffiti file")
+			return errors.Wrap(err, "could not load graffiti file")
 		}
+		gStruct = gStore.Graffiti()
+		go gStore.ReloadOnSignal(c.cliCtx.Context)
 	}
 
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
+		BroadcastEndpoints:         broadcastEndpoints,
 		DataDir:                    dataDir,
 		KeyManager:                 keyManager,
 		LogValidatorBalances:       logValidatorBalances,
@@ -419,7 +472,18 @@ func (c *ValidatorClient) registerValidatorService(
 		UseWeb:                     c.cliCtx.Bool(flags.EnableWebFlag.Name),
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
+		GraffitiStore:              gStore,
 		LogDutyCountDown:           c.cliCtx.Bool(flags.EnableDutyCountDown.Name),
+		DoppelgangerEpochs:         types.Epoch(c.cliCtx.Uint64(flags.DoppelgangerEpochsFlag.Name)),
+		CheckWithdrawalCredentials: c.cliCtx.Bool(flags.CheckWithdrawalCredentialsFlag.Name),
+		WithdrawalAddresses:        withdrawalAddresses,
+		LateBlockThreshold:         c.cliCtx.Duration(flags.LateBlockThresholdFlag.Name),
+		MinPeers:                   c.cliCtx.Uint64(flags.MinBeaconNodePeersFlag.Name),
+		MinPeersTimeout:            c.cliCtx.Duration(flags.MinBeaconNodePeersTimeoutFlag.Name),
+		SkipDutiesWhenUnsynced:     c.cliCtx.Bool(flags.SkipDutiesWhenNodeUnsyncedFlag.Name),
+		MaxHeadLag:                 types.Slot(c.cliCtx.Uint64(flags.MaxNodeHeadLagFlag.Name)),
+		AggregationSlotOffset:      aggregationOffset,
+		BlockProductionTimeout:     c.cliCtx.Duration(flags.BlockProductionTimeoutFlag.Name),
 	})
 
 	if err != nil {
@@ -0,0 +1,47 @@
+package rpc
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetNextEpochDuties returns the assignments of the managed validator keys for the next epoch,
+// from the duties the validator client already maintains. It never signs: the aggregator status
+// is only reported once the validator client has signed the selection proof on its own.
+func (s *Server) GetNextEpochDuties(ctx context.Context, _ *ptypes.Empty) (*pb.NextEpochDutiesResponse, error) {
+	epoch, duties, err := s.validatorService.NextEpochDuties(ctx)
+	switch {
+	case errors.Is(err, client.ErrValidatorNotStarted):
+		return nil, status.Error(codes.FailedPrecondition, "Validator client is not started yet")
+	case errors.Is(err, client.ErrDutiesNotReady):
+		return nil, status.Errorf(codes.Unavailable, "Duties for epoch %d are not known yet", epoch)
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "Could not get next epoch duties: %v", err)
+	}
+	resp := &pb.NextEpochDutiesResponse{
+		Epoch:  uint64(epoch),
+		Duties: make([]*pb.NextEpochDuty, len(duties)),
+	}
+	for i, duty := range duties {
+		proposerSlots := make([]uint64, len(duty.ProposerSlots))
+		for j, slot := range duty.ProposerSlots {
+			proposerSlots[j] = uint64(slot)
+		}
+		resp.Duties[i] = &pb.NextEpochDuty{
+			PublicKey:       duty.PublicKey,
+			ValidatorIndex:  uint64(duty.ValidatorIndex),
+			ProposerSlots:   proposerSlots,
+			AttesterSlot:    uint64(duty.AttesterSlot),
+			CommitteeIndex:  uint64(duty.CommitteeIndex),
+			IsAggregator:    duty.IsAggregator,
+			AggregatorKnown: duty.AggregatorKnown,
+		}
+	}
+	return resp, nil
+}
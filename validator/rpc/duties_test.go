@@ -0,0 +1,19 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/validator/client"
+)
+
+var _ pb.BeaconServer = (*Server)(nil)
+
+func TestServer_GetNextEpochDuties_ValidatorNotStarted(t *testing.T) {
+	s := &Server{validatorService: &client.ValidatorService{}}
+	_, err := s.GetNextEpochDuties(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "Validator client is not started yet", err)
+}
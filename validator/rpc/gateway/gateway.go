@@ -60,6 +60,7 @@ func (g *Gateway) Start() {
 		pb.RegisterHealthHandlerFromEndpoint,
 		pb.RegisterAccountsHandlerFromEndpoint,
 		pb.RegisterBeaconHandlerFromEndpoint,
+		pb.RegisterMaintenanceHandlerFromEndpoint,
 	}
 	for _, h := range handlers {
 		if err := h(ctx, gwmux, g.remoteAddr, opts); err != nil {
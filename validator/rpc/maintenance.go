@@ -0,0 +1,71 @@
+package rpc
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Quiesce stops the validator client from starting new duties and waits for the duties in flight
+// to complete, so the validator client or its beacon node can be safely restarted for maintenance.
+func (s *Server) Quiesce(ctx context.Context, _ *ptypes.Empty) (*pb.MaintenanceStatusResponse, error) {
+	if err := s.validatorService.Quiesce(ctx); err != nil {
+		return nil, maintenanceError(err, "Could not quiesce validator client")
+	}
+	return s.GetMaintenanceStatus(ctx, &ptypes.Empty{})
+}
+
+// Resume lets the validator client perform its duties again after Quiesce.
+func (s *Server) Resume(ctx context.Context, _ *ptypes.Empty) (*pb.MaintenanceStatusResponse, error) {
+	if err := s.validatorService.Resume(); err != nil {
+		return nil, maintenanceError(err, "Could not resume validator client")
+	}
+	return s.GetMaintenanceStatus(ctx, &ptypes.Empty{})
+}
+
+// GetMaintenanceStatus reports whether the validator client is quiesced and safe to shut down.
+func (s *Server) GetMaintenanceStatus(_ context.Context, _ *ptypes.Empty) (*pb.MaintenanceStatusResponse, error) {
+	quiesced, inFlight, err := s.validatorService.MaintenanceStatus()
+	if err != nil {
+		return nil, maintenanceError(err, "Could not get maintenance status")
+	}
+	return &pb.MaintenanceStatusResponse{
+		Quiesced:       quiesced,
+		InFlightDuties: inFlight,
+		SafeToShutdown: quiesced && inFlight == 0,
+	}, nil
+}
+
+// SwitchBeaconNode points the validator client at other beacon node endpoints without restarting it.
+func (s *Server) SwitchBeaconNode(ctx context.Context, req *pb.SwitchBeaconNodeRequest) (*pb.SwitchBeaconNodeResponse, error) {
+	previous, endpoint, err := s.validatorService.SwitchBeaconNode(ctx, req.Endpoint)
+	if err != nil {
+		return nil, maintenanceError(err, "Could not switch beacon node")
+	}
+	return &pb.SwitchBeaconNodeResponse{
+		PreviousEndpoint: previous,
+		Endpoint:         endpoint,
+	}, nil
+}
+
+func maintenanceError(err error, msg string) error {
+	switch {
+	case errors.Is(err, client.ErrValidatorNotStarted):
+		return status.Error(codes.FailedPrecondition, "Validator client is not started yet")
+	case errors.Is(err, client.ErrInvalidBeaconNodeEndpoint):
+		return status.Errorf(codes.InvalidArgument, "%s: %v", msg, err)
+	case errors.Is(err, client.ErrBeaconNodeUnreachable):
+		return status.Errorf(codes.Unavailable, "%s: %v", msg, err)
+	case errors.Is(err, client.ErrBeaconNodeGenesisMismatch):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return status.Errorf(codes.DeadlineExceeded, "%s: duties still in flight", msg)
+	default:
+		return status.Errorf(codes.Internal, "%s: %v", msg, err)
+	}
+}
@@ -0,0 +1,25 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/validator/client"
+)
+
+var _ pb.MaintenanceServer = (*Server)(nil)
+
+func TestServer_Quiesce_ValidatorNotStarted(t *testing.T) {
+	s := &Server{validatorService: &client.ValidatorService{}}
+	_, err := s.Quiesce(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "Validator client is not started yet", err)
+	_, err = s.Resume(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "Validator client is not started yet", err)
+	_, err = s.GetMaintenanceStatus(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "Validator client is not started yet", err)
+	_, err = s.SwitchBeaconNode(context.Background(), &pb.SwitchBeaconNodeRequest{Endpoint: "127.0.0.1:4000"})
+	assert.ErrorContains(t, "Validator client is not started yet", err)
+}
@@ -0,0 +1,308 @@
+package slashingprotection
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/validator/accounts/prompt"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+)
+
+// onChainHistory is the signing history of a validator found on chain.
+type onChainHistory struct {
+	proposalSlots []types.Slot
+	// Source epochs of the included attestations, by target epoch.
+	sourceByTarget map[types.Epoch]types.Epoch
+}
+
+// RegenerateSlashingProtectionCLI rebuilds the slashing protection history of the wallet
+// validators from their attestations and blocks included on chain, as reported by the beacon
+// node. Anything signed but never included is missed, so it must be explicitly acknowledged.
+//
+// Steps:
+// 1. Check the user acknowledged the history is incomplete.
+// 2. Open the wallet to get the validating public keys.
+// 3. Open or create the validator database.
+// 4. Scan the recent chain history for each validator.
+// 5. Save the found history and raise the lowest signed watermarks to it.
+func RegenerateSlashingProtectionCLI(cliCtx *cli.Context) error {
+	if !cliCtx.Bool(flags.AcceptIncompleteSlashingProtectionFlag.Name) {
+		return fmt.Errorf(
+			"regenerating the slashing protection history from the chain misses anything signed but never "+
+				"included, please acknowledge it with the --%s flag",
+			flags.AcceptIncompleteSlashingProtectionFlag.Name,
+		)
+	}
+	log.Warn("The regenerated slashing protection history only contains the attestations and blocks included " +
+		"on chain. Anything signed but never included, or signed by another instance of the validators, is not " +
+		"captured and could still be signed again")
+
+	var err error
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	if !cliCtx.IsSet(cmd.DataDirFlag.Name) {
+		dataDir, err = prompt.InputDirectory(cliCtx, prompt.DataDirDirPromptText, cmd.DataDirFlag)
+		if err != nil {
+			return err
+		}
+	}
+
+	w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	km, err := w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	if err != nil {
+		return errors.Wrap(err, "could not initialize keymanager")
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating public keys")
+	}
+	if len(pubKeys) == 0 {
+		return errors.New("wallet is empty, no slashing protection history to regenerate")
+	}
+
+	dialOpts := client.ConstructDialOptions(
+		cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
+		cliCtx.String(flags.CertFlag.Name),
+		cliCtx.Uint(flags.GrpcRetriesFlag.Name),
+		cliCtx.Duration(flags.GrpcRetryDelayFlag.Name),
+	)
+	if dialOpts == nil {
+		return errors.New("failed to construct dial options")
+	}
+	grpcHeaders := strings.Split(cliCtx.String(flags.GrpcHeadersFlag.Name), ",")
+	ctx := grpcutils.AppendHeaders(cliCtx.Context, grpcHeaders)
+	conn, err := grpc.DialContext(ctx, cliCtx.String(flags.BeaconRPCProviderFlag.Name), dialOpts...)
+	if err != nil {
+		return errors.Wrapf(err, "could not dial endpoint %s", cliCtx.String(flags.BeaconRPCProviderFlag.Name))
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to the beacon node")
+		}
+	}()
+
+	valDB, err := kv.NewKVStore(ctx, dataDir, &kv.Config{PubKeys: pubKeys})
+	if err != nil {
+		return errors.Wrapf(err, "could not access validator database at path: %s", dataDir)
+	}
+	defer func() {
+		if err := valDB.Close(); err != nil {
+			log.WithError(err).Errorf("Could not close validator DB")
+		}
+	}()
+
+	return regenerateSlashingProtection(
+		ctx,
+		valDB,
+		ethpb.NewBeaconChainClient(conn),
+		pubKeys,
+		types.Epoch(cliCtx.Uint64(flags.SlashingProtectionHistoryEpochsFlag.Name)),
+	)
+}
+
+// regenerateSlashingProtection scans the given number of epochs before the chain head for the
+// blocks proposed and the attestations included for the public keys, then saves them to the
+// database. The lowest signed watermarks are only ever raised, so existing history is kept.
+func regenerateSlashingProtection(
+	ctx context.Context,
+	valDB db.Database,
+	beaconClient ethpb.BeaconChainClient,
+	pubKeys [][48]byte,
+	historyEpochs types.Epoch,
+) error {
+	histories, err := onChainHistories(ctx, beaconClient, pubKeys, historyEpochs)
+	if err != nil {
+		return err
+	}
+	for pubKey, history := range histories {
+		// Without the signing roots, the found messages are saved with a zero signing
+		// root, which the slashing protection considers different from anything signed.
+		zeroRoot := params.BeaconConfig().ZeroHash
+		var highestSlot types.Slot
+		for _, slot := range history.proposalSlots {
+			if err := valDB.SaveProposalHistoryForSlot(ctx, pubKey, slot, zeroRoot[:]); err != nil {
+				return errors.Wrapf(err, "could not save proposal of %#x", pubKey)
+			}
+			if slot > highestSlot {
+				highestSlot = slot
+			}
+		}
+		if len(history.proposalSlots) > 0 {
+			if err := valDB.RaiseLowestSignedProposal(ctx, pubKey, highestSlot); err != nil {
+				return errors.Wrapf(err, "could not raise lowest signed proposal of %#x", pubKey)
+			}
+		}
+
+		signingRoots := make([][32]byte, 0, len(history.sourceByTarget))
+		atts := make([]*ethpb.IndexedAttestation, 0, len(history.sourceByTarget))
+		var highestSource, highestTarget types.Epoch
+		for target, source := range history.sourceByTarget {
+			signingRoots = append(signingRoots, zeroRoot)
+			atts = append(atts, &ethpb.IndexedAttestation{
+				Data: &ethpb.AttestationData{
+					Source: &ethpb.Checkpoint{Epoch: source},
+					Target: &ethpb.Checkpoint{Epoch: target},
+				},
+			})
+			if source > highestSource {
+				highestSource = source
+			}
+			if target > highestTarget {
+				highestTarget = target
+			}
+		}
+		if len(atts) > 0 {
+			if err := valDB.SaveAttestationsForPubKey(ctx, pubKey, signingRoots, atts); err != nil {
+				return errors.Wrapf(err, "could not save attestations of %#x", pubKey)
+			}
+			if err := valDB.RaiseLowestSignedEpochs(ctx, pubKey, highestSource, highestTarget); err != nil {
+				return errors.Wrapf(err, "could not raise lowest signed epochs of %#x", pubKey)
+			}
+		}
+		log.WithFields(logrus.Fields{
+			"publicKey":    fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+			"proposals":    len(history.proposalSlots),
+			"attestations": len(atts),
+		}).Info("Regenerated slashing protection history")
+	}
+	log.Info("Slashing protection history successfully regenerated")
+	return nil
+}
+
+// onChainHistories returns the history found on chain of the public keys known to the beacon node.
+func onChainHistories(
+	ctx context.Context,
+	beaconClient ethpb.BeaconChainClient,
+	pubKeys [][48]byte,
+	historyEpochs types.Epoch,
+) (map[[48]byte]*onChainHistory, error) {
+	head, err := beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get chain head")
+	}
+	pubKeysByIndex, err := validatorIndices(ctx, beaconClient, pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	histories := make(map[[48]byte]*onChainHistory, len(pubKeysByIndex))
+	for _, pubKey := range pubKeysByIndex {
+		histories[pubKey] = &onChainHistory{sourceByTarget: make(map[types.Epoch]types.Epoch)}
+	}
+
+	startEpoch := types.Epoch(0)
+	if head.HeadEpoch > historyEpochs {
+		startEpoch = head.HeadEpoch - historyEpochs
+	}
+	committees := make(map[types.Epoch]*ethpb.BeaconCommittees)
+	for epoch := startEpoch; epoch <= head.HeadEpoch; epoch++ {
+		blks, err := blocksAtEpoch(ctx, beaconClient, epoch)
+		if err != nil {
+			return nil, err
+		}
+		for _, blk := range blks {
+			if pubKey, ok := pubKeysByIndex[blk.Block.ProposerIndex]; ok {
+				histories[pubKey].proposalSlots = append(histories[pubKey].proposalSlots, blk.Block.Slot)
+			}
+			for _, att := range blk.Block.Body.Attestations {
+				attEpoch := att.Data.Target.Epoch
+				if _, ok := committees[attEpoch]; !ok {
+					committees[attEpoch], err = beaconClient.ListBeaconCommittees(ctx, &ethpb.ListCommitteesRequest{
+						QueryFilter: &ethpb.ListCommitteesRequest_Epoch{Epoch: attEpoch},
+					})
+					if err != nil {
+						return nil, errors.Wrapf(err, "could not get committees of epoch %d", attEpoch)
+					}
+				}
+				for _, idx := range attestingIndices(committees[attEpoch], att) {
+					if pubKey, ok := pubKeysByIndex[idx]; ok {
+						histories[pubKey].sourceByTarget[att.Data.Target.Epoch] = att.Data.Source.Epoch
+					}
+				}
+			}
+		}
+	}
+	return histories, nil
+}
+
+// validatorIndices returns the public keys known to the beacon node by validator index.
+func validatorIndices(
+	ctx context.Context, beaconClient ethpb.BeaconChainClient, pubKeys [][48]byte,
+) (map[types.ValidatorIndex][48]byte, error) {
+	rawPubKeys := make([][]byte, len(pubKeys))
+	for i := range pubKeys {
+		rawPubKeys[i] = pubKeys[i][:]
+	}
+	pubKeysByIndex := make(map[types.ValidatorIndex][48]byte, len(pubKeys))
+	req := &ethpb.ListValidatorsRequest{PublicKeys: rawPubKeys}
+	for {
+		resp, err := beaconClient.ListValidators(ctx, req)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list validators")
+		}
+		for _, val := range resp.ValidatorList {
+			pubKeysByIndex[val.Index] = bytesutil.ToBytes48(val.Validator.PublicKey)
+		}
+		if resp.NextPageToken == "" || len(resp.ValidatorList) == 0 {
+			return pubKeysByIndex, nil
+		}
+		req.PageToken = resp.NextPageToken
+	}
+}
+
+// blocksAtEpoch returns all the blocks known to the beacon node at the epoch, canonical or not.
+func blocksAtEpoch(
+	ctx context.Context, beaconClient ethpb.BeaconChainClient, epoch types.Epoch,
+) ([]*ethpb.SignedBeaconBlock, error) {
+	var blks []*ethpb.SignedBeaconBlock
+	req := &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: epoch}}
+	for {
+		resp, err := beaconClient.ListBlocks(ctx, req)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not list blocks of epoch %d", epoch)
+		}
+		for _, ctr := range resp.BlockContainers {
+			blks = append(blks, ctr.Block)
+		}
+		if resp.NextPageToken == "" || len(resp.BlockContainers) == 0 {
+			return blks, nil
+		}
+		req.PageToken = resp.NextPageToken
+	}
+}
+
+// attestingIndices returns the indices of the committee members who took part in the attestation.
+func attestingIndices(committees *ethpb.BeaconCommittees, att *ethpb.Attestation) []types.ValidatorIndex {
+	slotCommittees, ok := committees.Committees[uint64(att.Data.Slot)]
+	if !ok || uint64(att.Data.CommitteeIndex) >= uint64(len(slotCommittees.Committees)) {
+		return nil
+	}
+	committee := slotCommittees.Committees[att.Data.CommitteeIndex].ValidatorIndices
+	var indices []types.ValidatorIndex
+	for i, idx := range committee {
+		if uint64(i) < att.AggregationBits.Len() && att.AggregationBits.BitAt(uint64(i)) {
+			indices = append(indices, idx)
+		}
+	}
+	return indices
+}
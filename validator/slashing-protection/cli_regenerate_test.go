@@ -0,0 +1,108 @@
+package slashingprotection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestRegenerateSlashingProtection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	proposer := [48]byte{1}
+	idle := [48]byte{2}
+	unknown := [48]byte{3}
+	pubKeys := [][48]byte{proposer, idle, unknown}
+	validatorDB := dbTest.SetupDB(t, pubKeys)
+
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 3}, nil)
+	beaconClient.EXPECT().ListValidators(gomock.Any(), gomock.Any()).Return(&ethpb.Validators{
+		ValidatorList: []*ethpb.Validators_ValidatorContainer{
+			{Index: 5, Validator: &ethpb.Validator{PublicKey: proposer[:]}},
+			{Index: 7, Validator: &ethpb.Validator{PublicKey: idle[:]}},
+		},
+	}, nil)
+
+	// The proposer proposes a block, and attests as the second member of its committee.
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	attSlot := slotsPerEpoch + 3
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slotsPerEpoch + 8
+	blk.Block.ProposerIndex = 5
+	blk.Block.Body.Attestations = []*ethpb.Attestation{{
+		AggregationBits: bitfield.Bitlist{0b1110},
+		Data: testutil.HydrateAttestationData(&ethpb.AttestationData{
+			Slot:   attSlot,
+			Source: &ethpb.Checkpoint{Epoch: 0},
+			Target: &ethpb.Checkpoint{Epoch: 1},
+		}),
+	}}
+	for epoch := types.Epoch(1); epoch <= 3; epoch++ {
+		var containers []*ethpb.BeaconBlockContainer
+		if epoch == 1 {
+			containers = []*ethpb.BeaconBlockContainer{{Block: blk}}
+		}
+		beaconClient.EXPECT().ListBlocks(gomock.Any(), &ethpb.ListBlocksRequest{
+			QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: epoch},
+		}).Return(&ethpb.ListBlocksResponse{BlockContainers: containers}, nil)
+	}
+	beaconClient.EXPECT().ListBeaconCommittees(gomock.Any(), &ethpb.ListCommitteesRequest{
+		QueryFilter: &ethpb.ListCommitteesRequest_Epoch{Epoch: 1},
+	}).Return(&ethpb.BeaconCommittees{
+		Committees: map[uint64]*ethpb.BeaconCommittees_CommitteesList{
+			uint64(attSlot): {Committees: []*ethpb.BeaconCommittees_CommitteeItem{
+				{ValidatorIndices: []types.ValidatorIndex{9, 5, 8}},
+			}},
+		},
+	}, nil)
+
+	require.NoError(t, regenerateSlashingProtection(ctx, validatorDB, beaconClient, pubKeys, 2))
+
+	lowestProposal, exists, err := validatorDB.LowestSignedProposal(ctx, proposer)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, blk.Block.Slot, lowestProposal)
+	_, exists, err = validatorDB.ProposalHistoryForSlot(ctx, proposer, blk.Block.Slot)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+
+	lowestSource, exists, err := validatorDB.LowestSignedSourceEpoch(ctx, proposer)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(0), lowestSource)
+	lowestTarget, exists, err := validatorDB.LowestSignedTargetEpoch(ctx, proposer)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(1), lowestTarget)
+	slashingKind, err := validatorDB.CheckSlashableAttestation(ctx, proposer, [32]byte{'a'}, &ethpb.IndexedAttestation{
+		Data: testutil.HydrateAttestationData(&ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 0},
+			Target: &ethpb.Checkpoint{Epoch: 1},
+		}),
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, kv.DoubleVote, slashingKind)
+
+	for _, pubKey := range [][48]byte{idle, unknown} {
+		_, exists, err = validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+		require.NoError(t, err)
+		assert.Equal(t, false, exists)
+		_, exists, err = validatorDB.LowestSignedProposal(ctx, pubKey)
+		require.NoError(t, err)
+		assert.Equal(t, false, exists)
+	}
+}
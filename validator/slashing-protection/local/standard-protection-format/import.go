@@ -12,6 +12,7 @@ import (
 	types "github.com/prysmaticlabs/eth2-types"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slashutil"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
@@ -77,6 +78,11 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 	// We validate and filter out public keys parsed from JSON to ensure we are
 	// not importing those which are slashable with respect to other data within the same JSON.
 	slashableProposerKeys := filterSlashablePubKeysFromBlocks(ctx, proposalHistoryByPubKey)
+	conflictingProposerKeys, err := filterConflictingPubKeysFromBlocks(ctx, validatorDB, proposalHistoryByPubKey)
+	if err != nil {
+		return errors.Wrap(err, "could not filter conflicting proposer public keys from JSON data")
+	}
+	slashableProposerKeys = append(slashableProposerKeys, conflictingProposerKeys...)
 	slashableAttesterKeys, err := filterSlashablePubKeysFromAttestations(
 		ctx, validatorDB, attestingHistoryByPubKey,
 	)
@@ -98,6 +104,13 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 		return errors.Wrap(err, "could not save slashable public keys to database")
 	}
 
+	// Importing must never lower an existing protection watermark, so we take note of
+	// the current watermarks and restore the higher of both values after the import.
+	watermarks, err := existingWatermarks(ctx, validatorDB, proposalHistoryByPubKey, attestingHistoryByPubKey)
+	if err != nil {
+		return errors.Wrap(err, "could not read existing slashing protection watermarks")
+	}
+
 	// We save the histories to disk as atomic operations, ensuring that this only occurs
 	// until after we successfully parse all data from the JSON file. If there is any error
 	// in parsing the JSON proposal and attesting histories, we will not reach this point.
@@ -134,6 +147,66 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 			return errors.Wrap(err, "could not save attestations from imported JSON to database")
 		}
 	}
+	return restoreWatermarks(ctx, validatorDB, watermarks)
+}
+
+// lowestSignedWatermarks for a validator public key, only set if they existed in the database.
+type lowestSignedWatermarks struct {
+	proposalSlot *types.Slot
+	sourceEpoch  *types.Epoch
+	targetEpoch  *types.Epoch
+}
+
+func existingWatermarks(
+	ctx context.Context,
+	validatorDB db.Database,
+	proposalHistoryByPubKey map[[48]byte]kv.ProposalHistoryForPubkey,
+	attestingHistoryByPubKey map[[48]byte][]*kv.AttestationRecord,
+) (map[[48]byte]*lowestSignedWatermarks, error) {
+	watermarks := make(map[[48]byte]*lowestSignedWatermarks)
+	for pubKey := range proposalHistoryByPubKey {
+		slot, exists, err := validatorDB.LowestSignedProposal(ctx, pubKey)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			watermarks[pubKey] = &lowestSignedWatermarks{proposalSlot: &slot}
+		}
+	}
+	for pubKey := range attestingHistoryByPubKey {
+		source, sourceExists, err := validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+		if err != nil {
+			return nil, err
+		}
+		target, targetExists, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+		if err != nil {
+			return nil, err
+		}
+		if !sourceExists || !targetExists {
+			continue
+		}
+		if _, ok := watermarks[pubKey]; !ok {
+			watermarks[pubKey] = &lowestSignedWatermarks{}
+		}
+		watermarks[pubKey].sourceEpoch = &source
+		watermarks[pubKey].targetEpoch = &target
+	}
+	return watermarks, nil
+}
+
+func restoreWatermarks(ctx context.Context, validatorDB db.Database, watermarks map[[48]byte]*lowestSignedWatermarks) error {
+	for pubKey, w := range watermarks {
+		if w.proposalSlot != nil {
+			if err := validatorDB.RaiseLowestSignedProposal(ctx, pubKey, *w.proposalSlot); err != nil {
+				return errors.Wrapf(err, "could not restore lowest signed proposal for key %#x", pubKey)
+			}
+		}
+		if w.sourceEpoch != nil && w.targetEpoch != nil {
+			if err := validatorDB.RaiseLowestSignedEpochs(ctx, pubKey, *w.sourceEpoch, *w.targetEpoch); err != nil {
+				return errors.Wrapf(err, "could not restore lowest signed epochs for key %#x", pubKey)
+			}
+		}
+	}
 	return nil
 }
 
@@ -258,6 +331,44 @@ func filterSlashablePubKeysFromBlocks(ctx context.Context, historyByPubKey map[[
 	return slashablePubKeys
 }
 
+// filterConflictingPubKeysFromBlocks finds public keys for which the JSON contains a block at a
+// slot we already have a proposal for in our database with a different signing root, which is a
+// double proposal. The other blocks at slots we already have a proposal for, such as those with a
+// missing signing root on either side, are dropped from the import so our own history is kept.
+func filterConflictingPubKeysFromBlocks(
+	ctx context.Context,
+	validatorDB db.Database,
+	historyByPubKey map[[48]byte]kv.ProposalHistoryForPubkey,
+) ([][48]byte, error) {
+	conflictingPubKeys := make([][48]byte, 0)
+	zeroHash := params.BeaconConfig().ZeroHash
+	for pubKey, proposals := range historyByPubKey {
+		newProposals := make([]kv.Proposal, 0, len(proposals.Proposals))
+		conflicting := false
+		for _, blk := range proposals.Proposals {
+			signingRoot, exists, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, blk.Slot)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				newProposals = append(newProposals, blk)
+				continue
+			}
+			importedRoot := bytesutil.ToBytes32(blk.SigningRoot)
+			if signingRoot != zeroHash && importedRoot != zeroHash && signingRoot != importedRoot {
+				conflicting = true
+				break
+			}
+		}
+		if conflicting {
+			conflictingPubKeys = append(conflictingPubKeys, pubKey)
+			continue
+		}
+		historyByPubKey[pubKey] = kv.ProposalHistoryForPubkey{Proposals: newProposals}
+	}
+	return conflictingPubKeys, nil
+}
+
 func filterSlashablePubKeysFromAttestations(
 	ctx context.Context,
 	validatorDB db.Database,
@@ -167,6 +167,127 @@ func TestStore_ImportInterchangeData_OK(t *testing.T) {
 	}
 }
 
+func TestStore_ImportInterchangeData_DoesNotLowerWatermarks(t *testing.T) {
+	ctx := context.Background()
+	publicKeys, err := valtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	pubKey := publicKeys[0]
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+
+	// Our own history starts at higher slots and epochs than the imported data.
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 100, []byte{1}))
+	require.NoError(t, validatorDB.SaveAttestationsForPubKey(
+		ctx, pubKey, [][32]byte{{1}}, []*ethpb.IndexedAttestation{createAttestation(10, 11)},
+	))
+
+	pubKeyHex, err := pubKeyToHexString(pubKey[:])
+	require.NoError(t, err)
+	interchangeJSON := &format.EIPSlashingProtectionFormat{
+		Data: []*format.ProtectionData{
+			{
+				Pubkey:             pubKeyHex,
+				SignedBlocks:       []*format.SignedBlock{{Slot: "50"}},
+				SignedAttestations: []*format.SignedAttestation{{SourceEpoch: "5", TargetEpoch: "6"}},
+			},
+		},
+	}
+	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	interchangeJSON.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{})
+	blob, err := json.Marshal(interchangeJSON)
+	require.NoError(t, err)
+	require.NoError(t, ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(blob)))
+
+	// The imported records are merged into our history.
+	_, exists, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, 50)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+
+	// But the watermarks are the higher of the existing and imported values.
+	lowestSlot, _, err := validatorDB.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(100), lowestSlot)
+	lowestSource, _, err := validatorDB.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(10), lowestSource)
+	lowestTarget, _, err := validatorDB.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(11), lowestTarget)
+}
+
+func TestStore_ImportInterchangeData_ConflictingBlock_Blacklists(t *testing.T) {
+	ctx := context.Background()
+	publicKeys, err := valtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	pubKey := publicKeys[0]
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+
+	ourRoot := [32]byte{1}
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 100, ourRoot[:]))
+
+	pubKeyHex, err := pubKeyToHexString(pubKey[:])
+	require.NoError(t, err)
+	interchangeJSON := &format.EIPSlashingProtectionFormat{
+		Data: []*format.ProtectionData{
+			{
+				Pubkey:       pubKeyHex,
+				SignedBlocks: []*format.SignedBlock{{Slot: "100", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})}},
+			},
+		},
+	}
+	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	interchangeJSON.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{})
+	blob, err := json.Marshal(interchangeJSON)
+	require.NoError(t, err)
+	require.NoError(t, ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(blob)))
+
+	// Our own signing root is kept and the key is blacklisted.
+	root, _, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, 100)
+	require.NoError(t, err)
+	assert.Equal(t, ourRoot, root)
+	blacklisted, err := validatorDB.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blacklisted))
+	assert.Equal(t, pubKey, blacklisted[0])
+}
+
+func TestStore_ImportInterchangeData_MissingSigningRoot_DoesNotBlacklist(t *testing.T) {
+	ctx := context.Background()
+	publicKeys, err := valtest.CreateRandomPubKeys(1)
+	require.NoError(t, err)
+	pubKey := publicKeys[0]
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+
+	ourRoot := [32]byte{1}
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, 100, ourRoot[:]))
+
+	pubKeyHex, err := pubKeyToHexString(pubKey[:])
+	require.NoError(t, err)
+	interchangeJSON := &format.EIPSlashingProtectionFormat{
+		Data: []*format.ProtectionData{
+			{
+				Pubkey:       pubKeyHex,
+				SignedBlocks: []*format.SignedBlock{{Slot: "100"}, {Slot: "101"}},
+			},
+		},
+	}
+	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	interchangeJSON.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{})
+	blob, err := json.Marshal(interchangeJSON)
+	require.NoError(t, err)
+	require.NoError(t, ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(blob)))
+
+	// A missing signing root is not a double proposal, but our own signing root is kept.
+	root, _, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, 100)
+	require.NoError(t, err)
+	assert.Equal(t, ourRoot, root)
+	_, exists, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, 101)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	blacklisted, err := validatorDB.EIPImportBlacklistedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(blacklisted))
+}
+
 func Test_validateMetadata(t *testing.T) {
 	goodRoot := [32]byte{1}
 	goodStr := make([]byte, hex.EncodedLen(len(goodRoot)))